@@ -0,0 +1,50 @@
+package gu
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/a11y"
+)
+
+// AccessibilityWarning defines a function called with the accessibility issues
+// found in the markup of a view when it renders.
+type AccessibilityWarning func(view *NView, issues []a11y.Issue)
+
+// appAccessibility contains the warning and rules set by CheckAccessibility.
+type appAccessibility struct {
+	warn  AccessibilityWarning
+	rules []a11y.Rule
+}
+
+// CheckAccessibility sets the views of the app to check their markup for
+// accessibility issues with the rules of the trees/a11y package every time they
+// render, which default to a11y.DefaultRules. The issues found are passed to
+// warn, or written to stderr if warn is nil. It is meant for development, as
+// the checks run with every render.
+func (app *NApp) CheckAccessibility(warn AccessibilityWarning, rules ...a11y.Rule) *NApp {
+	app.accessibility = &appAccessibility{
+		warn:  warn,
+		rules: rules,
+	}
+
+	return app
+}
+
+// check checks the markup rendered by the view, warning of the issues found.
+func (a *appAccessibility) check(view *NView, markup *trees.Markup) {
+	issues := a11y.Check(markup, a.rules...)
+	if len(issues) == 0 {
+		return
+	}
+
+	if a.warn != nil {
+		a.warn(view, issues)
+		return
+	}
+
+	for _, issue := range issues {
+		fmt.Fprintf(os.Stderr, "gu: view %s: %s\n", view.uuid, issue)
+	}
+}
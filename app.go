@@ -3,7 +3,9 @@ package gu
 import (
 	"fmt"
 	"html/template"
+	"sync"
 
+	"github.com/gu-io/gu/common"
 	"github.com/gu-io/gu/drivers/core"
 	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/router"
@@ -14,10 +16,14 @@ import (
 // NApp defines a struct which encapsulates all the core view management functions
 // for views.
 type NApp struct {
+	// ml guards the route path, views, active views and components of the app,
+	// which are read by Inspect from other goroutines.
+	ml             sync.RWMutex
 	active         bool
 	title          string
 	uuid           string
 	location       Location
+	path           string
 	views          []*NView
 	activeViews    []*NView
 	tree           *trees.Markup
@@ -25,13 +31,28 @@ type NApp struct {
 	router         *router.Router
 	resourceHeader []*trees.Markup
 	resourceBody   []*trees.Markup
+	ids            trees.IDGenerator
+	styles         *appStyles
+	theme          []common.ThemeVariable
+	accessibility  *appAccessibility
 }
 
 // App creates a new app structure to rendering gu components.
 func App(title string, router *router.Router) *NApp {
+	return AppWithIDs(title, router, nil)
+}
+
+// AppWithIDs creates a new app structure which uses the provided IDGenerator for
+// the keys of the app, its views and components, and the hashes of their markup,
+// whose uids are derived from their position within their view (see
+// trees.AssignIDs). Using a trees.SeededIDs with the same seed on the server and
+// client, with views and components added in the same order, renders the app
+// with the same ids on both. A nil IDGenerator behaves as App.
+func AppWithIDs(title string, router *router.Router, ids trees.IDGenerator) *NApp {
 	var app NApp
+	app.ids = ids
 	app.title = title
-	app.uuid = NewKey()
+	app.uuid = app.newKey()
 	app.router = router
 	app.notifications = notifications.AppNotification(app.uuid)
 
@@ -40,11 +61,64 @@ func App(title string, router *router.Router) *NApp {
 	head = append(head, elems.Meta(trees.NewAttr("app-id", app.uuid)))
 	head = append(head, elems.Meta(trees.NewAttr("charset", "utf-8")))
 
+	for _, item := range head {
+		app.assignIDs(item)
+	}
+
 	app.resourceHeader = head
 
 	return &app
 }
 
+// newKey returns a new key from the IDGenerator of the app if set, else using
+// NewKey.
+func (app *NApp) newKey() string {
+	if app.ids != nil {
+		return app.ids.UID()
+	}
+
+	return NewKey()
+}
+
+// assignIDs sets the ids of the markup and its children from the IDGenerator of
+// the app if set.
+func (app *NApp) assignIDs(markup *trees.Markup) {
+	if app.ids == nil {
+		return
+	}
+
+	markup.SwapUID(app.newKey())
+	trees.AssignIDs(markup, app.ids)
+}
+
+// ExtractStyles sets the app to collect the stylesheets of the components
+// rendered by its views (see trees.CSSStylesheet) into a single stylesheet in
+// the head of the app, instead of each rendering its own style markup. The rules
+// of a stylesheet are only recomputed when its binding data changes, and
+// identical rules are written once. If href is not empty, the head links to the
+// stylesheet at href instead, which can be written for production with
+// StylesheetDirective.
+func (app *NApp) ExtractStyles(href string) *NApp {
+	app.styles = &appStyles{
+		href:  href,
+		views: make(map[string][]string),
+	}
+
+	return app
+}
+
+// SetTheme sets the css custom properties of the theme of the app, like those
+// returned by styleguide.Variables, which are rendered as a `:root` rule in the
+// head of the app. Drivers are notified through a ThemeUpdate to replace only
+// the markup of the rule, as rendered by ThemeRenderCommand.
+func (app *NApp) SetTheme(variables []common.ThemeVariable) {
+	app.theme = variables
+
+	notifications.Dispatch(ThemeUpdate{
+		App: app,
+	})
+}
+
 // Navigate sets the giving app location and also sets the location of the
 // NOOPLocation which returns that always.
 func (app *NApp) Navigate(pe router.PushDirectiveEvent) {
@@ -64,6 +138,14 @@ func (app *NApp) InitApp(location Location) {
 	app.location = location
 }
 
+// guard calls the giving function with the lock of the app held, for changes
+// to the state read by Inspect.
+func (app *NApp) guard(action func()) {
+	app.ml.Lock()
+	defer app.ml.Unlock()
+	action()
+}
+
 // Do calls the giving function providing it with the NApp instance.
 func (app *NApp) Do(appFun func(*NApp)) *NApp {
 	if appFun != nil {
@@ -114,7 +196,12 @@ func (app *NApp) ActivateRoute(es interface{}) {
 		pe = esm
 	}
 
-	app.activeViews = app.PushViews(pe)
+	views := app.PushViews(pe)
+
+	app.guard(func() {
+		app.path = pe.Path
+		app.activeViews = views
+	})
 }
 
 // AppJSON defines a struct which holds the giving sets of tree changes to be
@@ -164,9 +251,18 @@ func (app *NApp) RenderJSON(es interface{}) AppJSON {
 
 	tjson.Body = append(tjson.Body, afterBody...)
 
+	if app.theme != nil {
+		tjson.HeadResources = append(tjson.HeadResources, app.themeMarkup().TreeJSON())
+	}
+
+	if app.styles != nil {
+		tjson.HeadResources = append(tjson.HeadResources, app.styles.markup(app).TreeJSON())
+	}
+
 	script := trees.NewMarkup("script", false)
 	trees.NewAttr("type", "text/javascript").Apply(script)
 	trees.NewText(core.JavascriptDriverCore).Apply(script)
+	app.frameIDs(script, "script")
 	tjson.BodyResources = append(tjson.BodyResources, script.TreeJSON())
 
 	return tjson
@@ -208,6 +304,14 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 		}
 	}
 
+	if app.theme != nil {
+		head.AddChild(app.themeMarkup())
+	}
+
+	if app.styles != nil {
+		head.AddChild(app.styles.markup(app))
+	}
+
 	script := trees.NewMarkup("script", false)
 	trees.NewAttr("type", "text/javascript").Apply(script)
 	trees.NewText(core.JavascriptDriverCore).Apply(script)
@@ -218,9 +322,26 @@ func (app *NApp) Render(es interface{}) *trees.Markup {
 
 	body.AddChild(toBody...)
 
+	app.frameIDs(script, "script")
+	app.frameIDs(body, "body")
+	app.frameIDs(head, "head")
+	app.frameIDs(html, "html")
+
 	return html
 }
 
+// frameIDs sets the uid of the markup created by the app on every render from
+// the uuid of the app and the giving name, and its hash from the IDGenerator of
+// the app if set.
+func (app *NApp) frameIDs(markup *trees.Markup, name string) {
+	if app.ids == nil {
+		return
+	}
+
+	markup.SwapUID(app.uuid + "-" + name)
+	markup.SwapHash(app.ids.Hash(markup))
+}
+
 // PushViews returns a slice of  views that match and pass the provided path.
 func (app *NApp) PushViews(event router.PushEvent) []*NView {
 	// fmt.Printf("Routing Path: %s\n", event.Rem)
@@ -243,6 +364,8 @@ func (app *NApp) PushViews(event router.PushEvent) []*NView {
 // AddAsset adds giving tree.Markup has assets to be loaded either in the head
 // or body based on the posiiton desired.
 func (app *NApp) AddAsset(asset *trees.Markup, target ViewTarget) {
+	app.assignIDs(asset)
+
 	switch target {
 	case HeadTarget:
 		app.resourceHeader = append(app.resourceHeader, asset)
@@ -310,12 +433,21 @@ func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *
 	vw.root = app
 	vw.target = target
 	vw.base = base
-	vw.uuid = NewKey()
+	vw.uuid = app.newKey()
 	vw.appUUID = app.uuid
 	vw.Reactive = NewReactive()
+	vw.mounted = NewSubscriptions()
+	vw.rendered = NewSubscriptions()
+	vw.updated = NewSubscriptions()
+	vw.unmounted = NewSubscriptions()
 
 	vw.router = router.NewResolver(route)
 
+	// if the renderable can push reactions then update the view.
+	if rr, ok := base.(Reactor); ok {
+		rr.React(vw.Publish)
+	}
+
 	// app.driver.Update(app, &vw)
 	vw.React(func() {
 		notifications.Dispatch(ViewUpdate{
@@ -331,7 +463,9 @@ func (app *NApp) View(renderable interface{}, route string, target ViewTarget) *
 		vw.Unmounted()
 	})
 
-	app.views = append(app.views, &vw)
+	app.guard(func() {
+		app.views = append(app.views, &vw)
+	})
 
 	return &vw
 }
@@ -380,19 +514,46 @@ func (v *NView) totalComponents() int {
 // ViewJSON defines a struct which holds the giving sets of view changes to be
 // rendered.
 type ViewJSON struct {
-	AppID  string           `json:"AppID"`
-	ViewID string           `json:"ViewID"`
-	Tree   trees.MarkupJSON `json:"Tree"`
+	AppID  string            `json:"AppID"`
+	ViewID string            `json:"ViewID"`
+	Tree   trees.MarkupJSON  `json:"Tree"`
+	Styles *trees.MarkupJSON `json:"Styles,omitempty"`
 }
 
 // RenderJSON returns the ViewJSON for the provided View and its current events and
-// changes.
+// changes. If the app extracts the styles of its views, the stylesheet of the
+// app is sent along for drivers to replace.
 func (v *NView) RenderJSON() ViewJSON {
 	return ViewJSON{
 		AppID:  v.appUUID,
 		ViewID: v.uuid,
 		Tree:   v.Render().TreeJSON(),
+		Styles: v.stylesJSON(),
+	}
+}
+
+// PatchJSON returns the ViewJSON for the provided View, where the markup of
+// components found unchanged since their last render is left out for drivers
+// to keep the elements they already have. If the app extracts the styles of
+// its views, the stylesheet of the app is sent along for drivers to replace.
+func (v *NView) PatchJSON() ViewJSON {
+	return ViewJSON{
+		AppID:  v.appUUID,
+		ViewID: v.uuid,
+		Tree:   v.Render().PatchJSON(),
+		Styles: v.stylesJSON(),
+	}
+}
+
+// stylesJSON returns the stylesheet of the app if it extracts the styles of
+// its views into the head, which must be retrieved after rendering the view.
+func (v *NView) stylesJSON() *trees.MarkupJSON {
+	if v.root.styles == nil || v.root.styles.href != "" {
+		return nil
 	}
+
+	styles := v.root.styles.markup(v.root).TreeJSON()
+	return &styles
 }
 
 // Target returns the associated view target.
@@ -450,7 +611,20 @@ func (v *NView) Render() *trees.Markup {
 	}
 
 	base.SwapUID(v.uuid)
-	base.UpdateHash()
+
+	if v.root.ids != nil {
+		trees.AssignIDs(base, v.root.ids)
+	} else {
+		base.UpdateHash()
+	}
+
+	if v.root.styles != nil {
+		v.root.styles.extract(v.uuid, base)
+	}
+
+	if v.root.accessibility != nil {
+		v.root.accessibility.check(v, base)
+	}
 
 	return base
 }
@@ -506,6 +680,7 @@ func (v *NView) Services() Services {
 		Unmounted: v.unmounted,
 		Updated:   v.updated,
 		Rendered:  v.rendered,
+		JS:        JSChannel{AppUUID: v.appUUID},
 	}
 }
 
@@ -528,7 +703,8 @@ func (v *NView) Component(renderable interface{}, order RenderingOrder, route st
 	}
 
 	var c Component
-	c.uuid = NewKey()
+	c.root = v.root
+	c.uuid = v.root.newKey()
 	c.Target = target
 	c.Rendering = base
 	c.Reactive = NewReactive()
@@ -547,7 +723,7 @@ func (v *NView) Component(renderable interface{}, order RenderingOrder, route st
 
 	// format for the object.
 	// Add the component into the right order.
-	{
+	v.root.guard(func() {
 		switch order {
 		case FirstOrder:
 			v.beginComponents = append(v.beginComponents, &c)
@@ -556,7 +732,7 @@ func (v *NView) Component(renderable interface{}, order RenderingOrder, route st
 		case AnyOrder:
 			v.anyComponents = append(v.anyComponents, &c)
 		}
-	}
+	})
 }
 
 // Component defines a struct which
@@ -568,6 +744,7 @@ type Component struct {
 	Rendering Renderable
 	Router    router.Resolver
 
+	root *NApp
 	live *trees.Markup
 }
 
@@ -593,9 +770,13 @@ func (c *Component) Render() *trees.Markup {
 		live.Empty()
 	}
 
-	c.live = newTree.ApplyMorphers()
+	live := newTree.ApplyMorphers()
+
+	c.root.guard(func() {
+		c.live = live
+	})
 
-	return c.live
+	return live
 }
 
 // Disabled returns true/false if the giving view is disabled.
@@ -692,13 +873,14 @@ func (s *StaticView) UUID() string {
 	return s.uid
 }
 
-// Render returns the markup for the static view.
+// Render returns the markup for the static view, being a copy of its content so
+// the markup added into it by its view does not change the content.
 func (s *StaticView) Render() *trees.Markup {
 	if s.Morph {
-		return s.Content.ApplyMorphers()
+		return s.Content.Clone().ApplyMorphers()
 	}
 
-	return s.Content
+	return s.Content.Clone()
 }
 
 // RenderHTML returns the html template version of the StaticView content.
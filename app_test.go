@@ -0,0 +1,296 @@
+package gu_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/guttest"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/a11y"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/property"
+	"github.com/influx6/faux/tests"
+)
+
+// counter defines a Reactor component which publishes its changes to the view
+// rendering it.
+type counter struct {
+	gu.Reactive
+	count int
+}
+
+// Render returns the markup of the counter.
+func (c *counter) Render() *trees.Markup {
+	return elems.Span(elems.Text("%d", c.count))
+}
+
+func TestAppView(t *testing.T) {
+	app := gu.App("Views", nil)
+
+	component := &counter{Reactive: gu.NewReactive()}
+	view := app.View(component, "/*", gu.BodyTarget)
+
+	var mounted int
+	view.Services().Mounted.React(func() {
+		mounted++
+	})
+
+	view.Mounted()
+
+	if mounted != 1 {
+		tests.Failed("Should have published the mount of the view to its subscriptions: %d", mounted)
+	}
+	tests.Passed("Should have published the mount of the view to its subscriptions")
+
+	var updates int
+	remover := notifications.SubscribeWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+		if update.View == view {
+			updates++
+		}
+	}))
+	defer remover.Remove()
+
+	component.count++
+	component.Publish()
+
+	if updates != 1 {
+		tests.Failed("Should have updated the view when its Reactor component published: %d", updates)
+	}
+	tests.Passed("Should have updated the view when its Reactor component published")
+}
+
+// seededApp returns a app using a trees.SeededIDs of the giving seed.
+func seededApp(seed int64) *gu.NApp {
+	app := gu.AppWithIDs("Seeded", nil, trees.NewSeededIDs(seed))
+
+	app.View(elems.Div(
+		property.ClassAttr("wrapper"),
+		elems.Header1(elems.Text("Seeded App")),
+		elems.Paragraph(elems.Text("Rendered with the same ids")),
+	), "/*", gu.BodyTarget)
+
+	return app
+}
+
+func TestAppWithIDs(t *testing.T) {
+	first := guttest.Mount(t, seededApp(20), "/#")
+	defer first.Unmount()
+
+	second := guttest.Mount(t, seededApp(20), "/#")
+	defer second.Unmount()
+
+	if first.HTML() != second.HTML() {
+		tests.Failed("Should have rendered the same html for apps of the same seed:\n%s\n%s", first.HTML(), second.HTML())
+	}
+	tests.Passed("Should have rendered the same html for apps of the same seed")
+
+	other := guttest.Mount(t, seededApp(21), "/#")
+	defer other.Unmount()
+
+	if first.HTML() == other.HTML() {
+		tests.Failed("Should have rendered different html for apps of different seeds")
+	}
+	tests.Passed("Should have rendered different html for apps of different seeds")
+}
+
+func TestViewRenderCommand(t *testing.T) {
+	app := gu.App("Patch", nil)
+
+	view := app.View(elems.Div(property.ClassAttr("wrapper")), "/*", gu.BodyTarget)
+	view.Component(elems.Section(elems.Paragraph(elems.Text("Unchanged content"))), gu.AnyOrder, "/*", "")
+
+	first := gu.ViewPatchCommand(view)
+	if first.Command != "PatchView" || !strings.Contains(first.View.Tree.Markup, "Unchanged content") {
+		tests.Failed("Should have sent content of component on first render: %s", first.View.Tree.Markup)
+	}
+	tests.Passed("Should have sent content of component on first render")
+
+	second := gu.ViewPatchCommand(view)
+	if strings.Contains(second.View.Tree.Markup, "Unchanged content") || !strings.Contains(second.View.Tree.Markup, "NodeUnchanged") {
+		tests.Failed("Should have left out content of unchanged component: %s", second.View.Tree.Markup)
+	}
+	tests.Passed("Should have left out content of unchanged component")
+
+	full := gu.ViewRenderCommand(view)
+	if full.Command != "RenderView" || !strings.Contains(full.View.Tree.Markup, "Unchanged content") || strings.Contains(full.View.Tree.Markup, "NodeUnchanged") {
+		tests.Failed("Should have kept content of unchanged component in full render: %s", full.View.Tree.Markup)
+	}
+	tests.Passed("Should have kept content of unchanged component in full render")
+}
+
+func TestStaticViewRender(t *testing.T) {
+	content := elems.Div(property.ClassAttr("wrapper"))
+	static := gu.Static(content)
+
+	static.Render().AddChild(elems.Span(elems.Text("Added to copy")))
+
+	if len(content.Children()) != 0 {
+		tests.Failed("Should have kept the content apart from the rendered markup: %s", content.HTML())
+	}
+	tests.Passed("Should have kept the content apart from the rendered markup")
+
+	app := gu.App("Static", nil)
+
+	view := app.View(static, "/*", gu.BodyTarget)
+	view.Component(elems.Paragraph(elems.Text("Component text")), gu.AnyOrder, "/*", "")
+
+	view.Render()
+
+	if html := view.Render().HTML(); strings.Count(html, "Component text") != 1 {
+		tests.Failed("Should have not added components into the content across renders: %s", html)
+	}
+	tests.Passed("Should have not added components into the content across renders")
+
+	content.AddChild(elems.Span(elems.Text("Changed content")))
+
+	if html := view.Render().HTML(); !strings.Contains(html, "Changed content") {
+		tests.Failed("Should have rendered changes made to the content: %s", html)
+	}
+	tests.Passed("Should have rendered changes made to the content")
+}
+
+// styledItem returns a markup with a stylesheet scoped to it and a stylesheet
+// shared by all items.
+func styledItem(name string) *trees.Markup {
+	return elems.Div(
+		elems.CSS(`
+			& {
+				color: {{ .Color }};
+			}
+		`, struct{ Color string }{Color: "red"}, nil),
+		elems.CSS(`
+			.item {
+				margin: 0;
+			}
+		`, nil, nil),
+		elems.Span(elems.Text("%s", name)),
+	)
+}
+
+func TestExtractStyles(t *testing.T) {
+	app := gu.App("Styles", nil).ExtractStyles("")
+
+	view := app.View(elems.Div(property.ClassAttr("wrapper")), "/*", gu.BodyTarget)
+	view.Component(styledItem("first"), gu.AnyOrder, "/*", "")
+	view.Component(styledItem("second"), gu.AnyOrder, "/*", "")
+
+	html := app.Render("/").HTML()
+
+	if strings.Count(html, "<style") != 1 {
+		tests.Failed("Should have rendered a single stylesheet for the app: %s", html)
+	}
+	tests.Passed("Should have rendered a single stylesheet for the app")
+
+	if strings.Count(html, ".item {") != 1 {
+		tests.Failed("Should have written identical rules once: %s", html)
+	}
+	tests.Passed("Should have written identical rules once")
+
+	if strings.Count(html, "color: red;") != 2 {
+		tests.Failed("Should have written the rules of each owner: %s", html)
+	}
+	tests.Passed("Should have written the rules of each owner")
+
+	if strings.Index(html, "<style") > strings.Index(html, "<body") {
+		tests.Failed("Should have rendered the stylesheet in the head: %s", html)
+	}
+	tests.Passed("Should have rendered the stylesheet in the head")
+
+	full := gu.ViewRenderCommand(view)
+	if full.View.Styles == nil || !strings.Contains(full.View.Styles.Markup, ".item {") {
+		tests.Failed("Should have sent the stylesheet of the app along with the full view")
+	}
+	tests.Passed("Should have sent the stylesheet of the app along with the full view")
+
+	patch := gu.ViewPatchCommand(view)
+	if patch.View.Styles == nil || !strings.Contains(patch.View.Styles.Markup, ".item {") {
+		tests.Failed("Should have sent the stylesheet of the app along with the view")
+	}
+	tests.Passed("Should have sent the stylesheet of the app along with the view")
+
+	if strings.Contains(patch.View.Tree.Markup, "<style") {
+		tests.Failed("Should have left out the stylesheets of components: %s", patch.View.Tree.Markup)
+	}
+	tests.Passed("Should have left out the stylesheets of components")
+
+	linked := gu.App("Linked", nil).ExtractStyles("/css/app.css")
+	linked.View(styledItem("linked"), "/*", gu.BodyTarget)
+
+	html = linked.Render("/").HTML()
+	if strings.Contains(html, "<style") || !strings.Contains(html, `href="/css/app.css"`) {
+		tests.Failed("Should have linked to the stylesheet at href: %s", html)
+	}
+	tests.Passed("Should have linked to the stylesheet at href")
+
+	var content bytes.Buffer
+	if _, err := linked.StylesheetDirective("/", "public/css/app.css").Writer.WriteTo(&content); err != nil {
+		tests.Failed("Should have written the stylesheet of the app: %s", err)
+	}
+	tests.Passed("Should have written the stylesheet of the app")
+
+	if !strings.Contains(content.String(), ".item {") {
+		tests.Failed("Should have written the rules of the app into the directive: %s", content.String())
+	}
+	tests.Passed("Should have written the rules of the app into the directive")
+}
+
+func TestSetTheme(t *testing.T) {
+	app := gu.App("Themed", nil)
+	app.View(elems.Div(property.ClassAttr("wrapper")), "/*", gu.BodyTarget)
+
+	driver := guttest.Mount(t, app, "/#")
+	defer driver.Unmount()
+
+	if strings.Contains(driver.HTML(), ":root") {
+		tests.Failed("Should have rendered no theme variables without a theme")
+	}
+	tests.Passed("Should have rendered no theme variables without a theme")
+
+	renders := driver.Renders()
+
+	app.SetTheme([]common.ThemeVariable{
+		{Name: "--color-primary", Value: "#2196f3"},
+		{Name: "--radius-small", Value: "2px"},
+	})
+
+	if driver.Renders() != renders+1 {
+		tests.Failed("Should have notified the driver of the theme update")
+	}
+	tests.Passed("Should have notified the driver of the theme update")
+
+	if !strings.Contains(driver.HTML(), ":root {\n  --color-primary: #2196f3;\n  --radius-small: 2px;\n}") {
+		tests.Failed("Should have rendered the theme variables in the head: %s", driver.HTML())
+	}
+	tests.Passed("Should have rendered the theme variables in the head")
+
+	command := gu.ThemeRenderCommand(app)
+	if command.Command != "RenderTheme" || !strings.Contains(command.Theme.Markup, "--color-primary: #2196f3;") {
+		tests.Failed("Should have rendered only the theme variables: %#v", command)
+	}
+	tests.Passed("Should have rendered only the theme variables")
+
+	if strings.Contains(command.Theme.Markup, "wrapper") {
+		tests.Failed("Should have left out the views of the app: %s", command.Theme.Markup)
+	}
+	tests.Passed("Should have left out the views of the app")
+}
+
+func TestCheckAccessibility(t *testing.T) {
+	var warned []a11y.Issue
+
+	app := gu.App("Accessibility", nil).CheckAccessibility(func(view *gu.NView, issues []a11y.Issue) {
+		warned = append(warned, issues...)
+	})
+
+	app.View(elems.Div(elems.Image(property.SrcAttr("logo.png")), elems.Header1(trees.NewText("%s", "Title"))), "/*", gu.BodyTarget)
+	app.Render("/")
+
+	if len(warned) != 1 || warned[0].Rule != "img-alt" {
+		tests.Failed("Should have warned of issues of rendered views: %+v", warned)
+	}
+	tests.Passed("Should have warned of issues of rendered views")
+}
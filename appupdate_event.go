@@ -1,6 +1,8 @@
 package gu
 
-import "sync"
+import (
+	"sync"
+)
 
 // AppUpdateSubscriber defines a interface that which is used to subscribe specifically for
 // events  AppUpdate type.
@@ -31,9 +33,19 @@ func (sn *AppUpdateHandler) Receive(elem AppUpdate) {
 // Handle takes the giving value and asserts the expected value to match the
 // AppUpdate type then passes it to the Receive method.
 func (sn *AppUpdateHandler) Handle(receive interface{}) {
-	if elem, ok := receive.(AppUpdate); ok {
-		sn.Receive(elem)
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// AppUpdate type, returning true/false if it was accepted.
+func (sn *AppUpdateHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(AppUpdate)
+	if !ok {
+		return false
 	}
+
+	sn.Receive(elem)
+	return true
 }
 
 //=========================================================================================================
@@ -47,7 +59,8 @@ type AppUpdateNotification struct {
 	register   map[AppUpdateSubscriber]int
 }
 
-// NewAppUpdateNotificationWith returns a new instance of AppUpdateNotification.
+// NewAppUpdateNotificationWith returns a new instance of AppUpdateNotification
+// which only delivers events that pass the provided validation.
 func NewAppUpdateNotificationWith(validation func(AppUpdate) bool) *AppUpdateNotification {
 	var elem AppUpdateNotification
 
@@ -57,7 +70,7 @@ func NewAppUpdateNotificationWith(validation func(AppUpdate) bool) *AppUpdateNot
 	return &elem
 }
 
-// NewAppUpdateNotification returns a new instance of NewAppUpdateNotification.
+// NewAppUpdateNotification returns a new instance of AppUpdateNotification.
 func NewAppUpdateNotification() *AppUpdateNotification {
 	var elem AppUpdateNotification
 	elem.register = make(map[AppUpdateSubscriber]int, 0)
@@ -73,7 +86,12 @@ func (sn *AppUpdateNotification) UnNotify(sub AppUpdateSubscriber) {
 			return
 		}
 
+		delete(sn.register, sub)
 		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
 	})
 }
 
@@ -81,6 +99,10 @@ func (sn *AppUpdateNotification) UnNotify(sub AppUpdateSubscriber) {
 // a new event of the given AppUpdate type.
 func (sn *AppUpdateNotification) Notify(sub AppUpdateSubscriber) {
 	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
 		sn.register[sub] = len(sn.subs)
 		sn.subs = append(sn.subs, sub)
 	})
@@ -89,23 +111,28 @@ func (sn *AppUpdateNotification) Notify(sub AppUpdateSubscriber) {
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
 func (sn *AppUpdateNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(AppUpdate); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	sn.Accept(elem)
+}
 
-			return
-		}
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *AppUpdateNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(AppUpdate)
+	if !ok {
+		return false
+	}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
 	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
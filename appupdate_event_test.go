@@ -0,0 +1,53 @@
+package gu
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestAppUpdateNotification validates the delivery, validation and filters of
+// AppUpdate events through the generated AppUpdateHandler and AppUpdateNotification.
+func TestAppUpdateNotification(t *testing.T) {
+	var received int
+	handler := NewAppUpdateHandler(func(AppUpdate) {
+		received++
+	})
+
+	var elem AppUpdate
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only AppUpdate events: %d", received)
+	}
+	tests.Passed("Should have accepted only AppUpdate events")
+
+	received = 0
+
+	notifier := NewAppUpdateNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered AppUpdate event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered AppUpdate event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering AppUpdate events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering AppUpdate events after UnNotify")
+
+	invalid := NewAppUpdateNotificationWith(func(AppUpdate) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered AppUpdate event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered AppUpdate event failing validation")
+}
@@ -10,9 +10,12 @@ import (
 	"github.com/gu-io/gu/assets"
 )
 
-// CSSPacker defines an implementation for parsing css files.
+// CSSPacker defines an implementation for parsing css files. CleanCSS minifies
+// the files with CleanCSSPacker, which requires Nodejs, while Minify minifies
+// them in Go with MinifyCSSPacker.
 type CSSPacker struct {
 	CleanCSS bool
+	Minify   bool
 }
 
 // Pack process all files present in the FileStatment slice and returns WriteDirectives
@@ -22,6 +25,10 @@ func (csp CSSPacker) Pack(statements []assets.FileStatement, dir assets.DirState
 		return (CleanCSSPacker{Args: []string{"-O", "1"}}).Pack(statements, dir)
 	}
 
+	if csp.Minify {
+		return (MinifyCSSPacker{}).Pack(statements, dir)
+	}
+
 	var directives []assets.WriteDirective
 
 	for _, statement := range statements {
@@ -0,0 +1,762 @@
+// +build !js
+
+package packers
+
+import (
+	"bytes"
+	"io/ioutil"
+	"regexp"
+	"strings"
+
+	bcss "github.com/aymerick/douceur/css"
+	"github.com/aymerick/douceur/parser"
+	"github.com/gu-io/gu/assets"
+	"github.com/gu-io/gu/common/colors"
+)
+
+// MinifyCSSPacker defines an implementation for minifying css files in Go,
+// removing whitespace and comments, collapsing shorthands, shortening colors
+// and merging duplicate rules. Unlike CleanCSSPacker it does not require
+// Nodejs to be installed.
+type MinifyCSSPacker struct{}
+
+// Pack process all files present in the FileStatment slice and returns WriteDirectives
+// which contains expected outputs for these files.
+func (MinifyCSSPacker) Pack(statements []assets.FileStatement, dir assets.DirStatement) ([]assets.WriteDirective, error) {
+	var directives []assets.WriteDirective
+
+	for _, statement := range statements {
+		data, err := ioutil.ReadFile(statement.AbsPath)
+		if err != nil {
+			return nil, err
+		}
+
+		minified, err := MinifyCSS(string(data))
+		if err != nil {
+			return nil, err
+		}
+
+		directives = append(directives, assets.WriteDirective{
+			OriginPath:    statement.Path,
+			OriginAbsPath: statement.AbsPath,
+			Writer:        bytes.NewReader([]byte(minified)),
+		})
+	}
+
+	return directives, nil
+}
+
+// MinifyCSS returns the css stylesheet minified.
+func MinifyCSS(source string) (string, error) {
+	sheet, err := parser.Parse(source)
+	if err != nil {
+		return "", err
+	}
+
+	var out bytes.Buffer
+	writeRules(&out, minifyRules(sheet.Rules))
+
+	return out.String(), nil
+}
+
+//==============================================================================
+
+// minifyRules returns the rules minified, dropping those left empty and merging
+// rules of the same selectors into the first of them when the rules between
+// them do not set any of the properties moved.
+func minifyRules(rules []*bcss.Rule) []*bcss.Rule {
+	var minified []*bcss.Rule
+
+	for _, rule := range rules {
+		if rule = minifyRule(rule); rule == nil {
+			continue
+		}
+
+		if rule.Kind == bcss.QualifiedRule {
+			if index := duplicateRule(minified, rule); index != -1 {
+				minified[index].Declarations = minifyDeclarations(append(minified[index].Declarations, rule.Declarations...))
+				continue
+			}
+		}
+
+		minified = append(minified, rule)
+	}
+
+	return minified
+}
+
+// minifyRule returns the rule minified or nil if it is left empty.
+func minifyRule(rule *bcss.Rule) *bcss.Rule {
+	if rule.Kind == bcss.QualifiedRule {
+		selectors := minifySelectors(rule.Prelude)
+		declarations := minifyDeclarations(rule.Declarations)
+		if len(selectors) == 0 || len(declarations) == 0 {
+			return nil
+		}
+
+		return &bcss.Rule{
+			Kind:         bcss.QualifiedRule,
+			Prelude:      strings.Join(selectors, ","),
+			Selectors:    selectors,
+			Declarations: declarations,
+		}
+	}
+
+	minified := &bcss.Rule{
+		Kind:    bcss.AtRule,
+		Name:    strings.ToLower(rule.Name),
+		Prelude: compact(stripComments(rule.Prelude), ",:"),
+	}
+
+	switch {
+	case rule.EmbedsRules():
+		if minified.Rules = minifyRules(rule.Rules); len(minified.Rules) == 0 {
+			return nil
+		}
+	case rule.Declarations != nil:
+		if minified.Declarations = minifyDeclarations(rule.Declarations); len(minified.Declarations) == 0 {
+			return nil
+		}
+	}
+
+	return minified
+}
+
+// duplicateRule returns the index of the last rule of the same selectors as the
+// rule, or -1 if there is none or the rules after it set properties of the
+// rule, which would be overridden once the rules are merged.
+func duplicateRule(rules []*bcss.Rule, rule *bcss.Rule) int {
+	for index := len(rules) - 1; index >= 0; index-- {
+		if rules[index].Kind == bcss.QualifiedRule && rules[index].Prelude == rule.Prelude {
+			return index
+		}
+
+		if conflicts(rules[index], rule) {
+			return -1
+		}
+	}
+
+	return -1
+}
+
+// conflicts returns true if the rule, or the rules within it, set properties
+// sharing a shorthand with those of the other rule.
+func conflicts(rule *bcss.Rule, other *bcss.Rule) bool {
+	for _, inner := range rule.Rules {
+		if conflicts(inner, other) {
+			return true
+		}
+	}
+
+	// Descriptors of @font-face or @page do not take part in the cascade.
+	if rule.Kind == bcss.AtRule {
+		return false
+	}
+
+	for _, decl := range rule.Declarations {
+		for _, otherDecl := range other.Declarations {
+			if propertyRoot(decl.Property) == propertyRoot(otherDecl.Property) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+var vendorPrefix = regexp.MustCompile(`^-[a-z]+-`)
+
+// propertyRoot returns the shorthand which the property is part of, being its
+// name without vendor prefix up to the first hyphen, eg 'border' for
+// 'border-top-color'. Every property shares the root of all.
+func propertyRoot(property string) string {
+	if strings.HasPrefix(property, "--") {
+		return property
+	}
+
+	property = vendorPrefix.ReplaceAllString(property, "")
+
+	switch property {
+	case "all":
+		return "*"
+	case "line-height":
+		return "font"
+	}
+
+	if index := strings.IndexByte(property, '-'); index != -1 {
+		return property[:index]
+	}
+
+	return property
+}
+
+//==============================================================================
+
+// minifySelectors returns the selectors of the prelude with whitespace and
+// comments removed, leaving out duplicates.
+func minifySelectors(prelude string) []string {
+	var selectors []string
+
+	seen := make(map[string]bool)
+	for _, selector := range splitTop(stripComments(prelude), ',') {
+		selector = compact(selector, ",>+~")
+		if selector == "" || seen[selector] {
+			continue
+		}
+
+		seen[selector] = true
+		selectors = append(selectors, selector)
+	}
+
+	return selectors
+}
+
+// minifyDeclarations returns the declarations with their values minified,
+// collapsing the sides of margin and padding into their shorthand and leaving
+// out those overridden by later declarations of the same property.
+func minifyDeclarations(decls []*bcss.Declaration) []*bcss.Declaration {
+	var minified []*bcss.Declaration
+
+	for _, decl := range decls {
+		property := strings.TrimSpace(decl.Property)
+		if property == "" {
+			continue
+		}
+
+		if strings.HasPrefix(property, "--") {
+			minified = append(minified, &bcss.Declaration{Property: property, Value: strings.TrimSpace(decl.Value), Important: decl.Important})
+			continue
+		}
+
+		property = strings.ToLower(property)
+		minified = append(minified, &bcss.Declaration{Property: property, Value: minifyValue(property, decl.Value), Important: decl.Important})
+	}
+
+	minified = collapseSides(minified, "margin")
+	minified = collapseSides(minified, "padding")
+
+	var kept []*bcss.Declaration
+	for index, decl := range minified {
+		if !overridden(decl, minified[index+1:]) {
+			kept = append(kept, decl)
+		}
+	}
+
+	return kept
+}
+
+// overridden returns true if the declaration is overridden by one of the
+// declarations following it, being the same declaration or a declaration of
+// the same property and importance, when neither value is a fallback which may
+// be unsupported by browsers, like vendor keywords or functions.
+func overridden(decl *bcss.Declaration, following []*bcss.Declaration) bool {
+	for _, other := range following {
+		if other.Property != decl.Property {
+			continue
+		}
+
+		if other.Equal(decl) {
+			return true
+		}
+
+		if (other.Important || !decl.Important) && plainValue(decl.Value) && plainValue(other.Value) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// plainValue returns true if the value has no functions, vendor keywords or
+// hacks, which are used as fallbacks for one another.
+func plainValue(value string) bool {
+	if strings.ContainsAny(value, "(\\") {
+		return false
+	}
+
+	for _, part := range splitTop(value, ' ') {
+		if strings.HasPrefix(part, "-") && !numberPattern.MatchString(part) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// sides contains the suffixes of the properties of the sides of a box, in the
+// order of the values of their shorthand.
+var sides = []string{"-top", "-right", "-bottom", "-left"}
+
+// collapseSides returns the declarations with the four sides of the shorthand
+// replaced by the shorthand, where the last of them was, if each is set once
+// with the same importance and the shorthand is not set between them.
+func collapseSides(decls []*bcss.Declaration, shorthand string) []*bcss.Declaration {
+	found := []int{-1, -1, -1, -1}
+
+	var first, last = -1, -1
+	for index, decl := range decls {
+		if decl.Property == shorthand && first != -1 {
+			return decls
+		}
+
+		for side, suffix := range sides {
+			if decl.Property != shorthand+suffix {
+				continue
+			}
+
+			if found[side] != -1 {
+				return decls
+			}
+
+			if first == -1 {
+				first = index
+			}
+
+			found[side] = index
+			last = index
+		}
+	}
+
+	values := make([]string, len(sides))
+	for side, index := range found {
+		if index == -1 || decls[index].Important != decls[first].Important {
+			return decls
+		}
+
+		values[side] = decls[index].Value
+	}
+
+	var collapsed []*bcss.Declaration
+	for index, decl := range decls {
+		switch index {
+		case last:
+			collapsed = append(collapsed, &bcss.Declaration{
+				Property:  shorthand,
+				Value:     strings.Join(collapseBox(values), " "),
+				Important: decl.Important,
+			})
+		case found[0], found[1], found[2], found[3]:
+		default:
+			collapsed = append(collapsed, decl)
+		}
+	}
+
+	return collapsed
+}
+
+// collapseBox returns the values of the sides of a box, being one to four
+// values as within the margin shorthand, with those implied by the others left
+// out, eg '1px 2px' for '1px 2px 1px 2px'.
+func collapseBox(values []string) []string {
+	if len(values) == 0 || len(values) > 4 {
+		return values
+	}
+
+	top, right, bottom, left := values[0], values[0], values[0], values[0]
+	switch len(values) {
+	case 2:
+		right, left = values[1], values[1]
+	case 3:
+		right, bottom, left = values[1], values[2], values[1]
+	case 4:
+		right, bottom, left = values[1], values[2], values[3]
+	}
+
+	switch {
+	case top == right && top == bottom && top == left:
+		return []string{top}
+	case top == bottom && right == left:
+		return []string{top, right}
+	case right == left:
+		return []string{top, right, bottom}
+	}
+
+	return []string{top, right, bottom, left}
+}
+
+//==============================================================================
+
+// boxProperties contains the properties whose values are those of the sides of
+// a box.
+var boxProperties = map[string]bool{
+	"margin":       true,
+	"padding":      true,
+	"border-width": true,
+	"border-style": true,
+	"border-color": true,
+}
+
+// colorProperties contains the properties whose values may contain named
+// colors.
+var colorProperties = map[string]bool{
+	"background":            true,
+	"background-color":      true,
+	"border":                true,
+	"border-bottom":         true,
+	"border-bottom-color":   true,
+	"border-color":          true,
+	"border-left":           true,
+	"border-left-color":     true,
+	"border-right":          true,
+	"border-right-color":    true,
+	"border-top":            true,
+	"border-top-color":      true,
+	"box-shadow":            true,
+	"caret-color":           true,
+	"color":                 true,
+	"column-rule":           true,
+	"column-rule-color":     true,
+	"fill":                  true,
+	"outline":               true,
+	"outline-color":         true,
+	"stop-color":            true,
+	"stroke":                true,
+	"text-decoration":       true,
+	"text-decoration-color": true,
+	"text-shadow":           true,
+}
+
+// colorFunctions contains the functions returning colors.
+var colorFunctions = map[string]bool{
+	"rgb":  true,
+	"rgba": true,
+	"hsl":  true,
+	"hsla": true,
+}
+
+// lengthUnits contains the units of lengths, which can be left out of zero.
+var lengthUnits = map[string]bool{
+	"px": true, "em": true, "rem": true, "ex": true, "ch": true,
+	"vw": true, "vh": true, "vmin": true, "vmax": true,
+	"cm": true, "mm": true, "q": true, "in": true, "pt": true, "pc": true,
+}
+
+// fontWeights contains the numeric values of font weight keywords.
+var fontWeights = map[string]string{
+	"normal": "400",
+	"bold":   "700",
+}
+
+// minifyValue returns the value of the property with whitespace and comments
+// removed, numbers and colors shortened and the sides of box values collapsed.
+func minifyValue(property string, value string) string {
+	value = minifyTerms(property, compact(stripComments(value), ",/"), false)
+
+	if property == "font-weight" {
+		if weight, ok := fontWeights[strings.ToLower(value)]; ok {
+			return weight
+		}
+	}
+
+	if boxProperties[property] && !strings.Contains(value, "var(") {
+		if parts := splitTop(value, ' '); len(parts) > 1 && !strings.ContainsAny(value, ",/") {
+			value = strings.Join(collapseBox(parts), " ")
+		}
+	}
+
+	return value
+}
+
+// minifyTerms returns the compacted value with its numbers and colors
+// shortened, along with those within functions, which are nested.
+func minifyTerms(property string, value string, nested bool) string {
+	var out bytes.Buffer
+
+	for index := 0; index < len(value); {
+		switch char := value[index]; {
+		case char == '"' || char == '\'':
+			end := stringEnd(value, index)
+			out.WriteString(value[index:end])
+			index = end
+		case strings.IndexByte(" ,/()", char) != -1:
+			out.WriteByte(char)
+			index++
+		default:
+			end := index
+			for end < len(value) && strings.IndexByte(" ,/()\"'", value[end]) == -1 {
+				end++
+			}
+
+			word := value[index:end]
+			if end == len(value) || value[end] != '(' {
+				out.WriteString(minifyWord(property, word, nested))
+				index = end
+				continue
+			}
+
+			closing := closingParen(value, end)
+			name := strings.ToLower(word)
+
+			if closing == -1 {
+				out.WriteString(value[index:])
+				index = len(value)
+				continue
+			}
+
+			if name == "url" {
+				out.WriteString(value[index:closing])
+				index = closing
+				continue
+			}
+
+			function := word + "(" + minifyTerms(property, value[end+1:closing-1], true) + ")"
+			if colorFunctions[name] {
+				if c, err := colors.Parse(function); err == nil && c.A >= 1 {
+					function = shortestColor(c)
+				}
+			}
+
+			out.WriteString(function)
+			index = closing
+		}
+	}
+
+	return out.String()
+}
+
+// numberPattern matches numbers with an optional unit, eg '-0.50em'.
+var numberPattern = regexp.MustCompile(`^([+-]?)([0-9]*)(?:\.([0-9]+))?([a-zA-Z%]*)$`)
+
+// minifyWord returns the word of a value of the property shortened, being
+// numbers without needless zeros or units and colors as their shortest hex or
+// name. Units of zero lengths within functions, like calc(), are kept.
+func minifyWord(property string, word string, nested bool) string {
+	if strings.HasPrefix(word, "#") {
+		if c, err := colors.Parse(word); err == nil && c.A >= 1 {
+			return shortestColor(c)
+		}
+
+		return word
+	}
+
+	if parts := numberPattern.FindStringSubmatch(word); parts != nil && parts[2]+parts[3] != "" {
+		sign, integer, fraction, unit := parts[1], strings.TrimLeft(parts[2], "0"), strings.TrimRight(parts[3], "0"), parts[4]
+
+		if integer == "" && fraction == "" {
+			if !nested && property != "flex" && lengthUnits[strings.ToLower(unit)] {
+				unit = ""
+			}
+
+			return "0" + unit
+		}
+
+		number := sign + integer
+		if fraction != "" {
+			number += "." + fraction
+		}
+
+		return number + unit
+	}
+
+	if colorProperties[property] {
+		if c, err := colors.Parse(word); err == nil && c.A >= 1 {
+			if color := shortestColor(c); len(color) < len(word) {
+				return color
+			}
+		}
+	}
+
+	return word
+}
+
+// shortestColor returns the shortest representation of the opaque color, being
+// its hex with three digits if possible or its name.
+func shortestColor(c colors.Color) string {
+	hex := c.Hex()
+	if hex[1] == hex[2] && hex[3] == hex[4] && hex[5] == hex[6] {
+		hex = string([]byte{'#', hex[1], hex[3], hex[5]})
+	}
+
+	if name, ok := c.Name(); ok && len(name) < len(hex) {
+		return name
+	}
+
+	return hex
+}
+
+//==============================================================================
+
+// stripComments returns the text with its comments removed, leaving those
+// within strings.
+func stripComments(text string) string {
+	if !strings.Contains(text, "/*") {
+		return text
+	}
+
+	var out bytes.Buffer
+	for index := 0; index < len(text); {
+		switch {
+		case text[index] == '"' || text[index] == '\'':
+			end := stringEnd(text, index)
+			out.WriteString(text[index:end])
+			index = end
+		case strings.HasPrefix(text[index:], "/*"):
+			end := strings.Index(text[index+2:], "*/")
+			if end == -1 {
+				return out.String()
+			}
+
+			out.WriteByte(' ')
+			index += end + 4
+		default:
+			out.WriteByte(text[index])
+			index++
+		}
+	}
+
+	return out.String()
+}
+
+// compact returns the text with whitespace outside of strings collapsed and
+// removed around the tight characters, after opening and before closing
+// parentheses and brackets.
+func compact(text string, tight string) string {
+	var out []byte
+
+	space := false
+	for index := 0; index < len(text); index++ {
+		char := text[index]
+
+		if char == ' ' || char == '\t' || char == '\n' || char == '\r' || char == '\f' {
+			space = true
+			continue
+		}
+
+		if space && len(out) != 0 {
+			last := out[len(out)-1]
+			if strings.IndexByte(tight+"([", last) == -1 && strings.IndexByte(tight+")]", char) == -1 {
+				out = append(out, ' ')
+			}
+		}
+		space = false
+
+		if char == '"' || char == '\'' {
+			end := stringEnd(text, index)
+			out = append(out, text[index:end]...)
+			index = end - 1
+			continue
+		}
+
+		out = append(out, char)
+	}
+
+	return string(out)
+}
+
+// splitTop returns the parts of the text separated by the separator outside of
+// strings, parentheses and brackets.
+func splitTop(text string, separator byte) []string {
+	var parts []string
+
+	var depth, start int
+	for index := 0; index < len(text); index++ {
+		switch char := text[index]; {
+		case char == '"' || char == '\'':
+			index = stringEnd(text, index) - 1
+		case char == '(' || char == '[':
+			depth++
+		case char == ')' || char == ']':
+			depth--
+		case char == separator && depth == 0:
+			if part := strings.TrimSpace(text[start:index]); part != "" {
+				parts = append(parts, part)
+			}
+			start = index + 1
+		}
+	}
+
+	if part := strings.TrimSpace(text[start:]); part != "" {
+		parts = append(parts, part)
+	}
+
+	return parts
+}
+
+// stringEnd returns the index after the end of the string starting at the
+// index, or the length of the text if it is not closed.
+func stringEnd(text string, index int) int {
+	quote := text[index]
+
+	for index++; index < len(text); index++ {
+		switch text[index] {
+		case '\\':
+			index++
+		case quote:
+			return index + 1
+		}
+	}
+
+	return len(text)
+}
+
+// closingParen returns the index after the parenthesis closing the one at the
+// index, or -1 if it is not closed.
+func closingParen(text string, index int) int {
+	depth := 0
+
+	for ; index < len(text); index++ {
+		switch text[index] {
+		case '"', '\'':
+			index = stringEnd(text, index) - 1
+		case '(':
+			depth++
+		case ')':
+			if depth--; depth == 0 {
+				return index + 1
+			}
+		}
+	}
+
+	return -1
+}
+
+//==============================================================================
+
+// writeRules writes the rules into the buffer without whitespace.
+func writeRules(out *bytes.Buffer, rules []*bcss.Rule) {
+	for _, rule := range rules {
+		if rule.Kind == bcss.QualifiedRule {
+			out.WriteString(rule.Prelude)
+			writeDeclarations(out, rule.Declarations)
+			continue
+		}
+
+		out.WriteString(rule.Name)
+		if rule.Prelude != "" {
+			out.WriteByte(' ')
+			out.WriteString(rule.Prelude)
+		}
+
+		switch {
+		case rule.Rules != nil:
+			out.WriteByte('{')
+			writeRules(out, rule.Rules)
+			out.WriteByte('}')
+		case rule.Declarations != nil:
+			writeDeclarations(out, rule.Declarations)
+		default:
+			out.WriteByte(';')
+		}
+	}
+}
+
+// writeDeclarations writes the declarations into the buffer as a block.
+func writeDeclarations(out *bytes.Buffer, decls []*bcss.Declaration) {
+	out.WriteByte('{')
+
+	for index, decl := range decls {
+		if index != 0 {
+			out.WriteByte(';')
+		}
+
+		out.WriteString(decl.Property)
+		out.WriteByte(':')
+		out.WriteString(decl.Value)
+
+		if decl.Important {
+			out.WriteString("!important")
+		}
+	}
+
+	out.WriteByte('}')
+}
@@ -0,0 +1,106 @@
+package packers_test
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/gu-io/gu/assets"
+	"github.com/gu-io/gu/assets/packers"
+	"github.com/influx6/faux/tests"
+)
+
+func TestMinifyCSSPacker(t *testing.T) {
+	expected := "html{width:100%;height:100%}body{width:100%;height:100%}div.tuglife{width:100%;height:100%}"
+	fixtures := filepath.Join(thisSrc, "assets/packers/fixtures")
+	wordan := filepath.Join(fixtures, "wordan.css")
+	wordanRel := filepath.Join("./packers/fixtures/", "wordan.css")
+
+	var minify packers.MinifyCSSPacker
+
+	response, err := minify.Pack([]assets.FileStatement{{
+		Path:    wordanRel,
+		AbsPath: wordan,
+	}}, assets.DirStatement{})
+
+	if err != nil {
+		tests.Failed("Should have successfully packed css file: %+q", err)
+	}
+	tests.Passed("Should have successfully packed css file")
+
+	if len(response) != 1 {
+		tests.Failed("Should have successfully received minified css file")
+	}
+	tests.Passed("Should have successfully received minified css file")
+
+	var b bytes.Buffer
+	if _, err := response[0].Writer.WriteTo(&b); err != nil {
+		tests.Failed("Should have successfully written data to buffer: %+q", err)
+	}
+	tests.Passed("Should have successfully written data to buffer")
+
+	if b.String() != expected {
+		tests.Info("Expected: %+q", expected)
+		tests.Info("Received: %+q", b.String())
+		tests.Failed("Should have successfully matched css output with expected")
+	}
+	tests.Passed("Should have successfully matched css output with expected")
+}
+
+func TestMinifyCSS(t *testing.T) {
+	styles := []struct {
+		name     string
+		source   string
+		expected string
+	}{
+		{
+			name:     "whitespace and comments",
+			source:   "/* header */\na  >  b ,\n a > b {\n  color : blue ; /* main */\n  font: italic 12px / 30px Georgia, serif;\n}",
+			expected: "a>b{color:blue;font:italic 12px/30px Georgia,serif}",
+		},
+		{
+			name:     "numbers",
+			source:   "a { margin: 0px; opacity: 0.50; width: calc(100% - 0px); flex: 1 1 0px; font-weight: bold }",
+			expected: "a{margin:0;opacity:.5;width:calc(100% - 0px);flex:1 1 0px;font-weight:700}",
+		},
+		{
+			name:     "colors",
+			source:   "a { color: #FF0000; background: rgb(255, 255, 255) url(\"x.png\"); border-color: #aabbcc; outline: 1px solid White; box-shadow: 0 0 1px rgba(0, 0, 0, 0.5); font-family: Red }",
+			expected: "a{color:red;background:#fff url(\"x.png\");border-color:#abc;outline:1px solid #fff;box-shadow:0 0 1px rgba(0,0,0,.5);font-family:Red}",
+		},
+		{
+			name:     "shorthands",
+			source:   "a { margin: 1px 2px 1px 2px; border-width: 1px 2px 3px 2px; padding-top: 0; padding-right: 1em; padding-bottom: 0; padding-left: 1em }",
+			expected: "a{margin:1px 2px;border-width:1px 2px 3px;padding:0 1em}",
+		},
+		{
+			name:     "overridden declarations",
+			source:   "a { color: red; color: blue; display: -webkit-box; display: flex; width: 10px !important; width: 20px }",
+			expected: "a{color:blue;display:-webkit-box;display:flex;width:10px!important;width:20px}",
+		},
+		{
+			name:     "duplicate rules",
+			source:   "a { color: red } b { margin: 0 } a { padding: 0 } c { padding: 1px } a { margin: 1px } .empty {}",
+			expected: "a{color:red;padding:0}b{margin:0}c{padding:1px}a{margin:1px}",
+		},
+		{
+			name:     "at-rules",
+			source:   "@import url(\"a.css\") screen;\n@media screen and ( max-width : 600px ) { .x { color: #000000 } .y {} }\n@media print {}",
+			expected: "@import url(\"a.css\") screen;@media screen and (max-width:600px){.x{color:#000}}",
+		},
+	}
+
+	for _, style := range styles {
+		minified, err := packers.MinifyCSS(style.source)
+		if err != nil {
+			tests.Failed("Should have successfully minified %s: %+q", style.name, err)
+		}
+
+		if minified != style.expected {
+			tests.Info("Expected: %+q", style.expected)
+			tests.Info("Received: %+q", minified)
+			tests.Failed("Should have successfully minified %s", style.name)
+		}
+		tests.Passed("Should have successfully minified %s", style.name)
+	}
+}
@@ -295,10 +295,14 @@ func initCommands() {
 
 			register := ast.NewAnnotationRegistry()
 
-			generators.RegisterGenerators(register)
+			if err := generators.RegisterGenerators(register); err != nil {
+				return err
+			}
 
 			// Register @assets annotation for our registery as well.
-			register.Register("assets", annotations.AssetsAnnotationGenerator)
+			if err := register.Register("assets", annotations.AssetsAnnotationGenerator); err != nil {
+				return err
+			}
 
 			events := metrics.New(custom.BlockDisplay(os.Stdout))
 			pkg, err := ast.ParseAnnotations(events, indir)
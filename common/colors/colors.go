@@ -0,0 +1,274 @@
+// Package colors provides the parsing of css colors, their conversion between
+// color spaces, WCAG contrast ratios, mixing and the generation of
+// material-design palettes.
+package colors
+
+import (
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+)
+
+// contains colors used for mixing.
+var (
+	White = Color{R: 1, G: 1, B: 1, A: 1}
+	Black = Color{A: 1}
+)
+
+// Color defines a color by its red, green, blue and alpha values, which range
+// from 0 to 1.
+type Color struct {
+	R float64
+	G float64
+	B float64
+	A float64
+}
+
+// RGB returns a new opaque Color of the red, green and blue values, which range
+// from 0 to 255.
+func RGB(r, g, b uint8) Color {
+	return Color{R: float64(r) / 255, G: float64(g) / 255, B: float64(b) / 255, A: 1}
+}
+
+// Parse returns the Color of the css color value, which is either a hex color
+// (#rgb, #rgba, #rrggbb or #rrggbbaa), a rgb(), rgba(), hsl() or hsla()
+// function using commas or spaces, a named color or transparent.
+func Parse(value string) (Color, error) {
+	value = strings.ToLower(strings.TrimSpace(value))
+
+	if hex, ok := named[value]; ok {
+		value = hex
+	}
+
+	switch {
+	case value == "transparent":
+		return Color{}, nil
+	case strings.HasPrefix(value, "#"):
+		return parseHex(value)
+	case strings.HasPrefix(value, "rgb(") || strings.HasPrefix(value, "rgba("):
+		return parseRGB(value)
+	case strings.HasPrefix(value, "hsl(") || strings.HasPrefix(value, "hsla("):
+		return parseHSL(value)
+	}
+
+	return Color{}, fmt.Errorf("Invalid color value %q", value)
+}
+
+// MustParse returns the Color of the css color value like Parse, panicking if
+// the value is not a valid color.
+func MustParse(value string) Color {
+	c, err := Parse(value)
+	if err != nil {
+		panic(err)
+	}
+
+	return c
+}
+
+// parseHex returns the Color of the hex color value.
+func parseHex(value string) (Color, error) {
+	digits := value[1:]
+
+	if len(digits) == 3 || len(digits) == 4 {
+		var expanded []byte
+		for i := 0; i < len(digits); i++ {
+			expanded = append(expanded, digits[i], digits[i])
+		}
+
+		digits = string(expanded)
+	}
+
+	if len(digits) == 6 {
+		digits += "ff"
+	}
+
+	if len(digits) != 8 {
+		return Color{}, fmt.Errorf("Invalid hex color value %q", value)
+	}
+
+	channels, err := strconv.ParseUint(digits, 16, 32)
+	if err != nil {
+		return Color{}, fmt.Errorf("Invalid hex color value %q", value)
+	}
+
+	return Color{
+		R: float64(channels>>24&0xff) / 255,
+		G: float64(channels>>16&0xff) / 255,
+		B: float64(channels>>8&0xff) / 255,
+		A: float64(channels&0xff) / 255,
+	}, nil
+}
+
+// parseRGB returns the Color of the rgb() or rgba() color value.
+func parseRGB(value string) (Color, error) {
+	args, err := functionArgs(value)
+	if err != nil {
+		return Color{}, err
+	}
+
+	var channels [4]float64
+	channels[3] = 1
+
+	for index, arg := range args {
+		var number float64
+
+		switch {
+		case index == 3:
+			number, err = parseAlpha(arg)
+		case strings.HasSuffix(arg, "%"):
+			number, err = strconv.ParseFloat(strings.TrimSuffix(arg, "%"), 64)
+			number /= 100
+		default:
+			number, err = strconv.ParseFloat(arg, 64)
+			number /= 255
+		}
+
+		if err != nil {
+			return Color{}, fmt.Errorf("Invalid rgb color value %q", value)
+		}
+
+		channels[index] = clamp(number)
+	}
+
+	return Color{R: channels[0], G: channels[1], B: channels[2], A: channels[3]}, nil
+}
+
+// parseHSL returns the Color of the hsl() or hsla() color value.
+func parseHSL(value string) (Color, error) {
+	args, err := functionArgs(value)
+	if err != nil {
+		return Color{}, err
+	}
+
+	hue, err := parseHue(args[0])
+	if err != nil {
+		return Color{}, fmt.Errorf("Invalid hsl color value %q", value)
+	}
+
+	var fractions [2]float64
+
+	for index, arg := range args[1:3] {
+		number, err := strconv.ParseFloat(strings.TrimSuffix(arg, "%"), 64)
+		if err != nil {
+			return Color{}, fmt.Errorf("Invalid hsl color value %q", value)
+		}
+
+		if strings.HasSuffix(arg, "%") || number > 1 {
+			number /= 100
+		}
+
+		fractions[index] = clamp(number)
+	}
+
+	c := HSL(hue, fractions[0], fractions[1])
+
+	if len(args) == 4 {
+		if c.A, err = parseAlpha(args[3]); err != nil {
+			return Color{}, fmt.Errorf("Invalid hsl color value %q", value)
+		}
+	}
+
+	return c, nil
+}
+
+// functionArgs returns the three or four arguments of the css color function
+// value, separated by commas, spaces or a slash before the alpha value.
+func functionArgs(value string) ([]string, error) {
+	start := strings.Index(value, "(")
+	if start == -1 || !strings.HasSuffix(value, ")") {
+		return nil, fmt.Errorf("Invalid color function %q", value)
+	}
+
+	args := strings.Fields(strings.NewReplacer(",", " ", "/", " ").Replace(value[start+1 : len(value)-1]))
+	if len(args) != 3 && len(args) != 4 {
+		return nil, fmt.Errorf("Invalid color function %q", value)
+	}
+
+	return args, nil
+}
+
+// parseAlpha returns the alpha value of the number or percentage.
+func parseAlpha(arg string) (float64, error) {
+	number, err := strconv.ParseFloat(strings.TrimSuffix(arg, "%"), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if strings.HasSuffix(arg, "%") {
+		number /= 100
+	}
+
+	return clamp(number), nil
+}
+
+// parseHue returns the degrees of the hue, which is a number of degrees or an
+// angle in deg, rad, grad or turn units.
+func parseHue(arg string) (float64, error) {
+	units := []struct {
+		suffix  string
+		degrees float64
+	}{
+		{suffix: "grad", degrees: 0.9},
+		{suffix: "deg", degrees: 1},
+		{suffix: "rad", degrees: 180 / math.Pi},
+		{suffix: "turn", degrees: 360},
+	}
+
+	scale := 1.0
+
+	for _, unit := range units {
+		if strings.HasSuffix(arg, unit.suffix) {
+			arg = strings.TrimSuffix(arg, unit.suffix)
+			scale = unit.degrees
+			break
+		}
+	}
+
+	number, err := strconv.ParseFloat(arg, 64)
+	if err != nil {
+		return 0, err
+	}
+
+	return math.Mod(math.Mod(number*scale, 360)+360, 360), nil
+}
+
+// RGB255 returns the red, green and blue values of the color ranging from 0 to
+// 255.
+func (c Color) RGB255() (r, g, b uint8) {
+	return channel255(c.R), channel255(c.G), channel255(c.B)
+}
+
+// Hex returns the hex representation of the color, which contains the alpha
+// value if the color is not opaque.
+func (c Color) Hex() string {
+	r, g, b := c.RGB255()
+
+	if c.A < 1 {
+		return fmt.Sprintf("#%02x%02x%02x%02x", r, g, b, channel255(c.A))
+	}
+
+	return fmt.Sprintf("#%02x%02x%02x", r, g, b)
+}
+
+// String returns the hex representation of the color if it is opaque, else its
+// rgba() representation.
+func (c Color) String() string {
+	if c.A >= 1 {
+		return c.Hex()
+	}
+
+	r, g, b := c.RGB255()
+	return fmt.Sprintf("rgba(%d, %d, %d, %s)", r, g, b, strconv.FormatFloat(c.A, 'f', -1, 64))
+}
+
+// channel255 returns the value ranging from 0 to 1 as a value ranging from 0 to
+// 255.
+func channel255(value float64) uint8 {
+	return uint8(math.Floor(clamp(value)*255 + 0.5))
+}
+
+// clamp returns the value limited to the range of 0 to 1.
+func clamp(value float64) float64 {
+	return math.Max(0, math.Min(1, value))
+}
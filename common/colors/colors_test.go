@@ -0,0 +1,148 @@
+package colors_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/gu-io/gu/common/colors"
+	"github.com/influx6/faux/tests"
+)
+
+func TestParse(t *testing.T) {
+	values := map[string]string{
+		"#2196f3":                     "#2196f3",
+		"#FFF":                        "#ffffff",
+		"#2196f380":                   "rgba(33, 150, 243, 0.5019607843137255)",
+		"rgb(33, 150, 243)":           "#2196f3",
+		"rgb(33 150 243 / 50%)":       "rgba(33, 150, 243, 0.5)",
+		"rgba(33,150,243,0.25)":       "rgba(33, 150, 243, 0.25)",
+		"hsl(120, 100%, 50%)":         "#00ff00",
+		"hsla(0.5turn, 100%, 50%, 1)": "#00ffff",
+		"RebeccaPurple":               "#663399",
+		"transparent":                 "rgba(0, 0, 0, 0)",
+	}
+
+	for value, expected := range values {
+		c, err := colors.Parse(value)
+		if err != nil {
+			tests.Failed("Should have parsed color %q: %s", value, err)
+		}
+
+		if c.String() != expected {
+			tests.Failed("Should have parsed color %q as %q: %q", value, expected, c.String())
+		}
+	}
+	tests.Passed("Should have parsed hex, rgb, hsl and named colors")
+
+	for _, value := range []string{"blue-ish", "#12", "rgb(1, 2)", "hsl(a, b, c)"} {
+		if _, err := colors.Parse(value); err == nil {
+			tests.Failed("Should have failed to parse invalid color %q", value)
+		}
+	}
+	tests.Passed("Should have failed to parse invalid colors")
+}
+
+func TestName(t *testing.T) {
+	values := map[string]string{
+		"#ff0000":            "red",
+		"#0ff":               "aqua",
+		"rgb(128, 128, 128)": "gray",
+		"RebeccaPurple":      "rebeccapurple",
+	}
+
+	for value, expected := range values {
+		if name, ok := colors.MustParse(value).Name(); !ok || name != expected {
+			tests.Failed("Should have named color %q as %q: %q", value, expected, name)
+		}
+	}
+	tests.Passed("Should have named colors with their shortest keyword")
+
+	for _, value := range []string{"#123456", "rgba(255, 0, 0, 0.5)", "transparent"} {
+		if name, ok := colors.MustParse(value).Name(); ok {
+			tests.Failed("Should not have named color %q: %q", value, name)
+		}
+	}
+	tests.Passed("Should not have named colors without a keyword or translucent")
+}
+
+func TestSpaces(t *testing.T) {
+	c := colors.MustParse("#2196f3")
+
+	if h, s, l := c.HSL(); colors.HSL(h, s, l).Hex() != c.Hex() {
+		tests.Failed("Should have converted color to and from HSL")
+	}
+	tests.Passed("Should have converted color to and from HSL")
+
+	if h, s, v := c.HSV(); colors.HSV(h, s, v).Hex() != c.Hex() {
+		tests.Failed("Should have converted color to and from HSV")
+	}
+	tests.Passed("Should have converted color to and from HSV")
+
+	if l, _, _ := colors.White.Lab(); math.Abs(l-100) > 0.01 {
+		tests.Failed("Should have converted white to a Lab lightness of 100: %f", l)
+	}
+
+	if l, a, b := c.Lab(); colors.Lab(l, a, b).Hex() != c.Hex() {
+		tests.Failed("Should have converted color to and from Lab")
+	}
+	tests.Passed("Should have converted color to and from Lab")
+
+	if l, chroma, _ := colors.White.OKLCH(); math.Abs(l-1) > 0.001 || chroma > 0.001 {
+		tests.Failed("Should have converted white to an OKLCH lightness of 1: %f %f", l, chroma)
+	}
+
+	if l, chroma, h := c.OKLCH(); colors.OKLCH(l, chroma, h).Hex() != c.Hex() {
+		tests.Failed("Should have converted color to and from OKLCH")
+	}
+	tests.Passed("Should have converted color to and from OKLCH")
+}
+
+func TestContrast(t *testing.T) {
+	if ratio := colors.Contrast(colors.Black, colors.White); math.Abs(ratio-21) > 0.001 {
+		tests.Failed("Should have returned contrast ratio of 21 for black against white: %f", ratio)
+	}
+	tests.Passed("Should have returned contrast ratio of 21 for black against white")
+
+	if ratio := colors.Contrast(colors.MustParse("#777"), colors.White); ratio >= colors.ContrastAA {
+		tests.Failed("Should have returned contrast ratio below AA for grey against white: %f", ratio)
+	}
+	tests.Passed("Should have returned contrast ratio below AA for grey against white")
+}
+
+func TestMixing(t *testing.T) {
+	red := colors.MustParse("red")
+
+	if mixed := colors.Mix(red, colors.MustParse("blue"), 0.5); mixed.Hex() != "#800080" {
+		tests.Failed("Should have mixed red and blue into purple: %q", mixed.Hex())
+	}
+	tests.Passed("Should have mixed red and blue into purple")
+
+	if tint := colors.Tint(red, 1); tint.Hex() != "#ffffff" {
+		tests.Failed("Should have tinted color fully into white: %q", tint.Hex())
+	}
+
+	if shade := colors.Shade(red, 1); shade.Hex() != "#000000" {
+		tests.Failed("Should have shaded color fully into black: %q", shade.Hex())
+	}
+	tests.Passed("Should have tinted and shaded colors")
+
+	if _, _, l := colors.Lighten(red, 0.2).HSL(); math.Abs(l-0.7) > 0.01 {
+		tests.Failed("Should have lightened color: %f", l)
+	}
+	tests.Passed("Should have lightened color")
+
+	base := colors.MustParse("#2196f3")
+	palette := colors.Palette(base)
+
+	if len(palette) != len(colors.Grades) || palette[5].Hex() != base.Hex() {
+		tests.Failed("Should have generated palette with the color as the 500 grade")
+	}
+	tests.Passed("Should have generated palette with the color as the 500 grade")
+
+	for index := 1; index < len(palette); index++ {
+		if colors.Luminance(palette[index]) >= colors.Luminance(palette[index-1]) {
+			tests.Failed("Should have generated palette darkening with every grade: %d", colors.Grades[index])
+		}
+	}
+	tests.Passed("Should have generated palette darkening with every grade")
+}
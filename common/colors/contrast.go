@@ -0,0 +1,26 @@
+package colors
+
+// contains the WCAG contrast ratios of text against its background.
+const (
+	ContrastAA       = 4.5 // ContrastAA is the AA level for normal text.
+	ContrastAALarge  = 3.0 // ContrastAALarge is the AA level for large text.
+	ContrastAAA      = 7.0 // ContrastAAA is the AAA level for normal text.
+	ContrastAAALarge = 4.5 // ContrastAAALarge is the AAA level for large text.
+)
+
+// Luminance returns the WCAG relative luminance of the color, ranging from 0 for
+// black to 1 for white.
+func Luminance(c Color) float64 {
+	return 0.2126*linearize(c.R) + 0.7152*linearize(c.G) + 0.0722*linearize(c.B)
+}
+
+// Contrast returns the WCAG contrast ratio between the colors, ranging from 1
+// for identical colors to 21 for black against white.
+func Contrast(a Color, b Color) float64 {
+	first, second := Luminance(a), Luminance(b)
+	if first < second {
+		first, second = second, first
+	}
+
+	return (first + 0.05) / (second + 0.05)
+}
@@ -1,10 +1,9 @@
-package styleguide
+package colors
 
-var (
-	// MaterialPalettes provides material-design chosen color palettes
-	// converted from the material-design spec.
-	MaterialPalettes = map[string][]string{}
-)
+// MaterialPalettes provides material-design chosen color palettes
+// converted from the material-design spec, where each color is a comma
+// separated set of its red, green and blue values.
+var MaterialPalettes = map[string][]string{}
 
 func init() {
 	MaterialPalettes["black"] = []string{
@@ -0,0 +1,85 @@
+package colors
+
+// Grades contains the grades of the colors of palettes returned by Palette.
+var Grades = []int{50, 100, 200, 300, 400, 500, 600, 700, 800, 900}
+
+// paletteWeights contains the weight of the color mixed into the lighter and
+// darker colors of palettes for every grade of Grades.
+var paletteWeights = []float64{0.12, 0.30, 0.50, 0.70, 0.85, 1, 0.87, 0.70, 0.54, 0.25}
+
+// Mix returns the color mixed with the other color by the weight of the other
+// color, ranging from 0 returning the color to 1 returning the other color.
+func Mix(c Color, other Color, weight float64) Color {
+	weight = clamp(weight)
+
+	return Color{
+		R: c.R + (other.R-c.R)*weight,
+		G: c.G + (other.G-c.G)*weight,
+		B: c.B + (other.B-c.B)*weight,
+		A: c.A + (other.A-c.A)*weight,
+	}
+}
+
+// Tint returns the color mixed with white by the amount ranging from 0 to 1,
+// keeping the alpha value of the color.
+func Tint(c Color, amount float64) Color {
+	white := White
+	white.A = c.A
+
+	return Mix(c, white, amount)
+}
+
+// Shade returns the color mixed with black by the amount ranging from 0 to 1,
+// keeping the alpha value of the color.
+func Shade(c Color, amount float64) Color {
+	black := Black
+	black.A = c.A
+
+	return Mix(c, black, amount)
+}
+
+// Lighten returns the color with the amount added to its HSL lightness, which
+// darkens the color for negative amounts.
+func Lighten(c Color, amount float64) Color {
+	h, s, l := c.HSL()
+
+	lighter := HSL(h, s, clamp(l+amount))
+	lighter.A = c.A
+
+	return lighter
+}
+
+// Saturate returns the color with the amount added to its HSL saturation, which
+// desaturates the color for negative amounts.
+func Saturate(c Color, amount float64) Color {
+	h, s, l := c.HSL()
+
+	saturated := HSL(h, clamp(s+amount), l)
+	saturated.A = c.A
+
+	return saturated
+}
+
+// Palette returns the material-design palette of the color for the grades
+// within Grades, where the 500 grade is the color. Lower grades mix the color
+// into white and higher grades into a darker shade of the color, which is the
+// color multiplied by itself.
+func Palette(c Color) []Color {
+	light := White
+	light.A = c.A
+
+	dark := Color{R: c.R * c.R, G: c.G * c.G, B: c.B * c.B, A: c.A}
+
+	palette := make([]Color, len(Grades))
+
+	for index, weight := range paletteWeights {
+		if Grades[index] <= 500 {
+			palette[index] = Mix(light, c, weight)
+			continue
+		}
+
+		palette[index] = Mix(dark, c, weight)
+	}
+
+	return palette
+}
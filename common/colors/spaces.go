@@ -0,0 +1,216 @@
+package colors
+
+import "math"
+
+// contains the D65 white point used by the CIE XYZ and Lab color spaces.
+const (
+	whiteX = 0.95047
+	whiteY = 1.0
+	whiteZ = 1.08883
+
+	labEpsilon = 6.0 / 29.0
+)
+
+// HSL returns a new opaque Color of the hue in degrees, and the saturation and
+// lightness ranging from 0 to 1.
+func HSL(h, s, l float64) Color {
+	chroma := (1 - math.Abs(2*l-1)) * clamp(s)
+	return fromHue(h, chroma, clamp(l)-chroma/2)
+}
+
+// HSL returns the hue of the color in degrees, and its saturation and lightness
+// ranging from 0 to 1.
+func (c Color) HSL() (h, s, l float64) {
+	max, min := math.Max(c.R, math.Max(c.G, c.B)), math.Min(c.R, math.Min(c.G, c.B))
+
+	l = (max + min) / 2
+
+	if delta := max - min; delta > 0 {
+		s = delta / (1 - math.Abs(2*l-1))
+	}
+
+	return c.hue(), clamp(s), l
+}
+
+// HSV returns a new opaque Color of the hue in degrees, and the saturation and
+// value ranging from 0 to 1.
+func HSV(h, s, v float64) Color {
+	chroma := clamp(v) * clamp(s)
+	return fromHue(h, chroma, clamp(v)-chroma)
+}
+
+// HSV returns the hue of the color in degrees, and its saturation and value
+// ranging from 0 to 1.
+func (c Color) HSV() (h, s, v float64) {
+	max, min := math.Max(c.R, math.Max(c.G, c.B)), math.Min(c.R, math.Min(c.G, c.B))
+
+	if max > 0 {
+		s = (max - min) / max
+	}
+
+	return c.hue(), s, max
+}
+
+// Lab returns a new opaque Color of the CIE L*a*b* lightness ranging from 0 to
+// 100, and its a and b axes.
+func Lab(l, a, b float64) Color {
+	y := (l + 16) / 116
+	x := y + a/500
+	z := y - b/200
+
+	return fromXYZ(whiteX*labInverse(x), whiteY*labInverse(y), whiteZ*labInverse(z))
+}
+
+// Lab returns the CIE L*a*b* lightness of the color ranging from 0 to 100, and
+// its a and b axes, using the D65 white point.
+func (c Color) Lab() (l, a, b float64) {
+	x, y, z := c.xyz()
+
+	fx, fy, fz := labForward(x/whiteX), labForward(y/whiteY), labForward(z/whiteZ)
+
+	return 116*fy - 16, 500 * (fx - fy), 200 * (fy - fz)
+}
+
+// OKLCH returns a new opaque Color of the OKLCH lightness ranging from 0 to 1,
+// its chroma and its hue in degrees. Colors out of the sRGB gamut are clamped.
+func OKLCH(l, c, h float64) Color {
+	radians := h * math.Pi / 180
+	a, b := c*math.Cos(radians), c*math.Sin(radians)
+
+	lc := cube(l + 0.3963377774*a + 0.2158037573*b)
+	mc := cube(l - 0.1055613458*a - 0.0638541728*b)
+	sc := cube(l - 0.0894841775*a - 1.2914855480*b)
+
+	return fromLinear(
+		4.0767416621*lc-3.3077115913*mc+0.2309699292*sc,
+		-1.2684380046*lc+2.6097574011*mc-0.3413193965*sc,
+		-0.0041960863*lc-0.7034186147*mc+1.7076147010*sc,
+	)
+}
+
+// OKLCH returns the OKLCH lightness of the color ranging from 0 to 1, its
+// chroma and its hue in degrees.
+func (c Color) OKLCH() (l, chroma, h float64) {
+	r, g, b := linearize(c.R), linearize(c.G), linearize(c.B)
+
+	lc := math.Cbrt(0.4122214708*r + 0.5363325363*g + 0.0514459929*b)
+	mc := math.Cbrt(0.2119034982*r + 0.6806995451*g + 0.1073969566*b)
+	sc := math.Cbrt(0.0883024619*r + 0.2817188376*g + 0.6299787005*b)
+
+	l = 0.2104542553*lc + 0.7936177850*mc - 0.0040720468*sc
+	a := 1.9779984951*lc - 2.4285922050*mc + 0.4505937099*sc
+	bb := 0.0259040371*lc + 0.7827717662*mc - 0.8086757660*sc
+
+	return l, math.Hypot(a, bb), math.Mod(math.Atan2(bb, a)*180/math.Pi+360, 360)
+}
+
+// hue returns the hue of the color in degrees.
+func (c Color) hue() float64 {
+	max, min := math.Max(c.R, math.Max(c.G, c.B)), math.Min(c.R, math.Min(c.G, c.B))
+
+	delta := max - min
+	if delta == 0 {
+		return 0
+	}
+
+	var h float64
+
+	switch max {
+	case c.R:
+		h = math.Mod((c.G-c.B)/delta, 6)
+	case c.G:
+		h = (c.B-c.R)/delta + 2
+	default:
+		h = (c.R-c.G)/delta + 4
+	}
+
+	return math.Mod(h*60+360, 360)
+}
+
+// fromHue returns a new opaque Color of the hue in degrees, with the chroma
+// and the value added to every channel.
+func fromHue(h, chroma, m float64) Color {
+	h = math.Mod(math.Mod(h, 360)+360, 360) / 60
+	x := chroma * (1 - math.Abs(math.Mod(h, 2)-1))
+
+	var r, g, b float64
+
+	switch {
+	case h < 1:
+		r, g, b = chroma, x, 0
+	case h < 2:
+		r, g, b = x, chroma, 0
+	case h < 3:
+		r, g, b = 0, chroma, x
+	case h < 4:
+		r, g, b = 0, x, chroma
+	case h < 5:
+		r, g, b = x, 0, chroma
+	default:
+		r, g, b = chroma, 0, x
+	}
+
+	return Color{R: clamp(r + m), G: clamp(g + m), B: clamp(b + m), A: 1}
+}
+
+// xyz returns the CIE XYZ values of the color.
+func (c Color) xyz() (x, y, z float64) {
+	r, g, b := linearize(c.R), linearize(c.G), linearize(c.B)
+
+	x = 0.4124564*r + 0.3575761*g + 0.1804375*b
+	y = 0.2126729*r + 0.7151522*g + 0.0721750*b
+	z = 0.0193339*r + 0.1191920*g + 0.9503041*b
+	return
+}
+
+// fromXYZ returns a new opaque Color of the CIE XYZ values.
+func fromXYZ(x, y, z float64) Color {
+	return fromLinear(
+		3.2404542*x-1.5371385*y-0.4985314*z,
+		-0.9692660*x+1.8760108*y+0.0415560*z,
+		0.0556434*x-0.2040259*y+1.0572252*z,
+	)
+}
+
+// fromLinear returns a new opaque Color of the linear sRGB values.
+func fromLinear(r, g, b float64) Color {
+	return Color{R: clamp(delinearize(r)), G: clamp(delinearize(g)), B: clamp(delinearize(b)), A: 1}
+}
+
+// linearize returns the linear value of the sRGB channel value.
+func linearize(value float64) float64 {
+	if value <= 0.04045 {
+		return value / 12.92
+	}
+
+	return math.Pow((value+0.055)/1.055, 2.4)
+}
+
+// delinearize returns the sRGB channel value of the linear value.
+func delinearize(value float64) float64 {
+	if value <= 0.0031308 {
+		return value * 12.92
+	}
+
+	return 1.055*math.Pow(value, 1/2.4) - 0.055
+}
+
+func labForward(t float64) float64 {
+	if t > labEpsilon*labEpsilon*labEpsilon {
+		return math.Cbrt(t)
+	}
+
+	return t/(3*labEpsilon*labEpsilon) + 4.0/29.0
+}
+
+func labInverse(t float64) float64 {
+	if t > labEpsilon {
+		return t * t * t
+	}
+
+	return 3 * labEpsilon * labEpsilon * (t - 4.0/29.0)
+}
+
+func cube(value float64) float64 {
+	return value * value * value
+}
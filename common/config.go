@@ -85,4 +85,14 @@ type Theme struct {
 	AnimationCurveFastOutSlowIn   string
 	AnimationCurveLinearOutSlowIn string
 	MaterialPalettes              map[string][]string
+	Scheme                        string           // Scheme of the theme, either "light" or "dark", defaulting to "light".
+	MinimumContrast               float64          // MinimumContrast is the WCAG contrast ratio of colors of variants against their white color.
+	Variants                      map[string]Theme // Variants like light, dark and high-contrast, whose fields not set are taken or derived from the theme.
+}
+
+// ThemeVariable defines a css custom property generated from the values of a
+// Theme, eg `--color-primary`.
+type ThemeVariable struct {
+	Name  string
+	Value string
 }
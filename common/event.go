@@ -1,11 +1,16 @@
 package common
 
 // EventBroadcast defines a struct which gets published for the events.
-//@notification:event
+//@notification:event(where => EventID)
 type EventBroadcast struct {
 	EventName string      `json:"event"`
 	EventID   string      `json:"event_id"`
 	Event     EventObject `json:"event_object"`
+
+	// OptionsApplied is set by drivers which already applied the Once, Debounce,
+	// Throttle and KeyFilter options of the event (e.g core.js), letting the
+	// Go side deliver the event as is.
+	OptionsApplied bool `json:"options_applied"`
 }
 
 // Deliver will deliver the giving events into the appropriate pipeline for
@@ -14,6 +19,13 @@ func (sn *EventBroadcastHandler) Deliver(name, id string, receive EventObject) {
 	sn.Handle(EventBroadcast{EventName: name, EventID: id, Event: receive})
 }
 
+// DeliverApplied delivers the giving events like Deliver, marking them has
+// having their options already applied by the driver (e.g core.js), which
+// must be used for events decoded from a wire.Event whose OptionsApplied is set.
+func (sn *EventBroadcastHandler) DeliverApplied(name, id string, receive EventObject) {
+	sn.Handle(EventBroadcast{EventName: name, EventID: id, Event: receive, OptionsApplied: true})
+}
+
 // EventObject defines a interface for the basic methods which events needs
 // expose.
 type EventObject interface {
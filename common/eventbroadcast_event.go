@@ -1,6 +1,8 @@
 package common
 
-import "sync"
+import (
+	"sync"
+)
 
 // EventBroadcastSubscriber defines a interface that which is used to subscribe specifically for
 // events  EventBroadcast type.
@@ -31,9 +33,19 @@ func (sn *EventBroadcastHandler) Receive(elem EventBroadcast) {
 // Handle takes the giving value and asserts the expected value to match the
 // EventBroadcast type then passes it to the Receive method.
 func (sn *EventBroadcastHandler) Handle(receive interface{}) {
-	if elem, ok := receive.(EventBroadcast); ok {
-		sn.Receive(elem)
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// EventBroadcast type, returning true/false if it was accepted.
+func (sn *EventBroadcastHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(EventBroadcast)
+	if !ok {
+		return false
 	}
+
+	sn.Receive(elem)
+	return true
 }
 
 //=========================================================================================================
@@ -47,7 +59,8 @@ type EventBroadcastNotification struct {
 	register   map[EventBroadcastSubscriber]int
 }
 
-// NewEventBroadcastNotificationWith returns a new instance of EventBroadcastNotification.
+// NewEventBroadcastNotificationWith returns a new instance of EventBroadcastNotification
+// which only delivers events that pass the provided validation.
 func NewEventBroadcastNotificationWith(validation func(EventBroadcast) bool) *EventBroadcastNotification {
 	var elem EventBroadcastNotification
 
@@ -57,7 +70,15 @@ func NewEventBroadcastNotificationWith(validation func(EventBroadcast) bool) *Ev
 	return &elem
 }
 
-// NewEventBroadcastNotification returns a new instance of NewEventBroadcastNotification.
+// NewEventBroadcastNotificationWhereEventID returns a new instance of EventBroadcastNotification
+// which only delivers events whose EventID field equals the provided value.
+func NewEventBroadcastNotificationWhereEventID(value string) *EventBroadcastNotification {
+	return NewEventBroadcastNotificationWith(func(elem EventBroadcast) bool {
+		return elem.EventID == value
+	})
+}
+
+// NewEventBroadcastNotification returns a new instance of EventBroadcastNotification.
 func NewEventBroadcastNotification() *EventBroadcastNotification {
 	var elem EventBroadcastNotification
 	elem.register = make(map[EventBroadcastSubscriber]int, 0)
@@ -73,7 +94,12 @@ func (sn *EventBroadcastNotification) UnNotify(sub EventBroadcastSubscriber) {
 			return
 		}
 
+		delete(sn.register, sub)
 		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
 	})
 }
 
@@ -81,6 +107,10 @@ func (sn *EventBroadcastNotification) UnNotify(sub EventBroadcastSubscriber) {
 // a new event of the given EventBroadcast type.
 func (sn *EventBroadcastNotification) Notify(sub EventBroadcastSubscriber) {
 	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
 		sn.register[sub] = len(sn.subs)
 		sn.subs = append(sn.subs, sub)
 	})
@@ -89,23 +119,28 @@ func (sn *EventBroadcastNotification) Notify(sub EventBroadcastSubscriber) {
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
 func (sn *EventBroadcastNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(EventBroadcast); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	sn.Accept(elem)
+}
 
-			return
-		}
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *EventBroadcastNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(EventBroadcast)
+	if !ok {
+		return false
+	}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
 	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
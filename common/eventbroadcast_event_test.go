@@ -0,0 +1,71 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestEventBroadcastNotification validates the delivery, validation and filters of
+// EventBroadcast events through the generated EventBroadcastHandler and EventBroadcastNotification.
+func TestEventBroadcastNotification(t *testing.T) {
+	var received int
+	handler := NewEventBroadcastHandler(func(EventBroadcast) {
+		received++
+	})
+
+	var elem EventBroadcast
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only EventBroadcast events: %d", received)
+	}
+	tests.Passed("Should have accepted only EventBroadcast events")
+
+	received = 0
+
+	notifier := NewEventBroadcastNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered EventBroadcast event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered EventBroadcast event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering EventBroadcast events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering EventBroadcast events after UnNotify")
+
+	invalid := NewEventBroadcastNotificationWith(func(EventBroadcast) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered EventBroadcast event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered EventBroadcast event failing validation")
+
+	whereEventID := NewEventBroadcastNotificationWhereEventID(elem.EventID)
+	whereEventID.Notify(handler)
+
+	if !whereEventID.Accept(elem) || received != 2 {
+		tests.Failed("Should have delivered EventBroadcast event with matching EventID: %d", received)
+	}
+	tests.Passed("Should have delivered EventBroadcast event with matching EventID")
+
+	received = 1
+
+	unmatchedEventID := NewEventBroadcastNotificationWhereEventID("unmatched")
+	unmatchedEventID.Notify(handler)
+
+	if unmatchedEventID.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered EventBroadcast event with different EventID: %d", received)
+	}
+	tests.Passed("Should have not delivered EventBroadcast event with different EventID")
+}
@@ -9,7 +9,7 @@ import (
 	"text/template"
 
 	"github.com/gu-io/gu/common"
-	"github.com/influx6/faux/colors"
+	"github.com/gu-io/gu/common/colors"
 	colorful "github.com/lucasb-eyer/go-colorful"
 )
 
@@ -44,6 +44,10 @@ const (
 )
 
 var (
+	// MaterialPalettes provides material-design chosen color palettes
+	// converted from the material-design spec.
+	MaterialPalettes = colors.MaterialPalettes
+
 	helpers = template.FuncMap{
 		"quote": func(b interface{}) string {
 			switch bo := b.(type) {
@@ -143,7 +147,9 @@ type styleColors struct {
 
 // Render initializes the style guide and all internal properties into
 // appropriate defaults and states and generates a css style written into
-// the provided writer
+// the provided writer. The variants of the theme are written after it as css
+// custom properties which the color classes of the stylesheet use, see
+// Variants.
 func Render(w io.Writer, attr common.Theme) error {
 	var err error
 
@@ -152,44 +158,49 @@ func Render(w io.Writer, attr common.Theme) error {
 	attr = initAttr(attr)
 
 	if attr.PrimaryBrandColor != "" {
-		brand.PrimaryBrand, err = NewTones(attr.PrimaryBrandColor)
+		brand.PrimaryBrand, err = newSchemeTones(attr.PrimaryBrandColor, attr.Scheme)
 		if err != nil {
 			return errors.New("Invalid primary brand color: " + err.Error())
 		}
 	}
 
 	if attr.SecondaryBrandColor != "" {
-		brand.SecondaryBrand, err = NewTones(attr.SecondaryBrandColor)
+		brand.SecondaryBrand, err = newSchemeTones(attr.SecondaryBrandColor, attr.Scheme)
 		if err != nil {
 			return errors.New("Invalid secondary brand color: " + err.Error())
 		}
 	}
 
-	brand.Primary, err = NewTones(attr.PrimaryColor)
+	brand.Primary, err = newSchemeTones(attr.PrimaryColor, attr.Scheme)
 	if err != nil {
 		return errors.New("Invalid primary color: " + err.Error())
 	}
 
-	brand.Secondary, err = NewTones(attr.SecondaryColor)
+	brand.Secondary, err = newSchemeTones(attr.SecondaryColor, attr.Scheme)
 	if err != nil {
 		return errors.New("Invalid secondary color: " + err.Error())
 	}
 
-	brand.White, err = NewTones(attr.PrimaryWhite)
+	brand.White, err = newSchemeTones(attr.PrimaryWhite, attr.Scheme)
 	if err != nil {
 		return errors.New("Invalid white color: " + err.Error())
 	}
 
-	brand.Success, err = NewTones(attr.SuccessColor)
+	brand.Success, err = newSchemeTones(attr.SuccessColor, attr.Scheme)
 	if err != nil {
 		return errors.New("Invalid success color: " + err.Error())
 	}
 
-	brand.Failure, err = NewTones(attr.FailureColor)
+	brand.Failure, err = newSchemeTones(attr.FailureColor, attr.Scheme)
 	if err != nil {
 		return errors.New("Invalid failure color: " + err.Error())
 	}
 
+	variants, err := renderVariants(attr)
+	if err != nil {
+		return err
+	}
+
 	tml, err := template.New("styleguide").Funcs(helpers).Parse(styleTemplate)
 	if err != nil {
 		return err
@@ -198,7 +209,7 @@ func Render(w io.Writer, attr common.Theme) error {
 	shm, bhm := GenerateValueScale(1, attr.HeaderBaseScale, attr.MinimumHeadScaleCount, attr.MaximumHeadScaleCount)
 	sm, bg := GenerateValueScale(1, attr.BaseScale, attr.MinimumScaleCount, attr.MaximumScaleCount)
 
-	return tml.Execute(w, struct {
+	if err := tml.Execute(w, struct {
 		common.Theme
 		Brand            styleColors
 		SmallFontScale   []float64
@@ -212,7 +223,12 @@ func Render(w io.Writer, attr common.Theme) error {
 		BigHeaderScale:   bhm,
 		Brand:            brand,
 		Theme:            attr,
-	})
+	}); err != nil {
+		return err
+	}
+
+	_, err = io.WriteString(w, variants)
+	return err
 }
 
 //================================================================================================
@@ -235,6 +251,24 @@ func NewTones(base string) (Tones, error) {
 	return HamonicsFrom(c), nil
 }
 
+// newSchemeTones returns the Tones of the color for the scheme, where the grades
+// of the dark scheme are reversed, so that the lowest grades keep the most
+// contrast against its dark white color.
+func newSchemeTones(base string, scheme string) (Tones, error) {
+	tones, err := NewTones(base)
+	if err != nil || scheme != DarkScheme {
+		return tones, err
+	}
+
+	grades := make([]Color, len(tones.Grades))
+	for index, grade := range tones.Grades {
+		grades[len(grades)-index-1] = grade
+	}
+
+	tones.Grades = grades
+	return tones, nil
+}
+
 // String returns the string representation of the provided tone.
 func (t Tones) String() string {
 	return fmt.Sprintf(`%q %q`, t.Base, t.Grades)
@@ -265,6 +299,10 @@ func initAttr(attr common.Theme) common.Theme {
 		attr.FailureColor = fmt.Sprintf("rgb(%s)", MaterialPalettes["red"][5])
 	}
 
+	if attr.Scheme == "" {
+		attr.Scheme = LightScheme
+	}
+
 	if attr.BaseFontSize <= 0 {
 		attr.BaseFontSize = 16
 	}
@@ -515,34 +553,14 @@ func (c Color) String() string {
 
 // ColorFrom returns a Color instance representing the valid
 // color values provided else returning error if the color value
-// is not a valid color presentation i.e (rgb,rgba, hsl, hex).
+// is not a valid color presentation i.e (rgb,rgba, hsl, hex, named).
 func ColorFrom(value string) (Color, error) {
-	var c colorful.Color
-
-	alpha := float64(1)
-
-	switch {
-	case colors.IsHex(value):
-		c, _ = colorful.Hex(value)
-		break
-	case colors.IsHSL(value):
-		h, s, l := colors.ParseHSL(value)
-		c = colorful.Hsl(h, s, l)
-		break
-	case colors.IsRGB(value):
-		var red, green, blue int
-		red, green, blue, alpha = colors.ParseRGB(value)
-		c = colorful.Color{R: float64(red) / 255, G: float64(green) / 255, B: float64(blue) / 255}
-		break
-	case colors.IsRGBA(value):
-		var red, green, blue int
-		red, green, blue, alpha = colors.ParseRGB(value)
-		c = colorful.Color{R: float64(red) / 255, G: float64(green) / 255, B: float64(blue) / 255}
-		break
-	default:
-		return Color{}, errors.New("Invalid color value received")
+	parsed, err := colors.Parse(value)
+	if err != nil {
+		return Color{}, err
 	}
 
+	c := colorful.Color{R: parsed.R, G: parsed.G, B: parsed.B}
 	h, s, l := c.Hsl()
 
 	return Color{
@@ -550,10 +568,15 @@ func ColorFrom(value string) (Color, error) {
 		Hue:        h,
 		Saturation: s,
 		Luminosity: l,
-		Alpha:      alpha,
+		Alpha:      parsed.A,
 	}, nil
 }
 
+// rgba returns the color as a colors.Color.
+func (c Color) rgba() colors.Color {
+	return colors.Color{R: c.C.R, G: c.C.G, B: c.C.B, A: c.Alpha}
+}
+
 //==================================================================================
 
 // GenerateValueScale returns a slice of values which are the a combination of
@@ -517,57 +517,57 @@ ____________ Color set ____________________________
 */
 
 .brand-color-primary {
-	color: {{.Brand.PrimaryBrand.Base}};
+	color: var(--color-primary-brand, {{.Brand.PrimaryBrand.Base}});
 }
 
 .brand-border-color-primary {
-	border-color: {{.Brand.PrimaryBrand.Base}};
+	border-color: var(--color-primary-brand, {{.Brand.PrimaryBrand.Base}});
 }
 
 .brand-background-color-primary {
-	background: {{.Brand.PrimaryBrand.Base}};
+	background: var(--color-primary-brand, {{.Brand.PrimaryBrand.Base}});
 }
 
 {{ range $index, $item := .Brand.PrimaryBrand.Grades }}
 {{ $rn := add $index 1 }}
 .brand-background-color-primary-{{ multiply $rn 10}} {
-	background: {{$item}};
+	background: var(--color-primary-brand-{{ multiply $rn 10}}, {{$item}});
 }
 
 .brand-border-color-primary-{{ multiply $rn 10}} {
-	border-color: {{$item}};
+	border-color: var(--color-primary-brand-{{ multiply $rn 10}}, {{$item}});
 }
 
 .brand-primary-{{ multiply $rn 10}} {
-	color: {{$item}};
+	color: var(--color-primary-brand-{{ multiply $rn 10}}, {{$item}});
 }
 {{ end }}
 
 
 .color-primary {
-	color: {{.Brand.Primary.Base}};
+	color: var(--color-primary, {{.Brand.Primary.Base}});
 }
 
 .border-color-primary {
-	border-color: {{.Brand.Primary.Base}};
+	border-color: var(--color-primary, {{.Brand.Primary.Base}});
 }
 
 .background-color-primary {
-	background: {{.Brand.Primary.Base}};
+	background: var(--color-primary, {{.Brand.Primary.Base}});
 }
 
 {{ range $index, $item := .Brand.Primary.Grades }}
 {{ $rn := add $index 1 }}
 .background-color-primary-{{ multiply $rn 10}} {
-	background: {{$item}};
+	background: var(--color-primary-{{ multiply $rn 10}}, {{$item}});
 }
 
 .color-primary-{{ multiply $rn 10}} {
-	color: {{$item}};
+	color: var(--color-primary-{{ multiply $rn 10}}, {{$item}});
 }
 
 .border-color-primary-{{ multiply $rn 10}} {
-	border-color: {{$item}};
+	border-color: var(--color-primary-{{ multiply $rn 10}}, {{$item}});
 }
 {{ end }}
 
@@ -576,56 +576,56 @@ ____________ Color set ____________________________
 */
 
 .color-secondary {
-	color: {{.Brand.Secondary.Base}};
+	color: var(--color-secondary, {{.Brand.Secondary.Base}});
 }
 
 .background-color-secondary {
-	background: {{.Brand.Secondary.Base}};
+	background: var(--color-secondary, {{.Brand.Secondary.Base}});
 }
 
 .border-color-secondary {
-	background: {{.Brand.Secondary.Base}};
+	background: var(--color-secondary, {{.Brand.Secondary.Base}});
 }
 
 {{ range $index, $item := .Brand.Secondary.Grades }}
 {{ $rn := add $index 1 }}
 .background-color-secondary-{{ multiply $rn 10}} {
-	background: {{$item}};
+	background: var(--color-secondary-{{ multiply $rn 10}}, {{$item}});
 }
 
 .border-color-secondary-{{ multiply $rn 10}} {
-	border-color: {{$item}};
+	border-color: var(--color-secondary-{{ multiply $rn 10}}, {{$item}});
 }
 
 .color-secondary-{{ multiply $rn 10}} {
-	color: {{$item}};
+	color: var(--color-secondary-{{ multiply $rn 10}}, {{$item}});
 }
 {{ end }}
 
 .brand-color-secondary {
-	color: {{.Brand.SecondaryBrand.Base}};
+	color: var(--color-secondary-brand, {{.Brand.SecondaryBrand.Base}});
 }
 
 .brand-background-color-secondary {
-	background: {{.Brand.SecondaryBrand.Base}};
+	background: var(--color-secondary-brand, {{.Brand.SecondaryBrand.Base}});
 }
 
 .brand-border-color-secondary {
-	background: {{.Brand.SecondaryBrand.Base}};
+	background: var(--color-secondary-brand, {{.Brand.SecondaryBrand.Base}});
 }
 
 {{ range $index, $item := .Brand.SecondaryBrand.Grades }}
 {{ $rn := add $index 1 }}
 .brand-background-color-secondary-{{ multiply $rn 10}} {
-	background: {{$item}};
+	background: var(--color-secondary-brand-{{ multiply $rn 10}}, {{$item}});
 }
 
 .brand-border-color-secondary-{{ multiply $rn 10}} {
-	border-color: {{$item}};
+	border-color: var(--color-secondary-brand-{{ multiply $rn 10}}, {{$item}});
 }
 
 .brand-color-secondary-{{ multiply $rn 10}} {
-	color: {{$item}};
+	color: var(--color-secondary-brand-{{ multiply $rn 10}}, {{$item}});
 }
 {{ end }}
 
@@ -635,29 +635,29 @@ ____________ Color set ____________________________
 */
 
 .brand-success {
-	color: {{.Brand.Success.Base}};
+	color: var(--color-success, {{.Brand.Success.Base}});
 }
 
 .background-color-success {
-	background: {{.Brand.Success.Base}};
+	background: var(--color-success, {{.Brand.Success.Base}});
 }
 
 .border-color-success {
-	border-color: {{.Brand.Success.Base}};
+	border-color: var(--color-success, {{.Brand.Success.Base}});
 }
 
 {{ range $index, $item := .Brand.Success.Grades }}
 {{ $rn := add $index 1 }}
 .background-color-success-{{ multiply $rn 10}} {
-	background: {{$item}};
+	background: var(--color-success-{{ multiply $rn 10}}, {{$item}});
 }
 
 .brand-success-{{ multiply $rn 10}} {
-	color: {{$item}};
+	color: var(--color-success-{{ multiply $rn 10}}, {{$item}});
 }
 
 .border-color-success-{{ multiply $rn 10}} {
-	border-color: {{$item}};
+	border-color: var(--color-success-{{ multiply $rn 10}}, {{$item}});
 }
 {{ end }}
 
@@ -666,29 +666,29 @@ ____________ Color set ____________________________
 */
 
 .background-color-white {
-	background: {{.Brand.White.Base}};
+	background: var(--color-white, {{.Brand.White.Base}});
 }
 
 .brand-white {
-	color: {{.Brand.White.Base}};
+	color: var(--color-white, {{.Brand.White.Base}});
 }
 
 .border-color-white {
-	border-color: {{.Brand.White.Base}};
+	border-color: var(--color-white, {{.Brand.White.Base}});
 }
 
 {{ range $index, $item := .Brand.White.Grades }}
 {{ $rn := add $index 1 }}
 .background-color-white-{{ multiply $rn 10}} {
-	background: {{$item}};
+	background: var(--color-white-{{ multiply $rn 10}}, {{$item}});
 }
 
 .brand-white-{{ multiply $rn 10}} {
-	color: {{$item}};
+	color: var(--color-white-{{ multiply $rn 10}}, {{$item}});
 }
 
 .border-color-white-{{ multiply $rn 10}} {
-	border-color: {{$item}};
+	border-color: var(--color-white-{{ multiply $rn 10}}, {{$item}});
 }
 {{ end }}
 
@@ -697,29 +697,29 @@ ____________ Color set ____________________________
 */
 
 .background-color-failure {
-	background: {{.Brand.Failure.Base}};
+	background: var(--color-failure, {{.Brand.Failure.Base}});
 }
 
 .brand-failure {
-	color: {{.Brand.Failure.Base}};
+	color: var(--color-failure, {{.Brand.Failure.Base}});
 }
 
 .border-color-failure {
-	border-color: {{.Brand.Failure.Base}};
+	border-color: var(--color-failure, {{.Brand.Failure.Base}});
 }
 
 {{ range $index, $item := .Brand.Failure.Grades }}
 {{ $rn := add $index 1 }}
 .background-color-failure-{{ multiply $rn 10}} {
-	background: {{$item}};
+	background: var(--color-failure-{{ multiply $rn 10}}, {{$item}});
 }
 
 .brand-failure-{{ multiply $rn 10}} {
-	color: {{$item}};
+	color: var(--color-failure-{{ multiply $rn 10}}, {{$item}});
 }
 
 .border-color-failure-{{ multiply $rn 10}} {
-	border-color: {{$item}};
+	border-color: var(--color-failure-{{ multiply $rn 10}}, {{$item}});
 }
 {{ end }}
 
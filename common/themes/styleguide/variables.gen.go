@@ -0,0 +1,1350 @@
+// Package styleguide is generated to contain the names of the css custom properties of the default theme.
+
+// Document is auto-generate and should not be modified by hand.
+
+package styleguide
+
+// contains the names of the css custom properties returned by Variables for the
+// default theme, with the brand colors set.
+const (
+	// ColorPrimaryVar defines the name of the "--color-primary" css custom property.
+	ColorPrimaryVar = "--color-primary"
+
+	// ColorPrimary10Var defines the name of the "--color-primary-10" css custom property.
+	ColorPrimary10Var = "--color-primary-10"
+
+	// ColorPrimary20Var defines the name of the "--color-primary-20" css custom property.
+	ColorPrimary20Var = "--color-primary-20"
+
+	// ColorPrimary30Var defines the name of the "--color-primary-30" css custom property.
+	ColorPrimary30Var = "--color-primary-30"
+
+	// ColorPrimary40Var defines the name of the "--color-primary-40" css custom property.
+	ColorPrimary40Var = "--color-primary-40"
+
+	// ColorPrimary50Var defines the name of the "--color-primary-50" css custom property.
+	ColorPrimary50Var = "--color-primary-50"
+
+	// ColorPrimary60Var defines the name of the "--color-primary-60" css custom property.
+	ColorPrimary60Var = "--color-primary-60"
+
+	// ColorPrimary70Var defines the name of the "--color-primary-70" css custom property.
+	ColorPrimary70Var = "--color-primary-70"
+
+	// ColorPrimary80Var defines the name of the "--color-primary-80" css custom property.
+	ColorPrimary80Var = "--color-primary-80"
+
+	// ColorPrimary90Var defines the name of the "--color-primary-90" css custom property.
+	ColorPrimary90Var = "--color-primary-90"
+
+	// ColorPrimary100Var defines the name of the "--color-primary-100" css custom property.
+	ColorPrimary100Var = "--color-primary-100"
+
+	// ColorPrimary110Var defines the name of the "--color-primary-110" css custom property.
+	ColorPrimary110Var = "--color-primary-110"
+
+	// ColorPrimary120Var defines the name of the "--color-primary-120" css custom property.
+	ColorPrimary120Var = "--color-primary-120"
+
+	// ColorPrimary130Var defines the name of the "--color-primary-130" css custom property.
+	ColorPrimary130Var = "--color-primary-130"
+
+	// ColorPrimary140Var defines the name of the "--color-primary-140" css custom property.
+	ColorPrimary140Var = "--color-primary-140"
+
+	// ColorPrimary150Var defines the name of the "--color-primary-150" css custom property.
+	ColorPrimary150Var = "--color-primary-150"
+
+	// ColorPrimary160Var defines the name of the "--color-primary-160" css custom property.
+	ColorPrimary160Var = "--color-primary-160"
+
+	// ColorPrimary170Var defines the name of the "--color-primary-170" css custom property.
+	ColorPrimary170Var = "--color-primary-170"
+
+	// ColorPrimary180Var defines the name of the "--color-primary-180" css custom property.
+	ColorPrimary180Var = "--color-primary-180"
+
+	// ColorPrimary190Var defines the name of the "--color-primary-190" css custom property.
+	ColorPrimary190Var = "--color-primary-190"
+
+	// ColorPrimary200Var defines the name of the "--color-primary-200" css custom property.
+	ColorPrimary200Var = "--color-primary-200"
+
+	// ColorSecondaryVar defines the name of the "--color-secondary" css custom property.
+	ColorSecondaryVar = "--color-secondary"
+
+	// ColorSecondary10Var defines the name of the "--color-secondary-10" css custom property.
+	ColorSecondary10Var = "--color-secondary-10"
+
+	// ColorSecondary20Var defines the name of the "--color-secondary-20" css custom property.
+	ColorSecondary20Var = "--color-secondary-20"
+
+	// ColorSecondary30Var defines the name of the "--color-secondary-30" css custom property.
+	ColorSecondary30Var = "--color-secondary-30"
+
+	// ColorSecondary40Var defines the name of the "--color-secondary-40" css custom property.
+	ColorSecondary40Var = "--color-secondary-40"
+
+	// ColorSecondary50Var defines the name of the "--color-secondary-50" css custom property.
+	ColorSecondary50Var = "--color-secondary-50"
+
+	// ColorSecondary60Var defines the name of the "--color-secondary-60" css custom property.
+	ColorSecondary60Var = "--color-secondary-60"
+
+	// ColorSecondary70Var defines the name of the "--color-secondary-70" css custom property.
+	ColorSecondary70Var = "--color-secondary-70"
+
+	// ColorSecondary80Var defines the name of the "--color-secondary-80" css custom property.
+	ColorSecondary80Var = "--color-secondary-80"
+
+	// ColorSecondary90Var defines the name of the "--color-secondary-90" css custom property.
+	ColorSecondary90Var = "--color-secondary-90"
+
+	// ColorSecondary100Var defines the name of the "--color-secondary-100" css custom property.
+	ColorSecondary100Var = "--color-secondary-100"
+
+	// ColorSecondary110Var defines the name of the "--color-secondary-110" css custom property.
+	ColorSecondary110Var = "--color-secondary-110"
+
+	// ColorSecondary120Var defines the name of the "--color-secondary-120" css custom property.
+	ColorSecondary120Var = "--color-secondary-120"
+
+	// ColorSecondary130Var defines the name of the "--color-secondary-130" css custom property.
+	ColorSecondary130Var = "--color-secondary-130"
+
+	// ColorSecondary140Var defines the name of the "--color-secondary-140" css custom property.
+	ColorSecondary140Var = "--color-secondary-140"
+
+	// ColorSecondary150Var defines the name of the "--color-secondary-150" css custom property.
+	ColorSecondary150Var = "--color-secondary-150"
+
+	// ColorSecondary160Var defines the name of the "--color-secondary-160" css custom property.
+	ColorSecondary160Var = "--color-secondary-160"
+
+	// ColorSecondary170Var defines the name of the "--color-secondary-170" css custom property.
+	ColorSecondary170Var = "--color-secondary-170"
+
+	// ColorSecondary180Var defines the name of the "--color-secondary-180" css custom property.
+	ColorSecondary180Var = "--color-secondary-180"
+
+	// ColorSecondary190Var defines the name of the "--color-secondary-190" css custom property.
+	ColorSecondary190Var = "--color-secondary-190"
+
+	// ColorSecondary200Var defines the name of the "--color-secondary-200" css custom property.
+	ColorSecondary200Var = "--color-secondary-200"
+
+	// ColorSuccessVar defines the name of the "--color-success" css custom property.
+	ColorSuccessVar = "--color-success"
+
+	// ColorSuccess10Var defines the name of the "--color-success-10" css custom property.
+	ColorSuccess10Var = "--color-success-10"
+
+	// ColorSuccess20Var defines the name of the "--color-success-20" css custom property.
+	ColorSuccess20Var = "--color-success-20"
+
+	// ColorSuccess30Var defines the name of the "--color-success-30" css custom property.
+	ColorSuccess30Var = "--color-success-30"
+
+	// ColorSuccess40Var defines the name of the "--color-success-40" css custom property.
+	ColorSuccess40Var = "--color-success-40"
+
+	// ColorSuccess50Var defines the name of the "--color-success-50" css custom property.
+	ColorSuccess50Var = "--color-success-50"
+
+	// ColorSuccess60Var defines the name of the "--color-success-60" css custom property.
+	ColorSuccess60Var = "--color-success-60"
+
+	// ColorSuccess70Var defines the name of the "--color-success-70" css custom property.
+	ColorSuccess70Var = "--color-success-70"
+
+	// ColorSuccess80Var defines the name of the "--color-success-80" css custom property.
+	ColorSuccess80Var = "--color-success-80"
+
+	// ColorSuccess90Var defines the name of the "--color-success-90" css custom property.
+	ColorSuccess90Var = "--color-success-90"
+
+	// ColorSuccess100Var defines the name of the "--color-success-100" css custom property.
+	ColorSuccess100Var = "--color-success-100"
+
+	// ColorSuccess110Var defines the name of the "--color-success-110" css custom property.
+	ColorSuccess110Var = "--color-success-110"
+
+	// ColorSuccess120Var defines the name of the "--color-success-120" css custom property.
+	ColorSuccess120Var = "--color-success-120"
+
+	// ColorSuccess130Var defines the name of the "--color-success-130" css custom property.
+	ColorSuccess130Var = "--color-success-130"
+
+	// ColorSuccess140Var defines the name of the "--color-success-140" css custom property.
+	ColorSuccess140Var = "--color-success-140"
+
+	// ColorSuccess150Var defines the name of the "--color-success-150" css custom property.
+	ColorSuccess150Var = "--color-success-150"
+
+	// ColorSuccess160Var defines the name of the "--color-success-160" css custom property.
+	ColorSuccess160Var = "--color-success-160"
+
+	// ColorSuccess170Var defines the name of the "--color-success-170" css custom property.
+	ColorSuccess170Var = "--color-success-170"
+
+	// ColorSuccess180Var defines the name of the "--color-success-180" css custom property.
+	ColorSuccess180Var = "--color-success-180"
+
+	// ColorSuccess190Var defines the name of the "--color-success-190" css custom property.
+	ColorSuccess190Var = "--color-success-190"
+
+	// ColorSuccess200Var defines the name of the "--color-success-200" css custom property.
+	ColorSuccess200Var = "--color-success-200"
+
+	// ColorFailureVar defines the name of the "--color-failure" css custom property.
+	ColorFailureVar = "--color-failure"
+
+	// ColorFailure10Var defines the name of the "--color-failure-10" css custom property.
+	ColorFailure10Var = "--color-failure-10"
+
+	// ColorFailure20Var defines the name of the "--color-failure-20" css custom property.
+	ColorFailure20Var = "--color-failure-20"
+
+	// ColorFailure30Var defines the name of the "--color-failure-30" css custom property.
+	ColorFailure30Var = "--color-failure-30"
+
+	// ColorFailure40Var defines the name of the "--color-failure-40" css custom property.
+	ColorFailure40Var = "--color-failure-40"
+
+	// ColorFailure50Var defines the name of the "--color-failure-50" css custom property.
+	ColorFailure50Var = "--color-failure-50"
+
+	// ColorFailure60Var defines the name of the "--color-failure-60" css custom property.
+	ColorFailure60Var = "--color-failure-60"
+
+	// ColorFailure70Var defines the name of the "--color-failure-70" css custom property.
+	ColorFailure70Var = "--color-failure-70"
+
+	// ColorFailure80Var defines the name of the "--color-failure-80" css custom property.
+	ColorFailure80Var = "--color-failure-80"
+
+	// ColorFailure90Var defines the name of the "--color-failure-90" css custom property.
+	ColorFailure90Var = "--color-failure-90"
+
+	// ColorFailure100Var defines the name of the "--color-failure-100" css custom property.
+	ColorFailure100Var = "--color-failure-100"
+
+	// ColorFailure110Var defines the name of the "--color-failure-110" css custom property.
+	ColorFailure110Var = "--color-failure-110"
+
+	// ColorFailure120Var defines the name of the "--color-failure-120" css custom property.
+	ColorFailure120Var = "--color-failure-120"
+
+	// ColorFailure130Var defines the name of the "--color-failure-130" css custom property.
+	ColorFailure130Var = "--color-failure-130"
+
+	// ColorFailure140Var defines the name of the "--color-failure-140" css custom property.
+	ColorFailure140Var = "--color-failure-140"
+
+	// ColorFailure150Var defines the name of the "--color-failure-150" css custom property.
+	ColorFailure150Var = "--color-failure-150"
+
+	// ColorFailure160Var defines the name of the "--color-failure-160" css custom property.
+	ColorFailure160Var = "--color-failure-160"
+
+	// ColorFailure170Var defines the name of the "--color-failure-170" css custom property.
+	ColorFailure170Var = "--color-failure-170"
+
+	// ColorFailure180Var defines the name of the "--color-failure-180" css custom property.
+	ColorFailure180Var = "--color-failure-180"
+
+	// ColorFailure190Var defines the name of the "--color-failure-190" css custom property.
+	ColorFailure190Var = "--color-failure-190"
+
+	// ColorFailure200Var defines the name of the "--color-failure-200" css custom property.
+	ColorFailure200Var = "--color-failure-200"
+
+	// ColorWhiteVar defines the name of the "--color-white" css custom property.
+	ColorWhiteVar = "--color-white"
+
+	// ColorWhite10Var defines the name of the "--color-white-10" css custom property.
+	ColorWhite10Var = "--color-white-10"
+
+	// ColorWhite20Var defines the name of the "--color-white-20" css custom property.
+	ColorWhite20Var = "--color-white-20"
+
+	// ColorWhite30Var defines the name of the "--color-white-30" css custom property.
+	ColorWhite30Var = "--color-white-30"
+
+	// ColorWhite40Var defines the name of the "--color-white-40" css custom property.
+	ColorWhite40Var = "--color-white-40"
+
+	// ColorWhite50Var defines the name of the "--color-white-50" css custom property.
+	ColorWhite50Var = "--color-white-50"
+
+	// ColorWhite60Var defines the name of the "--color-white-60" css custom property.
+	ColorWhite60Var = "--color-white-60"
+
+	// ColorWhite70Var defines the name of the "--color-white-70" css custom property.
+	ColorWhite70Var = "--color-white-70"
+
+	// ColorWhite80Var defines the name of the "--color-white-80" css custom property.
+	ColorWhite80Var = "--color-white-80"
+
+	// ColorWhite90Var defines the name of the "--color-white-90" css custom property.
+	ColorWhite90Var = "--color-white-90"
+
+	// ColorWhite100Var defines the name of the "--color-white-100" css custom property.
+	ColorWhite100Var = "--color-white-100"
+
+	// ColorWhite110Var defines the name of the "--color-white-110" css custom property.
+	ColorWhite110Var = "--color-white-110"
+
+	// ColorWhite120Var defines the name of the "--color-white-120" css custom property.
+	ColorWhite120Var = "--color-white-120"
+
+	// ColorWhite130Var defines the name of the "--color-white-130" css custom property.
+	ColorWhite130Var = "--color-white-130"
+
+	// ColorWhite140Var defines the name of the "--color-white-140" css custom property.
+	ColorWhite140Var = "--color-white-140"
+
+	// ColorWhite150Var defines the name of the "--color-white-150" css custom property.
+	ColorWhite150Var = "--color-white-150"
+
+	// ColorWhite160Var defines the name of the "--color-white-160" css custom property.
+	ColorWhite160Var = "--color-white-160"
+
+	// ColorWhite170Var defines the name of the "--color-white-170" css custom property.
+	ColorWhite170Var = "--color-white-170"
+
+	// ColorWhite180Var defines the name of the "--color-white-180" css custom property.
+	ColorWhite180Var = "--color-white-180"
+
+	// ColorWhite190Var defines the name of the "--color-white-190" css custom property.
+	ColorWhite190Var = "--color-white-190"
+
+	// ColorWhite200Var defines the name of the "--color-white-200" css custom property.
+	ColorWhite200Var = "--color-white-200"
+
+	// ColorPrimaryBrandVar defines the name of the "--color-primary-brand" css custom property.
+	ColorPrimaryBrandVar = "--color-primary-brand"
+
+	// ColorPrimaryBrand10Var defines the name of the "--color-primary-brand-10" css custom property.
+	ColorPrimaryBrand10Var = "--color-primary-brand-10"
+
+	// ColorPrimaryBrand20Var defines the name of the "--color-primary-brand-20" css custom property.
+	ColorPrimaryBrand20Var = "--color-primary-brand-20"
+
+	// ColorPrimaryBrand30Var defines the name of the "--color-primary-brand-30" css custom property.
+	ColorPrimaryBrand30Var = "--color-primary-brand-30"
+
+	// ColorPrimaryBrand40Var defines the name of the "--color-primary-brand-40" css custom property.
+	ColorPrimaryBrand40Var = "--color-primary-brand-40"
+
+	// ColorPrimaryBrand50Var defines the name of the "--color-primary-brand-50" css custom property.
+	ColorPrimaryBrand50Var = "--color-primary-brand-50"
+
+	// ColorPrimaryBrand60Var defines the name of the "--color-primary-brand-60" css custom property.
+	ColorPrimaryBrand60Var = "--color-primary-brand-60"
+
+	// ColorPrimaryBrand70Var defines the name of the "--color-primary-brand-70" css custom property.
+	ColorPrimaryBrand70Var = "--color-primary-brand-70"
+
+	// ColorPrimaryBrand80Var defines the name of the "--color-primary-brand-80" css custom property.
+	ColorPrimaryBrand80Var = "--color-primary-brand-80"
+
+	// ColorPrimaryBrand90Var defines the name of the "--color-primary-brand-90" css custom property.
+	ColorPrimaryBrand90Var = "--color-primary-brand-90"
+
+	// ColorPrimaryBrand100Var defines the name of the "--color-primary-brand-100" css custom property.
+	ColorPrimaryBrand100Var = "--color-primary-brand-100"
+
+	// ColorPrimaryBrand110Var defines the name of the "--color-primary-brand-110" css custom property.
+	ColorPrimaryBrand110Var = "--color-primary-brand-110"
+
+	// ColorPrimaryBrand120Var defines the name of the "--color-primary-brand-120" css custom property.
+	ColorPrimaryBrand120Var = "--color-primary-brand-120"
+
+	// ColorPrimaryBrand130Var defines the name of the "--color-primary-brand-130" css custom property.
+	ColorPrimaryBrand130Var = "--color-primary-brand-130"
+
+	// ColorPrimaryBrand140Var defines the name of the "--color-primary-brand-140" css custom property.
+	ColorPrimaryBrand140Var = "--color-primary-brand-140"
+
+	// ColorPrimaryBrand150Var defines the name of the "--color-primary-brand-150" css custom property.
+	ColorPrimaryBrand150Var = "--color-primary-brand-150"
+
+	// ColorPrimaryBrand160Var defines the name of the "--color-primary-brand-160" css custom property.
+	ColorPrimaryBrand160Var = "--color-primary-brand-160"
+
+	// ColorPrimaryBrand170Var defines the name of the "--color-primary-brand-170" css custom property.
+	ColorPrimaryBrand170Var = "--color-primary-brand-170"
+
+	// ColorPrimaryBrand180Var defines the name of the "--color-primary-brand-180" css custom property.
+	ColorPrimaryBrand180Var = "--color-primary-brand-180"
+
+	// ColorPrimaryBrand190Var defines the name of the "--color-primary-brand-190" css custom property.
+	ColorPrimaryBrand190Var = "--color-primary-brand-190"
+
+	// ColorPrimaryBrand200Var defines the name of the "--color-primary-brand-200" css custom property.
+	ColorPrimaryBrand200Var = "--color-primary-brand-200"
+
+	// ColorSecondaryBrandVar defines the name of the "--color-secondary-brand" css custom property.
+	ColorSecondaryBrandVar = "--color-secondary-brand"
+
+	// ColorSecondaryBrand10Var defines the name of the "--color-secondary-brand-10" css custom property.
+	ColorSecondaryBrand10Var = "--color-secondary-brand-10"
+
+	// ColorSecondaryBrand20Var defines the name of the "--color-secondary-brand-20" css custom property.
+	ColorSecondaryBrand20Var = "--color-secondary-brand-20"
+
+	// ColorSecondaryBrand30Var defines the name of the "--color-secondary-brand-30" css custom property.
+	ColorSecondaryBrand30Var = "--color-secondary-brand-30"
+
+	// ColorSecondaryBrand40Var defines the name of the "--color-secondary-brand-40" css custom property.
+	ColorSecondaryBrand40Var = "--color-secondary-brand-40"
+
+	// ColorSecondaryBrand50Var defines the name of the "--color-secondary-brand-50" css custom property.
+	ColorSecondaryBrand50Var = "--color-secondary-brand-50"
+
+	// ColorSecondaryBrand60Var defines the name of the "--color-secondary-brand-60" css custom property.
+	ColorSecondaryBrand60Var = "--color-secondary-brand-60"
+
+	// ColorSecondaryBrand70Var defines the name of the "--color-secondary-brand-70" css custom property.
+	ColorSecondaryBrand70Var = "--color-secondary-brand-70"
+
+	// ColorSecondaryBrand80Var defines the name of the "--color-secondary-brand-80" css custom property.
+	ColorSecondaryBrand80Var = "--color-secondary-brand-80"
+
+	// ColorSecondaryBrand90Var defines the name of the "--color-secondary-brand-90" css custom property.
+	ColorSecondaryBrand90Var = "--color-secondary-brand-90"
+
+	// ColorSecondaryBrand100Var defines the name of the "--color-secondary-brand-100" css custom property.
+	ColorSecondaryBrand100Var = "--color-secondary-brand-100"
+
+	// ColorSecondaryBrand110Var defines the name of the "--color-secondary-brand-110" css custom property.
+	ColorSecondaryBrand110Var = "--color-secondary-brand-110"
+
+	// ColorSecondaryBrand120Var defines the name of the "--color-secondary-brand-120" css custom property.
+	ColorSecondaryBrand120Var = "--color-secondary-brand-120"
+
+	// ColorSecondaryBrand130Var defines the name of the "--color-secondary-brand-130" css custom property.
+	ColorSecondaryBrand130Var = "--color-secondary-brand-130"
+
+	// ColorSecondaryBrand140Var defines the name of the "--color-secondary-brand-140" css custom property.
+	ColorSecondaryBrand140Var = "--color-secondary-brand-140"
+
+	// ColorSecondaryBrand150Var defines the name of the "--color-secondary-brand-150" css custom property.
+	ColorSecondaryBrand150Var = "--color-secondary-brand-150"
+
+	// ColorSecondaryBrand160Var defines the name of the "--color-secondary-brand-160" css custom property.
+	ColorSecondaryBrand160Var = "--color-secondary-brand-160"
+
+	// ColorSecondaryBrand170Var defines the name of the "--color-secondary-brand-170" css custom property.
+	ColorSecondaryBrand170Var = "--color-secondary-brand-170"
+
+	// ColorSecondaryBrand180Var defines the name of the "--color-secondary-brand-180" css custom property.
+	ColorSecondaryBrand180Var = "--color-secondary-brand-180"
+
+	// ColorSecondaryBrand190Var defines the name of the "--color-secondary-brand-190" css custom property.
+	ColorSecondaryBrand190Var = "--color-secondary-brand-190"
+
+	// ColorSecondaryBrand200Var defines the name of the "--color-secondary-brand-200" css custom property.
+	ColorSecondaryBrand200Var = "--color-secondary-brand-200"
+
+	// ShadowBaseVar defines the name of the "--shadow-base" css custom property.
+	ShadowBaseVar = "--shadow-base"
+
+	// ShadowDropVar defines the name of the "--shadow-drop" css custom property.
+	ShadowDropVar = "--shadow-drop"
+
+	// ShadowHoverVar defines the name of the "--shadow-hover" css custom property.
+	ShadowHoverVar = "--shadow-hover"
+
+	// ShadowFloatingVar defines the name of the "--shadow-floating" css custom property.
+	ShadowFloatingVar = "--shadow-floating"
+
+	// RadiusSmallVar defines the name of the "--radius-small" css custom property.
+	RadiusSmallVar = "--radius-small"
+
+	// RadiusMediumVar defines the name of the "--radius-medium" css custom property.
+	RadiusMediumVar = "--radius-medium"
+
+	// RadiusLargeVar defines the name of the "--radius-large" css custom property.
+	RadiusLargeVar = "--radius-large"
+
+	// FontSizeBaseVar defines the name of the "--font-size-base" css custom property.
+	FontSizeBaseVar = "--font-size-base"
+
+	// FontScaleSmall1Var defines the name of the "--font-scale-small-1" css custom property.
+	FontScaleSmall1Var = "--font-scale-small-1"
+
+	// FontScaleSmall2Var defines the name of the "--font-scale-small-2" css custom property.
+	FontScaleSmall2Var = "--font-scale-small-2"
+
+	// FontScaleSmall3Var defines the name of the "--font-scale-small-3" css custom property.
+	FontScaleSmall3Var = "--font-scale-small-3"
+
+	// FontScaleSmall4Var defines the name of the "--font-scale-small-4" css custom property.
+	FontScaleSmall4Var = "--font-scale-small-4"
+
+	// FontScaleSmall5Var defines the name of the "--font-scale-small-5" css custom property.
+	FontScaleSmall5Var = "--font-scale-small-5"
+
+	// FontScaleSmall6Var defines the name of the "--font-scale-small-6" css custom property.
+	FontScaleSmall6Var = "--font-scale-small-6"
+
+	// FontScaleSmall7Var defines the name of the "--font-scale-small-7" css custom property.
+	FontScaleSmall7Var = "--font-scale-small-7"
+
+	// FontScaleSmall8Var defines the name of the "--font-scale-small-8" css custom property.
+	FontScaleSmall8Var = "--font-scale-small-8"
+
+	// FontScaleSmall9Var defines the name of the "--font-scale-small-9" css custom property.
+	FontScaleSmall9Var = "--font-scale-small-9"
+
+	// FontScaleSmall10Var defines the name of the "--font-scale-small-10" css custom property.
+	FontScaleSmall10Var = "--font-scale-small-10"
+
+	// FontScaleBig1Var defines the name of the "--font-scale-big-1" css custom property.
+	FontScaleBig1Var = "--font-scale-big-1"
+
+	// FontScaleBig2Var defines the name of the "--font-scale-big-2" css custom property.
+	FontScaleBig2Var = "--font-scale-big-2"
+
+	// FontScaleBig3Var defines the name of the "--font-scale-big-3" css custom property.
+	FontScaleBig3Var = "--font-scale-big-3"
+
+	// FontScaleBig4Var defines the name of the "--font-scale-big-4" css custom property.
+	FontScaleBig4Var = "--font-scale-big-4"
+
+	// FontScaleBig5Var defines the name of the "--font-scale-big-5" css custom property.
+	FontScaleBig5Var = "--font-scale-big-5"
+
+	// FontScaleBig6Var defines the name of the "--font-scale-big-6" css custom property.
+	FontScaleBig6Var = "--font-scale-big-6"
+
+	// FontScaleBig7Var defines the name of the "--font-scale-big-7" css custom property.
+	FontScaleBig7Var = "--font-scale-big-7"
+
+	// FontScaleBig8Var defines the name of the "--font-scale-big-8" css custom property.
+	FontScaleBig8Var = "--font-scale-big-8"
+
+	// FontScaleBig9Var defines the name of the "--font-scale-big-9" css custom property.
+	FontScaleBig9Var = "--font-scale-big-9"
+
+	// FontScaleBig10Var defines the name of the "--font-scale-big-10" css custom property.
+	FontScaleBig10Var = "--font-scale-big-10"
+
+	// FontScaleBig11Var defines the name of the "--font-scale-big-11" css custom property.
+	FontScaleBig11Var = "--font-scale-big-11"
+
+	// HeadingScaleSmall1Var defines the name of the "--heading-scale-small-1" css custom property.
+	HeadingScaleSmall1Var = "--heading-scale-small-1"
+
+	// HeadingScaleSmall2Var defines the name of the "--heading-scale-small-2" css custom property.
+	HeadingScaleSmall2Var = "--heading-scale-small-2"
+
+	// HeadingScaleSmall3Var defines the name of the "--heading-scale-small-3" css custom property.
+	HeadingScaleSmall3Var = "--heading-scale-small-3"
+
+	// HeadingScaleSmall4Var defines the name of the "--heading-scale-small-4" css custom property.
+	HeadingScaleSmall4Var = "--heading-scale-small-4"
+
+	// HeadingScaleBig1Var defines the name of the "--heading-scale-big-1" css custom property.
+	HeadingScaleBig1Var = "--heading-scale-big-1"
+
+	// HeadingScaleBig2Var defines the name of the "--heading-scale-big-2" css custom property.
+	HeadingScaleBig2Var = "--heading-scale-big-2"
+
+	// HeadingScaleBig3Var defines the name of the "--heading-scale-big-3" css custom property.
+	HeadingScaleBig3Var = "--heading-scale-big-3"
+
+	// HeadingScaleBig4Var defines the name of the "--heading-scale-big-4" css custom property.
+	HeadingScaleBig4Var = "--heading-scale-big-4"
+
+	// HeadingScaleBig5Var defines the name of the "--heading-scale-big-5" css custom property.
+	HeadingScaleBig5Var = "--heading-scale-big-5"
+
+	// HeadingScaleBig6Var defines the name of the "--heading-scale-big-6" css custom property.
+	HeadingScaleBig6Var = "--heading-scale-big-6"
+
+	// HeadingScaleBig7Var defines the name of the "--heading-scale-big-7" css custom property.
+	HeadingScaleBig7Var = "--heading-scale-big-7"
+
+	// AnimationCurveDefaultVar defines the name of the "--animation-curve-default" css custom property.
+	AnimationCurveDefaultVar = "--animation-curve-default"
+
+	// AnimationCurveFastOutLinearInVar defines the name of the "--animation-curve-fast-out-linear-in" css custom property.
+	AnimationCurveFastOutLinearInVar = "--animation-curve-fast-out-linear-in"
+
+	// AnimationCurveFastOutSlowInVar defines the name of the "--animation-curve-fast-out-slow-in" css custom property.
+	AnimationCurveFastOutSlowInVar = "--animation-curve-fast-out-slow-in"
+
+	// AnimationCurveLinearOutSlowInVar defines the name of the "--animation-curve-linear-out-slow-in" css custom property.
+	AnimationCurveLinearOutSlowInVar = "--animation-curve-linear-out-slow-in"
+
+	// MaterialAmber50Var defines the name of the "--material-amber-50" css custom property.
+	MaterialAmber50Var = "--material-amber-50"
+
+	// MaterialAmber100Var defines the name of the "--material-amber-100" css custom property.
+	MaterialAmber100Var = "--material-amber-100"
+
+	// MaterialAmber200Var defines the name of the "--material-amber-200" css custom property.
+	MaterialAmber200Var = "--material-amber-200"
+
+	// MaterialAmber300Var defines the name of the "--material-amber-300" css custom property.
+	MaterialAmber300Var = "--material-amber-300"
+
+	// MaterialAmber400Var defines the name of the "--material-amber-400" css custom property.
+	MaterialAmber400Var = "--material-amber-400"
+
+	// MaterialAmber500Var defines the name of the "--material-amber-500" css custom property.
+	MaterialAmber500Var = "--material-amber-500"
+
+	// MaterialAmber600Var defines the name of the "--material-amber-600" css custom property.
+	MaterialAmber600Var = "--material-amber-600"
+
+	// MaterialAmber700Var defines the name of the "--material-amber-700" css custom property.
+	MaterialAmber700Var = "--material-amber-700"
+
+	// MaterialAmber800Var defines the name of the "--material-amber-800" css custom property.
+	MaterialAmber800Var = "--material-amber-800"
+
+	// MaterialAmber900Var defines the name of the "--material-amber-900" css custom property.
+	MaterialAmber900Var = "--material-amber-900"
+
+	// MaterialAmberA100Var defines the name of the "--material-amber-A100" css custom property.
+	MaterialAmberA100Var = "--material-amber-A100"
+
+	// MaterialAmberA200Var defines the name of the "--material-amber-A200" css custom property.
+	MaterialAmberA200Var = "--material-amber-A200"
+
+	// MaterialAmberA400Var defines the name of the "--material-amber-A400" css custom property.
+	MaterialAmberA400Var = "--material-amber-A400"
+
+	// MaterialAmberA700Var defines the name of the "--material-amber-A700" css custom property.
+	MaterialAmberA700Var = "--material-amber-A700"
+
+	// MaterialBlackVar defines the name of the "--material-black" css custom property.
+	MaterialBlackVar = "--material-black"
+
+	// MaterialBlue50Var defines the name of the "--material-blue-50" css custom property.
+	MaterialBlue50Var = "--material-blue-50"
+
+	// MaterialBlue100Var defines the name of the "--material-blue-100" css custom property.
+	MaterialBlue100Var = "--material-blue-100"
+
+	// MaterialBlue200Var defines the name of the "--material-blue-200" css custom property.
+	MaterialBlue200Var = "--material-blue-200"
+
+	// MaterialBlue300Var defines the name of the "--material-blue-300" css custom property.
+	MaterialBlue300Var = "--material-blue-300"
+
+	// MaterialBlue400Var defines the name of the "--material-blue-400" css custom property.
+	MaterialBlue400Var = "--material-blue-400"
+
+	// MaterialBlue500Var defines the name of the "--material-blue-500" css custom property.
+	MaterialBlue500Var = "--material-blue-500"
+
+	// MaterialBlue600Var defines the name of the "--material-blue-600" css custom property.
+	MaterialBlue600Var = "--material-blue-600"
+
+	// MaterialBlue700Var defines the name of the "--material-blue-700" css custom property.
+	MaterialBlue700Var = "--material-blue-700"
+
+	// MaterialBlue800Var defines the name of the "--material-blue-800" css custom property.
+	MaterialBlue800Var = "--material-blue-800"
+
+	// MaterialBlue900Var defines the name of the "--material-blue-900" css custom property.
+	MaterialBlue900Var = "--material-blue-900"
+
+	// MaterialBlueA100Var defines the name of the "--material-blue-A100" css custom property.
+	MaterialBlueA100Var = "--material-blue-A100"
+
+	// MaterialBlueA200Var defines the name of the "--material-blue-A200" css custom property.
+	MaterialBlueA200Var = "--material-blue-A200"
+
+	// MaterialBlueA400Var defines the name of the "--material-blue-A400" css custom property.
+	MaterialBlueA400Var = "--material-blue-A400"
+
+	// MaterialBlueA700Var defines the name of the "--material-blue-A700" css custom property.
+	MaterialBlueA700Var = "--material-blue-A700"
+
+	// MaterialBlueGrey50Var defines the name of the "--material-blue-grey-50" css custom property.
+	MaterialBlueGrey50Var = "--material-blue-grey-50"
+
+	// MaterialBlueGrey100Var defines the name of the "--material-blue-grey-100" css custom property.
+	MaterialBlueGrey100Var = "--material-blue-grey-100"
+
+	// MaterialBlueGrey200Var defines the name of the "--material-blue-grey-200" css custom property.
+	MaterialBlueGrey200Var = "--material-blue-grey-200"
+
+	// MaterialBlueGrey300Var defines the name of the "--material-blue-grey-300" css custom property.
+	MaterialBlueGrey300Var = "--material-blue-grey-300"
+
+	// MaterialBlueGrey400Var defines the name of the "--material-blue-grey-400" css custom property.
+	MaterialBlueGrey400Var = "--material-blue-grey-400"
+
+	// MaterialBlueGrey500Var defines the name of the "--material-blue-grey-500" css custom property.
+	MaterialBlueGrey500Var = "--material-blue-grey-500"
+
+	// MaterialBlueGrey600Var defines the name of the "--material-blue-grey-600" css custom property.
+	MaterialBlueGrey600Var = "--material-blue-grey-600"
+
+	// MaterialBlueGrey700Var defines the name of the "--material-blue-grey-700" css custom property.
+	MaterialBlueGrey700Var = "--material-blue-grey-700"
+
+	// MaterialBlueGrey800Var defines the name of the "--material-blue-grey-800" css custom property.
+	MaterialBlueGrey800Var = "--material-blue-grey-800"
+
+	// MaterialBlueGrey900Var defines the name of the "--material-blue-grey-900" css custom property.
+	MaterialBlueGrey900Var = "--material-blue-grey-900"
+
+	// MaterialBrown50Var defines the name of the "--material-brown-50" css custom property.
+	MaterialBrown50Var = "--material-brown-50"
+
+	// MaterialBrown100Var defines the name of the "--material-brown-100" css custom property.
+	MaterialBrown100Var = "--material-brown-100"
+
+	// MaterialBrown200Var defines the name of the "--material-brown-200" css custom property.
+	MaterialBrown200Var = "--material-brown-200"
+
+	// MaterialBrown300Var defines the name of the "--material-brown-300" css custom property.
+	MaterialBrown300Var = "--material-brown-300"
+
+	// MaterialBrown400Var defines the name of the "--material-brown-400" css custom property.
+	MaterialBrown400Var = "--material-brown-400"
+
+	// MaterialBrown500Var defines the name of the "--material-brown-500" css custom property.
+	MaterialBrown500Var = "--material-brown-500"
+
+	// MaterialBrown600Var defines the name of the "--material-brown-600" css custom property.
+	MaterialBrown600Var = "--material-brown-600"
+
+	// MaterialBrown700Var defines the name of the "--material-brown-700" css custom property.
+	MaterialBrown700Var = "--material-brown-700"
+
+	// MaterialBrown800Var defines the name of the "--material-brown-800" css custom property.
+	MaterialBrown800Var = "--material-brown-800"
+
+	// MaterialBrown900Var defines the name of the "--material-brown-900" css custom property.
+	MaterialBrown900Var = "--material-brown-900"
+
+	// MaterialCyan50Var defines the name of the "--material-cyan-50" css custom property.
+	MaterialCyan50Var = "--material-cyan-50"
+
+	// MaterialCyan100Var defines the name of the "--material-cyan-100" css custom property.
+	MaterialCyan100Var = "--material-cyan-100"
+
+	// MaterialCyan200Var defines the name of the "--material-cyan-200" css custom property.
+	MaterialCyan200Var = "--material-cyan-200"
+
+	// MaterialCyan300Var defines the name of the "--material-cyan-300" css custom property.
+	MaterialCyan300Var = "--material-cyan-300"
+
+	// MaterialCyan400Var defines the name of the "--material-cyan-400" css custom property.
+	MaterialCyan400Var = "--material-cyan-400"
+
+	// MaterialCyan500Var defines the name of the "--material-cyan-500" css custom property.
+	MaterialCyan500Var = "--material-cyan-500"
+
+	// MaterialCyan600Var defines the name of the "--material-cyan-600" css custom property.
+	MaterialCyan600Var = "--material-cyan-600"
+
+	// MaterialCyan700Var defines the name of the "--material-cyan-700" css custom property.
+	MaterialCyan700Var = "--material-cyan-700"
+
+	// MaterialCyan800Var defines the name of the "--material-cyan-800" css custom property.
+	MaterialCyan800Var = "--material-cyan-800"
+
+	// MaterialCyan900Var defines the name of the "--material-cyan-900" css custom property.
+	MaterialCyan900Var = "--material-cyan-900"
+
+	// MaterialCyanA100Var defines the name of the "--material-cyan-A100" css custom property.
+	MaterialCyanA100Var = "--material-cyan-A100"
+
+	// MaterialCyanA200Var defines the name of the "--material-cyan-A200" css custom property.
+	MaterialCyanA200Var = "--material-cyan-A200"
+
+	// MaterialCyanA400Var defines the name of the "--material-cyan-A400" css custom property.
+	MaterialCyanA400Var = "--material-cyan-A400"
+
+	// MaterialCyanA700Var defines the name of the "--material-cyan-A700" css custom property.
+	MaterialCyanA700Var = "--material-cyan-A700"
+
+	// MaterialDeepOrange50Var defines the name of the "--material-deep-orange-50" css custom property.
+	MaterialDeepOrange50Var = "--material-deep-orange-50"
+
+	// MaterialDeepOrange100Var defines the name of the "--material-deep-orange-100" css custom property.
+	MaterialDeepOrange100Var = "--material-deep-orange-100"
+
+	// MaterialDeepOrange200Var defines the name of the "--material-deep-orange-200" css custom property.
+	MaterialDeepOrange200Var = "--material-deep-orange-200"
+
+	// MaterialDeepOrange300Var defines the name of the "--material-deep-orange-300" css custom property.
+	MaterialDeepOrange300Var = "--material-deep-orange-300"
+
+	// MaterialDeepOrange400Var defines the name of the "--material-deep-orange-400" css custom property.
+	MaterialDeepOrange400Var = "--material-deep-orange-400"
+
+	// MaterialDeepOrange500Var defines the name of the "--material-deep-orange-500" css custom property.
+	MaterialDeepOrange500Var = "--material-deep-orange-500"
+
+	// MaterialDeepOrange600Var defines the name of the "--material-deep-orange-600" css custom property.
+	MaterialDeepOrange600Var = "--material-deep-orange-600"
+
+	// MaterialDeepOrange700Var defines the name of the "--material-deep-orange-700" css custom property.
+	MaterialDeepOrange700Var = "--material-deep-orange-700"
+
+	// MaterialDeepOrange800Var defines the name of the "--material-deep-orange-800" css custom property.
+	MaterialDeepOrange800Var = "--material-deep-orange-800"
+
+	// MaterialDeepOrange900Var defines the name of the "--material-deep-orange-900" css custom property.
+	MaterialDeepOrange900Var = "--material-deep-orange-900"
+
+	// MaterialDeepOrangeA100Var defines the name of the "--material-deep-orange-A100" css custom property.
+	MaterialDeepOrangeA100Var = "--material-deep-orange-A100"
+
+	// MaterialDeepOrangeA200Var defines the name of the "--material-deep-orange-A200" css custom property.
+	MaterialDeepOrangeA200Var = "--material-deep-orange-A200"
+
+	// MaterialDeepOrangeA400Var defines the name of the "--material-deep-orange-A400" css custom property.
+	MaterialDeepOrangeA400Var = "--material-deep-orange-A400"
+
+	// MaterialDeepOrangeA700Var defines the name of the "--material-deep-orange-A700" css custom property.
+	MaterialDeepOrangeA700Var = "--material-deep-orange-A700"
+
+	// MaterialDeepPurple50Var defines the name of the "--material-deep-purple-50" css custom property.
+	MaterialDeepPurple50Var = "--material-deep-purple-50"
+
+	// MaterialDeepPurple100Var defines the name of the "--material-deep-purple-100" css custom property.
+	MaterialDeepPurple100Var = "--material-deep-purple-100"
+
+	// MaterialDeepPurple200Var defines the name of the "--material-deep-purple-200" css custom property.
+	MaterialDeepPurple200Var = "--material-deep-purple-200"
+
+	// MaterialDeepPurple300Var defines the name of the "--material-deep-purple-300" css custom property.
+	MaterialDeepPurple300Var = "--material-deep-purple-300"
+
+	// MaterialDeepPurple400Var defines the name of the "--material-deep-purple-400" css custom property.
+	MaterialDeepPurple400Var = "--material-deep-purple-400"
+
+	// MaterialDeepPurple500Var defines the name of the "--material-deep-purple-500" css custom property.
+	MaterialDeepPurple500Var = "--material-deep-purple-500"
+
+	// MaterialDeepPurple600Var defines the name of the "--material-deep-purple-600" css custom property.
+	MaterialDeepPurple600Var = "--material-deep-purple-600"
+
+	// MaterialDeepPurple700Var defines the name of the "--material-deep-purple-700" css custom property.
+	MaterialDeepPurple700Var = "--material-deep-purple-700"
+
+	// MaterialDeepPurple800Var defines the name of the "--material-deep-purple-800" css custom property.
+	MaterialDeepPurple800Var = "--material-deep-purple-800"
+
+	// MaterialDeepPurple900Var defines the name of the "--material-deep-purple-900" css custom property.
+	MaterialDeepPurple900Var = "--material-deep-purple-900"
+
+	// MaterialDeepPurpleA100Var defines the name of the "--material-deep-purple-A100" css custom property.
+	MaterialDeepPurpleA100Var = "--material-deep-purple-A100"
+
+	// MaterialDeepPurpleA200Var defines the name of the "--material-deep-purple-A200" css custom property.
+	MaterialDeepPurpleA200Var = "--material-deep-purple-A200"
+
+	// MaterialDeepPurpleA400Var defines the name of the "--material-deep-purple-A400" css custom property.
+	MaterialDeepPurpleA400Var = "--material-deep-purple-A400"
+
+	// MaterialDeepPurpleA700Var defines the name of the "--material-deep-purple-A700" css custom property.
+	MaterialDeepPurpleA700Var = "--material-deep-purple-A700"
+
+	// MaterialGreen50Var defines the name of the "--material-green-50" css custom property.
+	MaterialGreen50Var = "--material-green-50"
+
+	// MaterialGreen100Var defines the name of the "--material-green-100" css custom property.
+	MaterialGreen100Var = "--material-green-100"
+
+	// MaterialGreen200Var defines the name of the "--material-green-200" css custom property.
+	MaterialGreen200Var = "--material-green-200"
+
+	// MaterialGreen300Var defines the name of the "--material-green-300" css custom property.
+	MaterialGreen300Var = "--material-green-300"
+
+	// MaterialGreen400Var defines the name of the "--material-green-400" css custom property.
+	MaterialGreen400Var = "--material-green-400"
+
+	// MaterialGreen500Var defines the name of the "--material-green-500" css custom property.
+	MaterialGreen500Var = "--material-green-500"
+
+	// MaterialGreen600Var defines the name of the "--material-green-600" css custom property.
+	MaterialGreen600Var = "--material-green-600"
+
+	// MaterialGreen700Var defines the name of the "--material-green-700" css custom property.
+	MaterialGreen700Var = "--material-green-700"
+
+	// MaterialGreen800Var defines the name of the "--material-green-800" css custom property.
+	MaterialGreen800Var = "--material-green-800"
+
+	// MaterialGreen900Var defines the name of the "--material-green-900" css custom property.
+	MaterialGreen900Var = "--material-green-900"
+
+	// MaterialGreenA100Var defines the name of the "--material-green-A100" css custom property.
+	MaterialGreenA100Var = "--material-green-A100"
+
+	// MaterialGreenA200Var defines the name of the "--material-green-A200" css custom property.
+	MaterialGreenA200Var = "--material-green-A200"
+
+	// MaterialGreenA400Var defines the name of the "--material-green-A400" css custom property.
+	MaterialGreenA400Var = "--material-green-A400"
+
+	// MaterialGreenA700Var defines the name of the "--material-green-A700" css custom property.
+	MaterialGreenA700Var = "--material-green-A700"
+
+	// MaterialGrey50Var defines the name of the "--material-grey-50" css custom property.
+	MaterialGrey50Var = "--material-grey-50"
+
+	// MaterialGrey100Var defines the name of the "--material-grey-100" css custom property.
+	MaterialGrey100Var = "--material-grey-100"
+
+	// MaterialGrey200Var defines the name of the "--material-grey-200" css custom property.
+	MaterialGrey200Var = "--material-grey-200"
+
+	// MaterialGrey300Var defines the name of the "--material-grey-300" css custom property.
+	MaterialGrey300Var = "--material-grey-300"
+
+	// MaterialGrey400Var defines the name of the "--material-grey-400" css custom property.
+	MaterialGrey400Var = "--material-grey-400"
+
+	// MaterialGrey500Var defines the name of the "--material-grey-500" css custom property.
+	MaterialGrey500Var = "--material-grey-500"
+
+	// MaterialGrey600Var defines the name of the "--material-grey-600" css custom property.
+	MaterialGrey600Var = "--material-grey-600"
+
+	// MaterialGrey700Var defines the name of the "--material-grey-700" css custom property.
+	MaterialGrey700Var = "--material-grey-700"
+
+	// MaterialGrey800Var defines the name of the "--material-grey-800" css custom property.
+	MaterialGrey800Var = "--material-grey-800"
+
+	// MaterialGrey900Var defines the name of the "--material-grey-900" css custom property.
+	MaterialGrey900Var = "--material-grey-900"
+
+	// MaterialIndigo50Var defines the name of the "--material-indigo-50" css custom property.
+	MaterialIndigo50Var = "--material-indigo-50"
+
+	// MaterialIndigo100Var defines the name of the "--material-indigo-100" css custom property.
+	MaterialIndigo100Var = "--material-indigo-100"
+
+	// MaterialIndigo200Var defines the name of the "--material-indigo-200" css custom property.
+	MaterialIndigo200Var = "--material-indigo-200"
+
+	// MaterialIndigo300Var defines the name of the "--material-indigo-300" css custom property.
+	MaterialIndigo300Var = "--material-indigo-300"
+
+	// MaterialIndigo400Var defines the name of the "--material-indigo-400" css custom property.
+	MaterialIndigo400Var = "--material-indigo-400"
+
+	// MaterialIndigo500Var defines the name of the "--material-indigo-500" css custom property.
+	MaterialIndigo500Var = "--material-indigo-500"
+
+	// MaterialIndigo600Var defines the name of the "--material-indigo-600" css custom property.
+	MaterialIndigo600Var = "--material-indigo-600"
+
+	// MaterialIndigo700Var defines the name of the "--material-indigo-700" css custom property.
+	MaterialIndigo700Var = "--material-indigo-700"
+
+	// MaterialIndigo800Var defines the name of the "--material-indigo-800" css custom property.
+	MaterialIndigo800Var = "--material-indigo-800"
+
+	// MaterialIndigo900Var defines the name of the "--material-indigo-900" css custom property.
+	MaterialIndigo900Var = "--material-indigo-900"
+
+	// MaterialIndigoA100Var defines the name of the "--material-indigo-A100" css custom property.
+	MaterialIndigoA100Var = "--material-indigo-A100"
+
+	// MaterialIndigoA200Var defines the name of the "--material-indigo-A200" css custom property.
+	MaterialIndigoA200Var = "--material-indigo-A200"
+
+	// MaterialIndigoA400Var defines the name of the "--material-indigo-A400" css custom property.
+	MaterialIndigoA400Var = "--material-indigo-A400"
+
+	// MaterialIndigoA700Var defines the name of the "--material-indigo-A700" css custom property.
+	MaterialIndigoA700Var = "--material-indigo-A700"
+
+	// MaterialLightBlue50Var defines the name of the "--material-light-blue-50" css custom property.
+	MaterialLightBlue50Var = "--material-light-blue-50"
+
+	// MaterialLightBlue100Var defines the name of the "--material-light-blue-100" css custom property.
+	MaterialLightBlue100Var = "--material-light-blue-100"
+
+	// MaterialLightBlue200Var defines the name of the "--material-light-blue-200" css custom property.
+	MaterialLightBlue200Var = "--material-light-blue-200"
+
+	// MaterialLightBlue300Var defines the name of the "--material-light-blue-300" css custom property.
+	MaterialLightBlue300Var = "--material-light-blue-300"
+
+	// MaterialLightBlue400Var defines the name of the "--material-light-blue-400" css custom property.
+	MaterialLightBlue400Var = "--material-light-blue-400"
+
+	// MaterialLightBlue500Var defines the name of the "--material-light-blue-500" css custom property.
+	MaterialLightBlue500Var = "--material-light-blue-500"
+
+	// MaterialLightBlue600Var defines the name of the "--material-light-blue-600" css custom property.
+	MaterialLightBlue600Var = "--material-light-blue-600"
+
+	// MaterialLightBlue700Var defines the name of the "--material-light-blue-700" css custom property.
+	MaterialLightBlue700Var = "--material-light-blue-700"
+
+	// MaterialLightBlue800Var defines the name of the "--material-light-blue-800" css custom property.
+	MaterialLightBlue800Var = "--material-light-blue-800"
+
+	// MaterialLightBlue900Var defines the name of the "--material-light-blue-900" css custom property.
+	MaterialLightBlue900Var = "--material-light-blue-900"
+
+	// MaterialLightBlueA100Var defines the name of the "--material-light-blue-A100" css custom property.
+	MaterialLightBlueA100Var = "--material-light-blue-A100"
+
+	// MaterialLightBlueA200Var defines the name of the "--material-light-blue-A200" css custom property.
+	MaterialLightBlueA200Var = "--material-light-blue-A200"
+
+	// MaterialLightBlueA400Var defines the name of the "--material-light-blue-A400" css custom property.
+	MaterialLightBlueA400Var = "--material-light-blue-A400"
+
+	// MaterialLightBlueA700Var defines the name of the "--material-light-blue-A700" css custom property.
+	MaterialLightBlueA700Var = "--material-light-blue-A700"
+
+	// MaterialLightGreen50Var defines the name of the "--material-light-green-50" css custom property.
+	MaterialLightGreen50Var = "--material-light-green-50"
+
+	// MaterialLightGreen100Var defines the name of the "--material-light-green-100" css custom property.
+	MaterialLightGreen100Var = "--material-light-green-100"
+
+	// MaterialLightGreen200Var defines the name of the "--material-light-green-200" css custom property.
+	MaterialLightGreen200Var = "--material-light-green-200"
+
+	// MaterialLightGreen300Var defines the name of the "--material-light-green-300" css custom property.
+	MaterialLightGreen300Var = "--material-light-green-300"
+
+	// MaterialLightGreen400Var defines the name of the "--material-light-green-400" css custom property.
+	MaterialLightGreen400Var = "--material-light-green-400"
+
+	// MaterialLightGreen500Var defines the name of the "--material-light-green-500" css custom property.
+	MaterialLightGreen500Var = "--material-light-green-500"
+
+	// MaterialLightGreen600Var defines the name of the "--material-light-green-600" css custom property.
+	MaterialLightGreen600Var = "--material-light-green-600"
+
+	// MaterialLightGreen700Var defines the name of the "--material-light-green-700" css custom property.
+	MaterialLightGreen700Var = "--material-light-green-700"
+
+	// MaterialLightGreen800Var defines the name of the "--material-light-green-800" css custom property.
+	MaterialLightGreen800Var = "--material-light-green-800"
+
+	// MaterialLightGreen900Var defines the name of the "--material-light-green-900" css custom property.
+	MaterialLightGreen900Var = "--material-light-green-900"
+
+	// MaterialLightGreenA100Var defines the name of the "--material-light-green-A100" css custom property.
+	MaterialLightGreenA100Var = "--material-light-green-A100"
+
+	// MaterialLightGreenA200Var defines the name of the "--material-light-green-A200" css custom property.
+	MaterialLightGreenA200Var = "--material-light-green-A200"
+
+	// MaterialLightGreenA400Var defines the name of the "--material-light-green-A400" css custom property.
+	MaterialLightGreenA400Var = "--material-light-green-A400"
+
+	// MaterialLightGreenA700Var defines the name of the "--material-light-green-A700" css custom property.
+	MaterialLightGreenA700Var = "--material-light-green-A700"
+
+	// MaterialLime50Var defines the name of the "--material-lime-50" css custom property.
+	MaterialLime50Var = "--material-lime-50"
+
+	// MaterialLime100Var defines the name of the "--material-lime-100" css custom property.
+	MaterialLime100Var = "--material-lime-100"
+
+	// MaterialLime200Var defines the name of the "--material-lime-200" css custom property.
+	MaterialLime200Var = "--material-lime-200"
+
+	// MaterialLime300Var defines the name of the "--material-lime-300" css custom property.
+	MaterialLime300Var = "--material-lime-300"
+
+	// MaterialLime400Var defines the name of the "--material-lime-400" css custom property.
+	MaterialLime400Var = "--material-lime-400"
+
+	// MaterialLime500Var defines the name of the "--material-lime-500" css custom property.
+	MaterialLime500Var = "--material-lime-500"
+
+	// MaterialLime600Var defines the name of the "--material-lime-600" css custom property.
+	MaterialLime600Var = "--material-lime-600"
+
+	// MaterialLime700Var defines the name of the "--material-lime-700" css custom property.
+	MaterialLime700Var = "--material-lime-700"
+
+	// MaterialLime800Var defines the name of the "--material-lime-800" css custom property.
+	MaterialLime800Var = "--material-lime-800"
+
+	// MaterialLime900Var defines the name of the "--material-lime-900" css custom property.
+	MaterialLime900Var = "--material-lime-900"
+
+	// MaterialLimeA100Var defines the name of the "--material-lime-A100" css custom property.
+	MaterialLimeA100Var = "--material-lime-A100"
+
+	// MaterialLimeA200Var defines the name of the "--material-lime-A200" css custom property.
+	MaterialLimeA200Var = "--material-lime-A200"
+
+	// MaterialLimeA400Var defines the name of the "--material-lime-A400" css custom property.
+	MaterialLimeA400Var = "--material-lime-A400"
+
+	// MaterialLimeA700Var defines the name of the "--material-lime-A700" css custom property.
+	MaterialLimeA700Var = "--material-lime-A700"
+
+	// MaterialOrange50Var defines the name of the "--material-orange-50" css custom property.
+	MaterialOrange50Var = "--material-orange-50"
+
+	// MaterialOrange100Var defines the name of the "--material-orange-100" css custom property.
+	MaterialOrange100Var = "--material-orange-100"
+
+	// MaterialOrange200Var defines the name of the "--material-orange-200" css custom property.
+	MaterialOrange200Var = "--material-orange-200"
+
+	// MaterialOrange300Var defines the name of the "--material-orange-300" css custom property.
+	MaterialOrange300Var = "--material-orange-300"
+
+	// MaterialOrange400Var defines the name of the "--material-orange-400" css custom property.
+	MaterialOrange400Var = "--material-orange-400"
+
+	// MaterialOrange500Var defines the name of the "--material-orange-500" css custom property.
+	MaterialOrange500Var = "--material-orange-500"
+
+	// MaterialOrange600Var defines the name of the "--material-orange-600" css custom property.
+	MaterialOrange600Var = "--material-orange-600"
+
+	// MaterialOrange700Var defines the name of the "--material-orange-700" css custom property.
+	MaterialOrange700Var = "--material-orange-700"
+
+	// MaterialOrange800Var defines the name of the "--material-orange-800" css custom property.
+	MaterialOrange800Var = "--material-orange-800"
+
+	// MaterialOrange900Var defines the name of the "--material-orange-900" css custom property.
+	MaterialOrange900Var = "--material-orange-900"
+
+	// MaterialOrangeA100Var defines the name of the "--material-orange-A100" css custom property.
+	MaterialOrangeA100Var = "--material-orange-A100"
+
+	// MaterialOrangeA200Var defines the name of the "--material-orange-A200" css custom property.
+	MaterialOrangeA200Var = "--material-orange-A200"
+
+	// MaterialOrangeA400Var defines the name of the "--material-orange-A400" css custom property.
+	MaterialOrangeA400Var = "--material-orange-A400"
+
+	// MaterialOrangeA700Var defines the name of the "--material-orange-A700" css custom property.
+	MaterialOrangeA700Var = "--material-orange-A700"
+
+	// MaterialPink50Var defines the name of the "--material-pink-50" css custom property.
+	MaterialPink50Var = "--material-pink-50"
+
+	// MaterialPink100Var defines the name of the "--material-pink-100" css custom property.
+	MaterialPink100Var = "--material-pink-100"
+
+	// MaterialPink200Var defines the name of the "--material-pink-200" css custom property.
+	MaterialPink200Var = "--material-pink-200"
+
+	// MaterialPink300Var defines the name of the "--material-pink-300" css custom property.
+	MaterialPink300Var = "--material-pink-300"
+
+	// MaterialPink400Var defines the name of the "--material-pink-400" css custom property.
+	MaterialPink400Var = "--material-pink-400"
+
+	// MaterialPink500Var defines the name of the "--material-pink-500" css custom property.
+	MaterialPink500Var = "--material-pink-500"
+
+	// MaterialPink600Var defines the name of the "--material-pink-600" css custom property.
+	MaterialPink600Var = "--material-pink-600"
+
+	// MaterialPink700Var defines the name of the "--material-pink-700" css custom property.
+	MaterialPink700Var = "--material-pink-700"
+
+	// MaterialPink800Var defines the name of the "--material-pink-800" css custom property.
+	MaterialPink800Var = "--material-pink-800"
+
+	// MaterialPink900Var defines the name of the "--material-pink-900" css custom property.
+	MaterialPink900Var = "--material-pink-900"
+
+	// MaterialPinkA100Var defines the name of the "--material-pink-A100" css custom property.
+	MaterialPinkA100Var = "--material-pink-A100"
+
+	// MaterialPinkA200Var defines the name of the "--material-pink-A200" css custom property.
+	MaterialPinkA200Var = "--material-pink-A200"
+
+	// MaterialPinkA400Var defines the name of the "--material-pink-A400" css custom property.
+	MaterialPinkA400Var = "--material-pink-A400"
+
+	// MaterialPinkA700Var defines the name of the "--material-pink-A700" css custom property.
+	MaterialPinkA700Var = "--material-pink-A700"
+
+	// MaterialPurple50Var defines the name of the "--material-purple-50" css custom property.
+	MaterialPurple50Var = "--material-purple-50"
+
+	// MaterialPurple100Var defines the name of the "--material-purple-100" css custom property.
+	MaterialPurple100Var = "--material-purple-100"
+
+	// MaterialPurple200Var defines the name of the "--material-purple-200" css custom property.
+	MaterialPurple200Var = "--material-purple-200"
+
+	// MaterialPurple300Var defines the name of the "--material-purple-300" css custom property.
+	MaterialPurple300Var = "--material-purple-300"
+
+	// MaterialPurple400Var defines the name of the "--material-purple-400" css custom property.
+	MaterialPurple400Var = "--material-purple-400"
+
+	// MaterialPurple500Var defines the name of the "--material-purple-500" css custom property.
+	MaterialPurple500Var = "--material-purple-500"
+
+	// MaterialPurple600Var defines the name of the "--material-purple-600" css custom property.
+	MaterialPurple600Var = "--material-purple-600"
+
+	// MaterialPurple700Var defines the name of the "--material-purple-700" css custom property.
+	MaterialPurple700Var = "--material-purple-700"
+
+	// MaterialPurple800Var defines the name of the "--material-purple-800" css custom property.
+	MaterialPurple800Var = "--material-purple-800"
+
+	// MaterialPurple900Var defines the name of the "--material-purple-900" css custom property.
+	MaterialPurple900Var = "--material-purple-900"
+
+	// MaterialPurpleA100Var defines the name of the "--material-purple-A100" css custom property.
+	MaterialPurpleA100Var = "--material-purple-A100"
+
+	// MaterialPurpleA200Var defines the name of the "--material-purple-A200" css custom property.
+	MaterialPurpleA200Var = "--material-purple-A200"
+
+	// MaterialPurpleA400Var defines the name of the "--material-purple-A400" css custom property.
+	MaterialPurpleA400Var = "--material-purple-A400"
+
+	// MaterialPurpleA700Var defines the name of the "--material-purple-A700" css custom property.
+	MaterialPurpleA700Var = "--material-purple-A700"
+
+	// MaterialRed50Var defines the name of the "--material-red-50" css custom property.
+	MaterialRed50Var = "--material-red-50"
+
+	// MaterialRed100Var defines the name of the "--material-red-100" css custom property.
+	MaterialRed100Var = "--material-red-100"
+
+	// MaterialRed200Var defines the name of the "--material-red-200" css custom property.
+	MaterialRed200Var = "--material-red-200"
+
+	// MaterialRed300Var defines the name of the "--material-red-300" css custom property.
+	MaterialRed300Var = "--material-red-300"
+
+	// MaterialRed400Var defines the name of the "--material-red-400" css custom property.
+	MaterialRed400Var = "--material-red-400"
+
+	// MaterialRed500Var defines the name of the "--material-red-500" css custom property.
+	MaterialRed500Var = "--material-red-500"
+
+	// MaterialRed600Var defines the name of the "--material-red-600" css custom property.
+	MaterialRed600Var = "--material-red-600"
+
+	// MaterialRed700Var defines the name of the "--material-red-700" css custom property.
+	MaterialRed700Var = "--material-red-700"
+
+	// MaterialRed800Var defines the name of the "--material-red-800" css custom property.
+	MaterialRed800Var = "--material-red-800"
+
+	// MaterialRed900Var defines the name of the "--material-red-900" css custom property.
+	MaterialRed900Var = "--material-red-900"
+
+	// MaterialRedA100Var defines the name of the "--material-red-A100" css custom property.
+	MaterialRedA100Var = "--material-red-A100"
+
+	// MaterialRedA200Var defines the name of the "--material-red-A200" css custom property.
+	MaterialRedA200Var = "--material-red-A200"
+
+	// MaterialRedA400Var defines the name of the "--material-red-A400" css custom property.
+	MaterialRedA400Var = "--material-red-A400"
+
+	// MaterialRedA700Var defines the name of the "--material-red-A700" css custom property.
+	MaterialRedA700Var = "--material-red-A700"
+
+	// MaterialTeal50Var defines the name of the "--material-teal-50" css custom property.
+	MaterialTeal50Var = "--material-teal-50"
+
+	// MaterialTeal100Var defines the name of the "--material-teal-100" css custom property.
+	MaterialTeal100Var = "--material-teal-100"
+
+	// MaterialTeal200Var defines the name of the "--material-teal-200" css custom property.
+	MaterialTeal200Var = "--material-teal-200"
+
+	// MaterialTeal300Var defines the name of the "--material-teal-300" css custom property.
+	MaterialTeal300Var = "--material-teal-300"
+
+	// MaterialTeal400Var defines the name of the "--material-teal-400" css custom property.
+	MaterialTeal400Var = "--material-teal-400"
+
+	// MaterialTeal500Var defines the name of the "--material-teal-500" css custom property.
+	MaterialTeal500Var = "--material-teal-500"
+
+	// MaterialTeal600Var defines the name of the "--material-teal-600" css custom property.
+	MaterialTeal600Var = "--material-teal-600"
+
+	// MaterialTeal700Var defines the name of the "--material-teal-700" css custom property.
+	MaterialTeal700Var = "--material-teal-700"
+
+	// MaterialTeal800Var defines the name of the "--material-teal-800" css custom property.
+	MaterialTeal800Var = "--material-teal-800"
+
+	// MaterialTeal900Var defines the name of the "--material-teal-900" css custom property.
+	MaterialTeal900Var = "--material-teal-900"
+
+	// MaterialTealA100Var defines the name of the "--material-teal-A100" css custom property.
+	MaterialTealA100Var = "--material-teal-A100"
+
+	// MaterialTealA200Var defines the name of the "--material-teal-A200" css custom property.
+	MaterialTealA200Var = "--material-teal-A200"
+
+	// MaterialTealA400Var defines the name of the "--material-teal-A400" css custom property.
+	MaterialTealA400Var = "--material-teal-A400"
+
+	// MaterialTealA700Var defines the name of the "--material-teal-A700" css custom property.
+	MaterialTealA700Var = "--material-teal-A700"
+
+	// MaterialWhiteVar defines the name of the "--material-white" css custom property.
+	MaterialWhiteVar = "--material-white"
+
+	// MaterialYellow50Var defines the name of the "--material-yellow-50" css custom property.
+	MaterialYellow50Var = "--material-yellow-50"
+
+	// MaterialYellow100Var defines the name of the "--material-yellow-100" css custom property.
+	MaterialYellow100Var = "--material-yellow-100"
+
+	// MaterialYellow200Var defines the name of the "--material-yellow-200" css custom property.
+	MaterialYellow200Var = "--material-yellow-200"
+
+	// MaterialYellow300Var defines the name of the "--material-yellow-300" css custom property.
+	MaterialYellow300Var = "--material-yellow-300"
+
+	// MaterialYellow400Var defines the name of the "--material-yellow-400" css custom property.
+	MaterialYellow400Var = "--material-yellow-400"
+
+	// MaterialYellow500Var defines the name of the "--material-yellow-500" css custom property.
+	MaterialYellow500Var = "--material-yellow-500"
+
+	// MaterialYellow600Var defines the name of the "--material-yellow-600" css custom property.
+	MaterialYellow600Var = "--material-yellow-600"
+
+	// MaterialYellow700Var defines the name of the "--material-yellow-700" css custom property.
+	MaterialYellow700Var = "--material-yellow-700"
+
+	// MaterialYellow800Var defines the name of the "--material-yellow-800" css custom property.
+	MaterialYellow800Var = "--material-yellow-800"
+
+	// MaterialYellow900Var defines the name of the "--material-yellow-900" css custom property.
+	MaterialYellow900Var = "--material-yellow-900"
+
+	// MaterialYellowA100Var defines the name of the "--material-yellow-A100" css custom property.
+	MaterialYellowA100Var = "--material-yellow-A100"
+
+	// MaterialYellowA200Var defines the name of the "--material-yellow-A200" css custom property.
+	MaterialYellowA200Var = "--material-yellow-A200"
+
+	// MaterialYellowA400Var defines the name of the "--material-yellow-A400" css custom property.
+	MaterialYellowA400Var = "--material-yellow-A400"
+
+	// MaterialYellowA700Var defines the name of the "--material-yellow-A700" css custom property.
+	MaterialYellowA700Var = "--material-yellow-A700"
+)
@@ -0,0 +1,149 @@
+package styleguide
+
+//go:generate go run variables_generate.go
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/gu-io/gu/common"
+)
+
+// materialShades contains the names of the shades of the MaterialPalettes, in
+// the order of their colors.
+var materialShades = []string{"50", "100", "200", "300", "400", "500", "600", "700", "800", "900", "A100", "A200", "A400", "A700"}
+
+// Variables returns the css custom properties of the theme, where fields not
+// set are defaulted as done by Render. These contain the colors and their
+// tones, graded for the scheme of the theme, the shadows, border radii, font
+// sizes and scales, animation curves and the colors of the MaterialPalettes,
+// whose names for the default theme are contained in the generated constants
+// suffixed with `Var`. Material colors are named by their shade, eg
+// `--material-red-500` or `--material-red-A100`, or by the palette alone for
+// palettes of a single color such as black, and hold the comma separated red,
+// green and blue values of the color, to be used as
+// `rgba(var(--material-red-500), 0.5)`.
+func Variables(attr common.Theme) ([]common.ThemeVariable, error) {
+	attr = initAttr(attr)
+
+	var vars []common.ThemeVariable
+
+	add := func(name string, value string) {
+		vars = append(vars, common.ThemeVariable{Name: name, Value: value})
+	}
+
+	colors := []struct {
+		name  string
+		value string
+	}{
+		{name: "primary", value: attr.PrimaryColor},
+		{name: "secondary", value: attr.SecondaryColor},
+		{name: "success", value: attr.SuccessColor},
+		{name: "failure", value: attr.FailureColor},
+		{name: "white", value: attr.PrimaryWhite},
+		{name: "primary-brand", value: attr.PrimaryBrandColor},
+		{name: "secondary-brand", value: attr.SecondaryBrandColor},
+	}
+
+	for _, color := range colors {
+		if color.value == "" {
+			continue
+		}
+
+		tones, err := newSchemeTones(color.value, attr.Scheme)
+		if err != nil {
+			return nil, errors.New("Invalid " + color.name + " color: " + err.Error())
+		}
+
+		add("--color-"+color.name, tones.Base.String())
+
+		for index, grade := range tones.Grades {
+			add(fmt.Sprintf("--color-%s-%d", color.name, (index+1)*10), grade.String())
+		}
+	}
+
+	add("--shadow-base", attr.BaseShadow)
+	add("--shadow-drop", attr.DropShadow)
+	add("--shadow-hover", attr.HoverShadow)
+	add("--shadow-floating", attr.FloatingShadow)
+
+	add("--radius-small", fmt.Sprintf("%dpx", attr.SmallBorderRadius))
+	add("--radius-medium", fmt.Sprintf("%dpx", attr.MediumBorderRadius))
+	add("--radius-large", fmt.Sprintf("%dpx", attr.LargeBorderRadius))
+
+	add("--font-size-base", fmt.Sprintf("%dpx", attr.BaseFontSize))
+
+	shm, bhm := GenerateValueScale(1, attr.HeaderBaseScale, attr.MinimumHeadScaleCount, attr.MaximumHeadScaleCount)
+	sm, bg := GenerateValueScale(1, attr.BaseScale, attr.MinimumScaleCount, attr.MaximumScaleCount)
+
+	scales := []struct {
+		name   string
+		values []float64
+	}{
+		{name: "--font-scale-small", values: sm},
+		{name: "--font-scale-big", values: bg},
+		{name: "--heading-scale-small", values: shm},
+		{name: "--heading-scale-big", values: bhm},
+	}
+
+	for _, scale := range scales {
+		for index, value := range scale.values {
+			add(fmt.Sprintf("%s-%d", scale.name, index+1), fmt.Sprintf("%.4fem", value))
+		}
+	}
+
+	add("--animation-curve-default", attr.AnimationCurveDefault)
+	add("--animation-curve-fast-out-linear-in", attr.AnimationCurveFastOutLinearIn)
+	add("--animation-curve-fast-out-slow-in", attr.AnimationCurveFastOutSlowIn)
+	add("--animation-curve-linear-out-slow-in", attr.AnimationCurveLinearOutSlowIn)
+
+	var palettes []string
+	for name := range attr.MaterialPalettes {
+		palettes = append(palettes, name)
+	}
+
+	sort.Strings(palettes)
+
+	for _, name := range palettes {
+		palette := attr.MaterialPalettes[name]
+
+		if len(palette) == 1 {
+			add("--material-"+name, palette[0])
+			continue
+		}
+
+		for index, color := range palette {
+			if index >= len(materialShades) {
+				break
+			}
+
+			add("--material-"+name+"-"+materialShades[index], color)
+		}
+	}
+
+	return vars, nil
+}
+
+// RenderVariables writes the css custom properties of the theme returned by
+// Variables as a `:root` rule into the provided writer.
+func RenderVariables(w io.Writer, attr common.Theme) error {
+	vars, err := Variables(attr)
+	if err != nil {
+		return err
+	}
+
+	if _, err := fmt.Fprintln(w, ":root {"); err != nil {
+		return err
+	}
+
+	for _, item := range vars {
+		if _, err := fmt.Fprintf(w, "  %s: %s;\n", item.Name, item.Value); err != nil {
+			return err
+		}
+	}
+
+	_, err = fmt.Fprintln(w, "}")
+	return err
+}
@@ -0,0 +1,70 @@
+// +build ignore
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"regexp"
+	"strings"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/themes/styleguide"
+)
+
+var (
+	pkg = `// Package styleguide is generated to contain the names of the css custom properties of the default theme.
+
+// Document is auto-generate and should not be modified by hand.
+
+package styleguide
+
+// contains the names of the css custom properties returned by Variables for the
+// default theme, with the brand colors set.
+const (
+`
+
+	separator = regexp.MustCompile("[^a-zA-Z0-9]+")
+)
+
+func main() {
+	vars, err := styleguide.Variables(common.Theme{
+		PrimaryBrandColor:   "#000000",
+		SecondaryBrandColor: "#000000",
+	})
+
+	if err != nil {
+		panic(fmt.Sprintf("Unable to generate variables of default theme: %q", err.Error()))
+	}
+
+	goFile, err := os.Create("./variables.gen.go")
+	if err != nil {
+		panic(fmt.Sprintf("Unable to create `variables.gen.go` file: %q", err.Error()))
+	}
+
+	defer goFile.Close()
+
+	if _, err := fmt.Fprint(goFile, pkg); err != nil {
+		panic(fmt.Sprintf("Unable to write data to `variables.gen.go`: %q", err.Error()))
+	}
+
+	var constants []string
+
+	for _, item := range vars {
+		var name string
+
+		for _, part := range separator.Split(item.Name, -1) {
+			name += strings.Title(part)
+		}
+
+		constants = append(constants, fmt.Sprintf("\t// %sVar defines the name of the %q css custom property.\n\t%sVar = %q\n", name, item.Name, name, item.Name))
+	}
+
+	if _, err := fmt.Fprint(goFile, strings.Join(constants, "\n")); err != nil {
+		panic(fmt.Sprintf("Unable to write data to `variables.gen.go`: %q", err.Error()))
+	}
+
+	if _, err := fmt.Fprint(goFile, ")\n"); err != nil {
+		panic(fmt.Sprintf("Unable to write data to `variables.gen.go`: %q", err.Error()))
+	}
+}
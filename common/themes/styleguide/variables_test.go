@@ -0,0 +1,65 @@
+package styleguide_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/themes/styleguide"
+	"github.com/influx6/faux/tests"
+)
+
+func TestVariables(t *testing.T) {
+	vars, err := styleguide.Variables(common.Theme{PrimaryColor: "#2196f3"})
+	if err != nil {
+		tests.Failed("Should have generated variables of theme: %s", err)
+	}
+	tests.Passed("Should have generated variables of theme")
+
+	values := make(map[string]string)
+	for _, item := range vars {
+		values[item.Name] = item.Value
+	}
+
+	if values[styleguide.ColorPrimaryVar] != "#2196f3" {
+		tests.Failed("Should have set primary color of theme: %q", values[styleguide.ColorPrimaryVar])
+	}
+	tests.Passed("Should have set primary color of theme")
+
+	if values[styleguide.RadiusSmallVar] != "2px" || values[styleguide.AnimationCurveDefaultVar] != styleguide.AnimationCurveDefault {
+		tests.Failed("Should have defaulted the unset values of theme")
+	}
+	tests.Passed("Should have defaulted the unset values of theme")
+
+	if _, ok := values[styleguide.ColorPrimaryBrandVar]; ok {
+		tests.Failed("Should have left out unset brand colors")
+	}
+	tests.Passed("Should have left out unset brand colors")
+
+	if values[styleguide.MaterialRed500Var] != "244,67,54" || values[styleguide.MaterialRedA700Var] != "213,0,0" {
+		tests.Failed("Should have named material colors by their shade: %q %q", values[styleguide.MaterialRed500Var], values[styleguide.MaterialRedA700Var])
+	}
+	tests.Passed("Should have named material colors by their shade")
+
+	if values[styleguide.MaterialBlackVar] != "0,0,0" || values[styleguide.MaterialWhiteVar] != "255,255,255" {
+		tests.Failed("Should have named single color palettes by the palette: %q %q", values[styleguide.MaterialBlackVar], values[styleguide.MaterialWhiteVar])
+	}
+	tests.Passed("Should have named single color palettes by the palette")
+
+	if _, err := styleguide.Variables(common.Theme{PrimaryColor: "blue-ish"}); err == nil {
+		tests.Failed("Should have failed to generate variables for invalid color")
+	}
+	tests.Passed("Should have failed to generate variables for invalid color")
+
+	var content bytes.Buffer
+	if err := styleguide.RenderVariables(&content, common.Theme{}); err != nil {
+		tests.Failed("Should have rendered variables of theme: %s", err)
+	}
+	tests.Passed("Should have rendered variables of theme")
+
+	if !strings.HasPrefix(content.String(), ":root {\n  --color-primary: ") {
+		tests.Failed("Should have rendered variables as a root rule: %s", content.String())
+	}
+	tests.Passed("Should have rendered variables as a root rule")
+}
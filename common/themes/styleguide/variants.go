@@ -0,0 +1,255 @@
+package styleguide
+
+import (
+	"bytes"
+	"fmt"
+	"reflect"
+	"sort"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/colors"
+)
+
+// contains the schemes and contrast ratios used by the variants of a theme.
+const (
+	LightScheme = "light"
+	DarkScheme  = "dark"
+
+	HighContrast = "high-contrast"
+
+	ContrastAA  = colors.ContrastAA
+	ContrastAAA = colors.ContrastAAA
+
+	darkSurface     = 0.07
+	luminosityStep  = 0.01
+	luminosityLimit = 0.5
+)
+
+// Variant defines a named variant of a theme, whose colors are derived from the
+// theme it varies and meet its minimum contrast against its white color.
+type Variant struct {
+	Name  string
+	Theme common.Theme
+}
+
+// Variants returns the variants of the theme sorted by name, whose fields not
+// set are taken from the theme. The scheme of variants named "light" or "dark"
+// defaults to their name, and the minimum contrast of the "high-contrast"
+// variant to ContrastAAA, else to the one of the theme or ContrastAA.
+//
+// Variants whose scheme differs from the theme, or the "high-contrast"
+// variant, derive their white color when not set, which becomes the background
+// of the variant. Colors not set are lightened or darkened with
+// AdditiveLumination until they meet the minimum contrast against it, while
+// colors set which do not meet it return an error.
+func Variants(attr common.Theme) ([]Variant, error) {
+	attr = initAttr(attr)
+
+	var names []string
+	for name := range attr.Variants {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	var variants []Variant
+
+	for _, name := range names {
+		theme, err := deriveVariant(attr, name, attr.Variants[name])
+		if err != nil {
+			return nil, err
+		}
+
+		variants = append(variants, Variant{Name: name, Theme: theme})
+	}
+
+	return variants, nil
+}
+
+// deriveVariant returns the theme of the named variant of the base theme.
+func deriveVariant(base common.Theme, name string, variant common.Theme) (common.Theme, error) {
+	theme := base
+	mergeTheme(&theme, variant)
+	theme.Variants = nil
+
+	if variant.Scheme == "" && (name == LightScheme || name == DarkScheme) {
+		theme.Scheme = name
+	}
+
+	if theme.Scheme != LightScheme && theme.Scheme != DarkScheme {
+		return theme, fmt.Errorf("Invalid scheme %q of %s variant", theme.Scheme, name)
+	}
+
+	if variant.MinimumContrast <= 0 && name == HighContrast {
+		theme.MinimumContrast = ContrastAAA
+	}
+
+	if theme.MinimumContrast <= 0 {
+		theme.MinimumContrast = ContrastAA
+	}
+
+	white, err := ColorFrom(theme.PrimaryWhite)
+	if err != nil {
+		return theme, fmt.Errorf("Invalid white color of %s variant: %s", name, err)
+	}
+
+	if variant.PrimaryWhite == "" && (theme.Scheme != base.Scheme || name == HighContrast) {
+		target := 1.0
+
+		if theme.Scheme == DarkScheme {
+			target = darkSurface
+
+			if name == HighContrast {
+				target = 0
+			}
+		}
+
+		white = AdditiveLumination(white, target-white.Luminosity)
+		theme.PrimaryWhite = white.String()
+	}
+
+	themeColors := []struct {
+		name  string
+		value *string
+		set   bool
+	}{
+		{name: "primary", value: &theme.PrimaryColor, set: variant.PrimaryColor != ""},
+		{name: "secondary", value: &theme.SecondaryColor, set: variant.SecondaryColor != ""},
+		{name: "success", value: &theme.SuccessColor, set: variant.SuccessColor != ""},
+		{name: "failure", value: &theme.FailureColor, set: variant.FailureColor != ""},
+		{name: "primary brand", value: &theme.PrimaryBrandColor, set: variant.PrimaryBrandColor != ""},
+		{name: "secondary brand", value: &theme.SecondaryBrandColor, set: variant.SecondaryBrandColor != ""},
+	}
+
+	for _, color := range themeColors {
+		if *color.value == "" {
+			continue
+		}
+
+		c, err := ColorFrom(*color.value)
+		if err != nil {
+			return theme, fmt.Errorf("Invalid %s color of %s variant: %s", color.name, name, err)
+		}
+
+		if !color.set {
+			c = contrastFrom(c, white, theme.MinimumContrast)
+		}
+
+		if ratio := colors.Contrast(c.rgba(), white.rgba()); ratio < theme.MinimumContrast {
+			return theme, fmt.Errorf("The %s color of %s variant has a contrast ratio of %.2f against its white color, below %.2f", color.name, name, ratio, theme.MinimumContrast)
+		}
+
+		*color.value = c.String()
+	}
+
+	return theme, nil
+}
+
+// mergeTheme sets the fields set in the variant into the theme.
+func mergeTheme(theme *common.Theme, variant common.Theme) {
+	target := reflect.ValueOf(theme).Elem()
+	source := reflect.ValueOf(variant)
+
+	for i := 0; i < source.NumField(); i++ {
+		field := source.Field(i)
+
+		if reflect.DeepEqual(field.Interface(), reflect.Zero(field.Type()).Interface()) {
+			continue
+		}
+
+		target.Field(i).Set(field)
+	}
+}
+
+// contrastFrom returns the color lightened against a dark background or
+// darkened against a light one, until it meets the minimum contrast ratio or
+// can not be changed further.
+func contrastFrom(c Color, background Color, minimum float64) Color {
+	step := -luminosityStep
+	if colors.Luminance(background.rgba()) < luminosityLimit {
+		step = luminosityStep
+	}
+
+	for colors.Contrast(c.rgba(), background.rgba()) < minimum {
+		if (step > 0 && c.Luminosity >= 1) || (step < 0 && c.Luminosity <= 0) {
+			break
+		}
+
+		c = AdditiveLumination(c, step)
+	}
+
+	return c
+}
+
+// renderVariants returns the css custom properties of the variants of the theme
+// which differ between them and the theme. These are written within a
+// `prefers-color-scheme` media rule for variants named after their scheme when
+// it differs from the scheme of the theme, and within a `.theme-<name>` rule
+// for every variant.
+func renderVariants(attr common.Theme) (string, error) {
+	variants, err := Variants(attr)
+	if err != nil || len(variants) == 0 {
+		return "", err
+	}
+
+	vars, err := Variables(attr)
+	if err != nil {
+		return "", err
+	}
+
+	base := make(map[string]string)
+	for _, item := range vars {
+		base[item.Name] = item.Value
+	}
+
+	changed := make(map[string]bool)
+	variantVars := make([][]common.ThemeVariable, len(variants))
+
+	for index, variant := range variants {
+		vars, err := Variables(variant.Theme)
+		if err != nil {
+			return "", fmt.Errorf("Invalid %s variant: %s", variant.Name, err)
+		}
+
+		for _, item := range vars {
+			if base[item.Name] != item.Value {
+				changed[item.Name] = true
+			}
+		}
+
+		variantVars[index] = vars
+	}
+
+	var content bytes.Buffer
+
+	writeRule := func(selector string, indent string, vars []common.ThemeVariable) {
+		fmt.Fprintf(&content, "%s%s {\n", indent, selector)
+
+		for _, item := range vars {
+			if changed[item.Name] {
+				fmt.Fprintf(&content, "%s  %s: %s;\n", indent, item.Name, item.Value)
+			}
+		}
+
+		fmt.Fprintf(&content, "%s}\n", indent)
+	}
+
+	content.WriteString("\n/*____________ Theme variants ____________________________\n\n*/\n")
+
+	for index, variant := range variants {
+		if variant.Name != variant.Theme.Scheme || variant.Theme.Scheme == attr.Scheme {
+			continue
+		}
+
+		fmt.Fprintf(&content, "\n@media (prefers-color-scheme: %s) {\n", variant.Theme.Scheme)
+		writeRule(":root", "  ", variantVars[index])
+		content.WriteString("}\n")
+	}
+
+	for index, variant := range variants {
+		content.WriteString("\n")
+		writeRule(".theme-"+variant.Name, "", variantVars[index])
+	}
+
+	return content.String(), nil
+}
@@ -0,0 +1,89 @@
+package styleguide_test
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/common/colors"
+	"github.com/gu-io/gu/common/themes/styleguide"
+	"github.com/influx6/faux/tests"
+)
+
+func TestVariants(t *testing.T) {
+	theme := common.Theme{
+		PrimaryColor: "#2196f3",
+		Variants: map[string]common.Theme{
+			"dark":                  {},
+			styleguide.HighContrast: {},
+		},
+	}
+
+	variants, err := styleguide.Variants(theme)
+	if err != nil {
+		tests.Failed("Should have derived variants of theme: %s", err)
+	}
+	tests.Passed("Should have derived variants of theme")
+
+	if len(variants) != 2 || variants[0].Name != "dark" || variants[1].Name != styleguide.HighContrast {
+		tests.Failed("Should have returned variants sorted by name: %+v", variants)
+	}
+	tests.Passed("Should have returned variants sorted by name")
+
+	dark := variants[0].Theme
+	if dark.Scheme != styleguide.DarkScheme {
+		tests.Failed("Should have defaulted scheme of dark variant to its name: %q", dark.Scheme)
+	}
+	tests.Passed("Should have defaulted scheme of dark variant to its name")
+
+	if colors.Luminance(colors.MustParse(dark.PrimaryWhite)) > 0.05 {
+		tests.Failed("Should have derived a dark white color for dark variant: %q", dark.PrimaryWhite)
+	}
+	tests.Passed("Should have derived a dark white color for dark variant")
+
+	for _, variant := range variants {
+		white := colors.MustParse(variant.Theme.PrimaryWhite)
+		primary := colors.MustParse(variant.Theme.PrimaryColor)
+
+		if ratio := colors.Contrast(primary, white); ratio < variant.Theme.MinimumContrast {
+			tests.Failed("Should have derived primary color of %s variant with minimum contrast: %.2f", variant.Name, ratio)
+		}
+	}
+	tests.Passed("Should have derived primary colors of variants with minimum contrast")
+
+	if variants[1].Theme.MinimumContrast != styleguide.ContrastAAA {
+		tests.Failed("Should have defaulted minimum contrast of high-contrast variant to AAA")
+	}
+	tests.Passed("Should have defaulted minimum contrast of high-contrast variant to AAA")
+
+	theme.Variants = map[string]common.Theme{"dark": {PrimaryColor: "#0d47a1"}}
+	if _, err := styleguide.Variants(theme); err == nil {
+		tests.Failed("Should have failed for set color below minimum contrast")
+	}
+	tests.Passed("Should have failed for set color below minimum contrast")
+
+	theme.Variants = map[string]common.Theme{"dark": {}, styleguide.HighContrast: {}}
+
+	var content bytes.Buffer
+	if err := styleguide.Render(&content, theme); err != nil {
+		tests.Failed("Should have rendered stylesheet with variants: %s", err)
+	}
+	tests.Passed("Should have rendered stylesheet with variants")
+
+	css := content.String()
+	if !strings.Contains(css, "@media (prefers-color-scheme: dark) {\n  :root {\n    --color-primary: ") {
+		tests.Failed("Should have rendered dark variant within color scheme media rule")
+	}
+	tests.Passed("Should have rendered dark variant within color scheme media rule")
+
+	if !strings.Contains(css, ".theme-dark {\n") || !strings.Contains(css, ".theme-high-contrast {\n") {
+		tests.Failed("Should have rendered class rules of variants")
+	}
+	tests.Passed("Should have rendered class rules of variants")
+
+	if !strings.Contains(css, "color: var(--color-primary, #2196f3);") {
+		tests.Failed("Should have used variables in color classes")
+	}
+	tests.Passed("Should have used variables in color classes")
+}
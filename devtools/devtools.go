@@ -0,0 +1,113 @@
+// +build !js
+
+// Package devtools provides a http.Handler which serves the notifications trace
+// and the current state of registered apps as JSON for a local devtools page.
+package devtools
+
+import (
+	"encoding/json"
+	"net/http"
+	"strings"
+	"sync"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/notifications"
+)
+
+// Snapshot defines the JSON structure served by the Inspector.
+type Snapshot struct {
+	Total  int                   `json:"total"`
+	Traces []notifications.Trace `json:"traces"`
+	Apps   []gu.AppInfo          `json:"apps"`
+}
+
+// Inspector defines a http.Handler which exposes the trace of a notification
+// Tracer and the view and component tree of the registered apps.
+//
+// Routes:
+//	/trace => Returns only the traces and total count.
+//	/apps  => Returns only the apps tree.
+//	/reset => Empties the tracer (POST only).
+//	*      => Returns the full Snapshot.
+type Inspector struct {
+	ml     sync.Mutex
+	tracer *notifications.Tracer
+	apps   []*gu.NApp
+}
+
+// New returns a new instance of the Inspector using the provided tracer and
+// apps. The tracer can be nil if only the apps tree is needed.
+func New(tracer *notifications.Tracer, apps ...*gu.NApp) *Inspector {
+	return &Inspector{
+		tracer: tracer,
+		apps:   apps,
+	}
+}
+
+// Add adds the giving app into the inspected list.
+func (i *Inspector) Add(app *gu.NApp) {
+	i.ml.Lock()
+	defer i.ml.Unlock()
+
+	i.apps = append(i.apps, app)
+}
+
+// Snapshot returns the current state of the traces and apps.
+func (i *Inspector) Snapshot() Snapshot {
+	i.ml.Lock()
+	defer i.ml.Unlock()
+
+	var snap Snapshot
+
+	if i.tracer != nil {
+		snap.Total = i.tracer.Total()
+		snap.Traces = i.tracer.Traces()
+	}
+
+	for _, app := range i.apps {
+		snap.Apps = append(snap.Apps, app.Inspect())
+	}
+
+	return snap
+}
+
+// ServeHTTP implements the http.Handler interface.
+func (i *Inspector) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	snap := i.Snapshot()
+
+	switch {
+	case strings.HasSuffix(r.URL.Path, "/reset"):
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+
+		if i.tracer != nil {
+			i.tracer.Reset()
+		}
+
+		w.WriteHeader(http.StatusNoContent)
+		return
+
+	case r.Method != http.MethodGet:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return
+
+	case strings.HasSuffix(r.URL.Path, "/trace"):
+		snap.Apps = nil
+
+	case strings.HasSuffix(r.URL.Path, "/apps"):
+		snap.Traces = nil
+		snap.Total = 0
+	}
+
+	data, err := json.Marshal(snap)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusOK)
+	w.Write(data)
+}
@@ -0,0 +1,71 @@
+package devtools_test
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/devtools"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/influx6/faux/tests"
+)
+
+// get returns the snapshot served by the inspector for the giving path.
+func get(inspector *devtools.Inspector, path string) devtools.Snapshot {
+	recorder := httptest.NewRecorder()
+	inspector.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, path, nil))
+
+	if recorder.Code != http.StatusOK {
+		tests.Failed("Should have served %q: %d", path, recorder.Code)
+	}
+
+	var snap devtools.Snapshot
+	if err := json.Unmarshal(recorder.Body.Bytes(), &snap); err != nil {
+		tests.Failed("Should have served json snapshot for %q: %+q", path, err)
+	}
+
+	return snap
+}
+
+func TestInspector(t *testing.T) {
+	tracer := notifications.NewTracer(10)
+
+	app := gu.App("Devtools", nil)
+	app.View(elems.Div(), "/*", gu.BodyTarget)
+
+	inspector := devtools.New(tracer, app)
+	tracer.Record(notifications.Trace{Type: "gu.ViewUpdate"})
+
+	snap := get(inspector, "/")
+	if snap.Total != 1 || len(snap.Traces) != 1 || len(snap.Apps) != 1 || snap.Apps[0].Title != "Devtools" {
+		tests.Failed("Should have served traces and apps: %+v", snap)
+	}
+	tests.Passed("Should have served traces and apps")
+
+	if snap := get(inspector, "/trace"); snap.Total != 1 || len(snap.Apps) != 0 {
+		tests.Failed("Should have served only traces: %+v", snap)
+	}
+	tests.Passed("Should have served only traces")
+
+	if snap := get(inspector, "/apps"); snap.Total != 0 || len(snap.Traces) != 0 || len(snap.Apps) != 1 {
+		tests.Failed("Should have served only apps: %+v", snap)
+	}
+	tests.Passed("Should have served only apps")
+
+	recorder := httptest.NewRecorder()
+	inspector.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/reset", nil))
+	if recorder.Code != http.StatusMethodNotAllowed || tracer.Total() != 1 {
+		tests.Failed("Should have refused reset through GET: %d", recorder.Code)
+	}
+	tests.Passed("Should have refused reset through GET")
+
+	recorder = httptest.NewRecorder()
+	inspector.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/reset", nil))
+	if recorder.Code != http.StatusNoContent || len(tracer.Traces()) != 0 {
+		tests.Failed("Should have emptied tracer through POST: %d", recorder.Code)
+	}
+	tests.Passed("Should have emptied tracer through POST")
+}
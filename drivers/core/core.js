@@ -17,15 +17,88 @@ function GuClient(onMessages, SendChannel) {
     GuJS.eventsCore = {};
     GuJS.currentAppID = null;
 
+    // GuJS.format contains the wire format negotiated with the server, which is
+    // json until the server replies to the Hello message.
+    GuJS.format = "json";
+
+    // GuJS.Serializers contains the generated serializers of the eventx types,
+    // keyed by their names (see events.js).
+    GuJS.Serializers = GuSerializers(GuJS);
+
     // GuJS.Dispatch defines a function which dispatches event object to the external
     // API.
     GuJS.Dispatch = function(name, model, meta) {
-        SendChannel({ "type": name, "meta": meta, "data": model });
+        var message = { "type": name, "meta": meta, "data": model, "options_applied": true };
+
+        if (GuJS.format === "binary") {
+            SendChannel(GuJS.Wire.EncodeEvent(message));
+            return
+        }
+
+        SendChannel(message);
+    };
+
+    // GuJS.ListenerOptions returns the options used when adding the listener
+    // for the giving event meta.
+    GuJS.ListenerOptions = function(eventMeta) {
+        return { capture: !!eventMeta.UseCapture, passive: !!eventMeta.Passive };
+    };
+
+    // GuJS.LimitEventCallback wraps the callback to apply the Once, Debounce,
+    // Throttle and KeyFilter options of the event meta provided.
+    GuJS.LimitEventCallback = function(eventMeta, callback) {
+        var fired = false;
+        var last = 0;
+        var timer = null;
+
+        return function(eventObj) {
+            if (eventMeta.KeyFilter && eventMeta.KeyFilter.length) {
+                if (eventMeta.KeyFilter.indexOf(eventObj.key) === -1) {
+                    return
+                }
+            }
+
+            if (eventMeta.Once && fired) {
+                return
+            }
+
+            if (eventMeta.Throttle > 0) {
+                var now = Date.now()
+                if (last && (now - last) < eventMeta.Throttle) {
+                    return
+                }
+
+                last = now
+            }
+
+            if (eventMeta.Debounce > 0) {
+                clearTimeout(timer)
+                timer = setTimeout(function() {
+                    if (eventMeta.Once && fired) {
+                        return
+                    }
+
+                    fired = true
+                    callback(eventObj)
+                }, eventMeta.Debounce)
+
+                return
+            }
+
+            fired = true
+            callback(eventObj)
+        }
     };
 
     // GuJS.MakeEventCallback defines a function to generate a callback for an event
-    // meta provided
+    // meta provided. The PreventDefault and propagation options apply to every
+    // matching event as it happens, while the Once, Debounce, Throttle and
+    // KeyFilter options only limit its dispatch.
     GuJS.MakeEventCallback = function(target, eventMeta) {
+        var dispatch = GuJS.LimitEventCallback(eventMeta, function(eventObj) {
+            GuJS.Dispatch(GuJS.EventType(eventObj, eventMeta), GuJS.GetEvent(eventObj, eventMeta), eventMeta)
+        })
+
         return function(eventObj) {
 
             // Do we match the event and possible targets for the event
@@ -35,7 +108,7 @@ function GuClient(onMessages, SendChannel) {
                     return
                 }
 
-                if (eventMeta.PreventDefault) {
+                if (eventMeta.PreventDefault && !eventMeta.Passive) {
                     eventObj.preventDefault()
                 }
 
@@ -47,7 +120,7 @@ function GuClient(onMessages, SendChannel) {
                     eventObj.stopPropagation()
                 }
 
-                GuJS.Dispatch(GuJS.GetEvent(eventObj), eventMeta)
+                dispatch(eventObj)
             })
         }
     };
@@ -61,11 +134,17 @@ function GuClient(onMessages, SendChannel) {
 
         var command
 
-        // if we are dealing with a string then parse with json.
+        // if we are dealing with a string then parse with json, binary messages
+        // are decoded with the binary wire format.
         switch (co.constructor) {
             case String:
                 command = JSON.parse(co)
-            case Object:
+                break
+            case ArrayBuffer:
+            case Uint8Array:
+                command = GuJS.Wire.DecodeCommand(co)
+                break
+            default:
                 command = co
         }
 
@@ -74,6 +153,12 @@ function GuClient(onMessages, SendChannel) {
         var body = document.querySelector("body")
 
         switch (command.Command) {
+            case "Format":
+                // The server replies to the Hello message with the wire format used
+                // for the messages following it.
+                GuJS.format = command.Format
+                break
+
             case "RenderApp":
                 // Rendering the app response is to clear what is currently in the view.
                 // We want specific replicate the way the gopherjs driver updates apps
@@ -91,18 +176,18 @@ function GuClient(onMessages, SendChannel) {
 
                 // Deregister all head base events.
                 GuJS.each(appEvents.base.headEvents, function(cb) {
-                    head.removeEventListener(cb.Event.Event, cb.Callback)
+                    head.removeEventListener(cb.Event.Event, cb.Callback, GuJS.ListenerOptions(cb.Event))
                 })
 
                 // Deregister all body base events.
                 GuJS.each(appEvents.base.bodyEvents, function(cb) {
-                    body.removeEventListener(cb.Event.Event, cb.Callback)
+                    body.removeEventListener(cb.Event.Event, cb.Callback, GuJS.ListenerOptions(cb.Event))
                 })
 
                 // Deregister all view events.
                 GuJS.each(appEvents.views, function(view) {
                     GuJS.each(view, function(cb) {
-                        body.removeEventListener(cb.Event.Event, cb.Callback)
+                        body.removeEventListener(cb.Event.Event, cb.Callback, GuJS.ListenerOptions(cb.Event))
                     })
                 })
 
@@ -125,7 +210,7 @@ function GuClient(onMessages, SendChannel) {
                         newEvent.Event = event
                         newEvent.Callback = GuJS.MakeEventCallback(head, event)
 
-                        head.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                        head.addEventListener(event.Event, newEvent.Callback, GuJS.ListenerOptions(event));
                         appEvents.base.headEvents.push(newEvent);
                     })
 
@@ -146,7 +231,7 @@ function GuClient(onMessages, SendChannel) {
                         newEvent.Event = event
                         newEvent.Callback = GuJS.MakeEventCallback(head, event)
 
-                        head.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                        head.addEventListener(event.Event, newEvent.Callback, GuJS.ListenerOptions(event));
                         viewEvents.puhs(newEvent)
                     })
 
@@ -167,7 +252,7 @@ function GuClient(onMessages, SendChannel) {
                         newEvent.Event = event
                         newEvent.Callback = GuJS.MakeEventCallback(body, event)
 
-                        body.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                        body.addEventListener(event.Event, newEvent.Callback, GuJS.ListenerOptions(event));
                         viewEvents.push(newEvent)
                     })
 
@@ -185,7 +270,7 @@ function GuClient(onMessages, SendChannel) {
                         newEvent.Event = event
                         newEvent.Callback = GuJS.MakeEventCallback(body, event)
 
-                        body.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                        body.addEventListener(event.Event, newEvent.Callback, GuJS.ListenerOptions(event));
                         appEvents.base.bodyEvents.push(newEvent);
                     })
 
@@ -223,6 +308,9 @@ function GuClient(onMessages, SendChannel) {
 
                 return
 
+            // PatchView carries the same view as RenderView, where unchanged elements
+            // are sent empty with a NodeUnchanged attribute, kept by PatchDOM.
+            case "PatchView":
             case "RenderView":
                 // Rendering the app response is to clear what is currently in the view.
                 // We want specific replicate the way the gopherjs driver updates apps views
@@ -247,31 +335,114 @@ function GuClient(onMessages, SendChannel) {
                 // Deregister all view events.
                 GuJS.each(viewEvents, function(view) {
                     GuJS.each(view, function(cb) {
-                        body.removeEventListener(cb.Event.Event, cb.Callback)
+                        body.removeEventListener(cb.Event.Event, cb.Callback, GuJS.ListenerOptions(cb.Event))
                     })
                 })
 
                 var fragmentDOM = GuJS.createDOMFragment(view.Tree.Markup)
                 GuJS.PatchDOM(fragmentDOM, body, false)
 
+                // Replace the stylesheet collected from the views of the app if
+                // sent along with the view.
+                if (view.Styles) {
+                    GuJS.PatchDOM(GuJS.createDOMFragment(view.Styles.Markup), head, false)
+                }
+
                 // Register all events for this markup.
                 GuJS.each(view.Tree.Events, function(event) {
                     var newEvent = {}
                     newEvent.Event = event
                     newEvent.Callback = GuJS.MakeEventCallback(body, event)
 
-                    body.addEventListener(event.Event, newEvent.Callback, event.UseCapture);
+                    body.addEventListener(event.Event, newEvent.Callback, GuJS.ListenerOptions(event));
                     viewEvents.push(newEvent)
                 })
 
                 return
 
+            case "RenderTheme":
+                // Rendering the theme replaces only the style markup containing
+                // the theme variables of the current app in the head, whose uid
+                // is prefixed with the id of the app.
+                if (GuJS.currentAppID && command.Theme.TreeID.indexOf(GuJS.currentAppID) !== 0) {
+                    return
+                }
+
+                GuJS.PatchDOM(GuJS.createDOMFragment(command.Theme.Markup), head, false)
+                return
+
+            case "CallJS":
+                GuJS.CallJS(command.Call)
+                return
+
             default:
                 console.log("Command not support: ", command);
         }
     };
 
 
+    // GuJS.JSFunctions contains the browser functions callable by the server
+    // through the CallJS command, called with the element matching the selector
+    // of the call and its arguments. The list matches gu.JSFunctions.
+    GuJS.JSFunctions = {
+        "focus": function(target, args) {
+            target.focus()
+        },
+        "scrollIntoView": function(target, args) {
+            target.scrollIntoView({ behavior: args[0] || "auto" })
+        },
+        "clipboard.writeText": function(target, args) {
+            return navigator.clipboard.writeText(args[0])
+        },
+        "localStorage.getItem": function(target, args) {
+            return window.localStorage.getItem(args[0])
+        },
+        "localStorage.setItem": function(target, args) {
+            window.localStorage.setItem(args[0], args[1])
+        },
+        "localStorage.removeItem": function(target, args) {
+            window.localStorage.removeItem(args[0])
+        },
+    };
+
+    // GuJS.CallJS calls the function of the giving call, sending its result or
+    // error back as a JSResult message, which is always sent as json.
+    GuJS.CallJS = function(call) {
+        var reply = function(value, err) {
+            SendChannel({
+                "type": "JSResult",
+                "id": call.ID,
+                "value": value === undefined ? null : value,
+                "error": err ? String(err.message || err) : "",
+            })
+        }
+
+        if (!GuJS.JSFunctions.hasOwnProperty(call.Function)) {
+            reply(null, "function " + call.Function + " is not allowed")
+            return
+        }
+
+        var target = null
+        if (call.Selector) {
+            target = document.querySelector(call.Selector)
+            if (target == null) {
+                reply(null, "no element matches " + call.Selector)
+                return
+            }
+        }
+
+        try {
+            Promise.resolve(GuJS.JSFunctions[call.Function](target, call.Args || [])).then(function(value) {
+                reply(value, null)
+            }, function(err) {
+                reply(null, err)
+            })
+        } catch (err) {
+            reply(null, err)
+        }
+    };
+
+
     // GuJS.PatchDOM patches the provided elements into the target from the current DOM.
     // It crawls a liveDOM version of the DOM, removing, replacing and adding node
     // changes as needed, until the dom resembles it's shadow/fragmentDOM.
@@ -312,6 +483,7 @@ function GuClient(onMessages, SendChannel) {
             var nodeKids = node.childNodes
             var nodeSel = nodeTagName + "[uid=" + nodeUID + "]"
             var nodeRemoved = node.hasAttribute("NodeRemoved")
+            var nodeUnchanged = node.hasAttribute("NodeUnchanged")
             var nodeHash = node.getAttribute("hash")
 
             if (!nodeId && !nodeUID && !nodeHash) {
@@ -334,6 +506,12 @@ function GuClient(onMessages, SendChannel) {
 
             var allTargets = liveDOM.querySelectorAll(nodeSel)
             if (!allTargets.length) {
+                // Unchanged nodes are sent without content, so only existing
+                // nodes can be kept.
+                if (nodeUnchanged) {
+                    continue
+                }
+
                 liveDOM.appendChild(node)
                 continue
             }
@@ -346,6 +524,10 @@ function GuClient(onMessages, SendChannel) {
                     continue
                 }
 
+                if (nodeUnchanged) {
+                    continue
+                }
+
                 if (replace) {
                     liveDOM.replaceNode(curTarget, node)
                     continue
@@ -438,44 +620,38 @@ function GuClient(onMessages, SendChannel) {
         return fragment
     }
 
-    // GetEvent returns the event as a object which can be jsonified and
-    // sent over the pipeline.
-    GuJS.GetEvent = function(ev) {
-        var eventObj
-
-        var c = ev.constructor
-        switch (c) {
-            case MutationRecord:
-                eventObj = GuJS.DeepClone(ev, {
-                    Functions: false,
-                })
-
-                var added = GuJS.map(eventObj.addedNodes, function(elem) {
-                    return GuJS.StringifyHTML(elem)
-                })
-
-                var removed = GuJS.map(eventObj.removedNodes, function(elem) {
-                    return GuJS.StringifyHTML(elem)
-                })
+    // GuJS.EventType returns the name of the eventx type the event is serialized
+    // into, preferring the name of the event meta before the names of the event's
+    // constructors.
+    GuJS.EventType = function(ev, eventMeta) {
+        if (eventMeta && GuJS.Serializers[eventMeta.EventName]) {
+            return eventMeta.EventName
+        }
 
-                var presib = GuJS.StringifyHTML(eventObj.preSibling)
-                var nextsib = GuJS.StringifyHTML(eventObj.nextSibling)
+        var proto = Object.getPrototypeOf(ev)
+        while (proto) {
+            var name = proto.constructor && proto.constructor.name
+            if (GuJS.Serializers[name]) {
+                return name
+            }
 
-                eventObj.AddedNodes = added
-                eventObj.RemovedNodes = removed
-                eventObj.PreSibling = presib
-                eventObj.NextSibling = nextsib
+            proto = Object.getPrototypeOf(proto)
+        }
 
-            case MediaStream:
-                eventObj = GuJS.toMediaStream(ev)
+        return GuJS.Type(ev)
+    }
 
-            default:
-                eventObj = GuJS.DeepClone(ev, {
-                    Functions: false,
-                })
+    // GetEvent returns the event as a object which can be jsonified and
+    // sent over the pipeline, using the serializer of its eventx type.
+    GuJS.GetEvent = function(ev, eventMeta) {
+        var serializer = GuJS.Serializers[GuJS.EventType(ev, eventMeta)]
+        if (serializer) {
+            return serializer(ev)
         }
 
-        return eventObj
+        return GuJS.DeepClone(ev, {
+            Functions: false,
+        })
     }
 
     // GuJS.Type returns the type of the native constructor of the passed in object.
@@ -715,16 +891,16 @@ function GuClient(onMessages, SendChannel) {
                 return newArray
 
             case TouchList:
-                return GuJS.toTouches(item)
+                return GuJS.Serializers.TouchList(item)
 
             case MediaStream:
-                return GuJS.toMediaStream(item)
+                return GuJS.Serializers.MediaStream(item)
 
             case Gamepad:
-                return GuJS.toGamepad(item)
+                return GuJS.Serializers.Gamepad(item)
 
             case DataTransfer:
-                return GuJS.toDataTransfer(item)
+                return GuJS.Serializers.DataTransfer(item)
 
             case Array:
                 var newArray = []
@@ -868,224 +1044,434 @@ function GuClient(onMessages, SendChannel) {
         return data
     }
 
-    // toInputSourceCapability returns the events.InputDeviceCapabilities from the object.
-    GuJS.toInputSourceCapability = function(o) {
+    // GuJS.extend copies the properties of the sources into the target.
+    GuJS.extend = function(target) {
+        for (var i = 1; i < arguments.length; i++) {
+            var source = arguments[i]
+            if (source == null || source == undefined) {
+                continue
+            }
+
+            for (var key in source) {
+                target[key] = source[key]
+            }
+        }
+
+        return target
+    }
+
+    // GuJS.toString returns the value as a string, nulls become empty strings.
+    GuJS.toString = function(o) {
         if (o == null || o == undefined) {
-            return
+            return ""
         }
 
-        return {
-            FiresTouchEvent: o.firesTouchEvent,
+        return String(o)
+    }
+
+    // GuJS.toInt returns the value as a integer, invalid numbers become zero.
+    GuJS.toInt = function(o) {
+        var n = Number(o)
+        if (!isFinite(n)) {
+            return 0
         }
+
+        return n < 0 ? Math.ceil(n) : Math.floor(n)
     }
 
-    // GuJS.toMotionData returns a motionData object from the Object.
-    GuJS.toMotionData = function(o) {
-        var md = { X: 0.0, Y: 0.0, Z: 0.0 }
+    // GuJS.toFloat returns the value as a number, invalid numbers become zero.
+    GuJS.toFloat = function(o) {
+        var n = Number(o)
+        if (!isFinite(n)) {
+            return 0
+        }
 
-        if (o == null || o == undefined) {
-            return md
+        return n
+    }
+
+    // GuJS.toDuration returns the seconds as nanoseconds for a time.Duration.
+    GuJS.toDuration = function(o) {
+        return GuJS.toInt(GuJS.toFloat(o) * 1e9)
+    }
+
+    // GuJS.toElement returns the markup of the node.
+    GuJS.toElement = function(o) {
+        if (o == null || o == undefined || !o.cloneNode) {
+            return ""
         }
 
-        md.X = o.x
-        md.Y = o.y
-        md.Z = o.z
-        return md
+        return GuJS.StringifyHTML(o, true)
     }
 
-    // GuJS.toRotationData returns a RotationData object from the Object.
-    GuJS.toRotationData = function(o) {
+    // GuJS.toValue returns a copy of the value which can be jsonified.
+    GuJS.toValue = function(o) {
         if (o == null || o == undefined) {
-            return
+            return null
         }
 
-        md.Alpha = o.alpha
-        md.Beta = o.beta
-        md.Gamma = o.gamma
-        return md
+        try {
+            return JSON.parse(JSON.stringify(o))
+        } catch (e) {
+            return null
+        }
     }
 
-    // GuJS.toMediaStream returns a events.MediaStream object.
-    GuJS.toMediaStream = function(o) {
+    // GuJS.toBytes returns the string or binary data as a base64 string, which
+    // decodes into a []byte. Blobs which can only be read asynchronously are
+    // returned as null.
+    GuJS.toBytes = function(o) {
         if (o == null || o == undefined) {
-            return
+            return null
         }
 
-        stream.Active = o.active
-        stream.Ended = o.ended
-        stream.ID = o.id
-        stream.Audios = []
-        stream.Videos = []
-
-        var audioTracks = o.getAudioTracks()
-        if (audioTracks != null && audioTracks != undefined) {
-            for (i = 0; i < audioTracks.length; i++) {
-                var track = audioTracks[i]
-                var settings = track.getSettings()
-
-                stream.Audios.push({
-                    Enabled: track.enabled,
-                    ID: track.id,
-                    Kind: track.kind,
-                    Label: track.label,
-                    Muted: track.muted,
-                    ReadyState: track.readyState,
-                    Remote: track.remote,
-                    AudioSettings: {
-                        ChannelCount: settings.channelCount.Int(),
-                        EchoCancellation: settings.echoCancellation,
-                        Latency: settings.latency,
-                        SampleRate: settings.sampleRate.Int64(),
-                        SampleSize: settings.sampleSize.Int64(),
-                        Volume: settings.volume,
-                        MediaTrackSettings: {
-                            DeviceID: settings.deviceId,
-                            GroupID: settings.groupId,
-                        },
-                    },
-                })
+        var binary = ""
+
+        if (typeof o === "string") {
+            binary = unescape(encodeURIComponent(o))
+        } else if (o instanceof ArrayBuffer || ArrayBuffer.isView(o)) {
+            var bytes = new Uint8Array(o.buffer || o, o.byteOffset || 0, o.byteLength)
+            for (var i = 0; i < bytes.length; i++) {
+                binary += String.fromCharCode(bytes[i])
             }
+        } else {
+            return null
         }
 
-        var videosTracks = o.getVideoTracks()
-        if (videosTracks != null && videosTracks != undefined) {
-            for (i = 0; i < videosTracks.length; i++) {
-                var track = videosTracks[i]
-                var settings = track.getSettings()
-
-                stream.Videos.push({
-                    Enabled: track.enabled,
-                    ID: track.id,
-                    Kind: track.kind,
-                    Label: track.label,
-                    Muted: track.muted,
-                    ReadyState: track.readyState,
-                    Remote: track.remote,
-                    VideoSettings: {
-                        AspectRatio: settings.aspectRation,
-                        FrameRate: settings.frameRate,
-                        Height: settings.height.Int64(),
-                        Width: settings.width.Int64(),
-                        FacingMode: settings.facingMode,
-                        MediaTrackSettings: {
-                            DeviceID: settings.deviceId,
-                            GroupID: settings.groupId,
-                        },
-                    },
-                })
-            }
+        return btoa(binary)
+    }
+
+    // GuJS.toList returns the items of the array-like object converted with the
+    // provided function.
+    GuJS.toList = function(o, fn) {
+        if (o == null || o == undefined || o.length === undefined) {
+            return null
+        }
+
+        var list = []
+        for (var i = 0; i < o.length; i++) {
+            list.push(fn(o[i]))
         }
 
-        return stream
+        return list
     }
 
-    GuJS.toTouches = function(o) {
-        if (o == null || o == undefined) {
-            return
+    // GuJS.toFile returns the file of a DataTransferItem or the object itself.
+    GuJS.toFile = function(o) {
+        if (o != null && o != undefined && typeof o.getAsFile === "function") {
+            return o.getAsFile()
         }
 
-        var touches = []
-
-        for (i = 0; i < o.length; i++) {
-            var ev = o.item(i)
-            touches.push({
-                ClientX: ev.clientX,
-                ClientY: ev.clientY,
-                OffsetX: ev.offsetX,
-                OffsetY: ev.offsetY,
-                PageX: ev.pageX,
-                PageY: ev.pageY,
-                ScreenX: ev.screenX,
-                ScreenY: ev.screenY,
-                Identifier: ev.identifier,
-            })
+        return o
+    }
+
+    // GuJS.selectedValues returns the values of the selected options of the
+    // element, if it is a select element.
+    GuJS.selectedValues = function(target) {
+        if (!target || !target.selectedOptions) {
+            return null
+        }
 
+        var values = []
+        for (var i = 0; i < target.selectedOptions.length; i++) {
+            values.push(target.selectedOptions[i].value)
         }
 
-        return touches
+        return values
     }
 
-    // toGamepad returns a Gamepad struct from the js object.
-    GuJS.toGamepad = function(o) {
-        var pad = {}
 
-        if (o == null || o == undefined) {
-            return pad
-        }
-
-        pad.DisplayID = o.displayId
-        pad.ID = o.id
-        pad.Index = o.index.Int()
-        pad.Mapping = o.mapping
-        pad.Connected = o.connected
-        pad.Timestamp = o.timestamp
-        pad.Axes = []
-        pad.Buttons = []
-
-        var axes = o.axes
-        if (axes != null && axes != undefined) {
-            for (i = 0; i < axes.length; i++) {
-                pad.Axes.push(axes[i])
+    // GuJS.Wire provides the binary wire format of drivers/wire, decoding the
+    // RenderCommands received and encoding the event payloads sent.
+    GuJS.Wire = (function() {
+        var Wire = {}
+        var magic = 71, version = 4, commandKind = 1, eventKind = 2
+
+        // Wire.Formats returns the formats supported in order of preference.
+        Wire.Formats = function() {
+            if (typeof Uint8Array !== "undefined" && typeof TextDecoder !== "undefined" && typeof TextEncoder !== "undefined") {
+                return ["binary", "json"]
             }
+
+            return ["json"]
         }
 
-        var buttons = o.buttons
-        if (buttons != null && buttons != undefined) {
-            for (i = 0; i < buttons.length; i++) {
-                button = buttons[i]
-                pad.Buttons.push({
-                    Value: button.value,
-                    Pressed: button.pressed,
-                })
+        // Reader decodes the values of a binary message.
+        function Reader(data, kind) {
+            this.bytes = data instanceof Uint8Array ? data : new Uint8Array(data)
+            this.offset = 3
+            this.strings = []
+            this.decoder = new TextDecoder("utf-8")
+
+            if (this.bytes[0] !== magic || this.bytes[1] !== version || this.bytes[2] !== kind) {
+                throw new Error("Invalid binary message")
             }
         }
 
-        return pad
-    }
+        Reader.prototype.byte = function() {
+            if (this.offset >= this.bytes.length) {
+                throw new Error("Invalid binary message")
+            }
 
-    // toDataTransfer returns a transfer object from the Object.
-    GuJS.toDataTransfer = function(o) {
-        if (o == null || o == undefined) {
-            return
+            return this.bytes[this.offset++]
         }
 
-        var dt = {}
-        dt.DropEffect = o.dropEffect
-        dt.EffectAllowed = o.effectAllowed
-        df.Types = o.types
-        df.Items = []
-
-        var items = o.items
-        if (items != null && items != undefined) {
-            for (i = 0; i < items.length; i++) {
-                item = items.DataTransferItem(i)
-                dItems.push({
-                    Name: item.name,
-                    Size: item.size.Int(),
-                    Data: GuJS.fromFile(item),
-                })
+        Reader.prototype.uvarint = function() {
+            var value = 0, scale = 1, b
+            do {
+                b = this.byte()
+                value += (b & 0x7f) * scale
+                scale *= 128
+            } while (b & 0x80)
+
+            return value
+        }
+
+        Reader.prototype.int = function() {
+            var value = this.uvarint()
+            return value % 2 === 0 ? value / 2 : -(value + 1) / 2
+        }
+
+        Reader.prototype.bool = function() {
+            return this.byte() === 1
+        }
+
+        Reader.prototype.string = function() {
+            var index = this.uvarint()
+            if (index !== 0) {
+                return this.strings[index - 1]
             }
+
+            var size = this.uvarint()
+            var value = this.decoder.decode(this.bytes.subarray(this.offset, this.offset + size))
+            this.offset += size
+            this.strings.push(value)
+            return value
         }
 
-        var dFiles = []
+        Reader.prototype.list = function(fn) {
+            var count = this.uvarint()
+            if (count === 0) {
+                return null
+            }
+
+            var list = []
+            for (var i = 0; i < count; i++) {
+                list.push(fn.call(this))
+            }
 
-        files = o.files
-        if (files != null && files != undefined) {
-            for (i = 0; i < files.length; i++) {
-                item = files[i]
-                dFiles.push({
-                    Name: item.name,
-                    Size: item.size.Int(),
-                    Data: GuJS.fromFile(item),
-                })
+            return list
+        }
+
+        Reader.prototype.meta = function() {
+            var meta = {
+                ParentSelector: this.string(),
+                EventSelector: this.string(),
+                EventName: this.string(),
+                Event: this.string(),
             }
+
+            var flags = this.uvarint()
+            meta.PreventDefault = !!(flags & 1)
+            meta.StopPropagation = !!(flags & 2)
+            meta.UseCapture = !!(flags & 4)
+            meta.StopImmediatePropagation = !!(flags & 8)
+            meta.Passive = !!(flags & 16)
+            meta.Once = !!(flags & 32)
+            meta.Debounce = this.int()
+            meta.Throttle = this.int()
+            meta.KeyFilter = this.list(this.string)
+            return meta
         }
 
-        dt.Items = { Items: dItems }
-        dt.Files = dFiles
-        return dt
-    }
+        Reader.prototype.markup = function() {
+            return {
+                TreeID: this.string(),
+                Events: this.list(this.meta),
+                Markup: this.string(),
+            }
+        }
 
+        Reader.prototype.view = function() {
+            return {
+                AppID: this.string(),
+                ViewID: this.string(),
+                Tree: this.markup(),
+                Styles: this.bool() ? this.markup() : null,
+            }
+        }
+
+        Reader.prototype.call = function() {
+            return {
+                ID: this.string(),
+                AppID: this.string(),
+                Function: this.string(),
+                Selector: this.string(),
+                Args: this.list(this.string),
+            }
+        }
+
+        Reader.prototype.app = function() {
+            return {
+                AppId: this.string(),
+                Name: this.string(),
+                Title: this.string(),
+                Head: this.list(this.view),
+                Body: this.list(this.view),
+                HeadResources: this.list(this.markup),
+                BodyResources: this.list(this.markup),
+            }
+        }
+
+        // Wire.DecodeCommand returns the RenderCommand decoded from the binary data.
+        Wire.DecodeCommand = function(data) {
+            var reader = new Reader(data, commandKind)
+            return {
+                Command: reader.string(),
+                Format: reader.string(),
+                App: reader.app(),
+                View: reader.view(),
+                Call: reader.bool() ? reader.call() : null,
+                Theme: reader.bool() ? reader.markup() : null,
+            }
+        }
+
+        // Writer encodes the values of a binary message.
+        function Writer(kind) {
+            this.bytes = [magic, version, kind]
+            this.strings = {}
+            this.total = 0
+            this.encoder = new TextEncoder()
+        }
+
+        Writer.prototype.uvarint = function(value) {
+            while (value >= 128) {
+                this.bytes.push((value % 128) | 0x80)
+                value = Math.floor(value / 128)
+            }
+
+            this.bytes.push(value)
+        }
+
+        Writer.prototype.int = function(value) {
+            this.uvarint(value >= 0 ? value * 2 : -value * 2 - 1)
+        }
+
+        Writer.prototype.bool = function(value) {
+            this.bytes.push(value ? 1 : 0)
+        }
+
+        Writer.prototype.string = function(value) {
+            value = GuJS.toString(value)
+            if (this.strings.hasOwnProperty(value)) {
+                this.uvarint(this.strings[value])
+                return
+            }
+
+            this.strings[value] = ++this.total
+
+            var encoded = this.encoder.encode(value)
+            this.uvarint(0)
+            this.uvarint(encoded.length)
+            for (var i = 0; i < encoded.length; i++) {
+                this.bytes.push(encoded[i])
+            }
+        }
+
+        Writer.prototype.meta = function(meta) {
+            meta = meta || {}
+
+            this.string(meta.ParentSelector)
+            this.string(meta.EventSelector)
+            this.string(meta.EventName)
+            this.string(meta.Event)
+
+            var flags = 0
+            if (meta.PreventDefault) { flags |= 1 }
+            if (meta.StopPropagation) { flags |= 2 }
+            if (meta.UseCapture) { flags |= 4 }
+            if (meta.StopImmediatePropagation) { flags |= 8 }
+            if (meta.Passive) { flags |= 16 }
+            if (meta.Once) { flags |= 32 }
+
+            this.uvarint(flags)
+            this.int(GuJS.toInt(meta.Debounce))
+            this.int(GuJS.toInt(meta.Throttle))
+
+            var keys = meta.KeyFilter || []
+            this.uvarint(keys.length)
+            for (var i = 0; i < keys.length; i++) {
+                this.string(keys[i])
+            }
+        }
+
+        Writer.prototype.value = function(value) {
+            if (value === null || value === undefined) {
+                this.bytes.push(0)
+                return
+            }
+
+            switch (typeof value) {
+                case "boolean":
+                    this.bytes.push(value ? 2 : 1)
+                    return
+                case "number":
+                    if (Math.floor(value) === value && Math.abs(value) <= Number.MAX_SAFE_INTEGER) {
+                        this.bytes.push(3)
+                        this.int(value)
+                        return
+                    }
+
+                    var bits = new DataView(new ArrayBuffer(8))
+                    bits.setFloat64(0, isFinite(value) ? value : 0, true)
+
+                    this.bytes.push(4)
+                    for (var i = 0; i < 8; i++) {
+                        this.bytes.push(bits.getUint8(i))
+                    }
+                    return
+                case "string":
+                    this.bytes.push(5)
+                    this.string(value)
+                    return
+            }
+
+            if (Array.isArray(value)) {
+                this.bytes.push(6)
+                this.uvarint(value.length)
+                for (var i = 0; i < value.length; i++) {
+                    this.value(value[i])
+                }
+                return
+            }
+
+            var keys = Object.keys(value).filter(function(key) {
+                return value[key] !== undefined && typeof value[key] !== "function"
+            }).sort()
+
+            this.bytes.push(7)
+            this.uvarint(keys.length)
+            for (var i = 0; i < keys.length; i++) {
+                this.string(keys[i])
+                this.value(value[keys[i]])
+            }
+        }
+
+        // Wire.EncodeEvent returns the binary encoding of the event payload.
+        Wire.EncodeEvent = function(message) {
+            var writer = new Writer(eventKind)
+            writer.string(message.type)
+            writer.meta(message.meta)
+            writer.bool(message.options_applied)
+            writer.value(message.data)
+            return new Uint8Array(writer.bytes)
+        }
+
+        return Wire
+    })()
 
     onMessages(GuJS.ExecuteCommand)
+
+    // Negotiate the wire format with the server, which replies with a Format
+    // command before any other.
+    SendChannel({ "type": "Hello", "formats": GuJS.Wire.Formats() })
 }
\ No newline at end of file
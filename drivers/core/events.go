@@ -1,3 +1,5 @@
+// Document is auto-generate from eventx/schema.json and should not be modified by hand.
+
 package core
 
 import (
@@ -10,24 +12,48 @@ import (
 // GetEvent returns the giving event structure suited to the provided type.
 func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*events.BaseEvent, error) {
 	switch eventName {
-	case "AnimationEvent":
-		var eventObject events.AnimationEvent
+	case "ChangeEvent":
+		var eventObject events.ChangeEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "AudioProcessingEvent":
-		var eventObject events.AudioProcessingEvent
+	case "IDBVersionChangeEvent":
+		var eventObject events.IDBVersionChangeEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "BeforeInputEvent":
-		var eventObject events.BeforeInputEvent
+	case "HashChangeEvent":
+		var eventObject events.HashChangeEvent
+
+		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
+			return nil, err
+		}
+
+		return events.NewBaseEvent(&eventObject, handle), nil
+	case "GamepadEvent":
+		var eventObject events.GamepadEvent
+
+		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
+			return nil, err
+		}
+
+		return events.NewBaseEvent(&eventObject, handle), nil
+	case "AnimationEvent":
+		var eventObject events.AnimationEvent
+
+		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
+			return nil, err
+		}
+
+		return events.NewBaseEvent(&eventObject, handle), nil
+	case "AudioProcessingEvent":
+		var eventObject events.AudioProcessingEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
@@ -42,16 +68,16 @@ func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*event
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "BlobEvent":
-		var eventObject events.BlobEvent
+	case "BeforeInputEvent":
+		var eventObject events.BeforeInputEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "ChangeEvent":
-		var eventObject events.ChangeEvent
+	case "BlobEvent":
+		var eventObject events.BlobEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
@@ -98,184 +124,184 @@ func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*event
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DeviceLightEvent":
-		var eventObject events.DeviceLightEvent
+	case "DropEvent":
+		var eventObject events.DropEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DeviceMotionEvent":
-		var eventObject events.DeviceMotionEvent
+	case "DragLeaveEvent":
+		var eventObject events.DragLeaveEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DeviceOrientationEvent":
-		var eventObject events.DeviceOrientationEvent
+	case "DragStartEvent":
+		var eventObject events.DragStartEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DeviceProximityEvent":
-		var eventObject events.DeviceProximityEvent
+	case "DragEndEvent":
+		var eventObject events.DragEndEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DOMTransactionEvent":
-		var eventObject events.DOMTransactionEvent
+	case "DragOverEvent":
+		var eventObject events.DragOverEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DragStartEvent":
-		var eventObject events.DragStartEvent
+	case "DragExitEvent":
+		var eventObject events.DragExitEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DragEndEvent":
-		var eventObject events.DragEndEvent
+	case "DragEnterEvent":
+		var eventObject events.DragEnterEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DragEnterEvent":
-		var eventObject events.DragEnterEvent
+	case "DragEvent":
+		var eventObject events.DragEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DragLeaveEvent":
-		var eventObject events.DragLeaveEvent
+	case "DeviceLightEvent":
+		var eventObject events.DeviceLightEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DragOverEvent":
-		var eventObject events.DragOverEvent
+	case "DeviceMotionEvent":
+		var eventObject events.DeviceMotionEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DropEvent":
-		var eventObject events.DropEvent
+	case "DeviceOrientationEvent":
+		var eventObject events.DeviceOrientationEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "DragEvent":
-		var eventObject events.DragEvent
+	case "DeviceProximityEvent":
+		var eventObject events.DeviceProximityEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "EditingBeforeInputEvent":
-		var eventObject events.EditingBeforeInputEvent
+	case "FetchEvent":
+		var eventObject events.FetchEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "ErrorEvent":
-		var eventObject events.ErrorEvent
+	case "DOMTransactionEvent":
+		var eventObject events.DOMTransactionEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "FocusEvent":
-		var eventObject events.FocusEvent
+	case "EditingBeforeInputEvent":
+		var eventObject events.EditingBeforeInputEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "GamepadEvent":
-		var eventObject events.GamepadEvent
+	case "ErrorEvent":
+		var eventObject events.ErrorEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "HashChangeEvent":
-		var eventObject events.HashChangeEvent
+	case "InputEvent":
+		var eventObject events.InputEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "IDBVersionChangeEvent":
-		var eventObject events.IDBVersionChangeEvent
+	case "FocusEvent":
+		var eventObject events.FocusEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "KeyboardEvent":
-		var eventObject events.KeyboardEvent
+	case "MutationEvent":
+		var eventObject events.MutationEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "MediaStreamEvent":
-		var eventObject events.MediaStreamEvent
+	case "MouseEvent":
+		var eventObject events.MouseEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "MessageEvent":
-		var eventObject events.MessageEvent
+	case "WebGLContextEvent":
+		var eventObject events.WebGLContextEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "MouseEvent":
-		var eventObject events.MouseEvent
+	case "WheelEvent":
+		var eventObject events.WheelEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "MutationEvent":
-		var eventObject events.MutationEvent
+	case "KeyboardEvent":
+		var eventObject events.KeyboardEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
@@ -337,6 +363,14 @@ func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*event
 			return nil, err
 		}
 
+		return events.NewBaseEvent(&eventObject, handle), nil
+	case "RTCIdentityEvent":
+		var eventObject events.RTCIdentityEvent
+
+		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
+			return nil, err
+		}
+
 		return events.NewBaseEvent(&eventObject, handle), nil
 	case "SensorEvent":
 		var eventObject events.SensorEvent
@@ -354,32 +388,32 @@ func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*event
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "SVGEvent":
-		var eventObject events.SVGEvent
+	case "TimeEvent":
+		var eventObject events.TimeEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "SVGZoomEvent":
-		var eventObject events.SVGZoomEvent
+	case "TransitionEvent":
+		var eventObject events.TransitionEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "TimeEvent":
-		var eventObject events.TimeEvent
+	case "UserProximityEvent":
+		var eventObject events.UserProximityEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "TouchEvent":
-		var eventObject events.TouchEvent
+	case "UIEvent":
+		var eventObject events.UIEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
@@ -394,39 +428,46 @@ func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*event
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "TransitionEvent":
-		var eventObject events.TransitionEvent
+	case "TouchEvent":
+		var eventObject events.TouchEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "UIEvent":
-		var eventObject events.UIEvent
+	case "SVGZoomEvent":
+		var eventObject events.SVGZoomEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "UserProximityEvent":
-		var eventObject events.UserProximityEvent
+	case "SVGEvent":
+		var eventObject events.SVGEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
-	case "WheelEvent":
-		var eventObject events.WheelEvent
+	case "MessageEvent":
+		var eventObject events.MessageEvent
 
 		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
 			return nil, err
 		}
 
 		return events.NewBaseEvent(&eventObject, handle), nil
+	case "MediaStreamEvent":
+		var eventObject events.MediaStreamEvent
 
+		if err := json.Unmarshal(eventJSON, &eventObject); err != nil {
+			return nil, err
+		}
+
+		return events.NewBaseEvent(&eventObject, handle), nil
 	}
 
 	var eventObject events.BasicEventMap
@@ -0,0 +1,960 @@
+// Package events.js provides the serializers which convert DOM events and their
+// objects into the json of their eventx types.
+
+// Document is auto-generate from eventx/schema.json and should not be modified by hand.
+
+// GuSerializers returns the serializers of the eventx types keyed by their
+// names, using the conversion functions of the provided GuJS object.
+function GuSerializers(GuJS) {
+    var Serializers = {};
+
+    // Serializers.ChangeEvent returns the eventx.ChangeEvent of the giving object.
+    Serializers.ChangeEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Value = GuJS.toString(o.target ? o.target.value : null)
+        obj.Checked = !!(o.target ? o.target.checked : false)
+        obj.Values = GuJS.toList(GuJS.selectedValues(o.target), function(item) { return GuJS.toString(item) })
+        return obj
+    };
+
+    // Serializers.InputDeviceCapabilities returns the eventx.InputDeviceCapabilities of the giving object.
+    Serializers.InputDeviceCapabilities = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.FiresTouchEvent = !!(o.firesTouchEvents)
+        return obj
+    };
+
+    // Serializers.IDBVersionChangeEvent returns the eventx.IDBVersionChangeEvent of the giving object.
+    Serializers.IDBVersionChangeEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.OldVersion = GuJS.toInt(o.oldVersion)
+        obj.NewVersion = GuJS.toInt(o.newVersion)
+        return obj
+    };
+
+    // Serializers.HashChangeEvent returns the eventx.HashChangeEvent of the giving object.
+    Serializers.HashChangeEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Old = GuJS.toString(o.oldURL)
+        obj.New = GuJS.toString(o.newURL)
+        return obj
+    };
+
+    // Serializers.Button returns the eventx.Button of the giving object.
+    Serializers.Button = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Value = GuJS.toFloat(o.value)
+        obj.Pressed = !!(o.pressed)
+        return obj
+    };
+
+    // Serializers.Gamepad returns the eventx.Gamepad of the giving object.
+    Serializers.Gamepad = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.DisplayID = GuJS.toString(o.displayId)
+        obj.ID = GuJS.toString(o.id)
+        obj.Index = GuJS.toInt(o.index)
+        obj.Mapping = GuJS.toString(o.mapping)
+        obj.Connected = !!(o.connected)
+        obj.Buttons = GuJS.toList(o.buttons, Serializers.Button)
+        obj.Axes = GuJS.toList(o.axes, function(item) { return GuJS.toFloat(item) })
+        obj.Timestamp = GuJS.toFloat(o.timestamp)
+        return obj
+    };
+
+    // Serializers.GamepadEvent returns the eventx.GamepadEvent of the giving object.
+    Serializers.GamepadEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Gamepad = Serializers.Gamepad(o.gamepad)
+        return obj
+    };
+
+    // Serializers.AnimationEvent returns the eventx.AnimationEvent of the giving object.
+    Serializers.AnimationEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.AnimationName = GuJS.toString(o.animationName)
+        obj.PseudoElement = GuJS.toString(o.pseudoElement)
+        obj.ElapsedTime = GuJS.toFloat(o.elapsedTime)
+        return obj
+    };
+
+    // Serializers.AudioBuffer returns the eventx.AudioBuffer of the giving object.
+    Serializers.AudioBuffer = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.SampleRate = GuJS.toFloat(o.sampleRate)
+        obj.Duration = GuJS.toDuration(o.duration)
+        obj.Channels = GuJS.toInt(o.numberOfChannels)
+        obj.SampleFramesLength = GuJS.toInt(o.length)
+        return obj
+    };
+
+    // Serializers.AudioProcessingEvent returns the eventx.AudioProcessingEvent of the giving object.
+    Serializers.AudioProcessingEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.PlaybackTime = GuJS.toFloat(o.playbackTime)
+        obj.InputBuffer = Serializers.AudioBuffer(o.inputBuffer)
+        obj.OutputBuffer = Serializers.AudioBuffer(o.outputBuffer)
+        return obj
+    };
+
+    // Serializers.BeforeUnloadEvent returns the eventx.BeforeUnloadEvent of the giving object.
+    Serializers.BeforeUnloadEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.BeforeInputEvent returns the eventx.BeforeInputEvent of the giving object.
+    Serializers.BeforeInputEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.BlobEvent returns the eventx.BlobEvent of the giving object.
+    Serializers.BlobEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Data = GuJS.toBytes(o.data)
+        return obj
+    };
+
+    // Serializers.DataTransferItem returns the eventx.DataTransferItem of the giving object.
+    Serializers.DataTransferItem = function(o) {
+        o = GuJS.toFile(o)
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Name = GuJS.toString(o.name)
+        obj.Size = GuJS.toInt(o.size)
+        return obj
+    };
+
+    // Serializers.DataTransferItemList returns the eventx.DataTransferItemList of the giving object.
+    Serializers.DataTransferItemList = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Items = GuJS.toList(o, Serializers.DataTransferItem)
+        return obj
+    };
+
+    // Serializers.DataTransfer returns the eventx.DataTransfer of the giving object.
+    Serializers.DataTransfer = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.DropEffect = GuJS.toString(o.dropEffect)
+        obj.EffectAllowed = GuJS.toString(o.effectAllowed)
+        obj.Files = GuJS.toList(o.files, Serializers.DataTransferItem)
+        obj.Items = Serializers.DataTransferItemList(o.items)
+        obj.Types = GuJS.toList(o.types, function(item) { return GuJS.toString(item) })
+        return obj
+    };
+
+    // Serializers.ClipboardEvent returns the eventx.ClipboardEvent of the giving object.
+    Serializers.ClipboardEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Data = Serializers.DataTransfer(o.clipboardData)
+        return obj
+    };
+
+    // Serializers.CloseEvent returns the eventx.CloseEvent of the giving object.
+    Serializers.CloseEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Code = GuJS.toInt(o.code)
+        obj.Reason = GuJS.toString(o.reason)
+        obj.WasClean = !!(o.wasClean)
+        return obj
+    };
+
+    // Serializers.CompositionEvent returns the eventx.CompositionEvent of the giving object.
+    Serializers.CompositionEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Text = GuJS.toString(o.data)
+        obj.Data = GuJS.toString(o.data)
+        obj.Locale = GuJS.toString(o.locale)
+        return obj
+    };
+
+    // Serializers.CSSFontFaceLoadEvent returns the eventx.CSSFontFaceLoadEvent of the giving object.
+    Serializers.CSSFontFaceLoadEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.CustomEvent returns the eventx.CustomEvent of the giving object.
+    Serializers.CustomEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Detail = GuJS.toValue(o.detail)
+        return obj
+    };
+
+    // Serializers.DropEvent returns the eventx.DropEvent of the giving object.
+    Serializers.DropEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DragLeaveEvent returns the eventx.DragLeaveEvent of the giving object.
+    Serializers.DragLeaveEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DragStartEvent returns the eventx.DragStartEvent of the giving object.
+    Serializers.DragStartEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DragEndEvent returns the eventx.DragEndEvent of the giving object.
+    Serializers.DragEndEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DragOverEvent returns the eventx.DragOverEvent of the giving object.
+    Serializers.DragOverEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DragExitEvent returns the eventx.DragExitEvent of the giving object.
+    Serializers.DragExitEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DragEnterEvent returns the eventx.DragEnterEvent of the giving object.
+    Serializers.DragEnterEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DragEvent returns the eventx.DragEvent of the giving object.
+    Serializers.DragEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.DataTransfer = Serializers.DataTransfer(o.dataTransfer)
+        return obj
+    };
+
+    // Serializers.DeviceLightEvent returns the eventx.DeviceLightEvent of the giving object.
+    Serializers.DeviceLightEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Value = GuJS.toFloat(o.value)
+        return obj
+    };
+
+    // Serializers.MotionData returns the eventx.MotionData of the giving object.
+    Serializers.MotionData = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.X = GuJS.toFloat(o.x)
+        obj.Y = GuJS.toFloat(o.y)
+        obj.Z = GuJS.toFloat(o.z)
+        return obj
+    };
+
+    // Serializers.RotationData returns the eventx.RotationData of the giving object.
+    Serializers.RotationData = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Alpha = GuJS.toFloat(o.alpha)
+        obj.Beta = GuJS.toFloat(o.beta)
+        obj.Gamma = GuJS.toFloat(o.gamma)
+        return obj
+    };
+
+    // Serializers.DeviceMotionEvent returns the eventx.DeviceMotionEvent of the giving object.
+    Serializers.DeviceMotionEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Interval = GuJS.toFloat(o.interval)
+        obj.Acceleration = Serializers.MotionData(o.acceleration)
+        obj.AccelerationIncludingGravity = Serializers.MotionData(o.accelerationIncludingGravity)
+        obj.RotationRate = Serializers.RotationData(o.rotationRate)
+        return obj
+    };
+
+    // Serializers.DeviceOrientationEvent returns the eventx.DeviceOrientationEvent of the giving object.
+    Serializers.DeviceOrientationEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Absolute = !!(o.absolute)
+        obj.Alpha = GuJS.toFloat(o.alpha)
+        obj.Beta = GuJS.toFloat(o.beta)
+        obj.Gamma = GuJS.toFloat(o.gamma)
+        return obj
+    };
+
+    // Serializers.DeviceProximityEvent returns the eventx.DeviceProximityEvent of the giving object.
+    Serializers.DeviceProximityEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Max = GuJS.toFloat(o.max)
+        obj.Min = GuJS.toFloat(o.min)
+        obj.Value = GuJS.toFloat(o.value)
+        return obj
+    };
+
+    // Serializers.FetchEvent returns the eventx.FetchEvent of the giving object.
+    Serializers.FetchEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.IsReload = !!(o.isReload)
+        obj.ClientID = GuJS.toString(o.clientId)
+        return obj
+    };
+
+    // Serializers.DOMTransactionEvent returns the eventx.DOMTransactionEvent of the giving object.
+    Serializers.DOMTransactionEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.EditingBeforeInputEvent returns the eventx.EditingBeforeInputEvent of the giving object.
+    Serializers.EditingBeforeInputEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.ErrorEvent returns the eventx.ErrorEvent of the giving object.
+    Serializers.ErrorEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Message = GuJS.toString(o.message)
+        obj.Filename = GuJS.toString(o.filename)
+        obj.LineNumber = GuJS.toInt(o.lineno)
+        obj.ColNumber = GuJS.toInt(o.colno)
+        return obj
+    };
+
+    // Serializers.InputEvent returns the eventx.InputEvent of the giving object.
+    Serializers.InputEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Data = GuJS.toString(o.data)
+        obj.IsComposing = !!(o.isComposing)
+        obj.Value = GuJS.toString(o.target ? o.target.value : null)
+        obj.Checked = !!(o.target ? o.target.checked : false)
+        obj.Values = GuJS.toList(GuJS.selectedValues(o.target), function(item) { return GuJS.toString(item) })
+        return obj
+    };
+
+    // Serializers.FocusEvent returns the eventx.FocusEvent of the giving object.
+    Serializers.FocusEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.MutationRecord returns the eventx.MutationRecord of the giving object.
+    Serializers.MutationRecord = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Type = GuJS.toString(o.type)
+        obj.AddedNodes = GuJS.toList(o.addedNodes, function(item) { return GuJS.toElement(item) })
+        obj.RemovedNodes = GuJS.toList(o.removedNodes, function(item) { return GuJS.toElement(item) })
+        obj.PreSibling = GuJS.toElement(o.previousSibling)
+        obj.NextSibling = GuJS.toElement(o.nextSibling)
+        obj.AttributeName = GuJS.toString(o.attributeName)
+        obj.AttributreNameNS = GuJS.toString(o.attributeNamespace)
+        return obj
+    };
+
+    // Serializers.MutationEvent returns the eventx.MutationEvent of the giving object.
+    Serializers.MutationEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.MouseEvent returns the eventx.MouseEvent of the giving object.
+    Serializers.MouseEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.UIEvent(o))
+        obj.ClientX = GuJS.toFloat(o.clientX)
+        obj.ClientY = GuJS.toFloat(o.clientY)
+        obj.PageX = GuJS.toFloat(o.pageX)
+        obj.PageY = GuJS.toFloat(o.pageY)
+        obj.OffsetX = GuJS.toFloat(o.offsetX)
+        obj.OffsetY = GuJS.toFloat(o.offsetY)
+        obj.ScreenX = GuJS.toFloat(o.screenX)
+        obj.ScreenY = GuJS.toFloat(o.screenY)
+        obj.MovemenX = GuJS.toFloat(o.movementX)
+        obj.MovemenY = GuJS.toFloat(o.movementY)
+        obj.Region = GuJS.toInt(o.region)
+        obj.Button = GuJS.toInt(o.button)
+        obj.Detail = GuJS.toInt(o.detail)
+        obj.AltKey = !!(o.altKey)
+        obj.CtrlKey = !!(o.ctrlKey)
+        obj.MetaKey = !!(o.metaKey)
+        obj.ShiftKey = !!(o.shiftKey)
+        return obj
+    };
+
+    // Serializers.WebGLContextEvent returns the eventx.WebGLContextEvent of the giving object.
+    Serializers.WebGLContextEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.StatusMessage = GuJS.toString(o.statusMessage)
+        return obj
+    };
+
+    // Serializers.WheelEvent returns the eventx.WheelEvent of the giving object.
+    Serializers.WheelEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.DeltaX = GuJS.toFloat(o.deltaX)
+        obj.DeltaY = GuJS.toFloat(o.deltaY)
+        obj.DeltaZ = GuJS.toFloat(o.deltaZ)
+        obj.DeltaMode = GuJS.toInt(o.deltaMode)
+        return obj
+    };
+
+    // Serializers.KeyboardEvent returns the eventx.KeyboardEvent of the giving object.
+    Serializers.KeyboardEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.CharCode = GuJS.toInt(o.charCode)
+        obj.KeyCode = GuJS.toInt(o.keyCode)
+        obj.KeyLocation = GuJS.toInt(o.location)
+        obj.Location = GuJS.toInt(o.location)
+        obj.Key = GuJS.toString(o.key)
+        obj.KeyIdentifier = GuJS.toString(o.keyIdentifier)
+        obj.Locale = GuJS.toString(o.locale)
+        obj.AltKey = !!(o.altKey)
+        obj.CtrlKey = !!(o.ctrlKey)
+        obj.MetaKey = !!(o.metaKey)
+        obj.ShiftKey = !!(o.shiftKey)
+        obj.Repeat = !!(o.repeat)
+        obj.ModifiedState = !!(o.getModifierState ? o.getModifierState("Shift") || o.getModifierState("CapsLock") : false)
+        return obj
+    };
+
+    // Serializers.OfflineAudioCompletionEvent returns the eventx.OfflineAudioCompletionEvent of the giving object.
+    Serializers.OfflineAudioCompletionEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.RenderedBuffer = Serializers.AudioBuffer(o.renderedBuffer)
+        return obj
+    };
+
+    // Serializers.PageTransitionEvent returns the eventx.PageTransitionEvent of the giving object.
+    Serializers.PageTransitionEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Persisted = !!(o.persisted)
+        return obj
+    };
+
+    // Serializers.PointerEvent returns the eventx.PointerEvent of the giving object.
+    Serializers.PointerEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MouseEvent(o))
+        obj.PointerID = GuJS.toInt(o.pointerId)
+        obj.Width = GuJS.toInt(o.width)
+        obj.Height = GuJS.toInt(o.height)
+        obj.Pressure = GuJS.toFloat(o.pressure)
+        obj.TiltX = GuJS.toFloat(o.tiltX)
+        obj.TiltY = GuJS.toFloat(o.tiltY)
+        obj.IsPrimary = !!(o.isPrimary)
+        obj.PointerType = GuJS.toString(o.pointerType)
+        return obj
+    };
+
+    // Serializers.PopStateEvent returns the eventx.PopStateEvent of the giving object.
+    Serializers.PopStateEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.ProgressEvent returns the eventx.ProgressEvent of the giving object.
+    Serializers.ProgressEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.LengthComputable = !!(o.lengthComputable)
+        obj.Loaded = GuJS.toInt(o.loaded)
+        obj.Total = GuJS.toInt(o.total)
+        return obj
+    };
+
+    // Serializers.RelatedEvent returns the eventx.RelatedEvent of the giving object.
+    Serializers.RelatedEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.RelatedTarget = GuJS.toElement(o.relatedTarget)
+        return obj
+    };
+
+    // Serializers.RTCPeerConnectionIceEvent returns the eventx.RTCPeerConnectionIceEvent of the giving object.
+    Serializers.RTCPeerConnectionIceEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Candidate = GuJS.toString(o.candidate ? o.candidate.candidate : null)
+        return obj
+    };
+
+    // Serializers.RTCIdentityEvent returns the eventx.RTCIdentityEvent of the giving object.
+    Serializers.RTCIdentityEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Assertion = GuJS.toString(o.assertion)
+        return obj
+    };
+
+    // Serializers.SensorEvent returns the eventx.SensorEvent of the giving object.
+    Serializers.SensorEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.StorageEvent returns the eventx.StorageEvent of the giving object.
+    Serializers.StorageEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Key = GuJS.toString(o.key)
+        obj.NewValue = GuJS.toString(o.newValue)
+        obj.OldValue = GuJS.toString(o.oldValue)
+        obj.URL = GuJS.toString(o.url)
+        return obj
+    };
+
+    // Serializers.TimeEvent returns the eventx.TimeEvent of the giving object.
+    Serializers.TimeEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Long = GuJS.toValue(o.detail)
+        return obj
+    };
+
+    // Serializers.TransitionEvent returns the eventx.TransitionEvent of the giving object.
+    Serializers.TransitionEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.PropertyName = GuJS.toString(o.propertyName)
+        obj.ElapsedTime = GuJS.toFloat(o.elapsedTime)
+        obj.PseudoElement = GuJS.toString(o.pseudoElement)
+        return obj
+    };
+
+    // Serializers.UserProximityEvent returns the eventx.UserProximityEvent of the giving object.
+    Serializers.UserProximityEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Near = !!(o.near)
+        return obj
+    };
+
+    // Serializers.UIEvent returns the eventx.UIEvent of the giving object.
+    Serializers.UIEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.IsChar = !!(o.isChar)
+        obj.LayerX = GuJS.toFloat(o.layerX)
+        obj.LayerY = GuJS.toFloat(o.layerY)
+        obj.PageX = GuJS.toFloat(o.pageX)
+        obj.PageY = GuJS.toFloat(o.pageY)
+        obj.Detail = GuJS.toInt(o.detail)
+        obj.SourceCapabilities = Serializers.InputDeviceCapabilities(o.sourceCapabilities)
+        return obj
+    };
+
+    // Serializers.TrackEvent returns the eventx.TrackEvent of the giving object.
+    Serializers.TrackEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.Touch returns the eventx.Touch of the giving object.
+    Serializers.Touch = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Identifier = GuJS.toFloat(o.identifier)
+        obj.ClientX = GuJS.toFloat(o.clientX)
+        obj.ClientY = GuJS.toFloat(o.clientY)
+        obj.PageX = GuJS.toFloat(o.pageX)
+        obj.PageY = GuJS.toFloat(o.pageY)
+        obj.OffsetX = GuJS.toFloat(o.offsetX)
+        obj.OffsetY = GuJS.toFloat(o.offsetY)
+        obj.ScreenX = GuJS.toFloat(o.screenX)
+        obj.ScreenY = GuJS.toFloat(o.screenY)
+        obj.Target = GuJS.toElement(o.target)
+        return obj
+    };
+
+    // Serializers.TouchList returns the eventx.TouchList of the giving object.
+    Serializers.TouchList = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Touches = GuJS.toList(o, Serializers.Touch)
+        obj.Length = GuJS.toInt(o.length)
+        return obj
+    };
+
+    // Serializers.TouchEvent returns the eventx.TouchEvent of the giving object.
+    Serializers.TouchEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.AltKey = !!(o.altKey)
+        obj.CtrlKey = !!(o.ctrlKey)
+        obj.MetaKey = !!(o.metaKey)
+        obj.ShiftKey = !!(o.shiftKey)
+        obj.TargetTouches = Serializers.TouchList(o.targetTouches)
+        obj.Touches = Serializers.TouchList(o.touches)
+        return obj
+    };
+
+    // Serializers.SVGZoomEvent returns the eventx.SVGZoomEvent of the giving object.
+    Serializers.SVGZoomEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.SVGEvent returns the eventx.SVGEvent of the giving object.
+    Serializers.SVGEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        return obj
+    };
+
+    // Serializers.MessageEvent returns the eventx.MessageEvent of the giving object.
+    Serializers.MessageEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Data = GuJS.toBytes(o.data)
+        obj.Origin = GuJS.toString(o.origin)
+        obj.Source = GuJS.toString(o.source ? GuJS.Type(o.source) : null)
+        obj.Port = GuJS.toInt(o.ports ? o.ports.length : 0)
+        return obj
+    };
+
+    // Serializers.MediaStream returns the eventx.MediaStream of the giving object.
+    Serializers.MediaStream = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Active = !!(o.active)
+        obj.Ended = !!(o.active === false)
+        obj.ID = GuJS.toString(o.id)
+        obj.Audios = GuJS.toList(o.getAudioTracks ? o.getAudioTracks() : null, Serializers.MediaStreamTrack)
+        obj.Videos = GuJS.toList(o.getVideoTracks ? o.getVideoTracks() : null, Serializers.MediaStreamTrack)
+        return obj
+    };
+
+    // Serializers.MediaTrackSettings returns the eventx.MediaTrackSettings of the giving object.
+    Serializers.MediaTrackSettings = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.DeviceID = GuJS.toString(o.deviceId)
+        obj.GroupID = GuJS.toString(o.groupId)
+        return obj
+    };
+
+    // Serializers.MediaAudioTrackSettings returns the eventx.MediaAudioTrackSettings of the giving object.
+    Serializers.MediaAudioTrackSettings = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MediaTrackSettings(o))
+        obj.ChannelCount = GuJS.toInt(o.channelCount)
+        obj.EchoCancellation = !!(o.echoCancellation)
+        obj.Latency = GuJS.toFloat(o.latency)
+        obj.SampleRate = GuJS.toInt(o.sampleRate)
+        obj.SampleSize = GuJS.toInt(o.sampleSize)
+        obj.Volume = GuJS.toFloat(o.volume)
+        return obj
+    };
+
+    // Serializers.MediaVideoTrackSettings returns the eventx.MediaVideoTrackSettings of the giving object.
+    Serializers.MediaVideoTrackSettings = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = GuJS.extend({}, Serializers.MediaTrackSettings(o))
+        obj.AspectRatio = GuJS.toFloat(o.aspectRatio)
+        obj.FacingMode = GuJS.toString(o.facingMode)
+        obj.FrameRate = GuJS.toFloat(o.frameRate)
+        obj.Height = GuJS.toInt(o.height)
+        obj.Width = GuJS.toInt(o.width)
+        return obj
+    };
+
+    // Serializers.MediaStreamTrack returns the eventx.MediaStreamTrack of the giving object.
+    Serializers.MediaStreamTrack = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Enabled = !!(o.enabled)
+        obj.ID = GuJS.toString(o.id)
+        obj.Kind = GuJS.toString(o.kind)
+        obj.Label = GuJS.toString(o.label)
+        obj.Muted = !!(o.muted)
+        obj.ReadyState = !!(o.readyState === "live")
+        obj.Remote = !!(o.remote)
+        obj.AudioSettings = Serializers.MediaAudioTrackSettings(o.kind === "audio" && o.getSettings ? o.getSettings() : null)
+        obj.VideoSettings = Serializers.MediaVideoTrackSettings(o.kind === "video" && o.getSettings ? o.getSettings() : null)
+        return obj
+    };
+
+    // Serializers.MediaStreamEvent returns the eventx.MediaStreamEvent of the giving object.
+    Serializers.MediaStreamEvent = function(o) {
+        if (o == null || o == undefined) {
+            return null
+        }
+
+        var obj = {}
+        obj.Stream = Serializers.MediaStream(o.stream)
+        return obj
+    };
+
+    return Serializers;
+}
@@ -0,0 +1,234 @@
+package core_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/drivers/core"
+	"github.com/gu-io/gu/eventx"
+	"github.com/influx6/faux/tests"
+)
+
+var (
+	serializerExpr = regexp.MustCompile(`(?s)Serializers\.(\w+) = function\(o\) \{(.*?)\n    \};`)
+	embedExpr      = regexp.MustCompile(`Serializers\.(\w+)\(o\)`)
+	fieldExpr      = regexp.MustCompile(`obj\.(\w+) = `)
+)
+
+// serializerKeys returns the keys of the json produced by the serializers of
+// events.js, keyed by the serialized type.
+func serializerKeys() (map[string][]string, error) {
+	js, err := ioutil.ReadFile("events.js")
+	if err != nil {
+		return nil, err
+	}
+
+	bodies := make(map[string]string)
+	for _, match := range serializerExpr.FindAllStringSubmatch(string(js), -1) {
+		bodies[match[1]] = match[2]
+	}
+
+	var keysOf func(name string) []string
+	keysOf = func(name string) []string {
+		var keys []string
+
+		body := bodies[name]
+		if start := strings.Index(body, "GuJS.extend({}"); start != -1 {
+			line := body[start : start+strings.Index(body[start:], "\n")]
+			for _, embed := range embedExpr.FindAllStringSubmatch(line, -1) {
+				keys = append(keys, keysOf(embed[1])...)
+			}
+		}
+
+		for _, field := range fieldExpr.FindAllStringSubmatch(body, -1) {
+			keys = append(keys, field[1])
+		}
+
+		return keys
+	}
+
+	serialized := make(map[string][]string)
+	for name := range bodies {
+		serialized[name] = unique(keysOf(name))
+	}
+
+	return serialized, nil
+}
+
+// eventNames returns the names of the event types in the eventx schema.
+func eventNames() ([]string, error) {
+	data, err := ioutil.ReadFile("../../eventx/schema.json")
+	if err != nil {
+		return nil, err
+	}
+
+	var schema struct {
+		Types []struct {
+			Name  string `json:"name"`
+			Event bool   `json:"event"`
+		} `json:"types"`
+	}
+
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, ty := range schema.Types {
+		if ty.Event {
+			names = append(names, ty.Name)
+		}
+	}
+
+	return names, nil
+}
+
+func TestGetEvent(t *testing.T) {
+	names, err := eventNames()
+	if err != nil {
+		tests.Failed("Should have loaded event types from schema: %+q", err)
+	}
+	tests.Passed("Should have loaded event types from schema")
+
+	serialized, err := serializerKeys()
+	if err != nil {
+		tests.Failed("Should have loaded serializers from events.js: %+q", err)
+	}
+	tests.Passed("Should have loaded serializers from events.js")
+
+	for _, name := range names {
+		fixture, err := ioutil.ReadFile(filepath.Join("testdata", "events", name+".json"))
+		if err != nil {
+			tests.Failed("Should have golden fixture for %q: %+q", name, err)
+		}
+
+		var expected map[string]interface{}
+		if err := json.Unmarshal(fixture, &expected); err != nil {
+			tests.Failed("Should have decoded golden fixture for %q: %+q", name, err)
+		}
+
+		keys, ok := serialized[name]
+		if !ok {
+			tests.Failed("Should have serializer for %q in events.js", name)
+		}
+
+		if fields := mapKeys(expected); !reflect.DeepEqual(fields, keys) {
+			tests.Failed("Should have matching fields between fixture and serializer of %q: %v != %v", name, fields, keys)
+		}
+
+		event, err := core.GetEvent(name, fixture, nil)
+		if err != nil {
+			tests.Failed("Should have decoded %q from fixture: %+q", name, err)
+		}
+
+		if typeName := reflect.TypeOf(event.Underlying()).String(); typeName != "*eventx."+name {
+			tests.Failed("Should have decoded %q into its eventx type: %s", name, typeName)
+		}
+
+		strict := json.NewDecoder(bytes.NewReader(fixture))
+		strict.DisallowUnknownFields()
+
+		if err := strict.Decode(reflect.New(reflect.TypeOf(event.Underlying()).Elem()).Interface()); err != nil {
+			tests.Failed("Should have known all fields of %q fixture: %+q", name, err)
+		}
+
+		encoded, err := json.Marshal(event.Underlying())
+		if err != nil {
+			tests.Failed("Should have encoded %q: %+q", name, err)
+		}
+
+		var decoded map[string]interface{}
+		if err := json.Unmarshal(encoded, &decoded); err != nil {
+			tests.Failed("Should have decoded encoded %q: %+q", name, err)
+		}
+
+		if err := subset(expected, decoded, name); err != nil {
+			tests.Failed("Should have kept values of %q fixture: %+q", name, err)
+		}
+	}
+	tests.Passed("Should have decoded golden fixtures of all event types")
+
+	event, err := core.GetEvent("UnknownEvent", []byte(`{"Type":"unknown"}`), nil)
+	if err != nil {
+		tests.Failed("Should have decoded unknown event: %+q", err)
+	}
+
+	if _, ok := event.Underlying().(*eventx.BasicEventMap); !ok {
+		tests.Failed("Should have decoded unknown event into BasicEventMap")
+	}
+	tests.Passed("Should have decoded unknown event into BasicEventMap")
+}
+
+// subset returns an error if the expected value is not contained in the decoded
+// value, where maps may contain additional keys.
+func subset(expected, decoded interface{}, path string) error {
+	switch value := expected.(type) {
+	case map[string]interface{}:
+		other, ok := decoded.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("%s: expected object, got %#v", path, decoded)
+		}
+
+		for key, item := range value {
+			if err := subset(item, other[key], path+"."+key); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	case []interface{}:
+		other, ok := decoded.([]interface{})
+		if !ok || len(other) != len(value) {
+			return fmt.Errorf("%s: expected %#v, got %#v", path, expected, decoded)
+		}
+
+		for index, item := range value {
+			if err := subset(item, other[index], fmt.Sprintf("%s[%d]", path, index)); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	if !reflect.DeepEqual(expected, decoded) {
+		return fmt.Errorf("%s: expected %#v, got %#v", path, expected, decoded)
+	}
+
+	return nil
+}
+
+// mapKeys returns the sorted keys of the map.
+func mapKeys(item map[string]interface{}) []string {
+	keys := make([]string, 0, len(item))
+	for key := range item {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+	return keys
+}
+
+// unique returns the sorted unique items of the list.
+func unique(items []string) []string {
+	seen := make(map[string]bool)
+	list := make([]string, 0, len(items))
+
+	for _, item := range items {
+		if !seen[item] {
+			seen[item] = true
+			list = append(list, item)
+		}
+	}
+
+	sort.Strings(list)
+	return list
+}
@@ -32,6 +32,15 @@ func main() {
 		panic(fmt.Sprintf("Unable to locate `core.js` file: %q", err.Error()))
 	}
 
+	// events.js is generated from eventx/schema.json and provides the serializers
+	// used by core.js.
+	serializers, err := ioutil.ReadFile("./events.js")
+	if err != nil {
+		panic(fmt.Sprintf("Unable to locate `events.js` file: %q", err.Error()))
+	}
+
+	js = append(append(js, '\n'), serializers...)
+
 	goFile, err := os.OpenFile("./corejs.go", os.O_CREATE|os.O_WRONLY, 0777)
 	if err != nil {
 		panic(fmt.Sprintf("Unable to create `corejs.go` file: %q", err.Error()))
@@ -0,0 +1,628 @@
+package wire
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees"
+)
+
+// The binary format encodes each message as:
+//
+//	message = magic version kind body
+//	kind    = 1 (command) | 2 (event)
+//	string  = uvarint(0) uvarint(len) bytes  // new string, added to the table
+//	        | uvarint(n)                     // n-th string of the table
+//	bool    = byte(0 | 1)
+//	int     = varint (zigzag)
+//	list<T> = uvarint(count) T*
+//	command = string(Command) string(Format) app view bool(has_call) call?
+//	          bool(has_theme) markup(Theme)?
+//	app     = string(AppID) string(Name) string(Title) list<view>(Head)
+//	          list<view>(Body) list<markup>(HeadResources) list<markup>(BodyResources)
+//	view    = string(AppID) string(ViewID) markup(Tree) bool(has_styles)
+//	          markup(Styles)?
+//	call    = string(ID) string(AppID) string(Function) string(Selector)
+//	          list<string>(Args)
+//	markup  = string(TreeID) list<meta>(Events) string(Markup)
+//	meta    = string(ParentSelector) string(EventSelector) string(EventName)
+//	          string(Event) uvarint(flags) int(Debounce) int(Throttle)
+//	          list<string>(KeyFilter)
+//	event   = string(type) meta bool(options_applied) value(data)
+//	value   = 0 (null) | 1 (false) | 2 (true) | 3 int | 4 float64 (little endian)
+//	        | 5 string | 6 list<value> | 7 uvarint(count) (string value)*
+//
+// The flags of a meta are set in the order of PreventDefault, StopPropagation,
+// UseCapture, StopImmediatePropagation, Passive and Once from the lowest bit.
+const (
+	binaryMagic   = 'G'
+	binaryVersion = 4
+
+	commandKind = 1
+	eventKind   = 2
+)
+
+// contains the tags of the values of a event's data.
+const (
+	nullValue = iota
+	falseValue
+	trueValue
+	intValue
+	floatValue
+	stringValue
+	listValue
+	objectValue
+)
+
+// contains the flags of a event meta.
+const (
+	preventDefaultFlag = 1 << iota
+	stopPropagationFlag
+	useCaptureFlag
+	stopImmediatePropagationFlag
+	passiveFlag
+	onceFlag
+)
+
+// maxValueDepth defines the depth of the lists and objects within a event's
+// data past which the data is refused, as done by encoding/json.
+const maxValueDepth = 10000
+
+// ErrInvalidMessage is returned when decoding data which is not a valid message
+// of the binary format.
+var ErrInvalidMessage = errors.New("Invalid binary message")
+
+// BinaryCodec implements the Codec interface for the compact binary format.
+type BinaryCodec struct{}
+
+// Format returns the Binary format.
+func (BinaryCodec) Format() Format {
+	return Binary
+}
+
+// EncodeCommand returns the binary encoding of the command.
+func (BinaryCodec) EncodeCommand(command gu.RenderCommand) ([]byte, error) {
+	w := newWriter(commandKind)
+	w.command(command)
+	return w.buf.Bytes(), nil
+}
+
+// DecodeCommand returns the command decoded from the binary data.
+func (BinaryCodec) DecodeCommand(data []byte) (gu.RenderCommand, error) {
+	r, err := newReader(data, commandKind)
+	if err != nil {
+		return gu.RenderCommand{}, err
+	}
+
+	command := r.command()
+	return command, r.err
+}
+
+// EncodeEvent returns the binary encoding of the event.
+func (BinaryCodec) EncodeEvent(event Event) ([]byte, error) {
+	var data interface{}
+
+	if len(event.Data) != 0 {
+		decoder := json.NewDecoder(bytes.NewReader(event.Data))
+		decoder.UseNumber()
+
+		if err := decoder.Decode(&data); err != nil {
+			return nil, err
+		}
+	}
+
+	w := newWriter(eventKind)
+	w.string(event.Type)
+	w.meta(event.Meta)
+	w.bool(event.OptionsApplied)
+
+	if err := w.value(data); err != nil {
+		return nil, err
+	}
+
+	return w.buf.Bytes(), nil
+}
+
+// DecodeEvent returns the event decoded from the binary data, where its Data
+// is converted back into json.
+func (BinaryCodec) DecodeEvent(data []byte) (Event, error) {
+	var event Event
+
+	r, err := newReader(data, eventKind)
+	if err != nil {
+		return event, err
+	}
+
+	event.Type = r.string()
+	event.Meta = r.meta()
+	event.OptionsApplied = r.bool()
+	value := r.value(0)
+
+	if r.err != nil {
+		return event, r.err
+	}
+
+	event.Data, err = json.Marshal(value)
+	return event, err
+}
+
+//==============================================================================
+
+// writer encodes the values of a message.
+type writer struct {
+	buf     bytes.Buffer
+	strings map[string]int
+	scratch [binary.MaxVarintLen64]byte
+}
+
+// newWriter returns a new writer with the header of a message of the giving
+// kind.
+func newWriter(kind byte) *writer {
+	w := &writer{strings: make(map[string]int)}
+	w.buf.Write([]byte{binaryMagic, binaryVersion, kind})
+	return w
+}
+
+func (w *writer) uvarint(value uint64) {
+	n := binary.PutUvarint(w.scratch[:], value)
+	w.buf.Write(w.scratch[:n])
+}
+
+func (w *writer) int(value int64) {
+	n := binary.PutVarint(w.scratch[:], value)
+	w.buf.Write(w.scratch[:n])
+}
+
+func (w *writer) bool(value bool) {
+	if value {
+		w.buf.WriteByte(1)
+		return
+	}
+
+	w.buf.WriteByte(0)
+}
+
+// string writes the index of the string within the table, adding it if it is
+// not yet written.
+func (w *writer) string(value string) {
+	if index, ok := w.strings[value]; ok {
+		w.uvarint(uint64(index))
+		return
+	}
+
+	w.strings[value] = len(w.strings) + 1
+	w.uvarint(0)
+	w.uvarint(uint64(len(value)))
+	w.buf.WriteString(value)
+}
+
+func (w *writer) command(command gu.RenderCommand) {
+	w.string(command.Command)
+	w.string(command.Format)
+	w.app(command.App)
+	w.view(command.View)
+
+	w.bool(command.Call != nil)
+	if command.Call != nil {
+		w.call(*command.Call)
+	}
+
+	w.bool(command.Theme != nil)
+	if command.Theme != nil {
+		w.markup(*command.Theme)
+	}
+}
+
+func (w *writer) app(app gu.AppJSON) {
+	w.string(app.AppID)
+	w.string(app.Name)
+	w.string(app.Title)
+
+	for _, views := range [][]gu.ViewJSON{app.Head, app.Body} {
+		w.uvarint(uint64(len(views)))
+		for _, view := range views {
+			w.view(view)
+		}
+	}
+
+	for _, resources := range [][]trees.MarkupJSON{app.HeadResources, app.BodyResources} {
+		w.uvarint(uint64(len(resources)))
+		for _, resource := range resources {
+			w.markup(resource)
+		}
+	}
+}
+
+func (w *writer) view(view gu.ViewJSON) {
+	w.string(view.AppID)
+	w.string(view.ViewID)
+	w.markup(view.Tree)
+
+	w.bool(view.Styles != nil)
+	if view.Styles != nil {
+		w.markup(*view.Styles)
+	}
+}
+
+func (w *writer) call(call gu.JSCall) {
+	w.string(call.ID)
+	w.string(call.AppID)
+	w.string(string(call.Function))
+	w.string(call.Selector)
+
+	w.uvarint(uint64(len(call.Args)))
+	for _, arg := range call.Args {
+		w.string(arg)
+	}
+}
+
+func (w *writer) markup(markup trees.MarkupJSON) {
+	w.string(markup.TreeID)
+
+	w.uvarint(uint64(len(markup.Events)))
+	for _, event := range markup.Events {
+		w.meta(event)
+	}
+
+	w.string(markup.Markup)
+}
+
+func (w *writer) meta(event trees.EventJSON) {
+	w.string(event.ParentSelector)
+	w.string(event.EventSelector)
+	w.string(event.EventName)
+	w.string(event.Event)
+
+	var flags uint64
+	for index, set := range []bool{
+		event.PreventDefault,
+		event.StopPropagation,
+		event.UseCapture,
+		event.StopImmediatePropagation,
+		event.Passive,
+		event.Once,
+	} {
+		if set {
+			flags |= 1 << uint(index)
+		}
+	}
+
+	w.uvarint(flags)
+	w.int(event.Debounce)
+	w.int(event.Throttle)
+
+	w.uvarint(uint64(len(event.KeyFilter)))
+	for _, key := range event.KeyFilter {
+		w.string(key)
+	}
+}
+
+// value writes the value decoded from json, with numbers as json.Number.
+func (w *writer) value(value interface{}) error {
+	switch item := value.(type) {
+	case nil:
+		w.buf.WriteByte(nullValue)
+	case bool:
+		if item {
+			w.buf.WriteByte(trueValue)
+		} else {
+			w.buf.WriteByte(falseValue)
+		}
+	case json.Number:
+		if number, err := strconv.ParseInt(string(item), 10, 64); err == nil {
+			w.buf.WriteByte(intValue)
+			w.int(number)
+			return nil
+		}
+
+		number, err := item.Float64()
+		if err != nil {
+			return err
+		}
+
+		w.buf.WriteByte(floatValue)
+		binary.Write(&w.buf, binary.LittleEndian, math.Float64bits(number))
+	case string:
+		w.buf.WriteByte(stringValue)
+		w.string(item)
+	case []interface{}:
+		w.buf.WriteByte(listValue)
+		w.uvarint(uint64(len(item)))
+
+		for _, elem := range item {
+			if err := w.value(elem); err != nil {
+				return err
+			}
+		}
+	case map[string]interface{}:
+		keys := make([]string, 0, len(item))
+		for key := range item {
+			keys = append(keys, key)
+		}
+
+		sort.Strings(keys)
+
+		w.buf.WriteByte(objectValue)
+		w.uvarint(uint64(len(keys)))
+
+		for _, key := range keys {
+			w.string(key)
+			if err := w.value(item[key]); err != nil {
+				return err
+			}
+		}
+	default:
+		return fmt.Errorf("Value of type %T can not be encoded", value)
+	}
+
+	return nil
+}
+
+//==============================================================================
+
+// reader decodes the values of a message, recording the first error met.
+type reader struct {
+	data    *bytes.Reader
+	strings []string
+	err     error
+}
+
+// newReader returns a new reader for the message, validating its header
+// against the kind expected.
+func newReader(data []byte, kind byte) (*reader, error) {
+	if len(data) < 3 || data[0] != binaryMagic || data[1] != binaryVersion || data[2] != kind {
+		return nil, ErrInvalidMessage
+	}
+
+	return &reader{data: bytes.NewReader(data[3:])}, nil
+}
+
+func (r *reader) fail(err error) {
+	if r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *reader) byte() byte {
+	if r.err != nil {
+		return 0
+	}
+
+	value, err := r.data.ReadByte()
+	if err != nil {
+		r.fail(ErrInvalidMessage)
+	}
+
+	return value
+}
+
+func (r *reader) uvarint() uint64 {
+	if r.err != nil {
+		return 0
+	}
+
+	value, err := binary.ReadUvarint(r.data)
+	if err != nil {
+		r.fail(ErrInvalidMessage)
+	}
+
+	return value
+}
+
+func (r *reader) int() int64 {
+	if r.err != nil {
+		return 0
+	}
+
+	value, err := binary.ReadVarint(r.data)
+	if err != nil {
+		r.fail(ErrInvalidMessage)
+	}
+
+	return value
+}
+
+func (r *reader) bool() bool {
+	return r.byte() == 1
+}
+
+// count returns the length of a list, failing if it exceeds the remaining data
+// as every item takes atleast a byte.
+func (r *reader) count() int {
+	count := r.uvarint()
+	if count > uint64(r.data.Len()) {
+		r.fail(ErrInvalidMessage)
+		return 0
+	}
+
+	return int(count)
+}
+
+func (r *reader) string() string {
+	index := r.uvarint()
+	if r.err != nil {
+		return ""
+	}
+
+	if index != 0 {
+		if index > uint64(len(r.strings)) {
+			r.fail(ErrInvalidMessage)
+			return ""
+		}
+
+		return r.strings[index-1]
+	}
+
+	size := r.uvarint()
+	if size > uint64(r.data.Len()) {
+		r.fail(ErrInvalidMessage)
+		return ""
+	}
+
+	value := make([]byte, size)
+	r.data.Read(value)
+
+	r.strings = append(r.strings, string(value))
+	return string(value)
+}
+
+func (r *reader) command() gu.RenderCommand {
+	var command gu.RenderCommand
+	command.Command = r.string()
+	command.Format = r.string()
+	command.App = r.app()
+	command.View = r.view()
+
+	if r.bool() {
+		call := r.call()
+		command.Call = &call
+	}
+
+	if r.bool() {
+		theme := r.markup()
+		command.Theme = &theme
+	}
+
+	return command
+}
+
+func (r *reader) app() gu.AppJSON {
+	var app gu.AppJSON
+	app.AppID = r.string()
+	app.Name = r.string()
+	app.Title = r.string()
+
+	for _, views := range []*[]gu.ViewJSON{&app.Head, &app.Body} {
+		for count := r.count(); count > 0 && r.err == nil; count-- {
+			*views = append(*views, r.view())
+		}
+	}
+
+	for _, resources := range []*[]trees.MarkupJSON{&app.HeadResources, &app.BodyResources} {
+		for count := r.count(); count > 0 && r.err == nil; count-- {
+			*resources = append(*resources, r.markup())
+		}
+	}
+
+	return app
+}
+
+func (r *reader) view() gu.ViewJSON {
+	var view gu.ViewJSON
+	view.AppID = r.string()
+	view.ViewID = r.string()
+	view.Tree = r.markup()
+
+	if r.bool() {
+		styles := r.markup()
+		view.Styles = &styles
+	}
+
+	return view
+}
+
+func (r *reader) call() gu.JSCall {
+	var call gu.JSCall
+	call.ID = r.string()
+	call.AppID = r.string()
+	call.Function = gu.JSFunction(r.string())
+	call.Selector = r.string()
+
+	for count := r.count(); count > 0 && r.err == nil; count-- {
+		call.Args = append(call.Args, r.string())
+	}
+
+	return call
+}
+
+func (r *reader) markup() trees.MarkupJSON {
+	var markup trees.MarkupJSON
+	markup.TreeID = r.string()
+
+	for count := r.count(); count > 0 && r.err == nil; count-- {
+		markup.Events = append(markup.Events, r.meta())
+	}
+
+	markup.Markup = r.string()
+	return markup
+}
+
+func (r *reader) meta() trees.EventJSON {
+	var event trees.EventJSON
+	event.ParentSelector = r.string()
+	event.EventSelector = r.string()
+	event.EventName = r.string()
+	event.Event = r.string()
+
+	flags := r.uvarint()
+	event.PreventDefault = flags&preventDefaultFlag != 0
+	event.StopPropagation = flags&stopPropagationFlag != 0
+	event.UseCapture = flags&useCaptureFlag != 0
+	event.StopImmediatePropagation = flags&stopImmediatePropagationFlag != 0
+	event.Passive = flags&passiveFlag != 0
+	event.Once = flags&onceFlag != 0
+
+	event.Debounce = r.int()
+	event.Throttle = r.int()
+
+	for count := r.count(); count > 0 && r.err == nil; count-- {
+		event.KeyFilter = append(event.KeyFilter, r.string())
+	}
+
+	return event
+}
+
+// value returns the value of a event's data at the giving depth, with integers
+// as int64 to keep them intact when converted back to json.
+func (r *reader) value(depth int) interface{} {
+	if depth > maxValueDepth {
+		r.fail(ErrInvalidMessage)
+		return nil
+	}
+
+	switch tag := r.byte(); tag {
+	case nullValue:
+		return nil
+	case falseValue:
+		return false
+	case trueValue:
+		return true
+	case intValue:
+		return r.int()
+	case floatValue:
+		var bits uint64
+		if err := binary.Read(r.data, binary.LittleEndian, &bits); err != nil {
+			r.fail(ErrInvalidMessage)
+		}
+
+		return math.Float64frombits(bits)
+	case stringValue:
+		return r.string()
+	case listValue:
+		list := make([]interface{}, 0)
+		for count := r.count(); count > 0 && r.err == nil; count-- {
+			list = append(list, r.value(depth+1))
+		}
+
+		return list
+	case objectValue:
+		object := make(map[string]interface{})
+		for count := r.count(); count > 0 && r.err == nil; count-- {
+			key := r.string()
+			object[key] = r.value(depth + 1)
+		}
+
+		return object
+	default:
+		r.fail(ErrInvalidMessage)
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Package wire provides the encodings of the messages exchanged between Gu and
+// its drivers, being the RenderCommands sent to the driver and the event payloads
+// received from it.
+//
+// Messages are encoded as JSON by default, whilst drivers able to decode it can
+// use the compact binary format, which avoids escaping the rendered markup and
+// interns repeated strings such as ids and selectors. The format is negotiated at
+// connection start, where the driver (e.g core.js) sends a Hello listing the
+// formats it supports, and the server replies with the RenderCommand returned by
+// gu.FormatRenderCommand for the format picked by Negotiate, encoded as JSON.
+// All messages after it use the negotiated format.
+package wire
+
+import (
+	"encoding/json"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees"
+)
+
+// Format defines the name of a wire format.
+type Format string
+
+// contains the wire formats supported.
+const (
+	JSON   Format = "json"
+	Binary Format = "binary"
+)
+
+// Formats lists the supported formats in order of preference.
+var Formats = []Format{Binary, JSON}
+
+// Hello defines the message sent by a driver at connection start, listing the
+// formats it supports.
+type Hello struct {
+	Type    string   `json:"type"`
+	Formats []Format `json:"formats"`
+}
+
+// Event defines the payload sent by a driver for a event fired in the
+// browser, where Data contains the json of the event's eventx type, as decoded
+// by drivers/core.GetEvent.
+type Event struct {
+	Type           string          `json:"type"`
+	Meta           trees.EventJSON `json:"meta"`
+	Data           json.RawMessage `json:"data"`
+	OptionsApplied bool            `json:"options_applied"`
+}
+
+// Codec defines a interface for the encoding of the messages exchanged with a
+// driver in a giving format.
+type Codec interface {
+	Format() Format
+	EncodeCommand(gu.RenderCommand) ([]byte, error)
+	DecodeCommand([]byte) (gu.RenderCommand, error)
+	EncodeEvent(Event) ([]byte, error)
+	DecodeEvent([]byte) (Event, error)
+}
+
+// Negotiate returns the codec of the first format within Formats offered by
+// the driver, defaulting to JSON.
+func Negotiate(offered []Format) Codec {
+	for _, format := range Formats {
+		for _, item := range offered {
+			if item == format {
+				return CodecFor(format)
+			}
+		}
+	}
+
+	return JSONCodec{}
+}
+
+// CodecFor returns the codec for the giving format, defaulting to JSON for
+// unknown formats.
+func CodecFor(format Format) Codec {
+	if format == Binary {
+		return BinaryCodec{}
+	}
+
+	return JSONCodec{}
+}
+
+//==============================================================================
+
+// JSONCodec implements the Codec interface for the JSON format.
+type JSONCodec struct{}
+
+// Format returns the JSON format.
+func (JSONCodec) Format() Format {
+	return JSON
+}
+
+// EncodeCommand returns the json of the command.
+func (JSONCodec) EncodeCommand(command gu.RenderCommand) ([]byte, error) {
+	return json.Marshal(command)
+}
+
+// DecodeCommand returns the command decoded from the json.
+func (JSONCodec) DecodeCommand(data []byte) (gu.RenderCommand, error) {
+	var command gu.RenderCommand
+	err := json.Unmarshal(data, &command)
+	return command, err
+}
+
+// EncodeEvent returns the json of the event.
+func (JSONCodec) EncodeEvent(event Event) ([]byte, error) {
+	return json.Marshal(event)
+}
+
+// DecodeEvent returns the event decoded from the json.
+func (JSONCodec) DecodeEvent(data []byte) (Event, error) {
+	var event Event
+	err := json.Unmarshal(data, &event)
+	return event, err
+}
@@ -0,0 +1,237 @@
+package wire_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/drivers/wire"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/gu-io/gu/trees/property"
+	"github.com/influx6/faux/tests"
+)
+
+// noop defines a event handler which does nothing.
+func noop(common.EventObject, *trees.Markup) {}
+
+// view returns a ViewJSON rendered from markup with events.
+func view(appID string, viewID string) gu.ViewJSON {
+	markup := elems.Div(
+		property.ClassAttr("todo-list"),
+		elems.Input(
+			property.TypeAttr("text"),
+			property.NameAttr("title"),
+			events.InputEvent(noop, trees.Debounce(200*time.Millisecond), trees.KeyFilter("Enter", "Escape")),
+		),
+		elems.Button(
+			trees.NewText("%s", `Save "todo"`),
+			events.ClickEvent(noop, trees.Once(), trees.Passive()),
+		),
+	)
+
+	return gu.ViewJSON{
+		AppID:  appID,
+		ViewID: viewID,
+		Tree:   markup.TreeJSON(),
+	}
+}
+
+func TestBinaryCommand(t *testing.T) {
+	command := gu.RenderCommand{
+		Command: "RenderApp",
+		App: gu.AppJSON{
+			AppID: "app-1",
+			Name:  "Todos",
+			Title: "Todos",
+			Head:  []gu.ViewJSON{view("app-1", "view-1")},
+			Body:  []gu.ViewJSON{view("app-1", "view-2"), view("app-1", "view-3")},
+			HeadResources: []trees.MarkupJSON{
+				elems.Link(property.HrefAttr("/todo.css")).TreeJSON(),
+			},
+		},
+	}
+
+	// Views sent by a app extracting styles carry the stylesheet of the app.
+	styles := elems.Style(trees.NewText(".todo-list { margin: 0; }")).TreeJSON()
+	command.View = view("app-1", "view-4")
+	command.View.Styles = &styles
+	theme := elems.Style(trees.NewText(":root { --color-primary: #2196f3; }")).TreeJSON()
+	command.Theme = &theme
+
+	codec := wire.BinaryCodec{}
+
+	data, err := codec.EncodeCommand(command)
+	if err != nil {
+		tests.Failed("Should have encoded command: %+q", err)
+	}
+	tests.Passed("Should have encoded command")
+
+	decoded, err := codec.DecodeCommand(data)
+	if err != nil {
+		tests.Failed("Should have decoded command: %+q", err)
+	}
+	tests.Passed("Should have decoded command")
+
+	if !reflect.DeepEqual(command, decoded) {
+		tests.Failed("Should have decoded command equal to encoded: %#v", decoded)
+	}
+	tests.Passed("Should have decoded command equal to encoded")
+
+	jsonData, err := wire.JSONCodec{}.EncodeCommand(command)
+	if err != nil {
+		tests.Failed("Should have encoded command as json: %+q", err)
+	}
+	tests.Passed("Should have encoded command as json")
+
+	if len(data) >= len(jsonData)/2 {
+		tests.Failed("Should have encoded command in under half the json size: %d >= %d/2", len(data), len(jsonData))
+	}
+	tests.Passed("Should have encoded command in under half the json size")
+
+	if _, err := codec.DecodeCommand(data[:len(data)/2]); err != wire.ErrInvalidMessage {
+		tests.Failed("Should have failed to decode truncated command: %+q", err)
+	}
+	tests.Passed("Should have failed to decode truncated command")
+
+	if _, err := codec.DecodeCommand(jsonData); err != wire.ErrInvalidMessage {
+		tests.Failed("Should have failed to decode json as binary command: %+q", err)
+	}
+	tests.Passed("Should have failed to decode json as binary command")
+
+	format, err := codec.DecodeCommand(mustEncode(codec, gu.FormatRenderCommand("binary")))
+	if err != nil || format.Command != "Format" || format.Format != "binary" {
+		tests.Failed("Should have round tripped format command: %#v", format)
+	}
+	tests.Passed("Should have round tripped format command")
+
+	formatJSON, err := wire.JSONCodec{}.EncodeCommand(gu.FormatRenderCommand("json"))
+	if err != nil || bytes.Contains(formatJSON, []byte(`"Call"`)) || bytes.Contains(formatJSON, []byte(`"Theme"`)) {
+		tests.Failed("Should have left out the call and theme of json commands without them: %s", formatJSON)
+	}
+	tests.Passed("Should have left out the call and theme of json commands without them")
+
+	call := gu.JSRenderCommand(gu.JSCall{
+		ID:       "call-1",
+		AppID:    "app-1",
+		Function: gu.JSLocalStorageSet,
+		Args:     []string{"theme", "dark"},
+	})
+
+	if decoded, err := codec.DecodeCommand(mustEncode(codec, call)); err != nil || !reflect.DeepEqual(call, decoded) {
+		tests.Failed("Should have round tripped javascript call command: %#v", decoded)
+	}
+	tests.Passed("Should have round tripped javascript call command")
+}
+
+func TestBinaryEvent(t *testing.T) {
+	event := wire.Event{
+		Type: "KeyboardEvent",
+		Meta: trees.EventJSON{
+			EventName:       "KeyDownEvent",
+			Event:           "keydown",
+			EventSelector:   "input[uid='4']",
+			ParentSelector:  "div[uid='3']",
+			PreventDefault:  true,
+			StopPropagation: true,
+			Once:            true,
+			Throttle:        50,
+			KeyFilter:       []string{"Enter"},
+		},
+		OptionsApplied: true,
+		Data:           json.RawMessage(`{"Key":"Enter","KeyCode":13,"AltKey":false,"Pressure":0.5,"Delta":-3,"Duration":2500000000,"Touches":[{"Target":"<b>Enter</b>"},null],"Locale":"Enter"}`),
+	}
+
+	codecs := []wire.Codec{wire.JSONCodec{}, wire.BinaryCodec{}}
+	for _, codec := range codecs {
+		data, err := codec.EncodeEvent(event)
+		if err != nil {
+			tests.Failed("Should have encoded event as %s: %+q", codec.Format(), err)
+		}
+		tests.Passed("Should have encoded event as %s", codec.Format())
+
+		decoded, err := codec.DecodeEvent(data)
+		if err != nil {
+			tests.Failed("Should have decoded event as %s: %+q", codec.Format(), err)
+		}
+		tests.Passed("Should have decoded event as %s", codec.Format())
+
+		if decoded.Type != event.Type || decoded.OptionsApplied != event.OptionsApplied || !reflect.DeepEqual(decoded.Meta, event.Meta) {
+			tests.Failed("Should have decoded event meta as %s: %#v", codec.Format(), decoded)
+		}
+		tests.Passed("Should have decoded event meta as %s", codec.Format())
+
+		var expected, received interface{}
+		json.Unmarshal(event.Data, &expected)
+		json.Unmarshal(decoded.Data, &received)
+
+		if !reflect.DeepEqual(expected, received) {
+			tests.Failed("Should have decoded event data as %s: %s", codec.Format(), decoded.Data)
+		}
+		tests.Passed("Should have decoded event data as %s", codec.Format())
+	}
+
+	var duration struct{ Duration time.Duration }
+	decoded, _ := wire.BinaryCodec{}.DecodeEvent(mustEncodeEvent(wire.BinaryCodec{}, event))
+	if err := json.Unmarshal(decoded.Data, &duration); err != nil || duration.Duration != 2500*time.Millisecond {
+		tests.Failed("Should have kept integers intact in event data: %+q", err)
+	}
+	tests.Passed("Should have kept integers intact in event data")
+}
+
+func TestBinaryEventDepth(t *testing.T) {
+	data := mustEncodeEvent(wire.BinaryCodec{}, wire.Event{Type: "CustomEvent", Data: json.RawMessage("null")})
+
+	// nested returns the message with its null data placed within the giving
+	// depth of lists (tag 6) of a single item.
+	nested := func(depth int) []byte {
+		message := append([]byte{}, data[:len(data)-1]...)
+		message = append(message, bytes.Repeat([]byte{6, 1}, depth)...)
+		return append(message, data[len(data)-1])
+	}
+
+	if _, err := (wire.BinaryCodec{}).DecodeEvent(nested(100)); err != nil {
+		tests.Failed("Should have decoded nested event data: %+q", err)
+	}
+	tests.Passed("Should have decoded nested event data")
+
+	if _, err := (wire.BinaryCodec{}).DecodeEvent(nested(20000)); err != wire.ErrInvalidMessage {
+		tests.Failed("Should have refused event data nested too deep: %+q", err)
+	}
+	tests.Passed("Should have refused event data nested too deep")
+}
+
+func TestNegotiate(t *testing.T) {
+	if codec := wire.Negotiate([]wire.Format{wire.JSON, wire.Binary}); codec.Format() != wire.Binary {
+		tests.Failed("Should have preferred binary format when offered: %s", codec.Format())
+	}
+	tests.Passed("Should have preferred binary format when offered")
+
+	if codec := wire.Negotiate([]wire.Format{"msgpack"}); codec.Format() != wire.JSON {
+		tests.Failed("Should have defaulted to json format: %s", codec.Format())
+	}
+	tests.Passed("Should have defaulted to json format")
+}
+
+func mustEncode(codec wire.Codec, command gu.RenderCommand) []byte {
+	data, err := codec.EncodeCommand(command)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
+
+func mustEncodeEvent(codec wire.Codec, event wire.Event) []byte {
+	data, err := codec.EncodeEvent(event)
+	if err != nil {
+		panic(err)
+	}
+
+	return data
+}
@@ -0,0 +1,308 @@
+// +build ignore
+
+// The generation of the eventx types, the drivers/core.GetEvent decoder and the
+// javascript serializers of drivers/core all run from the same schema
+// (schema.json), ensuring the json produced in the browser matches the types
+// decoded in Go.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"strings"
+)
+
+type field struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Tag  string `json:"tag"`
+
+	// JS defines the property of the javascript object the field is read from,
+	// defaulting to the field name with a lowercase first letter. A "." reads
+	// the object itself and "-" skips the field in the serializer.
+	JS string `json:"js"`
+
+	// Expr defines a javascript expression on the object `o` which is used as the
+	// value of the field in place of JS.
+	Expr string `json:"expr"`
+}
+
+type eventType struct {
+	Name    string   `json:"name"`
+	Doc     []string `json:"doc"`
+	Event   bool     `json:"event"`
+	Core    bool     `json:"core"`
+	Embeds  []string `json:"embeds"`
+	Prepare string   `json:"prepare"`
+	Fields  []field  `json:"fields"`
+}
+
+type schema struct {
+	Kinds map[string]string `json:"kinds"`
+	Types []eventType       `json:"types"`
+}
+
+func main() {
+	data, err := ioutil.ReadFile("./schema.json")
+	if err != nil {
+		panic(fmt.Sprintf("Unable to locate `schema.json` file: %q", err.Error()))
+	}
+
+	var sc schema
+	if err := json.Unmarshal(data, &sc); err != nil {
+		panic(fmt.Sprintf("Unable to decode `schema.json` file: %q", err.Error()))
+	}
+
+	writeGo("./eventx.gen.go", generateTypes(sc))
+	writeGo("../drivers/core/events.go", generateDecoder(sc))
+
+	js, err := generateSerializers(sc)
+	if err != nil {
+		panic(fmt.Sprintf("Unable to generate serializers: %q", err.Error()))
+	}
+
+	if err := ioutil.WriteFile("../drivers/core/events.js", js, 0644); err != nil {
+		panic(fmt.Sprintf("Unable to write `events.js` file: %q", err.Error()))
+	}
+}
+
+// writeGo formats and writes the go source into the giving file.
+func writeGo(file string, src []byte) {
+	formatted, err := format.Source(src)
+	if err != nil {
+		panic(fmt.Sprintf("Unable to format %q: %q", file, err.Error()))
+	}
+
+	if err := ioutil.WriteFile(file, formatted, 0644); err != nil {
+		panic(fmt.Sprintf("Unable to write %q: %q", file, err.Error()))
+	}
+}
+
+// generateTypes returns the source of the eventx types declared by the schema.
+func generateTypes(sc schema) []byte {
+	var std, ext []string
+
+	for _, ty := range sc.Types {
+		if usesPackage(ty, "time") && !contains(std, `"time"`) {
+			std = append(std, `"time"`)
+		}
+
+		if usesPackage(ty, "cache") && !contains(ext, `"github.com/gu-io/gu/router/cache"`) {
+			ext = append(ext, `"github.com/gu-io/gu/router/cache"`)
+		}
+	}
+
+	var src bytes.Buffer
+
+	fmt.Fprint(&src, "// Document is auto-generate and should not be modified by hand.\n\n")
+	fmt.Fprint(&src, "package eventx\n\n")
+
+	if len(std)+len(ext) != 0 {
+		fmt.Fprintf(&src, "import (\n%s\n\n%s\n)\n\n", strings.Join(std, "\n"), strings.Join(ext, "\n"))
+	}
+
+	for _, ty := range sc.Types {
+		for _, line := range ty.Doc {
+			fmt.Fprintf(&src, "// %s\n", line)
+		}
+
+		fmt.Fprintf(&src, "type %s struct {\n", ty.Name)
+
+		for _, embed := range ty.Embeds {
+			fmt.Fprintf(&src, "%s\n", embed)
+		}
+
+		if ty.Event || ty.Core {
+			fmt.Fprint(&src, "Core interface{} `json:\"-\"`\n")
+		}
+
+		for _, fl := range ty.Fields {
+			if fl.Tag != "" {
+				fmt.Fprintf(&src, "%s %s `%s`\n", fl.Name, fl.Type, fl.Tag)
+				continue
+			}
+
+			fmt.Fprintf(&src, "%s %s\n", fl.Name, fl.Type)
+		}
+
+		fmt.Fprint(&src, "}\n\n")
+	}
+
+	return src.Bytes()
+}
+
+// generateDecoder returns the source of drivers/core.GetEvent which decodes the
+// json of the event types declared by the schema.
+func generateDecoder(sc schema) []byte {
+	var src bytes.Buffer
+
+	fmt.Fprint(&src, "// Document is auto-generate from eventx/schema.json and should not be modified by hand.\n\n")
+	fmt.Fprint(&src, "package core\n\n")
+	fmt.Fprint(&src, "import (\n\"encoding/json\"\n\n\"github.com/gu-io/gu/common\"\nevents \"github.com/gu-io/gu/eventx\"\n)\n\n")
+	fmt.Fprint(&src, "// GetEvent returns the giving event structure suited to the provided type.\n")
+	fmt.Fprint(&src, "func GetEvent(eventName string, eventJSON []byte, handle common.Remover) (*events.BaseEvent, error) {\n")
+	fmt.Fprint(&src, "switch eventName {\n")
+
+	for _, ty := range sc.Types {
+		if !ty.Event {
+			continue
+		}
+
+		fmt.Fprintf(&src, "case %q:\n", ty.Name)
+		fmt.Fprintf(&src, "var eventObject events.%s\n\n", ty.Name)
+		fmt.Fprint(&src, "if err := json.Unmarshal(eventJSON, &eventObject); err != nil {\nreturn nil, err\n}\n\n")
+		fmt.Fprint(&src, "return events.NewBaseEvent(&eventObject, handle), nil\n")
+	}
+
+	fmt.Fprint(&src, "}\n\n")
+	fmt.Fprint(&src, "var eventObject events.BasicEventMap\n\n")
+	fmt.Fprint(&src, "if err := json.Unmarshal(eventJSON, &eventObject); err != nil {\nreturn nil, err\n}\n\n")
+	fmt.Fprint(&src, "return events.NewBaseEvent(&eventObject, handle), nil\n")
+	fmt.Fprint(&src, "}\n")
+
+	return src.Bytes()
+}
+
+// generateSerializers returns the javascript serializers which convert DOM objects
+// into the json of the types declared by the schema.
+func generateSerializers(sc schema) ([]byte, error) {
+	declared := make(map[string]bool)
+	for _, ty := range sc.Types {
+		declared[ty.Name] = true
+	}
+
+	var src bytes.Buffer
+
+	fmt.Fprint(&src, "// Package events.js provides the serializers which convert DOM events and their\n")
+	fmt.Fprint(&src, "// objects into the json of their eventx types.\n\n")
+	fmt.Fprint(&src, "// Document is auto-generate from eventx/schema.json and should not be modified by hand.\n\n")
+	fmt.Fprint(&src, "// GuSerializers returns the serializers of the eventx types keyed by their\n")
+	fmt.Fprint(&src, "// names, using the conversion functions of the provided GuJS object.\n")
+	fmt.Fprint(&src, "function GuSerializers(GuJS) {\n")
+	fmt.Fprint(&src, "    var Serializers = {};\n")
+
+	for _, ty := range sc.Types {
+		fmt.Fprintf(&src, "\n    // Serializers.%s returns the eventx.%s of the giving object.\n", ty.Name, ty.Name)
+		fmt.Fprintf(&src, "    Serializers.%s = function(o) {\n", ty.Name)
+
+		if ty.Prepare != "" {
+			fmt.Fprintf(&src, "        o = %s(o)\n", ty.Prepare)
+		}
+
+		fmt.Fprint(&src, "        if (o == null || o == undefined) {\n            return null\n        }\n\n")
+
+		if len(ty.Embeds) != 0 {
+			fmt.Fprint(&src, "        var obj = GuJS.extend({}")
+			for _, embed := range ty.Embeds {
+				fmt.Fprintf(&src, ", Serializers.%s(o)", strings.TrimPrefix(embed, "*"))
+			}
+			fmt.Fprint(&src, ")\n")
+		} else {
+			fmt.Fprint(&src, "        var obj = {}\n")
+		}
+
+		for _, fl := range ty.Fields {
+			if fl.JS == "-" {
+				continue
+			}
+
+			value := fl.Expr
+			if value == "" {
+				switch fl.JS {
+				case "":
+					value = "o." + strings.ToLower(fl.Name[:1]) + fl.Name[1:]
+				case ".":
+					value = "o"
+				default:
+					value = "o." + fl.JS
+				}
+			}
+
+			converted, err := convert(sc, declared, fl.Type, value)
+			if err != nil {
+				return nil, fmt.Errorf("%s.%s: %s", ty.Name, fl.Name, err)
+			}
+
+			fmt.Fprintf(&src, "        obj.%s = %s\n", fl.Name, converted)
+		}
+
+		fmt.Fprint(&src, "        return obj\n    };\n")
+	}
+
+	fmt.Fprint(&src, "\n    return Serializers;\n}\n")
+
+	return src.Bytes(), nil
+}
+
+// convert returns the javascript expression which converts the value into the
+// json of the giving go type.
+func convert(sc schema, declared map[string]bool, ty string, value string) (string, error) {
+	if kind, ok := sc.Kinds[ty]; ok {
+		ty = kind
+	}
+
+	switch ty {
+	case "string":
+		return fmt.Sprintf("GuJS.toString(%s)", value), nil
+	case "bool":
+		return fmt.Sprintf("!!(%s)", value), nil
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64":
+		return fmt.Sprintf("GuJS.toInt(%s)", value), nil
+	case "float32", "float64":
+		return fmt.Sprintf("GuJS.toFloat(%s)", value), nil
+	case "time.Duration":
+		return fmt.Sprintf("GuJS.toDuration(%s)", value), nil
+	case "element":
+		return fmt.Sprintf("GuJS.toElement(%s)", value), nil
+	case "interface{}":
+		return fmt.Sprintf("GuJS.toValue(%s)", value), nil
+	case "[]byte":
+		return fmt.Sprintf("GuJS.toBytes(%s)", value), nil
+	}
+
+	if strings.HasPrefix(ty, "[]") {
+		elem := strings.TrimPrefix(ty, "[]")
+		if declared[elem] {
+			return fmt.Sprintf("GuJS.toList(%s, Serializers.%s)", value, elem), nil
+		}
+
+		item, err := convert(sc, declared, elem, "item")
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("GuJS.toList(%s, function(item) { return %s })", value, item), nil
+	}
+
+	if name := strings.TrimPrefix(ty, "*"); declared[name] {
+		return fmt.Sprintf("Serializers.%s(%s)", name, value), nil
+	}
+
+	return "", fmt.Errorf("type %s can not be serialized, skip the field with \"js\": \"-\"", ty)
+}
+
+// usesPackage returns true/false if any field of the type uses the giving package.
+func usesPackage(ty eventType, pkg string) bool {
+	for _, fl := range ty.Fields {
+		if strings.Contains(fl.Type, pkg+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// contains returns true/false if the item exists within the list.
+func contains(list []string, item string) bool {
+	for _, elem := range list {
+		if elem == item {
+			return true
+		}
+	}
+
+	return false
+}
@@ -29,7 +29,9 @@ func Get(file string) ([]byte, error) {
 
 func init() {
 
-	files["notifications/eventtype.gen"] = []byte("\x2f\x2f\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x64\x65\x66\x69\x6e\x65\x73\x20\x61\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x20\x74\x68\x61\x74\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x75\x73\x65\x64\x20\x74\x6f\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x20\x73\x70\x65\x63\x69\x66\x69\x63\x61\x6c\x6c\x79\x20\x66\x6f\x72\x0d\x0a\x2f\x2f\x20\x65\x76\x65\x6e\x74\x73\x20\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x74\x79\x70\x65\x2e\x0d\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x0d\x0a\x20\x20\x20\x20\x52\x65\x63\x65\x69\x76\x65\x28\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x64\x65\x66\x69\x6e\x65\x73\x20\x61\x20\x73\x74\x72\x75\x63\x74\x75\x72\x65\x20\x74\x79\x70\x65\x20\x77\x68\x69\x63\x68\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x74\x68\x65\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x45\x76\x65\x6e\x74\x44\x69\x73\x74\x72\x69\x62\x75\x74\x6f\x72\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x2e\x0d\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x20\x20\x20\x20\x68\x61\x6e\x64\x6c\x65\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4e\x65\x77\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6e\x65\x77\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x6f\x66\x20\x61\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4e\x65\x77\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x28\x66\x6e\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x29\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x61\x6e\x64\x6c\x65\x3a\x20\x66\x6e\x2c\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x63\x65\x69\x76\x65\x20\x74\x61\x6b\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x61\x6e\x64\x20\x65\x78\x65\x63\x75\x74\x65\x20\x69\x74\x20\x61\x67\x61\x69\x6e\x73\x74\x20\x74\x68\x65\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x68\x61\x6e\x64\x6c\x65\x72\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x29\x20\x52\x65\x63\x65\x69\x76\x65\x28\x65\x6c\x65\x6d\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x68\x61\x6e\x64\x6c\x65\x28\x65\x6c\x65\x6d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x61\x6e\x64\x6c\x65\x20\x74\x61\x6b\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x61\x6e\x64\x20\x61\x73\x73\x65\x72\x74\x73\x20\x74\x68\x65\x20\x65\x78\x70\x65\x63\x74\x65\x64\x20\x76\x61\x6c\x75\x65\x20\x74\x6f\x20\x6d\x61\x74\x63\x68\x20\x74\x68\x65\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x74\x79\x70\x65\x20\x74\x68\x65\x6e\x20\x70\x61\x73\x73\x65\x73\x20\x69\x74\x20\x74\x6f\x20\x74\x68\x65\x20\x52\x65\x63\x65\x69\x76\x65\x20\x6d\x65\x74\x68\x6f\x64\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x29\x20\x48\x61\x6e\x64\x6c\x65\x28\x72\x65\x63\x65\x69\x76\x65\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x29\x7b\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x65\x6c\x65\x6d\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x72\x65\x63\x65\x69\x76\x65\x2e\x28\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x52\x65\x63\x65\x69\x76\x65\x28\x65\x6c\x65\x6d\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x64\x65\x66\x69\x6e\x65\x73\x20\x61\x20\x73\x74\x72\x75\x63\x74\x75\x72\x65\x20\x74\x79\x70\x65\x20\x77\x68\x69\x63\x68\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x75\x73\x65\x64\x20\x74\x6f\x0d\x0a\x2f\x2f\x20\x72\x65\x63\x65\x69\x76\x65\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x74\x79\x70\x65\x20\x68\x61\x73\x20\x61\x20\x65\x76\x65\x6e\x74\x2e\x0d\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6d\x6c\x20\x73\x79\x6e\x63\x2e\x4d\x75\x74\x65\x78\x0d\x0a\x20\x20\x20\x20\x73\x75\x62\x73\x20\x5b\x5d\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x0d\x0a\x20\x20\x20\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x20\x62\x6f\x6f\x6c\x0d\x0a\x20\x20\x20\x20\x72\x65\x67\x69\x73\x74\x65\x72\x20\x6d\x61\x70\x5b\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x5d\x69\x6e\x74\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4e\x65\x77\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x69\x74\x68\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6e\x65\x77\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x6f\x66\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4e\x65\x77\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x69\x74\x68\x28\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x20\x62\x6f\x6f\x6c\x29\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x7b\x0d\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6c\x65\x6d\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x2e\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x3d\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x20\x3d\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x5d\x69\x6e\x74\x2c\x20\x30\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x65\x6c\x65\x6d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4e\x65\x77\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6e\x65\x77\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x6f\x66\x20\x4e\x65\x77\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4e\x65\x77\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x28\x29\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x7b\x0d\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6c\x65\x6d\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x20\x3d\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x5d\x69\x6e\x74\x2c\x20\x30\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x65\x6c\x65\x6d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x55\x6e\x4e\x6f\x74\x69\x66\x79\x20\x72\x65\x6d\x6f\x76\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x27\x73\x20\x6c\x69\x73\x74\x20\x69\x66\x20\x66\x6f\x75\x6e\x64\x20\x66\x72\x6f\x6d\x20\x66\x75\x74\x75\x72\x65\x20\x65\x76\x65\x6e\x74\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x55\x6e\x4e\x6f\x74\x69\x66\x79\x28\x73\x75\x62\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x64\x6f\x28\x66\x75\x6e\x63\x28\x29\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x6e\x64\x65\x78\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x73\x6e\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x5b\x73\x75\x62\x5d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x73\x75\x62\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x73\x6e\x2e\x73\x75\x62\x73\x5b\x3a\x69\x6e\x64\x65\x78\x5d\x2c\x20\x73\x6e\x2e\x73\x75\x62\x73\x5b\x69\x6e\x64\x65\x78\x2b\x31\x3a\x5d\x2e\x2e\x2e\x29\x0d\x0a\x20\x20\x20\x20\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4e\x6f\x74\x69\x66\x79\x20\x61\x64\x64\x73\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x69\x6e\x74\x6f\x20\x74\x68\x65\x20\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6c\x69\x73\x74\x20\x61\x6e\x64\x20\x77\x69\x6c\x6c\x20\x61\x77\x61\x69\x74\x20\x61\x6e\x20\x75\x70\x64\x61\x74\x65\x20\x6f\x66\x0d\x0a\x2f\x2f\x20\x61\x20\x6e\x65\x77\x20\x65\x76\x65\x6e\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x74\x79\x70\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x4e\x6f\x74\x69\x66\x79\x28\x73\x75\x62\x20\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x64\x6f\x28\x66\x75\x6e\x63\x28\x29\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x5b\x73\x75\x62\x5d\x20\x3d\x20\x6c\x65\x6e\x28\x73\x6e\x2e\x73\x75\x62\x73\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x73\x75\x62\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x73\x6e\x2e\x73\x75\x62\x73\x2c\x20\x73\x75\x62\x29\x0d\x0a\x20\x20\x20\x20\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x61\x6e\x64\x6c\x65\x20\x74\x61\x6b\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x61\x6e\x64\x20\x61\x73\x73\x65\x72\x74\x73\x20\x74\x68\x65\x20\x65\x78\x70\x65\x63\x74\x65\x64\x20\x76\x61\x6c\x75\x65\x20\x74\x6f\x20\x62\x65\x20\x6f\x66\x0d\x0a\x2f\x2f\x20\x74\x68\x65\x20\x74\x79\x70\x65\x20\x61\x6e\x64\x20\x70\x61\x73\x73\x20\x6f\x6e\x20\x74\x6f\x20\x69\x74\x27\x73\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x73\x20\x65\x6c\x73\x65\x20\x69\x67\x6e\x6f\x72\x69\x6e\x67\x20\x74\x68\x65\x20\x65\x76\x65\x6e\x74\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x48\x61\x6e\x64\x6c\x65\x28\x65\x6c\x65\x6d\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x29\x7b\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x65\x6c\x65\x6d\x45\x76\x65\x6e\x74\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x65\x6c\x65\x6d\x2e\x28\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x73\x6e\x2e\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x73\x6e\x2e\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x28\x65\x6c\x65\x6d\x45\x76\x65\x6e\x74\x29\x20\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x64\x6f\x28\x66\x75\x6e\x63\x28\x29\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x5f\x2c\x20\x73\x75\x62\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x73\x6e\x2e\x73\x75\x62\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x75\x62\x2e\x52\x65\x63\x65\x69\x76\x65\x28\x65\x6c\x65\x6d\x45\x76\x65\x6e\x74\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x64\x6f\x28\x66\x75\x6e\x63\x28\x29\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x5f\x2c\x20\x73\x75\x62\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x73\x6e\x2e\x73\x75\x62\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x75\x62\x2e\x52\x65\x63\x65\x69\x76\x65\x28\x65\x6c\x65\x6d\x45\x76\x65\x6e\x74\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x64\x6f\x20\x70\x65\x72\x66\x6f\x72\x6d\x73\x20\x61\x63\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6d\x75\x74\x65\x78\x20\x6c\x6f\x63\x6b\x65\x64\x20\x61\x6e\x64\x20\x75\x6e\x6c\x6f\x63\x6b\x65\x64\x20\x61\x70\x70\x72\x6f\x70\x72\x69\x61\x74\x65\x6c\x79\x2c\x20\x65\x6e\x73\x75\x72\x69\x6e\x67\x20\x73\x61\x66\x65\x0d\x0a\x2f\x2f\x20\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x53\x74\x72\x75\x63\x74\x2e\x4f\x62\x6a\x65\x63\x74\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x64\x6f\x28\x66\x6e\x20\x66\x75\x6e\x63\x28\x29\x29\x7b\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x66\x6e\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x73\x6d\x6c\x2e\x4c\x6f\x63\x6b\x28\x29\x0d\x0a\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x73\x6e\x2e\x73\x6d\x6c\x2e\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x66\x6e\x28\x29\x0d\x0a\x7d\x0d\x0a")
+	files["notifications/eventtype.gen"] = []byte("\x2f\x2f\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x64\x65\x66\x69\x6e\x65\x73\x20\x61\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x20\x74\x68\x61\x74\x20\x77\x68\x69\x63\x68\x20\x69\x73\x20\x75\x73\x65\x64\x20\x74\x6f\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x20\x73\x70\x65\x63\x69\x66\x69\x63\x61\x6c\x6c\x79\x20\x66\x6f\x72\x0d\x0a\x2f\x2f\x20\x65\x76\x65\x6e\x74\x73\x20\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x74\x79\x70\x65\x2e\x0d\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x0d\x0a\x20\x20\x20\x20\x52\x65\x63\x65\x69\x76\x65\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x64\x65\x66\x69\x6e\x65\x73\x20\x61\x20\x73\x74\x72\x75\x63\x74\x75\x72\x65\x20\x74\x79\x70\x65\x20\x77\x68\x69\x63\x68\x20\x69\x6d\x70\x6c\x65\x6d\x65\x6e\x74\x73\x20\x74\x68\x65\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x20\x61\x6e\x64\x20\x74\x68\x65\x20\x45\x76\x65\x6e\x74\x44\x69\x73\x74\x72\x69\x62\x75\x74\x6f\x72\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x2e\x0d\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x20\x20\x20\x20\x68\x61\x6e\x64\x6c\x65\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6e\x65\x77\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x6f\x66\x20\x61\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x28\x66\x6e\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x29\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x68\x61\x6e\x64\x6c\x65\x3a\x20\x66\x6e\x2c\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x52\x65\x63\x65\x69\x76\x65\x20\x74\x61\x6b\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x61\x6e\x64\x20\x65\x78\x65\x63\x75\x74\x65\x20\x69\x74\x20\x61\x67\x61\x69\x6e\x73\x74\x20\x74\x68\x65\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x68\x61\x6e\x64\x6c\x65\x72\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x29\x20\x52\x65\x63\x65\x69\x76\x65\x28\x65\x6c\x65\x6d\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x68\x61\x6e\x64\x6c\x65\x28\x65\x6c\x65\x6d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x61\x6e\x64\x6c\x65\x20\x74\x61\x6b\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x61\x6e\x64\x20\x61\x73\x73\x65\x72\x74\x73\x20\x74\x68\x65\x20\x65\x78\x70\x65\x63\x74\x65\x64\x20\x76\x61\x6c\x75\x65\x20\x74\x6f\x20\x6d\x61\x74\x63\x68\x20\x74\x68\x65\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x74\x79\x70\x65\x20\x74\x68\x65\x6e\x20\x70\x61\x73\x73\x65\x73\x20\x69\x74\x20\x74\x6f\x20\x74\x68\x65\x20\x52\x65\x63\x65\x69\x76\x65\x20\x6d\x65\x74\x68\x6f\x64\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x29\x20\x48\x61\x6e\x64\x6c\x65\x28\x72\x65\x63\x65\x69\x76\x65\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x41\x63\x63\x65\x70\x74\x28\x72\x65\x63\x65\x69\x76\x65\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x41\x63\x63\x65\x70\x74\x20\x70\x61\x73\x73\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x74\x6f\x20\x74\x68\x65\x20\x52\x65\x63\x65\x69\x76\x65\x20\x6d\x65\x74\x68\x6f\x64\x20\x69\x66\x20\x69\x74\x20\x69\x73\x20\x6f\x66\x20\x74\x68\x65\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x74\x79\x70\x65\x2c\x20\x72\x65\x74\x75\x72\x6e\x69\x6e\x67\x20\x74\x72\x75\x65\x2f\x66\x61\x6c\x73\x65\x20\x69\x66\x20\x69\x74\x20\x77\x61\x73\x20\x61\x63\x63\x65\x70\x74\x65\x64\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x29\x20\x41\x63\x63\x65\x70\x74\x28\x72\x65\x63\x65\x69\x76\x65\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x72\x65\x63\x65\x69\x76\x65\x2e\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x52\x65\x63\x65\x69\x76\x65\x28\x65\x6c\x65\x6d\x29\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x3d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x64\x65\x66\x69\x6e\x65\x73\x20\x61\x20\x73\x74\x72\x75\x63\x74\x75\x72\x65\x20\x74\x79\x70\x65\x20\x77\x68\x69\x63\x68\x20\x6d\x75\x73\x74\x20\x62\x65\x20\x75\x73\x65\x64\x20\x74\x6f\x0d\x0a\x2f\x2f\x20\x72\x65\x63\x65\x69\x76\x65\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x74\x79\x70\x65\x20\x68\x61\x73\x20\x61\x20\x65\x76\x65\x6e\x74\x2e\x0d\x0a\x74\x79\x70\x65\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x73\x74\x72\x75\x63\x74\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6d\x6c\x20\x73\x79\x6e\x63\x2e\x4d\x75\x74\x65\x78\x0d\x0a\x20\x20\x20\x20\x73\x75\x62\x73\x20\x5b\x5d\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x0d\x0a\x20\x20\x20\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x62\x6f\x6f\x6c\x0d\x0a\x20\x20\x20\x20\x72\x65\x67\x69\x73\x74\x65\x72\x20\x6d\x61\x70\x5b\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x5d\x69\x6e\x74\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x69\x74\x68\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6e\x65\x77\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x6f\x66\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x0d\x0a\x2f\x2f\x20\x77\x68\x69\x63\x68\x20\x6f\x6e\x6c\x79\x20\x64\x65\x6c\x69\x76\x65\x72\x73\x20\x65\x76\x65\x6e\x74\x73\x20\x74\x68\x61\x74\x20\x70\x61\x73\x73\x20\x74\x68\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x69\x74\x68\x28\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x62\x6f\x6f\x6c\x29\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x7b\x0d\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6c\x65\x6d\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x2e\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x3d\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x20\x3d\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x5d\x69\x6e\x74\x2c\x20\x30\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x65\x6c\x65\x6d\x0d\x0a\x7d\x0d\x0a\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x69\x6c\x74\x65\x72\x73\x7d\x7d\x0d\x0a\x2f\x2f\x20\x4e\x65\x77\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x68\x65\x72\x65\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6e\x65\x77\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x6f\x66\x20\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x0d\x0a\x2f\x2f\x20\x77\x68\x69\x63\x68\x20\x6f\x6e\x6c\x79\x20\x64\x65\x6c\x69\x76\x65\x72\x73\x20\x65\x76\x65\x6e\x74\x73\x20\x77\x68\x6f\x73\x65\x20\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x20\x66\x69\x65\x6c\x64\x20\x65\x71\x75\x61\x6c\x73\x20\x74\x68\x65\x20\x70\x72\x6f\x76\x69\x64\x65\x64\x20\x76\x61\x6c\x75\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4e\x65\x77\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x68\x65\x72\x65\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x28\x76\x61\x6c\x75\x65\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x2a\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x7b\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x4e\x65\x77\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x69\x74\x68\x28\x66\x75\x6e\x63\x28\x65\x6c\x65\x6d\x20\x7b\x7b\x24\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x65\x6c\x65\x6d\x2e\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x20\x3d\x3d\x20\x76\x61\x6c\x75\x65\x0d\x0a\x20\x20\x20\x20\x7d\x29\x0d\x0a\x7d\x0d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x0d\x0a\x2f\x2f\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x72\x65\x74\x75\x72\x6e\x73\x20\x61\x20\x6e\x65\x77\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x6f\x66\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x28\x29\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x7b\x0d\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6c\x65\x6d\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x20\x3d\x20\x6d\x61\x6b\x65\x28\x6d\x61\x70\x5b\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x5d\x69\x6e\x74\x2c\x20\x30\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x26\x65\x6c\x65\x6d\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x55\x6e\x4e\x6f\x74\x69\x66\x79\x20\x72\x65\x6d\x6f\x76\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x66\x72\x6f\x6d\x20\x74\x68\x65\x20\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x27\x73\x20\x6c\x69\x73\x74\x20\x69\x66\x20\x66\x6f\x75\x6e\x64\x20\x66\x72\x6f\x6d\x20\x66\x75\x74\x75\x72\x65\x20\x65\x76\x65\x6e\x74\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x55\x6e\x4e\x6f\x74\x69\x66\x79\x28\x73\x75\x62\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x64\x6f\x28\x66\x75\x6e\x63\x28\x29\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x6e\x64\x65\x78\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x73\x6e\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x5b\x73\x75\x62\x5d\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x64\x65\x6c\x65\x74\x65\x28\x73\x6e\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x2c\x20\x73\x75\x62\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x73\x75\x62\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x73\x6e\x2e\x73\x75\x62\x73\x5b\x3a\x69\x6e\x64\x65\x78\x5d\x2c\x20\x73\x6e\x2e\x73\x75\x62\x73\x5b\x69\x6e\x64\x65\x78\x2b\x31\x3a\x5d\x2e\x2e\x2e\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x6e\x65\x78\x74\x2c\x20\x69\x74\x65\x6d\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x73\x6e\x2e\x73\x75\x62\x73\x5b\x69\x6e\x64\x65\x78\x3a\x5d\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x5b\x69\x74\x65\x6d\x5d\x20\x3d\x20\x69\x6e\x64\x65\x78\x20\x2b\x20\x6e\x65\x78\x74\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x4e\x6f\x74\x69\x66\x79\x20\x61\x64\x64\x73\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x69\x6e\x74\x6f\x20\x74\x68\x65\x20\x6e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x6c\x69\x73\x74\x20\x61\x6e\x64\x20\x77\x69\x6c\x6c\x20\x61\x77\x61\x69\x74\x20\x61\x6e\x20\x75\x70\x64\x61\x74\x65\x20\x6f\x66\x0d\x0a\x2f\x2f\x20\x61\x20\x6e\x65\x77\x20\x65\x76\x65\x6e\x74\x20\x6f\x66\x20\x74\x68\x65\x20\x67\x69\x76\x65\x6e\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x74\x79\x70\x65\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x4e\x6f\x74\x69\x66\x79\x28\x73\x75\x62\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x53\x75\x62\x73\x63\x72\x69\x62\x65\x72\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x64\x6f\x28\x66\x75\x6e\x63\x28\x29\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x69\x66\x20\x5f\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x73\x6e\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x5b\x73\x75\x62\x5d\x3b\x20\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x72\x65\x67\x69\x73\x74\x65\x72\x5b\x73\x75\x62\x5d\x20\x3d\x20\x6c\x65\x6e\x28\x73\x6e\x2e\x73\x75\x62\x73\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x73\x6e\x2e\x73\x75\x62\x73\x20\x3d\x20\x61\x70\x70\x65\x6e\x64\x28\x73\x6e\x2e\x73\x75\x62\x73\x2c\x20\x73\x75\x62\x29\x0d\x0a\x20\x20\x20\x20\x7d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x48\x61\x6e\x64\x6c\x65\x20\x74\x61\x6b\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x61\x6e\x64\x20\x61\x73\x73\x65\x72\x74\x73\x20\x74\x68\x65\x20\x65\x78\x70\x65\x63\x74\x65\x64\x20\x76\x61\x6c\x75\x65\x20\x74\x6f\x20\x62\x65\x20\x6f\x66\x0d\x0a\x2f\x2f\x20\x74\x68\x65\x20\x74\x79\x70\x65\x20\x61\x6e\x64\x20\x70\x61\x73\x73\x20\x6f\x6e\x20\x74\x6f\x20\x69\x74\x27\x73\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x73\x20\x65\x6c\x73\x65\x20\x69\x67\x6e\x6f\x72\x69\x6e\x67\x20\x74\x68\x65\x20\x65\x76\x65\x6e\x74\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x48\x61\x6e\x64\x6c\x65\x28\x65\x6c\x65\x6d\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x29\x7b\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x41\x63\x63\x65\x70\x74\x28\x65\x6c\x65\x6d\x29\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x41\x63\x63\x65\x70\x74\x20\x70\x61\x73\x73\x65\x73\x20\x74\x68\x65\x20\x67\x69\x76\x69\x6e\x67\x20\x76\x61\x6c\x75\x65\x20\x74\x6f\x20\x74\x68\x65\x20\x75\x6e\x64\x65\x72\x6c\x69\x6e\x65\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x73\x20\x69\x66\x20\x69\x74\x20\x69\x73\x20\x6f\x66\x20\x74\x68\x65\x0d\x0a\x2f\x2f\x20\x74\x79\x70\x65\x20\x61\x6e\x64\x20\x70\x61\x73\x73\x65\x73\x20\x74\x68\x65\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x2c\x20\x72\x65\x74\x75\x72\x6e\x69\x6e\x67\x20\x74\x72\x75\x65\x2f\x66\x61\x6c\x73\x65\x20\x69\x66\x20\x69\x74\x20\x77\x61\x73\x20\x61\x63\x63\x65\x70\x74\x65\x64\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x41\x63\x63\x65\x70\x74\x28\x65\x6c\x65\x6d\x20\x69\x6e\x74\x65\x72\x66\x61\x63\x65\x7b\x7d\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x65\x6c\x65\x6d\x45\x76\x65\x6e\x74\x2c\x20\x6f\x6b\x20\x3a\x3d\x20\x65\x6c\x65\x6d\x2e\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x21\x6f\x6b\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x73\x6e\x2e\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x21\x3d\x20\x6e\x69\x6c\x20\x26\x26\x20\x21\x73\x6e\x2e\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x28\x65\x6c\x65\x6d\x45\x76\x65\x6e\x74\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x64\x6f\x28\x66\x75\x6e\x63\x28\x29\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x66\x6f\x72\x20\x5f\x2c\x20\x73\x75\x62\x20\x3a\x3d\x20\x72\x61\x6e\x67\x65\x20\x73\x6e\x2e\x73\x75\x62\x73\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x20\x73\x75\x62\x2e\x52\x65\x63\x65\x69\x76\x65\x28\x65\x6c\x65\x6d\x45\x76\x65\x6e\x74\x29\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x7d\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x20\x74\x72\x75\x65\x0d\x0a\x7d\x0d\x0a\x0d\x0a\x2f\x2f\x20\x64\x6f\x20\x70\x65\x72\x66\x6f\x72\x6d\x73\x20\x61\x63\x74\x69\x6f\x6e\x20\x77\x69\x74\x68\x20\x74\x68\x65\x20\x6d\x75\x74\x65\x78\x20\x6c\x6f\x63\x6b\x65\x64\x20\x61\x6e\x64\x20\x75\x6e\x6c\x6f\x63\x6b\x65\x64\x20\x61\x70\x70\x72\x6f\x70\x72\x69\x61\x74\x65\x6c\x79\x2c\x20\x65\x6e\x73\x75\x72\x69\x6e\x67\x20\x73\x61\x66\x65\x0d\x0a\x2f\x2f\x20\x63\x6f\x6e\x63\x75\x72\x72\x65\x6e\x74\x20\x61\x63\x63\x65\x73\x73\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x28\x73\x6e\x20\x2a\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x29\x20\x64\x6f\x28\x66\x6e\x20\x66\x75\x6e\x63\x28\x29\x29\x7b\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x66\x6e\x20\x3d\x3d\x20\x6e\x69\x6c\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x74\x75\x72\x6e\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x73\x6e\x2e\x73\x6d\x6c\x2e\x4c\x6f\x63\x6b\x28\x29\x0d\x0a\x20\x20\x20\x20\x64\x65\x66\x65\x72\x20\x73\x6e\x2e\x73\x6d\x6c\x2e\x55\x6e\x6c\x6f\x63\x6b\x28\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x66\x6e\x28\x29\x0d\x0a\x7d\x0d\x0a")
+
+	files["notifications/eventtype_test.gen"] = []byte("\x2f\x2f\x20\x54\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x20\x76\x61\x6c\x69\x64\x61\x74\x65\x73\x20\x74\x68\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x79\x2c\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x20\x61\x6e\x64\x20\x66\x69\x6c\x74\x65\x72\x73\x20\x6f\x66\x0d\x0a\x2f\x2f\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x73\x20\x74\x68\x72\x6f\x75\x67\x68\x20\x74\x68\x65\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x20\x61\x6e\x64\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x2e\x0d\x0a\x66\x75\x6e\x63\x20\x54\x65\x73\x74\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x28\x74\x20\x2a\x74\x65\x73\x74\x69\x6e\x67\x2e\x54\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x69\x6e\x74\x0d\x0a\x20\x20\x20\x20\x68\x61\x6e\x64\x6c\x65\x72\x20\x3a\x3d\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x48\x61\x6e\x64\x6c\x65\x72\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x72\x65\x63\x65\x69\x76\x65\x64\x2b\x2b\x0d\x0a\x20\x20\x20\x20\x7d\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x76\x61\x72\x20\x65\x6c\x65\x6d\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x68\x61\x6e\x64\x6c\x65\x72\x2e\x41\x63\x63\x65\x70\x74\x28\x73\x74\x72\x75\x63\x74\x7b\x7d\x7b\x7d\x29\x20\x7c\x7c\x20\x21\x68\x61\x6e\x64\x6c\x65\x72\x2e\x41\x63\x63\x65\x70\x74\x28\x65\x6c\x65\x6d\x29\x20\x7c\x7c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x21\x3d\x20\x31\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x61\x63\x63\x65\x70\x74\x65\x64\x20\x6f\x6e\x6c\x79\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x73\x3a\x20\x25\x64\x22\x2c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x61\x63\x63\x65\x70\x74\x65\x64\x20\x6f\x6e\x6c\x79\x20\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x73\x22\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x3d\x20\x30\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x69\x65\x72\x20\x3a\x3d\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x28\x29\x0d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x69\x65\x72\x2e\x4e\x6f\x74\x69\x66\x79\x28\x68\x61\x6e\x64\x6c\x65\x72\x29\x0d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x69\x65\x72\x2e\x4e\x6f\x74\x69\x66\x79\x28\x68\x61\x6e\x64\x6c\x65\x72\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x69\x65\x72\x2e\x48\x61\x6e\x64\x6c\x65\x28\x65\x6c\x65\x6d\x29\x0d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x69\x65\x72\x2e\x48\x61\x6e\x64\x6c\x65\x28\x73\x74\x72\x75\x63\x74\x7b\x7d\x7b\x7d\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x21\x3d\x20\x31\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x6f\x6e\x63\x65\x20\x74\x6f\x20\x61\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x61\x64\x64\x65\x64\x20\x74\x77\x69\x63\x65\x3a\x20\x25\x64\x22\x2c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x6f\x6e\x63\x65\x20\x74\x6f\x20\x61\x20\x73\x75\x62\x73\x63\x72\x69\x62\x65\x72\x20\x61\x64\x64\x65\x64\x20\x74\x77\x69\x63\x65\x22\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x69\x65\x72\x2e\x55\x6e\x4e\x6f\x74\x69\x66\x79\x28\x68\x61\x6e\x64\x6c\x65\x72\x29\x0d\x0a\x20\x20\x20\x20\x6e\x6f\x74\x69\x66\x69\x65\x72\x2e\x48\x61\x6e\x64\x6c\x65\x28\x65\x6c\x65\x6d\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x21\x3d\x20\x31\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x73\x74\x6f\x70\x70\x65\x64\x20\x64\x65\x6c\x69\x76\x65\x72\x69\x6e\x67\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x73\x20\x61\x66\x74\x65\x72\x20\x55\x6e\x4e\x6f\x74\x69\x66\x79\x3a\x20\x25\x64\x22\x2c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x73\x74\x6f\x70\x70\x65\x64\x20\x64\x65\x6c\x69\x76\x65\x72\x69\x6e\x67\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x73\x20\x61\x66\x74\x65\x72\x20\x55\x6e\x4e\x6f\x74\x69\x66\x79\x22\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x6e\x76\x61\x6c\x69\x64\x20\x3a\x3d\x20\x4e\x65\x77\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x69\x74\x68\x28\x66\x75\x6e\x63\x28\x7b\x7b\x2e\x54\x79\x70\x65\x7d\x7d\x29\x20\x62\x6f\x6f\x6c\x20\x7b\x20\x72\x65\x74\x75\x72\x6e\x20\x66\x61\x6c\x73\x65\x20\x7d\x29\x0d\x0a\x20\x20\x20\x20\x69\x6e\x76\x61\x6c\x69\x64\x2e\x4e\x6f\x74\x69\x66\x79\x28\x68\x61\x6e\x64\x6c\x65\x72\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x69\x6e\x76\x61\x6c\x69\x64\x2e\x41\x63\x63\x65\x70\x74\x28\x65\x6c\x65\x6d\x29\x20\x7c\x7c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x21\x3d\x20\x31\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x6e\x6f\x74\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x66\x61\x69\x6c\x69\x6e\x67\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x3a\x20\x25\x64\x22\x2c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x6e\x6f\x74\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x66\x61\x69\x6c\x69\x6e\x67\x20\x76\x61\x6c\x69\x64\x61\x74\x69\x6f\x6e\x22\x29\x0d\x0a\x7b\x7b\x72\x61\x6e\x67\x65\x20\x2e\x46\x69\x6c\x74\x65\x72\x73\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x77\x68\x65\x72\x65\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x20\x3a\x3d\x20\x4e\x65\x77\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x68\x65\x72\x65\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x28\x65\x6c\x65\x6d\x2e\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x29\x0d\x0a\x20\x20\x20\x20\x77\x68\x65\x72\x65\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x2e\x4e\x6f\x74\x69\x66\x79\x28\x68\x61\x6e\x64\x6c\x65\x72\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x21\x77\x68\x65\x72\x65\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x2e\x41\x63\x63\x65\x70\x74\x28\x65\x6c\x65\x6d\x29\x20\x7c\x7c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x21\x3d\x20\x32\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x6d\x61\x74\x63\x68\x69\x6e\x67\x20\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x3a\x20\x25\x64\x22\x2c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x6d\x61\x74\x63\x68\x69\x6e\x67\x20\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x22\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x3d\x20\x31\x0d\x0a\x7b\x7b\x69\x66\x20\x2e\x55\x6e\x6d\x61\x74\x63\x68\x65\x64\x7d\x7d\x0d\x0a\x20\x20\x20\x20\x75\x6e\x6d\x61\x74\x63\x68\x65\x64\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x20\x3a\x3d\x20\x4e\x65\x77\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x4e\x6f\x74\x69\x66\x69\x63\x61\x74\x69\x6f\x6e\x57\x68\x65\x72\x65\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x28\x7b\x7b\x2e\x55\x6e\x6d\x61\x74\x63\x68\x65\x64\x7d\x7d\x29\x0d\x0a\x20\x20\x20\x20\x75\x6e\x6d\x61\x74\x63\x68\x65\x64\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x2e\x4e\x6f\x74\x69\x66\x79\x28\x68\x61\x6e\x64\x6c\x65\x72\x29\x0d\x0a\x0d\x0a\x20\x20\x20\x20\x69\x66\x20\x75\x6e\x6d\x61\x74\x63\x68\x65\x64\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x2e\x41\x63\x63\x65\x70\x74\x28\x65\x6c\x65\x6d\x29\x20\x7c\x7c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x20\x21\x3d\x20\x31\x20\x7b\x0d\x0a\x20\x20\x20\x20\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x46\x61\x69\x6c\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x6e\x6f\x74\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x20\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x3a\x20\x25\x64\x22\x2c\x20\x72\x65\x63\x65\x69\x76\x65\x64\x29\x0d\x0a\x20\x20\x20\x20\x7d\x0d\x0a\x20\x20\x20\x20\x74\x65\x73\x74\x73\x2e\x50\x61\x73\x73\x65\x64\x28\x22\x53\x68\x6f\x75\x6c\x64\x20\x68\x61\x76\x65\x20\x6e\x6f\x74\x20\x64\x65\x6c\x69\x76\x65\x72\x65\x64\x20\x7b\x7b\x24\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x65\x76\x65\x6e\x74\x20\x77\x69\x74\x68\x20\x64\x69\x66\x66\x65\x72\x65\x6e\x74\x20\x7b\x7b\x2e\x46\x69\x65\x6c\x64\x7d\x7d\x22\x29\x0d\x0a\x7b\x7b\x65\x6e\x64\x7d\x7d\x7b\x7b\x65\x6e\x64\x7d\x7d\x7d\x0d\x0a")
 
 	files["scaffolds/base.gen"] = []byte("\x2f\x2f\x20\x50\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x20\x69\x73\x20\x61\x6e\x20\x61\x75\x74\x6f\x2d\x67\x65\x6e\x65\x72\x61\x74\x65\x64\x20\x70\x61\x63\x6b\x61\x67\x65\x20\x77\x68\x69\x63\x68\x20\x65\x78\x70\x6f\x73\x65\x73\x20\x74\x68\x65\x20\x47\x75\x2e\x4e\x41\x70\x70\x20\x77\x68\x69\x63\x68\x0d\x0a\x2f\x2f\x20\x63\x61\x6e\x20\x62\x65\x20\x63\x72\x65\x61\x74\x65\x64\x20\x74\x6f\x20\x75\x73\x65\x20\x74\x68\x65\x20\x63\x6f\x6e\x73\x74\x72\x75\x63\x74\x65\x64\x20\x76\x69\x65\x77\x73\x20\x69\x66\x20\x61\x6e\x79\x2e\x20\x45\x64\x69\x74\x20\x61\x73\x20\x79\x6f\x75\x20\x73\x65\x65\x20\x66\x69\x74\x2e\x0d\x0a\x0d\x0a\x2f\x2f\x67\x6f\x3a\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x67\x6f\x20\x72\x75\x6e\x20\x73\x65\x74\x74\x69\x6e\x67\x73\x5f\x62\x75\x6e\x64\x6c\x65\x2e\x67\x6f\x0d\x0a\x2f\x2f\x67\x6f\x3a\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x67\x6f\x20\x72\x75\x6e\x20\x70\x75\x62\x6c\x69\x63\x5f\x62\x75\x6e\x64\x6c\x65\x2e\x67\x6f\x0d\x0a\x2f\x2f\x67\x6f\x3a\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x67\x6f\x20\x67\x65\x6e\x65\x72\x61\x74\x65\x20\x2e\x2f\x64\x72\x69\x76\x65\x72\x2f\x2e\x2e\x2e\x0d\x0a\x0d\x0a\x70\x61\x63\x6b\x61\x67\x65\x20\x7b\x7b\x2e\x4e\x61\x6d\x65\x7d\x7d\x0d\x0a\x0d\x0a\x69\x6d\x70\x6f\x72\x74\x20\x28\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x2f\x72\x6f\x75\x74\x65\x72\x22\x0d\x0a\x09\x22\x67\x69\x74\x68\x75\x62\x2e\x63\x6f\x6d\x2f\x67\x75\x2d\x69\x6f\x2f\x67\x75\x2f\x72\x6f\x75\x74\x65\x72\x2f\x63\x61\x63\x68\x65\x2f\x6d\x65\x6d\x6f\x72\x79\x63\x61\x63\x68\x65\x22\x0d\x0a\x29\x0d\x0a\x0d\x0a\x2f\x2f\x20\x43\x6f\x6e\x74\x61\x69\x6e\x73\x20\x74\x68\x65\x20\x70\x72\x6f\x6a\x65\x63\x74\x73\x20\x2a\x4e\x41\x70\x70\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x20\x61\x6e\x64\x20\x2a\x52\x6f\x75\x74\x65\x72\x20\x6c\x65\x76\x65\x6c\x20\x69\x6e\x73\x74\x61\x6e\x63\x65\x73\x2e\x0d\x0a\x76\x61\x72\x20\x28\x0d\x0a\x20\x20\x41\x70\x70\x52\x6f\x75\x74\x65\x72\x20\x20\x3d\x20\x72\x6f\x75\x74\x65\x72\x2e\x4e\x65\x77\x52\x6f\x75\x74\x65\x72\x28\x6e\x69\x6c\x2c\x20\x6d\x65\x6d\x6f\x72\x79\x63\x61\x63\x68\x65\x2e\x4e\x65\x77\x28\x7b\x7b\x71\x75\x6f\x74\x65\x20\x2e\x4e\x61\x6d\x65\x7d\x7d\x29\x29\x0d\x0a\x20\x20\x41\x70\x70\x20\x3d\x20\x67\x75\x2e\x41\x70\x70\x28\x7b\x7b\x63\x61\x70\x69\x74\x61\x6c\x69\x7a\x65\x20\x2e\x4e\x61\x6d\x65\x20\x7c\x20\x71\x75\x6f\x74\x65\x7d\x7d\x2c\x20\x41\x70\x70\x52\x6f\x75\x74\x65\x72\x29\x0d\x0a\x29\x0d\x0a")
 
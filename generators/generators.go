@@ -5,7 +5,16 @@ package generators
 import "github.com/influx6/moz/ast"
 
 // RegisterGenerators will add all generator functions from this package
-// into the provided registry.
-func RegisterGenerators(house *ast.AnnotationRegistry) {
-	house.Register("notification:event", NotificationTypeGenerator)
+// into the provided registry, returning an error if the registry does not
+// support any of them.
+func RegisterGenerators(house *ast.AnnotationRegistry) error {
+	if err := house.Register("notification:event", NotificationTypeGenerator); err != nil {
+		return err
+	}
+
+	if err := house.Register("notification:event", NotificationNamedTypeGenerator); err != nil {
+		return err
+	}
+
+	return house.Register("notification:event", NotificationPackageGenerator)
 }
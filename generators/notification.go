@@ -4,6 +4,7 @@ package generators
 
 import (
 	"fmt"
+	"path"
 	"strings"
 	"text/template"
 
@@ -30,25 +31,185 @@ import (
 //	If done this way we can get users to generate any event base type and get a handler to connect and handler type assertions
 //	for that event without need to worry about that themselves.
 //
+//	Filters:
+//	The where param lists fields separated by "|", each getting a NewXXXNotificationWhereField function which only delivers
+//	events whose field equals the provided value, e.g @notification:event(where => UUID|Name). Filtered fields must be
+//	comparable.
 //
-func NotificationTypeGenerator(an ast.AnnotationDeclaration, str ast.StructDeclaration, pkg ast.PackageDeclaration, pk ast.Package) ([]gen.WriteDirective, error) {
-	eventFileName := fmt.Sprintf("%s_event.go", strings.ToLower(str.Object.Name.Name))
+func NotificationTypeGenerator(toDir string, an ast.AnnotationDeclaration, str ast.StructDeclaration, pkg ast.PackageDeclaration, pk ast.Package) ([]gen.WriteDirective, error) {
+	fieldTypes := make(map[string]string)
+	for _, field := range ast.GetFields(str, &pkg) {
+		fieldTypes[field.FieldName] = field.FieldTypeName
+	}
+
+	filters, err := notificationFilters(an, fieldTypes)
+	if err != nil {
+		return nil, err
+	}
+
+	return notificationDirectives(pkg.Package, nil, notificationType{
+		Name:    str.Object.Name.Name,
+		Type:    str.Object.Name.Name,
+		Filters: filters,
+	})
+}
+
+// NotificationNamedTypeGenerator defines a function for generating the notification types for a named
+// non-struct type (e.g type Key string), which works the same as the NotificationTypeGenerator but does
+// not support field filters.
+//
+//	Annotation: @notification:event
+//
+func NotificationNamedTypeGenerator(toDir string, an ast.AnnotationDeclaration, ty ast.TypeDeclaration, pkg ast.PackageDeclaration, pk ast.Package) ([]gen.WriteDirective, error) {
+	if an.Param("where") != "" {
+		return nil, fmt.Errorf("Field filters are only supported for struct types: %q", ty.Object.Name.Name)
+	}
+
+	return notificationDirectives(pkg.Package, nil, notificationType{
+		Name: ty.Object.Name.Name,
+		Type: ty.Object.Name.Name,
+	})
+}
+
+// NotificationPackageGenerator defines a function for generating the notification types for a type
+// declared in another package, which is set through the package level annotation's target param as
+// the import path followed by the type name. The name param sets the prefix of the generated types,
+// defaulting to the target type name, and filters must provide their field types.
+//
+//	Annotation: @notification:event(target => github.com/gu-io/gu/router.PushEvent, name => Push, where => Host:string|Path:string)
+//
+func NotificationPackageGenerator(toDir string, an ast.AnnotationDeclaration, pkg ast.PackageDeclaration, pk ast.Package) ([]gen.WriteDirective, error) {
+	target := an.Param("target")
+
+	dot := strings.LastIndex(target, ".")
+	if dot <= 0 || dot == len(target)-1 {
+		return nil, fmt.Errorf("Expected target param as import path and type name (e.g net/url.URL): %q", target)
+	}
+
+	importPath, typeName := target[:dot], target[dot+1:]
+	importName := path.Base(importPath)
+
+	filters, err := notificationFilters(an, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	name := an.Param("name")
+	if name == "" {
+		name = typeName
+	}
+
+	return notificationDirectives(pkg.Package, []gen.ImportItemDeclr{gen.Import(importPath, "")}, notificationType{
+		Name:    name,
+		Type:    fmt.Sprintf("%s.%s", importName, typeName),
+		Filters: filters,
+	})
+}
+
+// notificationType defines the data used by the notification templates.
+type notificationType struct {
+	Name    string
+	Type    string
+	Filters []notificationFilter
+}
+
+// notificationFilter defines a field which gets a generated NewXXXNotificationWhereField
+// function. Unmatched holds a value of the field's type which differs from its zero value,
+// used by the generated test to check events are filtered out.
+type notificationFilter struct {
+	Field     string
+	Type      string
+	Unmatched string
+}
+
+// notificationFilters returns the filters listed in the where param of the annotation, which
+// are separated by "|" and can provide their type has "Field:Type". Fields without a type are
+// looked up from the provided field types.
+func notificationFilters(an ast.AnnotationDeclaration, fieldTypes map[string]string) ([]notificationFilter, error) {
+	where := strings.TrimSpace(an.Param("where"))
+	if where == "" {
+		return nil, nil
+	}
+
+	var filters []notificationFilter
+
+	for _, item := range strings.Split(where, "|") {
+		var filter notificationFilter
+
+		parts := strings.SplitN(strings.TrimSpace(item), ":", 2)
+		filter.Field = strings.TrimSpace(parts[0])
+
+		if len(parts) > 1 {
+			filter.Type = strings.TrimSpace(parts[1])
+		} else {
+			filter.Type = fieldTypes[filter.Field]
+		}
+
+		if filter.Field == "" || filter.Type == "" {
+			return nil, fmt.Errorf("Unable to resolve field and type for filter: %q", item)
+		}
+
+		filter.Unmatched = unmatchedValue(filter.Type)
+
+		filters = append(filters, filter)
+	}
+
+	return filters, nil
+}
+
+// unmatchedValue returns the source of a value of the giving type which differs from its
+// zero value, or an empty string for types whose values can not be known from their name,
+// which are then only tested against matching values.
+func unmatchedValue(typeName string) string {
+	switch typeName {
+	case "string":
+		return `"unmatched"`
+	case "bool":
+		return "true"
+	case "int", "int8", "int16", "int32", "int64", "uint", "uint8", "uint16", "uint32", "uint64",
+		"uintptr", "byte", "rune", "float32", "float64":
+		return "1"
+	}
+
+	if strings.HasPrefix(typeName, "*") {
+		return fmt.Sprintf("new(%s)", typeName[1:])
+	}
+
+	return ""
+}
+
+// notificationDirectives returns the directives for the notification file and its test file
+// for the giving notification type.
+func notificationDirectives(pkgName string, imports []gen.ImportItemDeclr, nt notificationType) ([]gen.WriteDirective, error) {
+	eventFileName := fmt.Sprintf("%s_event.go", strings.ToLower(nt.Name))
+	eventTestFileName := fmt.Sprintf("%s_event_test.go", strings.ToLower(nt.Name))
 
 	typeGen := gen.Block(
 		gen.Package(
-			gen.Name(pkg.Package),
-			gen.Imports(),
+			gen.Name(pkgName),
+			gen.Imports(append([]gen.ImportItemDeclr{gen.Import("sync", "")}, imports...)...),
 			gen.Block(
 				gen.SourceTextWith(
 					string(data.Must("notifications/eventtype.gen")),
 					template.FuncMap{},
-					struct {
-						Struct  ast.StructDeclaration
-						Package ast.PackageDeclaration
-					}{
-						Struct:  str,
-						Package: pkg,
-					},
+					nt,
+				),
+			),
+		),
+	)
+
+	testGen := gen.Block(
+		gen.Package(
+			gen.Name(pkgName),
+			gen.Imports(append([]gen.ImportItemDeclr{
+				gen.Import("testing", ""),
+				gen.Import("github.com/influx6/faux/tests", ""),
+			}, imports...)...),
+			gen.Block(
+				gen.SourceTextWith(
+					string(data.Must("notifications/eventtype_test.gen")),
+					template.FuncMap{},
+					nt,
 				),
 			),
 		),
@@ -61,5 +222,11 @@ func NotificationTypeGenerator(an ast.AnnotationDeclaration, str ast.StructDecla
 			FileName:     eventFileName,
 			Writer:       fmtwriter.New(typeGen, true, true),
 		},
+		{
+			Dir:          "./",
+			DontOverride: false,
+			FileName:     eventTestFileName,
+			Writer:       fmtwriter.New(testGen, true, true),
+		},
 	}, nil
 }
@@ -0,0 +1,140 @@
+package generators_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/generators"
+	"github.com/influx6/faux/metrics"
+	"github.com/influx6/faux/tests"
+	"github.com/influx6/moz/ast"
+)
+
+const eventsSource = `// Package events defines events for notification tests.
+//@notification:event(target => net/url.URL, name => Link, where => Host:string)
+package events
+
+// Key defines a named non-struct event.
+//@notification:event
+type Key string
+
+// Item defines a struct event with a filter.
+//@notification:event(where => Name)
+type Item struct {
+	Name string
+}
+`
+
+// generate parses the source as a package and returns the content of the
+// files generated for it by the registered generators.
+func generate(t *testing.T, source string) map[string]string {
+	dir, err := ioutil.TempDir("", "gu-generators")
+	if err != nil {
+		tests.Failed("Should have created package directory: %+q", err)
+	}
+	defer os.RemoveAll(dir)
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "events.go"), []byte(source), 0644); err != nil {
+		tests.Failed("Should have written package source: %+q", err)
+	}
+
+	house := ast.NewAnnotationRegistry()
+	if err := generators.RegisterGenerators(house); err != nil {
+		tests.Failed("Should have registered generators: %+q", err)
+	}
+	tests.Passed("Should have registered generators")
+
+	pkgs, err := ast.ParseAnnotations(metrics.New(), dir)
+	if err != nil {
+		tests.Failed("Should have parsed package annotations: %+q", err)
+	}
+
+	files := make(map[string]string)
+	for _, pkg := range pkgs {
+		for _, declr := range pkg.Packages {
+			directives, err := house.ParseDeclr(pkg, declr, dir)
+			if err != nil {
+				tests.Failed("Should have generated notification files: %+q", err)
+			}
+
+			for _, directive := range directives {
+				var content bytes.Buffer
+				if _, err := directive.Writer.WriteTo(&content); err != nil {
+					tests.Failed("Should have written %q: %+q", directive.FileName, err)
+				}
+
+				files[directive.FileName] = content.String()
+			}
+		}
+	}
+
+	return files
+}
+
+func TestNotificationGenerators(t *testing.T) {
+	files := generate(t, eventsSource)
+
+	expected := map[string][]string{
+		"item_event.go":      {"func NewItemNotificationWhereName(value string) *ItemNotification", "return elem.Name == value"},
+		"item_event_test.go": {`"github.com/influx6/faux/tests"`, `NewItemNotificationWhereName("unmatched")`},
+		"key_event.go":       {"func NewKeyHandler(fn func(Key)) *KeyHandler", "func (sn *KeyHandler) Accept(receive interface{}) bool", "func (sn *KeyNotification) Accept(elem interface{}) bool"},
+		"key_event_test.go":  {"func TestKeyNotification(t *testing.T)", `tests.Passed("Should have accepted only Key events")`},
+		"link_event.go":      {`"net/url"`, "func NewLinkHandler(fn func(url.URL)) *LinkHandler", "func NewLinkNotificationWhereHost(value string) *LinkNotification", "return elem.Host == value"},
+		"link_event_test.go": {`"net/url"`, "var elem url.URL", `NewLinkNotificationWhereHost("unmatched")`},
+	}
+
+	if len(files) != len(expected) {
+		tests.Failed("Should have generated only the notification files and their tests: %d", len(files))
+	}
+
+	for name, contents := range expected {
+		file, ok := files[name]
+		if !ok {
+			tests.Failed("Should have generated %q: %v", name, files)
+		}
+
+		for _, content := range contents {
+			if !strings.Contains(file, content) {
+				tests.Info("Received: %s", file)
+				tests.Failed("Should have generated %q with %q", name, content)
+			}
+		}
+	}
+	tests.Passed("Should have generated notifications for struct, named and package targets")
+}
+
+func TestNotificationFilterOnNamedType(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gu-generators")
+	if err != nil {
+		tests.Failed("Should have created package directory: %+q", err)
+	}
+	defer os.RemoveAll(dir)
+
+	source := "package events\n\n//@notification:event(where => Size)\ntype Key string\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "events.go"), []byte(source), 0644); err != nil {
+		tests.Failed("Should have written package source: %+q", err)
+	}
+
+	house := ast.NewAnnotationRegistry()
+	if err := generators.RegisterGenerators(house); err != nil {
+		tests.Failed("Should have registered generators: %+q", err)
+	}
+
+	pkgs, err := ast.ParseAnnotations(metrics.New(), dir)
+	if err != nil {
+		tests.Failed("Should have parsed package annotations: %+q", err)
+	}
+
+	for _, pkg := range pkgs {
+		for _, declr := range pkg.Packages {
+			if _, err := house.ParseDeclr(pkg, declr, dir); err == nil {
+				tests.Failed("Should have refused filters on named non-struct type")
+			}
+		}
+	}
+	tests.Passed("Should have refused filters on named non-struct type")
+}
@@ -22,8 +22,13 @@ var countKeeper = struct {
 }
 
 // NewKey returns a new string key which is path of the incremental key which once initializes
-// constantly increases.
+// constantly increases. If a IDGenerator other than trees.RandomIDs is set through
+// trees.SetIDGenerator then the key is generated by it.
 func NewKey() string {
+	if gen := trees.GetIDGenerator(); gen != trees.RandomIDs {
+		return gen.UID()
+	}
+
 	countKeeper.ml.Lock()
 	countKeeper.baseCount++
 	countKeeper.ml.Unlock()
@@ -100,6 +105,13 @@ type ViewUpdate struct {
 	View *NView
 }
 
+// ThemeUpdate defines a struct which is used to notify the need to update the
+// theme variables of a App.
+//@notification:event
+type ThemeUpdate struct {
+	App *NApp
+}
+
 //================================================================================
 
 // Services defines a struct which exposes certain fields to be accessible to
@@ -113,6 +125,7 @@ type Services struct {
 	Unmounted Subscriptions
 	Router    *router.Router
 	ViewRoute router.Resolver
+	JS        JSChannel
 }
 
 //================================================================================
@@ -155,9 +168,12 @@ type Reactive interface {
 // RenderCommand defines a struct to hold a giving command for the rendering
 // of a App or View using the JSON format.
 type RenderCommand struct {
-	Command string   `json:"Command"`
-	App     AppJSON  `json:"App,omitempty"`
-	View    ViewJSON `json:"View,omitempty"`
+	Command string           `json:"Command"`
+	Format  string           `json:"Format,omitempty"`
+	App     AppJSON          `json:"App,omitempty"`
+	View    ViewJSON         `json:"View,omitempty"`
+	Call    *JSCall           `json:"Call,omitempty"`
+	Theme   *trees.MarkupJSON `json:"Theme,omitempty"`
 }
 
 // AppRenderCommand returns a new RenderCommand for rendering a app.
@@ -176,6 +192,45 @@ func ViewRenderCommand(view *NView) RenderCommand {
 	}
 }
 
+// ViewPatchCommand returns a new RenderCommand for patching the view already
+// rendered by the driver, leaving out the markup of unchanged components. Only
+// drivers keeping elements marked NodeUnchanged (e.g core.js) can use it.
+func ViewPatchCommand(view *NView) RenderCommand {
+	return RenderCommand{
+		Command: "PatchView",
+		View:    view.PatchJSON(),
+	}
+}
+
+// ThemeRenderCommand returns a new RenderCommand for rendering the theme
+// variables of a app, which replaces only the style markup containing them in
+// the app already rendered by the driver.
+func ThemeRenderCommand(app *NApp) RenderCommand {
+	theme := app.themeMarkup().TreeJSON()
+	return RenderCommand{
+		Command: "RenderTheme",
+		Theme:   &theme,
+	}
+}
+
+// FormatRenderCommand returns a new RenderCommand which sets the wire format
+// used for the messages following it, as negotiated with the driver.
+func FormatRenderCommand(format string) RenderCommand {
+	return RenderCommand{
+		Command: "Format",
+		Format:  format,
+	}
+}
+
+// JSRenderCommand returns a new RenderCommand for calling a browser function,
+// where the driver returns the result of the call by dispatching a JSResult.
+func JSRenderCommand(call JSCall) RenderCommand {
+	return RenderCommand{
+		Command: "CallJS",
+		Call:    &call,
+	}
+}
+
 //==============================================================================
 
 // NewReactive returns an instance of a Reactive struct.
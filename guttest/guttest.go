@@ -0,0 +1,377 @@
+// Package guttest provides a headless driver for testing the behaviour of Gu
+// apps and components without a browser.
+//
+// The Driver mounts a NApp into an in-memory tree, which it renders again as the
+// app's views update. Tests interact with the tree through Click, Input and
+// Navigate, which synthesize the common.EventBroadcast messages a browser driver
+// would send for the events of the matching elements, and check the tree with
+// the assertions built on trees.Query:
+//
+//	func TestCounter(t *testing.T) {
+//		app := gu.App("Counter", nil)
+//		app.View(NewCounter(), "/*", gu.BodyTarget)
+//
+//		driver := guttest.Mount(t, app, "/#")
+//		defer driver.Unmount()
+//
+//		driver.Click("button.increment")
+//		driver.AssertText("span.count", "1")
+//	}
+//
+// AssertAccessible checks the tree for accessibility issues with the rules of
+// the trees/a11y package.
+package guttest
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/a11y"
+)
+
+// Driver defines a in-memory driver which renders a NApp into a tree and
+// delivers synthesized events to it.
+type Driver struct {
+	t        testing.TB
+	app      *gu.NApp
+	tree     *trees.Markup
+	current  router.PushEvent
+	renders  int
+	removers []common.Remover
+}
+
+// Mount returns a new Driver which renders the app for the giving path, failing
+// the test if the path is invalid.
+func Mount(t testing.TB, app *gu.NApp, path string) *Driver {
+	d := &Driver{t: t, app: app}
+
+	app.InitApp(location{driver: d})
+
+	d.removers = append(d.removers,
+		notifications.SubscribeWithRemover(gu.NewAppUpdateHandler(func(update gu.AppUpdate) {
+			if update.App == d.app {
+				d.render()
+			}
+		})),
+		notifications.SubscribeWithRemover(gu.NewViewUpdateHandler(func(update gu.ViewUpdate) {
+			if update.App == d.app {
+				d.render()
+			}
+		})),
+		notifications.SubscribeWithRemover(gu.NewThemeUpdateHandler(func(update gu.ThemeUpdate) {
+			if update.App == d.app {
+				d.render()
+			}
+		})),
+	)
+
+	d.Navigate(path)
+	app.Mounted()
+
+	return d
+}
+
+// Unmount stops the driver from receiving the updates of the app.
+func (d *Driver) Unmount() {
+	for _, remover := range d.removers {
+		remover.Remove()
+	}
+
+	d.removers = nil
+}
+
+// Tree returns the current rendered tree of the app.
+func (d *Driver) Tree() *trees.Markup {
+	return d.tree
+}
+
+// HTML returns the html of the current rendered tree.
+func (d *Driver) HTML() string {
+	return d.tree.HTML()
+}
+
+// Renders returns the total renders of the app since mounted.
+func (d *Driver) Renders() int {
+	return d.renders
+}
+
+// Location returns the current location of the app.
+func (d *Driver) Location() router.PushEvent {
+	return d.current
+}
+
+// Navigate activates the routes of the app matching the giving path and renders
+// it, as when the browser location changes.
+func (d *Driver) Navigate(path string) {
+	event, err := router.NewPushEvent(path, true)
+	if err != nil {
+		d.t.Fatalf("Invalid path %q: %+q", path, err)
+		return
+	}
+
+	d.current = event
+	d.app.ActivateRoute(event)
+	d.render()
+}
+
+// render renders the app into the tree for the active routes.
+func (d *Driver) render() {
+	d.tree = d.app.Render(nil)
+	d.renders++
+}
+
+//==============================================================================
+
+// Click delivers a click event to the first element matching the selector.
+// Checkboxes and radios are checked in the tree as browsers do, receiving a
+// change event with their new state.
+func (d *Driver) Click(selector string) {
+	target := d.Find(selector)
+
+	d.deliver(target, "click", &eventx.MouseEvent{UIEvent: &eventx.UIEvent{Detail: 1}})
+
+	if target.Name() != "input" {
+		return
+	}
+
+	switch attr(target, "type") {
+	case "checkbox":
+		checked := !hasAttr(target, "checked")
+		d.check(target, checked)
+		d.deliver(target, "change", &eventx.ChangeEvent{
+			Value:   attr(target, "value"),
+			Checked: checked,
+		})
+	case "radio":
+		for _, radio := range d.QueryAll("input[name='" + attr(target, "name") + "']") {
+			d.check(radio, false)
+		}
+
+		d.check(target, true)
+		d.deliver(target, "change", &eventx.ChangeEvent{
+			Value:   attr(target, "value"),
+			Checked: true,
+		})
+	}
+}
+
+// check sets the checked attribute of the markup to the giving state.
+func (d *Driver) check(target *trees.Markup, checked bool) {
+	if checked {
+		trees.ReplaceORAddAttribute(target, "checked", "checked")
+		return
+	}
+
+	trees.RemoveAttribute(target, "checked")
+}
+
+// Input delivers a input event carrying the value to the first element matching
+// the selector, as when the user types the value into it.
+func (d *Driver) Input(selector string, value string) {
+	target := d.Find(selector)
+
+	trees.ReplaceORAddAttribute(target, "value", value)
+
+	d.deliver(target, "input", &eventx.InputEvent{
+		Data:  value,
+		Value: value,
+	})
+}
+
+// Dispatch delivers the event object as a event of the giving type to the first
+// element matching the selector, where event is a pointer to a eventx type.
+func (d *Driver) Dispatch(selector string, eventType string, event interface{}) {
+	target := d.Find(selector)
+
+	d.deliver(target, eventType, event)
+}
+
+// deliver broadcasts the event for the events of the giving type registered on
+// the target and its parents whose selector matches the target, in the order
+// they bubble in the browser.
+func (d *Driver) deliver(target *trees.Markup, eventType string, event interface{}) {
+	object := eventx.NewBaseEvent(event, nil)
+
+	for node := target; node != nil; node = node.Parent() {
+		var stop bool
+
+		for _, ev := range node.Events() {
+			if ev.Type != eventType || !selects(ev, node, target) {
+				continue
+			}
+
+			notifications.Dispatch(common.EventBroadcast{
+				EventName: ev.EventName(),
+				EventID:   ev.ID(),
+				Event:     object,
+			})
+
+			if ev.StopPropagation || ev.StopImmediatePropagation {
+				stop = true
+			}
+		}
+
+		if stop {
+			return
+		}
+	}
+}
+
+// selects returns true/false if the event registered on the node fires for the
+// target, as core.js only fires events whose EventSelector matches the target
+// within the parent of the node.
+func selects(ev trees.Event, node *trees.Markup, target *trees.Markup) bool {
+	selector := ev.EventSelector()
+
+	// Selectors of the element of the event use its uid, which is not a
+	// attribute, and always select the node registering it.
+	if selector == "" || (ev.Tree != nil && selector == ev.Tree.IDSelector(false)) {
+		return target == node
+	}
+
+	return trees.Query.Matches(target, selector)
+}
+
+//==============================================================================
+
+// Query returns the first element of the tree matching the selector, or nil.
+func (d *Driver) Query(selector string) *trees.Markup {
+	return trees.Query.Query(d.tree, selector)
+}
+
+// QueryAll returns the elements of the tree matching the selector.
+func (d *Driver) QueryAll(selector string) []*trees.Markup {
+	return trees.Query.QueryAll(d.tree, selector)
+}
+
+// Find returns the first element of the tree matching the selector, failing the
+// test if none matches.
+func (d *Driver) Find(selector string) *trees.Markup {
+	target := d.Query(selector)
+	if target == nil {
+		d.t.Fatalf("Should have element matching %q in:\n%s", selector, d.HTML())
+	}
+
+	return target
+}
+
+// AssertExists fails the test if no element matches the selector.
+func (d *Driver) AssertExists(selector string) {
+	if d.Query(selector) == nil {
+		d.t.Errorf("Should have element matching %q in:\n%s", selector, d.HTML())
+	}
+}
+
+// AssertMissing fails the test if a element matches the selector.
+func (d *Driver) AssertMissing(selector string) {
+	if d.Query(selector) != nil {
+		d.t.Errorf("Should have no element matching %q in:\n%s", selector, d.HTML())
+	}
+}
+
+// AssertCount fails the test if the total elements matching the selector is
+// not the giving count.
+func (d *Driver) AssertCount(selector string, count int) {
+	if total := len(d.QueryAll(selector)); total != count {
+		d.t.Errorf("Should have %d elements matching %q, found %d", count, selector, total)
+	}
+}
+
+// AssertText fails the test if the text of the first element matching the
+// selector, trimmed of surrounding spaces, is not the giving text.
+func (d *Driver) AssertText(selector string, text string) {
+	target := d.Query(selector)
+	if target == nil {
+		d.t.Errorf("Should have element matching %q in:\n%s", selector, d.HTML())
+		return
+	}
+
+	if content := strings.TrimSpace(Text(target)); content != text {
+		d.t.Errorf("Should have text %q for %q, found %q", text, selector, content)
+	}
+}
+
+// AssertAttr fails the test if the attribute of the first element matching the
+// selector does not have the giving value.
+func (d *Driver) AssertAttr(selector string, name string, value string) {
+	target := d.Query(selector)
+	if target == nil {
+		d.t.Errorf("Should have element matching %q in:\n%s", selector, d.HTML())
+		return
+	}
+
+	if !hasAttr(target, name) {
+		d.t.Errorf("Should have attribute %q for %q", name, selector)
+		return
+	}
+
+	if found := attr(target, name); found != value {
+		d.t.Errorf("Should have attribute %q of %q for %q, found %q", name, value, selector, found)
+	}
+}
+
+// AssertAccessible fails the test for every accessibility issue found within
+// the tree by the rules, which default to a11y.DefaultRules.
+func (d *Driver) AssertAccessible(rules ...a11y.Rule) {
+	for _, issue := range a11y.Check(d.tree, rules...) {
+		d.t.Errorf("Should have no accessibility issues, found %s", issue)
+	}
+}
+
+// Text returns the text content of the markup and its children.
+func Text(markup *trees.Markup) string {
+	switch markup.Kind() {
+	case trees.TextNode:
+		return markup.TextContent()
+	case trees.CommentNode, trees.DoctypeNode:
+		return ""
+	}
+
+	var content []string
+	for _, child := range markup.Children() {
+		content = append(content, Text(child))
+	}
+
+	return strings.Join(content, "")
+}
+
+// attr returns the value of the giving attribute of the markup.
+func attr(markup *trees.Markup, name string) string {
+	item, err := trees.GetAttr(markup, name)
+	if err != nil {
+		return ""
+	}
+
+	_, value := item.Render()
+	return value
+}
+
+// hasAttr returns true/false if the markup has the giving attribute.
+func hasAttr(markup *trees.Markup, name string) bool {
+	_, err := trees.GetAttr(markup, name)
+	return err == nil
+}
+
+//==============================================================================
+
+// location implements the gu.Location interface for the apps mounted by a
+// Driver, letting components navigate the app.
+type location struct {
+	driver *Driver
+}
+
+// Location returns the current location of the driver.
+func (l location) Location() router.PushEvent {
+	return l.driver.Location()
+}
+
+// Navigate navigates the driver to the provided path.
+func (l location) Navigate(pe router.PushDirectiveEvent) {
+	l.driver.Navigate(pe.To)
+}
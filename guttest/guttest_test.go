@@ -0,0 +1,297 @@
+package guttest_test
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/guttest"
+	"github.com/gu-io/gu/router"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/a11y"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/gu-io/gu/trees/forms"
+	"github.com/gu-io/gu/trees/property"
+	"github.com/influx6/faux/tests"
+)
+
+// counter defines a component which counts the clicks of its button.
+type counter struct {
+	gu.Reactive
+	count int
+}
+
+// Render returns the markup of the counter.
+func (c *counter) Render() *trees.Markup {
+	return elems.Div(
+		elems.Span(property.ClassAttr("count"), trees.NewText("%d", c.count)),
+		elems.Button(
+			property.ClassAttr("increment"),
+			trees.NewText("%s", "Add"),
+			events.ClickEvent(func() {
+				c.count++
+				c.Publish()
+			}),
+		),
+	)
+}
+
+// tasks defines a component which counts the clicks on its done tasks through
+// a event delegated by its list.
+type tasks struct {
+	gu.Reactive
+	clicks int
+}
+
+// Render returns the markup of the tasks.
+func (ts *tasks) Render() *trees.Markup {
+	return elems.Div(
+		elems.Span(property.ClassAttr("clicks"), trees.NewText("%d", ts.clicks)),
+		elems.UnorderedList(
+			elems.ListItem(property.ClassAttr("done"), elems.Span(trees.NewText("%s", "Write"))),
+			elems.ListItem(property.ClassAttr("todo"), trees.NewText("%s", "Review")),
+			events.ClickEvent(func() {
+				ts.clicks++
+				ts.Publish()
+			}, trees.EventTarget("li.done")),
+		),
+	)
+}
+
+type profile struct {
+	Name  string `form:"name" validate:"required"`
+	Terms bool   `form:"terms"`
+}
+
+// editor defines a component which edits a profile through a form.
+type editor struct {
+	*forms.Form
+	profile *profile
+}
+
+// Render returns the markup of the editor.
+func (e *editor) Render() *trees.Markup {
+	return elems.Form(
+		e.Input("name", e.Errors().State("name", "invalid")),
+		e.Input("terms"),
+		elems.Paragraph(property.ClassAttr("greeting"), trees.NewText("Hello %s", e.profile.Name)),
+	)
+}
+
+func TestDriverEvents(t *testing.T) {
+	app := gu.App("Counter", nil)
+	app.View(&counter{Reactive: gu.NewReactive()}, "/*", gu.BodyTarget)
+
+	driver := guttest.Mount(t, app, "/#")
+	defer driver.Unmount()
+
+	driver.AssertText("span.count", "0")
+	tests.Passed("Should have rendered counter")
+
+	for i := 1; i <= 3; i++ {
+		driver.Click("button.increment")
+		driver.AssertText("span.count", strconv.Itoa(i))
+	}
+	tests.Passed("Should have updated counter on each click")
+
+	if driver.Renders() != 4 {
+		tests.Failed("Should have rendered app once per update: %d", driver.Renders())
+	}
+	tests.Passed("Should have rendered app once per update")
+
+	driver.AssertCount("button", 1)
+	driver.AssertMissing("button.decrement")
+}
+
+func TestDriverDelegatedEvents(t *testing.T) {
+	app := gu.App("Tasks", nil)
+	app.View(&tasks{Reactive: gu.NewReactive()}, "/*", gu.BodyTarget)
+
+	driver := guttest.Mount(t, app, "/#")
+	defer driver.Unmount()
+
+	driver.Click("li.done")
+	driver.AssertText("span.clicks", "1")
+	tests.Passed("Should have delivered delegated event to element matching its selector")
+
+	driver.Click("li.todo")
+	driver.Click("ul")
+	driver.Click("li.done > span")
+	driver.AssertText("span.clicks", "1")
+	tests.Passed("Should have not delivered delegated event to elements not matching its selector")
+}
+
+func TestDriverForms(t *testing.T) {
+	var user profile
+
+	form, err := forms.New(&user)
+	if err != nil {
+		tests.Failed("Should have bound profile to form: %+q", err)
+	}
+
+	app := gu.App("Profile", nil)
+	app.View(&editor{Form: form, profile: &user}, "/*", gu.BodyTarget)
+
+	driver := guttest.Mount(t, app, "/#")
+	defer driver.Unmount()
+
+	driver.Input("input[name='name']", "Alex")
+	if user.Name != "Alex" {
+		tests.Failed("Should have bound input to profile: %q", user.Name)
+	}
+	tests.Passed("Should have bound input to profile")
+
+	driver.AssertAttr("input[name='name']", "value", "Alex")
+	driver.AssertAttr("input[name='name']", "aria-invalid", "false")
+	tests.Passed("Should have set input value")
+
+	driver.Input("input[name='name']", "")
+	driver.AssertAttr("input[name='name']", "aria-invalid", "true")
+	driver.AssertText("p.greeting", "Hello")
+	tests.Passed("Should have rendered validation state")
+
+	driver.Click("input[name='terms']")
+	if !user.Terms {
+		tests.Failed("Should have checked terms through click")
+	}
+	driver.AssertAttr("input[name='terms']", "checked", "checked")
+
+	driver.Click("input[name='terms']")
+	if user.Terms {
+		tests.Failed("Should have unchecked terms through click")
+	}
+	tests.Passed("Should have toggled checkbox through click")
+}
+
+func TestDriverNavigate(t *testing.T) {
+	app := gu.App("Pages", nil)
+	app.View(elems.Header(trees.NewText("%s", "Home")), "/home/*", gu.BodyTarget)
+	app.View(elems.Header(trees.NewText("%s", "About")), "/about/*", gu.BodyTarget)
+
+	driver := guttest.Mount(t, app, "/#/home")
+	defer driver.Unmount()
+
+	driver.AssertText("header", "Home")
+	tests.Passed("Should have rendered route of mounted path")
+
+	driver.Navigate("/#/about")
+	driver.AssertText("header", "About")
+	driver.AssertCount("header", 1)
+
+	if location := driver.Location(); location.Hash != "/about" {
+		tests.Failed("Should have updated location: %q", location.Hash)
+	}
+	tests.Passed("Should have rendered route of navigated path")
+
+	app.Navigate(router.PushDirectiveEvent{To: "/#/home"})
+	driver.AssertText("header", "Home")
+	tests.Passed("Should have navigated through app location")
+}
+
+func TestSnapshot(t *testing.T) {
+	first := guttest.Normalize(elems.Div(property.IDAttr("box"), property.ClassAttr("panel"), elems.Span(trees.NewText("%s", "Gu"))))
+	second := guttest.Normalize(elems.Div(property.ClassAttr("panel"), property.IDAttr("box"), elems.Span(trees.NewText("%s", "Gu"))))
+
+	if first != second {
+		tests.Failed("Should have normalized markup independent of uids and attribute order:\n%s\n%s", first, second)
+	}
+	tests.Passed("Should have normalized markup independent of uids and attribute order")
+
+	guttest.Snapshot(t, "counter", &counter{Reactive: gu.NewReactive(), count: 3})
+	tests.Passed("Should have matched snapshot of renderable")
+
+	app := gu.App("Counter", nil)
+	app.View(&counter{Reactive: gu.NewReactive()}, "/*", gu.BodyTarget)
+
+	driver := guttest.Mount(t, app, "/#")
+	defer driver.Unmount()
+
+	driver.Click("button.increment")
+	driver.Snapshot("counter_clicked", "body > div")
+	tests.Passed("Should have matched snapshot of driver tree")
+}
+
+func TestSnapshotUpdate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "guttest")
+	if err != nil {
+		tests.Failed("Should have created snapshot directory: %+q", err)
+	}
+	defer os.RemoveAll(dir)
+
+	previous := guttest.SnapshotDir
+	guttest.SnapshotDir = dir
+	defer func() { guttest.SnapshotDir = previous }()
+
+	if flag.Lookup("update") != nil {
+		tests.Failed("Should not have registered a global update flag")
+	}
+	tests.Passed("Should not have registered a global update flag")
+
+	os.Setenv(guttest.UpdateEnv, "1")
+	guttest.Snapshot(t, "box", elems.Div(property.IDAttr("box")))
+	os.Unsetenv(guttest.UpdateEnv)
+
+	if _, err := os.Stat(filepath.Join(dir, "box.html")); err != nil {
+		tests.Failed("Should have written snapshot with %s set: %+q", guttest.UpdateEnv, err)
+	}
+	tests.Passed("Should have written snapshot with %s set", guttest.UpdateEnv)
+
+	var record recorder
+	record.TB = t
+
+	guttest.Snapshot(&record, "box", elems.Div(property.IDAttr("panel")))
+	if len(record.errors) != 1 {
+		tests.Failed("Should have compared snapshot without %s set: %q", guttest.UpdateEnv, record.errors)
+	}
+	tests.Passed("Should have compared snapshot without %s set", guttest.UpdateEnv)
+}
+
+// recorder records the errors of a test instead of failing it.
+type recorder struct {
+	testing.TB
+	errors []string
+}
+
+// Errorf records the error.
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func TestDriverAccessible(t *testing.T) {
+	app := gu.App("Counter", nil)
+	app.View(&counter{Reactive: gu.NewReactive()}, "/*", gu.BodyTarget)
+
+	driver := guttest.Mount(t, app, "/#")
+	defer driver.Unmount()
+
+	driver.AssertAccessible()
+	tests.Passed("Should have found no accessibility issues in counter")
+
+	record := &recorder{TB: t}
+
+	images := gu.App("Images", nil)
+	images.View(elems.Div(elems.Image(property.SrcAttr("a.png")), elems.Button()), "/*", gu.BodyTarget)
+
+	imageDriver := guttest.Mount(record, images, "/#")
+	defer imageDriver.Unmount()
+
+	imageDriver.AssertAccessible()
+	if len(record.errors) != 2 || !strings.Contains(record.errors[0], "img-alt") || !strings.Contains(record.errors[1], "button-name") {
+		tests.Failed("Should have failed for image without alt and button without name: %q", record.errors)
+	}
+	tests.Passed("Should have failed for image without alt and button without name")
+
+	record.errors = nil
+	imageDriver.AssertAccessible(a11y.ButtonNames)
+	if len(record.errors) != 1 {
+		tests.Failed("Should have only run the provided rules: %q", record.errors)
+	}
+	tests.Passed("Should have only run the provided rules")
+}
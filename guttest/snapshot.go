@@ -0,0 +1,273 @@
+package guttest
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees"
+)
+
+// UpdateEnv defines the environment variable which, when set to 1, sets the
+// snapshots to be written into their golden files instead of being compared with
+// them, as in `GUTTEST_UPDATE=1 go test`.
+const UpdateEnv = "GUTTEST_UPDATE"
+
+// SnapshotDir defines the directory of the golden files of snapshots, relative
+// to the package under test.
+var SnapshotDir = filepath.Join("testdata", "snapshots")
+
+// Snapshot compares the normalized html of the value with the golden file of the
+// giving name, failing the test if they differ. The value can be a *trees.Markup,
+// a list of them or a gu.Renderable. Golden files are written when the tests run
+// with the UpdateEnv environment variable set to 1.
+func Snapshot(t testing.TB, name string, value interface{}) {
+	var content string
+
+	switch item := value.(type) {
+	case *trees.Markup:
+		content = Normalize(item)
+	case []*trees.Markup:
+		content = Normalize(item...)
+	case gu.Renderable:
+		content = Normalize(item.Render())
+	default:
+		t.Fatalf("Only *trees.Markup/[]*trees.Markup/gu.Renderable allowed for snapshot %q: %T", name, value)
+		return
+	}
+
+	file := filepath.Join(SnapshotDir, name+".html")
+
+	if os.Getenv(UpdateEnv) == "1" {
+		if err := os.MkdirAll(filepath.Dir(file), 0755); err != nil {
+			t.Fatalf("Should have created snapshot directory for %q: %+q", file, err)
+		}
+
+		if err := ioutil.WriteFile(file, []byte(content), 0644); err != nil {
+			t.Fatalf("Should have written snapshot %q: %+q", file, err)
+		}
+
+		return
+	}
+
+	expected, err := ioutil.ReadFile(file)
+	if err != nil {
+		t.Fatalf("Should have snapshot %q, run the tests with %s=1 to create it: %+q", file, UpdateEnv, err)
+		return
+	}
+
+	if string(expected) != content {
+		t.Errorf("Should have matched snapshot %q, run the tests with %s=1 if intended:\n%s", file, UpdateEnv, diff(string(expected), content))
+	}
+}
+
+// Snapshot compares the normalized html of the first element matching the
+// selector with the golden file of the giving name.
+func (d *Driver) Snapshot(name string, selector string) {
+	if target := d.Find(selector); target != nil {
+		Snapshot(d.t, name, target)
+	}
+}
+
+// diff returns the lines around the first difference between the expected and
+// received content.
+func diff(expected string, received string) string {
+	expectedLines := strings.Split(expected, "\n")
+	receivedLines := strings.Split(received, "\n")
+
+	var line int
+	for line < len(expectedLines) && line < len(receivedLines) && expectedLines[line] == receivedLines[line] {
+		line++
+	}
+
+	start := line - 2
+	if start < 0 {
+		start = 0
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "@@ line %d @@\n", line+1)
+
+	for index := start; index < line; index++ {
+		fmt.Fprintf(&out, "  %s\n", expectedLines[index])
+	}
+
+	for index := line; index < line+3 && index < len(expectedLines); index++ {
+		fmt.Fprintf(&out, "- %s\n", expectedLines[index])
+	}
+
+	for index := line; index < line+3 && index < len(receivedLines); index++ {
+		fmt.Fprintf(&out, "+ %s\n", receivedLines[index])
+	}
+
+	return out.String()
+}
+
+//==============================================================================
+
+// Normalize returns the html of the markups in a normalized form which is stable
+// between renders: hashes are left out, uids are replaced with their order of
+// appearance, attributes and styles are sorted, removed markup is skipped as in
+// the trees.Pretty mode, and elements are indented on their own lines.
+func Normalize(markup ...*trees.Markup) string {
+	n := normalizer{uids: make(map[string]string)}
+
+	for _, item := range markup {
+		n.collect(item)
+	}
+
+	for _, item := range markup {
+		n.write(item, 0)
+	}
+
+	return n.out.String()
+}
+
+// normalizer writes the normalized html of markups.
+type normalizer struct {
+	out  bytes.Buffer
+	uids map[string]string
+	keys []string
+}
+
+// collect assigns the stable uids of the markup and its children.
+func (n *normalizer) collect(markup *trees.Markup) {
+	if markup.Removed() || markup.Kind() != trees.ElementNode {
+		return
+	}
+
+	if _, ok := n.uids[markup.UID()]; !ok && markup.UID() != "" {
+		n.uids[markup.UID()] = fmt.Sprintf("%d", len(n.uids)+1)
+		n.keys = append(n.keys, markup.UID())
+	}
+
+	for _, child := range markup.Children() {
+		n.collect(child)
+	}
+}
+
+// replace returns the value with the uids it contains replaced with their
+// stable uids.
+func (n *normalizer) replace(value string) string {
+	for _, uid := range n.keys {
+		value = strings.Replace(value, "'"+uid+"'", "'"+n.uids[uid]+"'", -1)
+	}
+
+	return value
+}
+
+// write writes the markup at the giving depth.
+func (n *normalizer) write(markup *trees.Markup, depth int) {
+	if markup.Removed() {
+		return
+	}
+
+	indent := strings.Repeat("  ", depth)
+
+	switch markup.Kind() {
+	case trees.TextNode:
+		if text := strings.TrimSpace(markup.TextContent()); text != "" {
+			fmt.Fprintf(&n.out, "%s%s\n", indent, n.replace(text))
+		}
+
+		return
+	case trees.CommentNode:
+		fmt.Fprintf(&n.out, "%s<!--%s-->\n", indent, markup.TextContent())
+		return
+	case trees.DoctypeNode:
+		fmt.Fprintf(&n.out, "%s<!DOCTYPE %s>\n", indent, markup.TextContent())
+		return
+	}
+
+	fmt.Fprintf(&n.out, "%s<%s", indent, markup.Name())
+
+	if uid, ok := n.uids[markup.UID()]; ok {
+		fmt.Fprintf(&n.out, " uid=%q", uid)
+	}
+
+	for _, attr := range n.properties(markup.Attributes(), " %s=%q") {
+		n.out.WriteString(attr)
+	}
+
+	if styles := n.properties(markup.Styles(), " %s: %s;"); len(styles) != 0 {
+		fmt.Fprintf(&n.out, " style=%q", strings.TrimSpace(strings.Join(styles, "")))
+	}
+
+	if markup.AutoClosed() {
+		n.out.WriteString(" />\n")
+		return
+	}
+
+	text := strings.TrimSpace(markup.TextContent())
+
+	var children []*trees.Markup
+	for _, child := range markup.Children() {
+		if child.Removed() || child.UID() == markup.UID() && child.Kind() == trees.ElementNode {
+			continue
+		}
+
+		if child.Kind() == trees.TextNode && strings.TrimSpace(child.TextContent()) == "" {
+			continue
+		}
+
+		children = append(children, child)
+	}
+
+	if text == "" && len(children) == 0 {
+		fmt.Fprintf(&n.out, "></%s>\n", markup.Name())
+		return
+	}
+
+	if text == "" && len(children) == 1 && children[0].Kind() == trees.TextNode {
+		text = strings.TrimSpace(children[0].TextContent())
+		children = nil
+	}
+
+	if text != "" && len(children) == 0 {
+		fmt.Fprintf(&n.out, ">%s</%s>\n", n.replace(text), markup.Name())
+		return
+	}
+
+	n.out.WriteString(">\n")
+
+	if text != "" {
+		fmt.Fprintf(&n.out, "%s  %s\n", indent, n.replace(text))
+	}
+
+	for _, child := range children {
+		n.write(child, depth+1)
+	}
+
+	fmt.Fprintf(&n.out, "%s</%s>\n", indent, markup.Name())
+}
+
+// properties returns the formatted properties sorted by their names.
+func (n *normalizer) properties(props []trees.Property, format string) []string {
+	type property struct {
+		name  string
+		value string
+	}
+
+	var items []property
+	for _, prop := range props {
+		name, value := prop.Render()
+		items = append(items, property{name: name, value: n.replace(value)})
+	}
+
+	sort.SliceStable(items, func(i, j int) bool {
+		return items[i].name < items[j].name
+	})
+
+	formatted := make([]string, 0, len(items))
+	for _, item := range items {
+		formatted = append(formatted, fmt.Sprintf(format, item.name, item.value))
+	}
+
+	return formatted
+}
@@ -0,0 +1,128 @@
+package gu
+
+import "fmt"
+
+// AppInfo defines a struct which describes the state of a NApp and its views
+// for inspection by development tools.
+type AppInfo struct {
+	UUID     string     `json:"uuid"`
+	Title    string     `json:"title"`
+	Location string     `json:"location"`
+	Views    []ViewInfo `json:"views"`
+}
+
+// ViewInfo defines a struct which describes the state of a NView and its
+// components.
+type ViewInfo struct {
+	UUID       string          `json:"uuid"`
+	Route      string          `json:"route"`
+	Target     string          `json:"target"`
+	Active     bool            `json:"active"`
+	Renderable string          `json:"renderable"`
+	Components []ComponentInfo `json:"components"`
+}
+
+// ComponentInfo defines a struct which describes the state of a Component.
+type ComponentInfo struct {
+	UUID       string `json:"uuid"`
+	Route      string `json:"route"`
+	Target     string `json:"target"`
+	Order      string `json:"order"`
+	Rendered   bool   `json:"rendered"`
+	Renderable string `json:"renderable"`
+}
+
+// Inspect returns a AppInfo describing the current state of the app, its views
+// and their components. It is safe to call while the app renders.
+func (app *NApp) Inspect() AppInfo {
+	app.ml.RLock()
+	defer app.ml.RUnlock()
+
+	var info AppInfo
+	info.UUID = app.uuid
+	info.Title = app.title
+
+	info.Location = app.path
+
+	active := make(map[*NView]bool, len(app.activeViews))
+	for _, view := range app.activeViews {
+		active[view] = true
+	}
+
+	for _, view := range app.views {
+		vinfo := view.inspect()
+		vinfo.Active = active[view]
+		info.Views = append(info.Views, vinfo)
+	}
+
+	return info
+}
+
+// Inspect returns a ViewInfo describing the current state of the view and its
+// components. It is safe to call while the app renders.
+func (v *NView) Inspect() ViewInfo {
+	v.root.ml.RLock()
+	defer v.root.ml.RUnlock()
+
+	return v.inspect()
+}
+
+// inspect returns a ViewInfo describing the view, the lock of the app must be
+// held by the caller.
+func (v *NView) inspect() ViewInfo {
+	var info ViewInfo
+	info.UUID = v.uuid
+	info.Route = v.router.Pattern()
+	info.Target = v.target.String()
+	info.Renderable = fmt.Sprintf("%T", v.base)
+
+	for _, component := range v.beginComponents {
+		info.Components = append(info.Components, component.inspect(FirstOrder))
+	}
+
+	for _, component := range v.anyComponents {
+		info.Components = append(info.Components, component.inspect(AnyOrder))
+	}
+
+	for _, component := range v.lastComponents {
+		info.Components = append(info.Components, component.inspect(LastOrder))
+	}
+
+	return info
+}
+
+// inspect returns a ComponentInfo describing the component.
+func (c *Component) inspect(order RenderingOrder) ComponentInfo {
+	return ComponentInfo{
+		UUID:       c.uuid,
+		Route:      c.Router.Pattern(),
+		Target:     c.Target,
+		Order:      order.String(),
+		Rendered:   c.live != nil,
+		Renderable: fmt.Sprintf("%T", c.Rendering),
+	}
+}
+
+// String returns the name of the view target.
+func (t ViewTarget) String() string {
+	switch t {
+	case HeadTarget:
+		return "head"
+	case AfterBodyTarget:
+		return "after-body"
+	default:
+		return "body"
+	}
+}
+
+// String returns the name of the rendering order.
+func (o RenderingOrder) String() string {
+	switch o {
+	case FirstOrder:
+		return "first"
+	case LastOrder:
+		return "last"
+	default:
+		return "any"
+	}
+}
@@ -0,0 +1,58 @@
+package gu_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/property"
+	"github.com/influx6/faux/tests"
+)
+
+func TestInspect(t *testing.T) {
+	app := gu.App("Inspect", nil)
+
+	view := app.View(elems.Div(property.ClassAttr("wrapper")), "/*", gu.BodyTarget)
+	view.Component(elems.Section(), gu.FirstOrder, "/*", "")
+	view.Component(elems.Footer(), gu.LastOrder, "/*", "")
+
+	info := app.Inspect()
+	if info.Title != "Inspect" || len(info.Views) != 1 || info.Views[0].Active {
+		tests.Failed("Should have described inactive view of app: %+v", info)
+	}
+	tests.Passed("Should have described inactive view of app")
+
+	app.Render("/#")
+
+	info = app.Inspect()
+	if !info.Views[0].Active || info.Location != "/" {
+		tests.Failed("Should have described active view and location of app: %+v", info)
+	}
+	tests.Passed("Should have described active view and location of app")
+
+	components := info.Views[0].Components
+	if len(components) != 2 || components[0].Order != "first" || components[1].Order != "last" || !components[0].Rendered {
+		tests.Failed("Should have described rendered components of view in order: %+v", components)
+	}
+	tests.Passed("Should have described rendered components of view in order")
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+
+	go func() {
+		defer wg.Done()
+
+		for i := 0; i < 50; i++ {
+			app.Inspect()
+		}
+	}()
+
+	for i := 0; i < 50; i++ {
+		app.Render("/#")
+		view.Component(elems.Span(), gu.AnyOrder, "/*", "")
+	}
+
+	wg.Wait()
+	tests.Passed("Should have inspected app while rendering")
+}
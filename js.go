@@ -0,0 +1,272 @@
+package gu
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/notifications"
+)
+
+// ErrJSNotAllowed is returned when a function not within the whitelist of
+// JSFunctions is called.
+var ErrJSNotAllowed = errors.New("Javascript function is not allowed")
+
+// ErrJSTimeout is returned when the driver fails to return the result of a call
+// within the timeout of the JSChannel.
+var ErrJSTimeout = errors.New("Javascript call timed out")
+
+// DefaultJSTimeout defines the duration a JSChannel waits for the result of a
+// call when not provided a timeout.
+const DefaultJSTimeout = 5 * time.Second
+
+// JSFunction defines the name of a browser function callable through a
+// JSChannel.
+type JSFunction string
+
+// contains the browser functions which can be called through a JSChannel.
+const (
+	// JSFocus focuses the element matching the selector of the call.
+	JSFocus JSFunction = "focus"
+
+	// JSScrollIntoView scrolls the element matching the selector of the call into
+	// view, using the optional behaviour ("auto" or "smooth") provided as argument.
+	JSScrollIntoView JSFunction = "scrollIntoView"
+
+	// JSClipboardWrite copies the text provided as argument to the clipboard.
+	JSClipboardWrite JSFunction = "clipboard.writeText"
+
+	// JSLocalStorageGet returns the value of the key provided as argument from
+	// localStorage, being null if not set.
+	JSLocalStorageGet JSFunction = "localStorage.getItem"
+
+	// JSLocalStorageSet sets the key and value provided as arguments within
+	// localStorage.
+	JSLocalStorageSet JSFunction = "localStorage.setItem"
+
+	// JSLocalStorageRemove removes the key provided as argument from localStorage.
+	JSLocalStorageRemove JSFunction = "localStorage.removeItem"
+)
+
+// JSFunctions lists the browser functions which can be called through a
+// JSChannel, matching those provided by core.js.
+var JSFunctions = []JSFunction{
+	JSFocus,
+	JSScrollIntoView,
+	JSClipboardWrite,
+	JSLocalStorageGet,
+	JSLocalStorageSet,
+	JSLocalStorageRemove,
+}
+
+// Allowed returns true/false if the function is within JSFunctions.
+func (fn JSFunction) Allowed() bool {
+	for _, item := range JSFunctions {
+		if item == fn {
+			return true
+		}
+	}
+
+	return false
+}
+
+// jsCalls holds the count of the calls made through JSChannels, giving their
+// ids apart from NewKey, so calls do not consume the uids of a IDGenerator set
+// through trees.SetIDGenerator.
+var jsCalls int64
+
+// JSCall defines a struct which is used to notify drivers of a browser function
+// to be called for a App, which the driver sends to the browser using the
+// RenderCommand returned by JSRenderCommand.
+//@notification:event
+type JSCall struct {
+	ID       string     `json:"ID"`
+	AppID    string     `json:"AppID"`
+	Function JSFunction `json:"Function"`
+	Selector string     `json:"Selector,omitempty"`
+	Args     []string   `json:"Args,omitempty"`
+}
+
+// JSResult defines a struct which drivers dispatch with the result of a JSCall,
+// as sent by core.js through its SendChannel using the "JSResult" type.
+//@notification:event(where => ID)
+type JSResult struct {
+	ID    string          `json:"id"`
+	Value json.RawMessage `json:"value"`
+	Error string          `json:"error"`
+}
+
+// JSError defines the error returned when the called browser function fails.
+type JSError struct {
+	Function JSFunction
+	Message  string
+}
+
+// Error returns the message of the error.
+func (e JSError) Error() string {
+	return fmt.Sprintf("Javascript call %q failed: %s", e.Function, e.Message)
+}
+
+// JSChannel defines a struct which lets components call the whitelisted browser
+// functions of JSFunctions for their App, independent of the driver used. Calls
+// block until the driver returns the result or the Timeout elapses. As results
+// are dispatched like events, a driver delivering its messages one at a time
+// can not return the result of a blocking call made from an event handler until
+// the handler returns, such handlers must use CallAsync.
+type JSChannel struct {
+	AppUUID string
+	Timeout time.Duration
+}
+
+// WithTimeout returns a copy of the JSChannel which uses the provided timeout.
+func (js JSChannel) WithTimeout(timeout time.Duration) JSChannel {
+	js.Timeout = timeout
+	return js
+}
+
+// Call calls the giving browser function with the element matching the selector
+// and the provided arguments, returning the json of the value returned by it.
+func (js JSChannel) Call(fn JSFunction, selector string, args ...string) (json.RawMessage, error) {
+	type reply struct {
+		value json.RawMessage
+		err   error
+	}
+
+	replies := make(chan reply, 1)
+
+	js.CallAsync(fn, selector, func(value json.RawMessage, err error) {
+		replies <- reply{value: value, err: err}
+	}, args...)
+
+	result := <-replies
+	return result.value, result.err
+}
+
+// CallAsync calls the giving browser function with the element matching the
+// selector and the provided arguments without waiting for its result, which is
+// passed to the callback once returned by the driver or the Timeout elapses.
+// The callback is called once, from the goroutine delivering the result.
+func (js JSChannel) CallAsync(fn JSFunction, selector string, callback func(json.RawMessage, error), args ...string) {
+	if !fn.Allowed() {
+		callback(nil, ErrJSNotAllowed)
+		return
+	}
+
+	call := JSCall{
+		ID:       fmt.Sprintf("jscall-%d", atomic.AddInt64(&jsCalls, 1)),
+		AppID:    js.AppUUID,
+		Function: fn,
+		Selector: selector,
+		Args:     args,
+	}
+
+	timeout := js.Timeout
+	if timeout <= 0 {
+		timeout = DefaultJSTimeout
+	}
+
+	// ml is held until the subscription and timer are set, so a early timeout
+	// waits for them before removing them.
+	var ml sync.Mutex
+	var done bool
+	var timer *time.Timer
+	var remover common.Remover
+
+	finish := func(value json.RawMessage, err error) {
+		ml.Lock()
+		if done {
+			ml.Unlock()
+			return
+		}
+
+		done = true
+		timer.Stop()
+		remover.Remove()
+		ml.Unlock()
+
+		callback(value, err)
+	}
+
+	ml.Lock()
+
+	notifier := NewJSResultNotificationWhereID(call.ID)
+	notifier.Notify(NewJSResultHandler(func(result JSResult) {
+		if result.Error != "" {
+			finish(nil, JSError{Function: fn, Message: result.Error})
+			return
+		}
+
+		finish(result.Value, nil)
+	}))
+
+	remover = notifications.SubscribeWithRemover(notifier)
+	timer = time.AfterFunc(timeout, func() {
+		finish(nil, ErrJSTimeout)
+	})
+
+	ml.Unlock()
+
+	notifications.Dispatch(call)
+}
+
+// Focus focuses the element matching the selector.
+func (js JSChannel) Focus(selector string) error {
+	_, err := js.Call(JSFocus, selector)
+	return err
+}
+
+// ScrollIntoView scrolls the element matching the selector into view, smoothly
+// if smooth is true.
+func (js JSChannel) ScrollIntoView(selector string, smooth bool) error {
+	behaviour := "auto"
+	if smooth {
+		behaviour = "smooth"
+	}
+
+	_, err := js.Call(JSScrollIntoView, selector, behaviour)
+	return err
+}
+
+// CopyToClipboard copies the text to the clipboard.
+func (js JSChannel) CopyToClipboard(text string) error {
+	_, err := js.Call(JSClipboardWrite, "", text)
+	return err
+}
+
+// LocalStorage returns the value of the key from localStorage, returning false
+// if the key is not set.
+func (js JSChannel) LocalStorage(key string) (string, bool, error) {
+	value, err := js.Call(JSLocalStorageGet, "", key)
+	if err != nil {
+		return "", false, err
+	}
+
+	var item *string
+	if len(value) != 0 {
+		if err := json.Unmarshal(value, &item); err != nil {
+			return "", false, err
+		}
+	}
+
+	if item == nil {
+		return "", false, nil
+	}
+
+	return *item, true, nil
+}
+
+// SetLocalStorage sets the key to the value within localStorage.
+func (js JSChannel) SetLocalStorage(key string, value string) error {
+	_, err := js.Call(JSLocalStorageSet, "", key, value)
+	return err
+}
+
+// RemoveLocalStorage removes the key from localStorage.
+func (js JSChannel) RemoveLocalStorage(key string) error {
+	_, err := js.Call(JSLocalStorageRemove, "", key)
+	return err
+}
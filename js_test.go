@@ -0,0 +1,229 @@
+package gu_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/trees"
+	"github.com/influx6/faux/tests"
+)
+
+// storage defines a driver which answers the javascript calls of a app with a
+// in-memory localStorage.
+type storage struct {
+	appID string
+	items map[string]string
+	calls []gu.JSCall
+}
+
+// Receive answers the call, dispatching the result asynchronously as drivers
+// receive it from the browser.
+func (s *storage) Receive(call gu.JSCall) {
+	if call.AppID != s.appID {
+		return
+	}
+
+	s.calls = append(s.calls, call)
+
+	result := gu.JSResult{ID: call.ID, Value: json.RawMessage("null")}
+
+	switch call.Function {
+	case gu.JSLocalStorageGet:
+		if value, ok := s.items[call.Args[0]]; ok {
+			result.Value, _ = json.Marshal(value)
+		}
+	case gu.JSLocalStorageSet:
+		s.items[call.Args[0]] = call.Args[1]
+	case gu.JSFocus:
+		result.Error = "no element matches " + call.Selector
+	case gu.JSClipboardWrite:
+		return
+	}
+
+	go notifications.Dispatch(result)
+}
+
+func TestJSChannel(t *testing.T) {
+	driver := &storage{appID: "js-app", items: make(map[string]string)}
+
+	remover := notifications.SubscribeWithRemover(gu.NewJSCallHandler(driver.Receive))
+	defer remover.Remove()
+
+	js := gu.JSChannel{AppUUID: "js-app"}
+
+	if err := js.SetLocalStorage("theme", "dark"); err != nil {
+		tests.Failed("Should have set localStorage item: %+q", err)
+	}
+	tests.Passed("Should have set localStorage item")
+
+	if value, ok, err := js.LocalStorage("theme"); err != nil || !ok || value != "dark" {
+		tests.Failed("Should have retrieved localStorage item: %q %t %+q", value, ok, err)
+	}
+	tests.Passed("Should have retrieved localStorage item")
+
+	if _, ok, err := js.LocalStorage("lang"); err != nil || ok {
+		tests.Failed("Should have reported missing localStorage item: %t %+q", ok, err)
+	}
+	tests.Passed("Should have reported missing localStorage item")
+
+	err := js.Focus("#missing")
+	if jserr, ok := err.(gu.JSError); !ok || jserr.Function != gu.JSFocus || jserr.Message != "no element matches #missing" {
+		tests.Failed("Should have propagated javascript error: %+q", err)
+	}
+	tests.Passed("Should have propagated javascript error")
+
+	if err := js.WithTimeout(20 * time.Millisecond).CopyToClipboard("hello"); err != gu.ErrJSTimeout {
+		tests.Failed("Should have timed out waiting for result: %+q", err)
+	}
+	tests.Passed("Should have timed out waiting for result")
+
+	total := len(driver.calls)
+	if _, err := js.Call(gu.JSFunction("eval"), "", "alert(1)"); err != gu.ErrJSNotAllowed {
+		tests.Failed("Should have refused function not whitelisted: %+q", err)
+	}
+	tests.Passed("Should have refused function not whitelisted")
+
+	if len(driver.calls) != total {
+		tests.Failed("Should have not sent refused call to driver")
+	}
+	tests.Passed("Should have not sent refused call to driver")
+}
+
+func TestJSChannelWithinHandler(t *testing.T) {
+	driver := &storage{appID: "js-handler-app", items: map[string]string{"theme": "dark"}}
+
+	remover := notifications.SubscribeWithRemover(gu.NewJSCallHandler(driver.Receive))
+	defer remover.Remove()
+
+	js := gu.JSChannel{AppUUID: "js-handler-app"}
+
+	values := make(chan string, 1)
+	handler := notifications.SubscribeWithRemover(notifications.NewAppEventHandler(func(ev notifications.AppEvent) {
+		if ev.UUID != "js-handler-app" {
+			return
+		}
+
+		value, _, err := js.LocalStorage("theme")
+		if err != nil {
+			value = err.Error()
+		}
+
+		values <- value
+	}))
+	defer handler.Remove()
+
+	go notifications.Dispatch(notifications.AppEvent{UUID: "js-handler-app"})
+
+	select {
+	case value := <-values:
+		if value != "dark" {
+			tests.Failed("Should have retrieved localStorage item within handler: %q", value)
+		}
+	case <-time.After(2 * time.Second):
+		tests.Failed("Should have called javascript within handler without deadlocking")
+	}
+	tests.Passed("Should have called javascript within handler")
+}
+
+func TestJSChannelWithinSerialDriver(t *testing.T) {
+	driver := &storage{appID: "js-serial-app", items: map[string]string{"theme": "dark"}}
+
+	// messages is delivered one message at a time, as drivers reading from a
+	// single connection deliver the events and results sent by the browser.
+	messages := make(chan interface{}, 10)
+	defer close(messages)
+
+	go func() {
+		for message := range messages {
+			notifications.Dispatch(message)
+		}
+	}()
+
+	remover := notifications.SubscribeWithRemover(gu.NewJSCallHandler(func(call gu.JSCall) {
+		if call.AppID != driver.appID {
+			return
+		}
+
+		driver.calls = append(driver.calls, call)
+
+		value, _ := json.Marshal(driver.items[call.Args[0]])
+		messages <- gu.JSResult{ID: call.ID, Value: value}
+	}))
+	defer remover.Remove()
+
+	js := gu.JSChannel{AppUUID: "js-serial-app", Timeout: 50 * time.Millisecond}
+
+	blocked := make(chan error, 1)
+	values := make(chan string, 1)
+
+	handler := notifications.SubscribeWithRemover(notifications.NewAppEventHandler(func(ev notifications.AppEvent) {
+		switch ev.UUID {
+		case "js-serial-blocking":
+			_, err := js.Call(gu.JSLocalStorageGet, "", "theme")
+			blocked <- err
+		case "js-serial-async":
+			js.CallAsync(gu.JSLocalStorageGet, "", func(value json.RawMessage, err error) {
+				var item string
+				if err == nil {
+					err = json.Unmarshal(value, &item)
+				}
+
+				if err != nil {
+					item = err.Error()
+				}
+
+				values <- item
+			}, "theme")
+		}
+	}))
+	defer handler.Remove()
+
+	messages <- notifications.AppEvent{UUID: "js-serial-blocking"}
+
+	select {
+	case err := <-blocked:
+		if err != gu.ErrJSTimeout {
+			tests.Failed("Should have timed out blocking on result within serial handler: %+q", err)
+		}
+	case <-time.After(2 * time.Second):
+		tests.Failed("Should have timed out blocking on result within serial handler")
+	}
+	tests.Passed("Should have timed out blocking on result within serial handler")
+
+	messages <- notifications.AppEvent{UUID: "js-serial-async"}
+
+	select {
+	case value := <-values:
+		if value != "dark" {
+			tests.Failed("Should have retrieved localStorage item asynchronously within serial handler: %q", value)
+		}
+	case <-time.After(2 * time.Second):
+		tests.Failed("Should have retrieved localStorage item asynchronously within serial handler")
+	}
+	tests.Passed("Should have retrieved localStorage item asynchronously within serial handler")
+}
+
+func TestJSChannelKeepsSeededIDs(t *testing.T) {
+	driver := &storage{appID: "js-seeded-app", items: make(map[string]string)}
+
+	remover := notifications.SubscribeWithRemover(gu.NewJSCallHandler(driver.Receive))
+	defer remover.Remove()
+
+	trees.SetIDGenerator(trees.NewSeededIDs(4))
+	defer trees.SetIDGenerator(nil)
+
+	js := gu.JSChannel{AppUUID: "js-seeded-app"}
+
+	if err := js.SetLocalStorage("theme", "dark"); err != nil {
+		tests.Failed("Should have set localStorage item: %+q", err)
+	}
+	tests.Passed("Should have set localStorage item")
+
+	if uid, expected := gu.NewKey(), trees.NewSeededIDs(4).UID(); uid != expected {
+		tests.Failed("Should have not consumed seeded uids for javascript calls: %q != %q", uid, expected)
+	}
+	tests.Passed("Should have not consumed seeded uids for javascript calls")
+}
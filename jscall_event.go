@@ -0,0 +1,149 @@
+package gu
+
+import (
+	"sync"
+)
+
+// JSCallSubscriber defines a interface that which is used to subscribe specifically for
+// events  JSCall type.
+type JSCallSubscriber interface {
+	Receive(JSCall)
+}
+
+//=========================================================================================================
+
+// JSCallHandler defines a structure type which implements the
+// JSCallSubscriber interface and the EventDistributor interface.
+type JSCallHandler struct {
+	handle func(JSCall)
+}
+
+// NewJSCallHandler returns a new instance of a JSCallHandler.
+func NewJSCallHandler(fn func(JSCall)) *JSCallHandler {
+	return &JSCallHandler{
+		handle: fn,
+	}
+}
+
+// Receive takes the giving value and execute it against the underline handler.
+func (sn *JSCallHandler) Receive(elem JSCall) {
+	sn.handle(elem)
+}
+
+// Handle takes the giving value and asserts the expected value to match the
+// JSCall type then passes it to the Receive method.
+func (sn *JSCallHandler) Handle(receive interface{}) {
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// JSCall type, returning true/false if it was accepted.
+func (sn *JSCallHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(JSCall)
+	if !ok {
+		return false
+	}
+
+	sn.Receive(elem)
+	return true
+}
+
+//=========================================================================================================
+
+// JSCallNotification defines a structure type which must be used to
+// receive JSCall type has a event.
+type JSCallNotification struct {
+	sml        sync.Mutex
+	subs       []JSCallSubscriber
+	validation func(JSCall) bool
+	register   map[JSCallSubscriber]int
+}
+
+// NewJSCallNotificationWith returns a new instance of JSCallNotification
+// which only delivers events that pass the provided validation.
+func NewJSCallNotificationWith(validation func(JSCall) bool) *JSCallNotification {
+	var elem JSCallNotification
+
+	elem.validation = validation
+	elem.register = make(map[JSCallSubscriber]int, 0)
+
+	return &elem
+}
+
+// NewJSCallNotification returns a new instance of JSCallNotification.
+func NewJSCallNotification() *JSCallNotification {
+	var elem JSCallNotification
+	elem.register = make(map[JSCallSubscriber]int, 0)
+
+	return &elem
+}
+
+// UnNotify removes the given subscriber from the notification's list if found from future events.
+func (sn *JSCallNotification) UnNotify(sub JSCallSubscriber) {
+	sn.do(func() {
+		index, ok := sn.register[sub]
+		if !ok {
+			return
+		}
+
+		delete(sn.register, sub)
+		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
+	})
+}
+
+// Notify adds the given subscriber into the notification list and will await an update of
+// a new event of the given JSCall type.
+func (sn *JSCallNotification) Notify(sub JSCallSubscriber) {
+	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
+		sn.register[sub] = len(sn.subs)
+		sn.subs = append(sn.subs, sub)
+	})
+}
+
+// Handle takes the giving value and asserts the expected value to be of
+// the type and pass on to it's underline subscribers else ignoring the event.
+func (sn *JSCallNotification) Handle(elem interface{}) {
+	sn.Accept(elem)
+}
+
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *JSCallNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(JSCall)
+	if !ok {
+		return false
+	}
+
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
+	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
+}
+
+// do performs action with the mutex locked and unlocked appropriately, ensuring safe
+// concurrent access.
+func (sn *JSCallNotification) do(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	sn.sml.Lock()
+	defer sn.sml.Unlock()
+
+	fn()
+}
@@ -0,0 +1,53 @@
+package gu
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestJSCallNotification validates the delivery, validation and filters of
+// JSCall events through the generated JSCallHandler and JSCallNotification.
+func TestJSCallNotification(t *testing.T) {
+	var received int
+	handler := NewJSCallHandler(func(JSCall) {
+		received++
+	})
+
+	var elem JSCall
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only JSCall events: %d", received)
+	}
+	tests.Passed("Should have accepted only JSCall events")
+
+	received = 0
+
+	notifier := NewJSCallNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered JSCall event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered JSCall event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering JSCall events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering JSCall events after UnNotify")
+
+	invalid := NewJSCallNotificationWith(func(JSCall) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered JSCall event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered JSCall event failing validation")
+}
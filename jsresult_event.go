@@ -0,0 +1,157 @@
+package gu
+
+import (
+	"sync"
+)
+
+// JSResultSubscriber defines a interface that which is used to subscribe specifically for
+// events  JSResult type.
+type JSResultSubscriber interface {
+	Receive(JSResult)
+}
+
+//=========================================================================================================
+
+// JSResultHandler defines a structure type which implements the
+// JSResultSubscriber interface and the EventDistributor interface.
+type JSResultHandler struct {
+	handle func(JSResult)
+}
+
+// NewJSResultHandler returns a new instance of a JSResultHandler.
+func NewJSResultHandler(fn func(JSResult)) *JSResultHandler {
+	return &JSResultHandler{
+		handle: fn,
+	}
+}
+
+// Receive takes the giving value and execute it against the underline handler.
+func (sn *JSResultHandler) Receive(elem JSResult) {
+	sn.handle(elem)
+}
+
+// Handle takes the giving value and asserts the expected value to match the
+// JSResult type then passes it to the Receive method.
+func (sn *JSResultHandler) Handle(receive interface{}) {
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// JSResult type, returning true/false if it was accepted.
+func (sn *JSResultHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(JSResult)
+	if !ok {
+		return false
+	}
+
+	sn.Receive(elem)
+	return true
+}
+
+//=========================================================================================================
+
+// JSResultNotification defines a structure type which must be used to
+// receive JSResult type has a event.
+type JSResultNotification struct {
+	sml        sync.Mutex
+	subs       []JSResultSubscriber
+	validation func(JSResult) bool
+	register   map[JSResultSubscriber]int
+}
+
+// NewJSResultNotificationWith returns a new instance of JSResultNotification
+// which only delivers events that pass the provided validation.
+func NewJSResultNotificationWith(validation func(JSResult) bool) *JSResultNotification {
+	var elem JSResultNotification
+
+	elem.validation = validation
+	elem.register = make(map[JSResultSubscriber]int, 0)
+
+	return &elem
+}
+
+// NewJSResultNotificationWhereID returns a new instance of JSResultNotification
+// which only delivers events whose ID field equals the provided value.
+func NewJSResultNotificationWhereID(value string) *JSResultNotification {
+	return NewJSResultNotificationWith(func(elem JSResult) bool {
+		return elem.ID == value
+	})
+}
+
+// NewJSResultNotification returns a new instance of JSResultNotification.
+func NewJSResultNotification() *JSResultNotification {
+	var elem JSResultNotification
+	elem.register = make(map[JSResultSubscriber]int, 0)
+
+	return &elem
+}
+
+// UnNotify removes the given subscriber from the notification's list if found from future events.
+func (sn *JSResultNotification) UnNotify(sub JSResultSubscriber) {
+	sn.do(func() {
+		index, ok := sn.register[sub]
+		if !ok {
+			return
+		}
+
+		delete(sn.register, sub)
+		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
+	})
+}
+
+// Notify adds the given subscriber into the notification list and will await an update of
+// a new event of the given JSResult type.
+func (sn *JSResultNotification) Notify(sub JSResultSubscriber) {
+	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
+		sn.register[sub] = len(sn.subs)
+		sn.subs = append(sn.subs, sub)
+	})
+}
+
+// Handle takes the giving value and asserts the expected value to be of
+// the type and pass on to it's underline subscribers else ignoring the event.
+func (sn *JSResultNotification) Handle(elem interface{}) {
+	sn.Accept(elem)
+}
+
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *JSResultNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(JSResult)
+	if !ok {
+		return false
+	}
+
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
+	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
+}
+
+// do performs action with the mutex locked and unlocked appropriately, ensuring safe
+// concurrent access.
+func (sn *JSResultNotification) do(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	sn.sml.Lock()
+	defer sn.sml.Unlock()
+
+	fn()
+}
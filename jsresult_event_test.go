@@ -0,0 +1,71 @@
+package gu
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestJSResultNotification validates the delivery, validation and filters of
+// JSResult events through the generated JSResultHandler and JSResultNotification.
+func TestJSResultNotification(t *testing.T) {
+	var received int
+	handler := NewJSResultHandler(func(JSResult) {
+		received++
+	})
+
+	var elem JSResult
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only JSResult events: %d", received)
+	}
+	tests.Passed("Should have accepted only JSResult events")
+
+	received = 0
+
+	notifier := NewJSResultNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered JSResult event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered JSResult event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering JSResult events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering JSResult events after UnNotify")
+
+	invalid := NewJSResultNotificationWith(func(JSResult) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered JSResult event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered JSResult event failing validation")
+
+	whereID := NewJSResultNotificationWhereID(elem.ID)
+	whereID.Notify(handler)
+
+	if !whereID.Accept(elem) || received != 2 {
+		tests.Failed("Should have delivered JSResult event with matching ID: %d", received)
+	}
+	tests.Passed("Should have delivered JSResult event with matching ID")
+
+	received = 1
+
+	unmatchedID := NewJSResultNotificationWhereID("unmatched")
+	unmatchedID.Notify(handler)
+
+	if unmatchedID.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered JSResult event with different ID: %d", received)
+	}
+	tests.Passed("Should have not delivered JSResult event with different ID")
+}
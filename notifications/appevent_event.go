@@ -1,6 +1,8 @@
 package notifications
 
-import "sync"
+import (
+	"sync"
+)
 
 // AppEventSubscriber defines a interface that which is used to subscribe specifically for
 // events  AppEvent type.
@@ -31,9 +33,19 @@ func (sn *AppEventHandler) Receive(elem AppEvent) {
 // Handle takes the giving value and asserts the expected value to match the
 // AppEvent type then passes it to the Receive method.
 func (sn *AppEventHandler) Handle(receive interface{}) {
-	if elem, ok := receive.(AppEvent); ok {
-		sn.Receive(elem)
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// AppEvent type, returning true/false if it was accepted.
+func (sn *AppEventHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(AppEvent)
+	if !ok {
+		return false
 	}
+
+	sn.Receive(elem)
+	return true
 }
 
 //=========================================================================================================
@@ -47,7 +59,8 @@ type AppEventNotification struct {
 	register   map[AppEventSubscriber]int
 }
 
-// NewAppEventNotificationWith returns a new instance of AppEventNotification.
+// NewAppEventNotificationWith returns a new instance of AppEventNotification
+// which only delivers events that pass the provided validation.
 func NewAppEventNotificationWith(validation func(AppEvent) bool) *AppEventNotification {
 	var elem AppEventNotification
 
@@ -57,7 +70,15 @@ func NewAppEventNotificationWith(validation func(AppEvent) bool) *AppEventNotifi
 	return &elem
 }
 
-// NewAppEventNotification returns a new instance of NewAppEventNotification.
+// NewAppEventNotificationWhereUUID returns a new instance of AppEventNotification
+// which only delivers events whose UUID field equals the provided value.
+func NewAppEventNotificationWhereUUID(value string) *AppEventNotification {
+	return NewAppEventNotificationWith(func(elem AppEvent) bool {
+		return elem.UUID == value
+	})
+}
+
+// NewAppEventNotification returns a new instance of AppEventNotification.
 func NewAppEventNotification() *AppEventNotification {
 	var elem AppEventNotification
 	elem.register = make(map[AppEventSubscriber]int, 0)
@@ -73,7 +94,12 @@ func (sn *AppEventNotification) UnNotify(sub AppEventSubscriber) {
 			return
 		}
 
+		delete(sn.register, sub)
 		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
 	})
 }
 
@@ -81,6 +107,10 @@ func (sn *AppEventNotification) UnNotify(sub AppEventSubscriber) {
 // a new event of the given AppEvent type.
 func (sn *AppEventNotification) Notify(sub AppEventSubscriber) {
 	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
 		sn.register[sub] = len(sn.subs)
 		sn.subs = append(sn.subs, sub)
 	})
@@ -89,23 +119,28 @@ func (sn *AppEventNotification) Notify(sub AppEventSubscriber) {
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
 func (sn *AppEventNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(AppEvent); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	sn.Accept(elem)
+}
 
-			return
-		}
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *AppEventNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(AppEvent)
+	if !ok {
+		return false
+	}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
 	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
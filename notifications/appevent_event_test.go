@@ -0,0 +1,71 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestAppEventNotification validates the delivery, validation and filters of
+// AppEvent events through the generated AppEventHandler and AppEventNotification.
+func TestAppEventNotification(t *testing.T) {
+	var received int
+	handler := NewAppEventHandler(func(AppEvent) {
+		received++
+	})
+
+	var elem AppEvent
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only AppEvent events: %d", received)
+	}
+	tests.Passed("Should have accepted only AppEvent events")
+
+	received = 0
+
+	notifier := NewAppEventNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered AppEvent event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered AppEvent event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering AppEvent events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering AppEvent events after UnNotify")
+
+	invalid := NewAppEventNotificationWith(func(AppEvent) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered AppEvent event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered AppEvent event failing validation")
+
+	whereUUID := NewAppEventNotificationWhereUUID(elem.UUID)
+	whereUUID.Notify(handler)
+
+	if !whereUUID.Accept(elem) || received != 2 {
+		tests.Failed("Should have delivered AppEvent event with matching UUID: %d", received)
+	}
+	tests.Passed("Should have delivered AppEvent event with matching UUID")
+
+	received = 1
+
+	unmatchedUUID := NewAppEventNotificationWhereUUID("unmatched")
+	unmatchedUUID.Notify(handler)
+
+	if unmatchedUUID.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered AppEvent event with different UUID: %d", received)
+	}
+	tests.Passed("Should have not delivered AppEvent event with different UUID")
+}
@@ -1,7 +1,9 @@
 package notifications
 
 import (
+	"fmt"
 	"sync"
+	"time"
 
 	"github.com/gu-io/gu/common"
 )
@@ -54,7 +56,7 @@ func (l listenerRemover) Remove() {
 
 // Dispatch emits a event into the dispatch callback listeners.
 func Dispatch(q interface{}) {
-	dispatch.Handle(q)
+	dispatch.handle(q, 1)
 }
 
 // EventDistributor defines a interface that exposes a single method which
@@ -63,12 +65,22 @@ type EventDistributor interface {
 	Handle(interface{})
 }
 
+// Accepter defines a EventDistributor which reports if it accepted the items it
+// handles, so traces only count it as a subscriber of the items it accepted.
+// EventDistributors which are not Accepters are counted for every item.
+type Accepter interface {
+	EventDistributor
+	Accept(interface{}) bool
+}
+
 // Notifications defines a central delivery pipe where all types of event notifications
 // will pass through to be delivered to all EventDistributor listening.
 type Notifications struct {
 	ml       sync.Mutex
 	sources  []EventDistributor
 	register map[EventDistributor]int
+	tl       sync.RWMutex
+	tracer   *Tracer
 }
 
 // New returns a new instance of a Notification primitive.
@@ -86,8 +98,12 @@ func (n *Notifications) UnNotify(source EventDistributor) {
 			return
 		}
 
-		n.sources[index] = nil
+		delete(n.register, source)
 		n.sources = append(n.sources[:index], n.sources[index+1:]...)
+
+		for next, item := range n.sources[index:] {
+			n.register[item] = index + next
+		}
 	})
 }
 
@@ -99,17 +115,63 @@ func (n *Notifications) Notify(source EventDistributor) {
 	})
 }
 
+// UseTracer sets the Tracer which will record all items handled by the
+// notification system, a nil value disables tracing.
+func (n *Notifications) UseTracer(tracer *Tracer) {
+	n.tl.Lock()
+	n.tracer = tracer
+	n.tl.Unlock()
+}
+
 // Handle will publish giving type to all internal EventDistributor who are
 // expected to convert the needed interface{} into expected type for consumption
 // for their internal state or operations.
 func (n *Notifications) Handle(item interface{}) {
+	n.handle(item, 1)
+}
+
+// handle delivers the item to all EventDistributors, recording a trace with the
+// caller skip frames above it if a Tracer is set. The EventDistributors are
+// called outside of the lock, allowing them to dispatch further items.
+func (n *Notifications) handle(item interface{}, skip int) {
+	n.tl.RLock()
+	tracer := n.tracer
+	n.tl.RUnlock()
+
+	var sources []EventDistributor
 	n.do(func() {
-		for _, source := range n.sources {
-			if source != nil {
-				source.Handle(item)
+		sources = append(sources, n.sources...)
+	})
+
+	if tracer == nil {
+		for _, source := range sources {
+			source.Handle(item)
+		}
+
+		return
+	}
+
+	trace := Trace{
+		Type:    fmt.Sprintf("%T", item),
+		Source:  callerSource(skip + 1),
+		Started: time.Now(),
+	}
+
+	for _, source := range sources {
+		if accepter, ok := source.(Accepter); ok {
+			if accepter.Accept(item) {
+				trace.Subscribers++
 			}
+
+			continue
 		}
-	})
+
+		source.Handle(item)
+		trace.Subscribers++
+	}
+
+	trace.Duration = time.Since(trace.Started)
+	tracer.Record(trace)
 }
 
 // do performs the needed function call guarded by a mutex call block.
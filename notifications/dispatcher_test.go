@@ -0,0 +1,41 @@
+package notifications_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/notifications"
+	"github.com/influx6/faux/tests"
+)
+
+func TestNestedDispatch(t *testing.T) {
+	var received []string
+
+	first := notifications.SubscribeWithRemover(notifications.NewAppEventHandler(func(ev notifications.AppEvent) {
+		received = append(received, ev.UUID)
+		if ev.UUID == "outer" {
+			notifications.Dispatch(notifications.AppEvent{UUID: "inner"})
+		}
+	}))
+
+	second := notifications.SubscribeWithRemover(notifications.NewAppEventHandler(func(ev notifications.AppEvent) {
+		received = append(received, "second:"+ev.UUID)
+	}))
+	defer second.Remove()
+
+	notifications.Dispatch(notifications.AppEvent{UUID: "outer"})
+
+	if len(received) != 4 {
+		tests.Failed("Should have delivered events dispatched by subscribers: %v", received)
+	}
+	tests.Passed("Should have delivered events dispatched by subscribers")
+
+	first.Remove()
+	received = nil
+
+	notifications.Dispatch(notifications.AppEvent{UUID: "last"})
+
+	if len(received) != 1 || received[0] != "second:last" {
+		tests.Failed("Should have kept later subscribers after removing earlier ones: %v", received)
+	}
+	tests.Passed("Should have kept later subscribers after removing earlier ones")
+}
@@ -3,7 +3,7 @@ package notifications
 // AppEvent defines a struct to contain a event which occurs to be delivered to
 // a giving AppNotification instance.
 //
-//@notification:event
+//@notification:event(where => UUID)
 type AppEvent struct {
 	UUID  string
 	Event interface{}
@@ -17,9 +17,7 @@ func (sn *AppEventNotification) Deliver(uuid string, item interface{}) {
 // AppNotification defines a structure which provides a local notification
 // framework for the pubsub.
 func AppNotification(uid string) *AppEventNotification {
-	app := NewAppEventNotificationWith(func(ev AppEvent) bool {
-		return ev.UUID == uid
-	})
+	app := NewAppEventNotificationWhereUUID(uid)
 
 	Subscribe(app)
 
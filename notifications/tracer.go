@@ -0,0 +1,123 @@
+package notifications
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"sync"
+	"time"
+)
+
+// defaultTraceSize defines the total traces kept by a Tracer when provided a
+// invalid size.
+const defaultTraceSize = 512
+
+// Trace defines a struct which records the details of a single dispatch
+// delivered through a Notifications instance, where Subscribers counts the
+// EventDistributors which accepted it.
+type Trace struct {
+	Type        string        `json:"type"`
+	Source      string        `json:"source"`
+	Subscribers int           `json:"subscribers"`
+	Started     time.Time     `json:"started"`
+	Duration    time.Duration `json:"duration"`
+}
+
+// String returns a readable representation of the trace.
+func (t Trace) String() string {
+	return fmt.Sprintf("%s from %s to %d subscribers in %s", t.Type, t.Source, t.Subscribers, t.Duration)
+}
+
+// Tracer defines a ring buffer which stores the last set of traces recorded
+// by the notification system, discarding the oldest once full.
+type Tracer struct {
+	ml     sync.Mutex
+	next   int
+	total  int
+	traces []Trace
+}
+
+// NewTracer returns a new instance of a Tracer which keeps the provided
+// size of traces.
+func NewTracer(size int) *Tracer {
+	if size <= 0 {
+		size = defaultTraceSize
+	}
+
+	return &Tracer{
+		traces: make([]Trace, 0, size),
+	}
+}
+
+// Record adds the giving trace into the tracer, replacing the oldest trace if
+// the buffer is already full.
+func (t *Tracer) Record(trace Trace) {
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	t.total++
+
+	if len(t.traces) < cap(t.traces) {
+		t.traces = append(t.traces, trace)
+		return
+	}
+
+	t.traces[t.next] = trace
+	t.next = (t.next + 1) % len(t.traces)
+}
+
+// Traces returns a copy of the recorded traces ordered from the oldest to the
+// most recent.
+func (t *Tracer) Traces() []Trace {
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	traces := make([]Trace, 0, len(t.traces))
+	traces = append(traces, t.traces[t.next:]...)
+	traces = append(traces, t.traces[:t.next]...)
+
+	return traces
+}
+
+// Total returns the total traces recorded by the tracer including those
+// discarded from the buffer.
+func (t *Tracer) Total() int {
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	return t.total
+}
+
+// Reset empties the tracer of all recorded traces.
+func (t *Tracer) Reset() {
+	t.ml.Lock()
+	defer t.ml.Unlock()
+
+	t.next = 0
+	t.total = 0
+	t.traces = t.traces[:0]
+}
+
+// EnableTracing sets a new Tracer of the provided size on the default
+// dispatcher and returns it, every dispatch made afterwards gets recorded.
+func EnableTracing(size int) *Tracer {
+	tracer := NewTracer(size)
+	dispatch.UseTracer(tracer)
+	return tracer
+}
+
+// DisableTracing removes any Tracer set on the default dispatcher.
+func DisableTracing() {
+	dispatch.UseTracer(nil)
+}
+
+// callerSource returns the file and line of the function which called into
+// the dispatcher, skipping the provided frames.
+func callerSource(skip int) string {
+	_, file, line, ok := runtime.Caller(skip + 1)
+	if !ok {
+		return "unknown"
+	}
+
+	return fmt.Sprintf("%s/%s:%d", filepath.Base(filepath.Dir(file)), filepath.Base(file), line)
+}
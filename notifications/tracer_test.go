@@ -0,0 +1,76 @@
+package notifications_test
+
+import (
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"testing"
+
+	"github.com/gu-io/gu/notifications"
+	"github.com/influx6/faux/tests"
+)
+
+type traced struct {
+	Name string
+}
+
+func TestTracer(t *testing.T) {
+	tracer := notifications.EnableTracing(2)
+	defer notifications.DisableTracing()
+
+	remover := notifications.SubscribeWithRemover(notifications.NewAppEventHandler(func(notifications.AppEvent) {}))
+	defer remover.Remove()
+
+	filtered := notifications.SubscribeWithRemover(notifications.NewAppEventNotificationWhereUUID("other"))
+	defer filtered.Remove()
+
+	notifications.Dispatch(traced{Name: "first"})
+	notifications.Dispatch(traced{Name: "second"})
+	_, file, line, _ := runtime.Caller(0)
+	notifications.Dispatch(notifications.AppEvent{UUID: "third"})
+
+	// The dispatch is on the line following the call to runtime.Caller.
+	source := fmt.Sprintf("%s/%s:%d", filepath.Base(filepath.Dir(file)), filepath.Base(file), line+1)
+
+	if tracer.Total() != 3 {
+		tests.Failed("Should have recorded 3 dispatches: %d", tracer.Total())
+	}
+	tests.Passed("Should have recorded 3 dispatches")
+
+	traces := tracer.Traces()
+	if len(traces) != 2 {
+		tests.Failed("Should have only kept the last 2 traces: %d", len(traces))
+	}
+	tests.Passed("Should have only kept the last 2 traces")
+
+	if traces[0].Type != "notifications_test.traced" {
+		tests.Failed("Should have oldest trace with type %q: %q", "notifications_test.traced", traces[0].Type)
+	}
+	tests.Passed("Should have oldest trace with type %q", "notifications_test.traced")
+
+	if traces[1].Type != "notifications.AppEvent" {
+		tests.Failed("Should have latest trace with type %q: %q", "notifications.AppEvent", traces[1].Type)
+	}
+	tests.Passed("Should have latest trace with type %q", "notifications.AppEvent")
+
+	if traces[0].Subscribers != 0 {
+		tests.Failed("Should have recorded no subscriber for unhandled type: %d", traces[0].Subscribers)
+	}
+	tests.Passed("Should have recorded no subscriber for unhandled type")
+
+	if traces[1].Subscribers != 1 {
+		tests.Failed("Should have recorded only the subscriber accepting the event: %d", traces[1].Subscribers)
+	}
+	tests.Passed("Should have recorded only the subscriber accepting the event")
+
+	if traces[1].Source != source {
+		tests.Failed("Should have recorded source of dispatch %q: %q", source, traces[1].Source)
+	}
+	tests.Passed("Should have recorded source of dispatch %q", source)
+
+	tracer.Reset()
+	if len(tracer.Traces()) != 0 {
+		tests.Failed("Should have emptied tracer")
+	}
+	tests.Passed("Should have emptied tracer")
+}
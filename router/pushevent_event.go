@@ -1,6 +1,8 @@
 package router
 
-import "sync"
+import (
+	"sync"
+)
 
 // PushEventSubscriber defines a interface that which is used to subscribe specifically for
 // events  PushEvent type.
@@ -31,9 +33,19 @@ func (sn *PushEventHandler) Receive(elem PushEvent) {
 // Handle takes the giving value and asserts the expected value to match the
 // PushEvent type then passes it to the Receive method.
 func (sn *PushEventHandler) Handle(receive interface{}) {
-	if elem, ok := receive.(PushEvent); ok {
-		sn.Receive(elem)
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// PushEvent type, returning true/false if it was accepted.
+func (sn *PushEventHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(PushEvent)
+	if !ok {
+		return false
 	}
+
+	sn.Receive(elem)
+	return true
 }
 
 //=========================================================================================================
@@ -47,7 +59,8 @@ type PushEventNotification struct {
 	register   map[PushEventSubscriber]int
 }
 
-// NewPushEventNotificationWith returns a new instance of PushEventNotification.
+// NewPushEventNotificationWith returns a new instance of PushEventNotification
+// which only delivers events that pass the provided validation.
 func NewPushEventNotificationWith(validation func(PushEvent) bool) *PushEventNotification {
 	var elem PushEventNotification
 
@@ -57,7 +70,7 @@ func NewPushEventNotificationWith(validation func(PushEvent) bool) *PushEventNot
 	return &elem
 }
 
-// NewPushEventNotification returns a new instance of NewPushEventNotification.
+// NewPushEventNotification returns a new instance of PushEventNotification.
 func NewPushEventNotification() *PushEventNotification {
 	var elem PushEventNotification
 	elem.register = make(map[PushEventSubscriber]int, 0)
@@ -73,7 +86,12 @@ func (sn *PushEventNotification) UnNotify(sub PushEventSubscriber) {
 			return
 		}
 
+		delete(sn.register, sub)
 		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
 	})
 }
 
@@ -81,6 +99,10 @@ func (sn *PushEventNotification) UnNotify(sub PushEventSubscriber) {
 // a new event of the given PushEvent type.
 func (sn *PushEventNotification) Notify(sub PushEventSubscriber) {
 	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
 		sn.register[sub] = len(sn.subs)
 		sn.subs = append(sn.subs, sub)
 	})
@@ -89,23 +111,28 @@ func (sn *PushEventNotification) Notify(sub PushEventSubscriber) {
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
 func (sn *PushEventNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(PushEvent); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	sn.Accept(elem)
+}
 
-			return
-		}
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *PushEventNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(PushEvent)
+	if !ok {
+		return false
+	}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
 	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
@@ -0,0 +1,53 @@
+package router
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestPushEventNotification validates the delivery, validation and filters of
+// PushEvent events through the generated PushEventHandler and PushEventNotification.
+func TestPushEventNotification(t *testing.T) {
+	var received int
+	handler := NewPushEventHandler(func(PushEvent) {
+		received++
+	})
+
+	var elem PushEvent
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only PushEvent events: %d", received)
+	}
+	tests.Passed("Should have accepted only PushEvent events")
+
+	received = 0
+
+	notifier := NewPushEventNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered PushEvent event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered PushEvent event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering PushEvent events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering PushEvent events after UnNotify")
+
+	invalid := NewPushEventNotificationWith(func(PushEvent) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered PushEvent event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered PushEvent event failing validation")
+}
@@ -62,6 +62,10 @@ func (b *basicResolver) Flush() {
 
 // Pattern returns the giving path pattern used by this resolver.
 func (b *basicResolver) Pattern() string {
+	if b.matcher == nil {
+		return ""
+	}
+
 	return b.matcher.Pattern()
 }
 
@@ -0,0 +1,134 @@
+package gu
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/css"
+)
+
+// appStyles collects the stylesheets of the components rendered by the views
+// of an app into a single stylesheet rendered in the head of the app.
+type appStyles struct {
+	href  string
+	views map[string][]string
+}
+
+// styleKey identifies a stylesheet by its rule and the selector of its owner.
+type styleKey struct {
+	rule  *css.Rule
+	owner string
+}
+
+// extract collects the rules of the stylesheets within the markup rendered by
+// the view and marks them extracted. Stylesheets of the same rule and owner
+// are only collected once.
+func (s *appStyles) extract(view string, markup *trees.Markup) {
+	var rules []string
+
+	seen := make(map[styleKey]bool)
+
+	var collect func(*trees.Markup)
+	collect = func(item *trees.Markup) {
+		if item.Removed() {
+			return
+		}
+
+		if sheet := item.Stylesheet(); sheet != nil {
+			item.Extract()
+
+			key := styleKey{rule: sheet.Rule(), owner: item.IDSelector(true)}
+			if seen[key] {
+				return
+			}
+
+			seen[key] = true
+
+			sheetRules, err := sheet.Rules(key.owner)
+			if err != nil {
+				rules = append(rules, "/* "+err.Error()+" */")
+				return
+			}
+
+			rules = append(rules, sheetRules...)
+			return
+		}
+
+		for _, child := range item.Children() {
+			collect(child)
+		}
+	}
+
+	collect(markup)
+
+	s.views[view] = rules
+}
+
+// String returns the stylesheet of the provided views, where identical rules
+// are only written once.
+func (s *appStyles) String(views []*NView) string {
+	var rules []string
+
+	seen := make(map[string]bool)
+
+	for _, view := range views {
+		for _, rule := range s.views[view.uuid] {
+			if seen[rule] {
+				continue
+			}
+
+			seen[rule] = true
+			rules = append(rules, rule)
+		}
+	}
+
+	return strings.Join(rules, "\n")
+}
+
+// markup returns the markup of the stylesheet of the active views of the app,
+// which links to the stylesheet at the href of the app styles if set.
+func (s *appStyles) markup(app *NApp) *trees.Markup {
+	var style *trees.Markup
+
+	if s.href != "" {
+		style = trees.NewMarkup("link", true)
+		trees.NewAttr("rel", "stylesheet").Apply(style)
+		trees.NewAttr("href", s.href).Apply(style)
+	} else {
+		style = trees.NewMarkup("style", false)
+		trees.NewAttr("type", "text/css").Apply(style)
+		trees.NewText("%s", s.String(app.activeViews)).Apply(style)
+	}
+
+	style.SwapUID(app.uuid + "-styles")
+	style.UpdateHash()
+	app.frameIDs(style, "styles")
+
+	return style
+}
+
+// themeMarkup returns the style markup of the `:root` rule containing the theme
+// variables of the app.
+func (app *NApp) themeMarkup() *trees.Markup {
+	var rule bytes.Buffer
+
+	rule.WriteString(":root {\n")
+
+	for _, item := range app.theme {
+		fmt.Fprintf(&rule, "  %s: %s;\n", item.Name, item.Value)
+	}
+
+	rule.WriteString("}")
+
+	style := trees.NewMarkup("style", false)
+	trees.NewAttr("type", "text/css").Apply(style)
+	trees.NewText("%s", rule.String()).Apply(style)
+
+	style.SwapUID(app.uuid + "-theme")
+	style.UpdateHash()
+	app.frameIDs(style, "theme")
+
+	return style
+}
@@ -0,0 +1,33 @@
+// +build !js
+
+package gu
+
+import (
+	"path/filepath"
+	"strings"
+
+	"github.com/gu-io/gu/assets"
+)
+
+// StylesheetDirective returns a assets.WriteDirective which writes the
+// stylesheet collected from the views of the app active for the route into the
+// destination file, to be linked in production by an app using ExtractStyles
+// with the href of the file. It sets the app to extract its styles if not set.
+func (app *NApp) StylesheetDirective(route interface{}, destination string) assets.WriteDirective {
+	if app.styles == nil {
+		app.ExtractStyles("")
+	}
+
+	app.Render(route)
+
+	return assets.WriteDirective{
+		Static: &assets.StaticDirective{
+			WriteInFile: true,
+			FileName:    filepath.Base(destination),
+			DirName:     filepath.Dir(destination),
+		},
+		Writer:        strings.NewReader(app.styles.String(app.activeViews)),
+		OriginPath:    destination,
+		OriginAbsPath: destination,
+	}
+}
@@ -0,0 +1,149 @@
+package gu
+
+import (
+	"sync"
+)
+
+// ThemeUpdateSubscriber defines a interface that which is used to subscribe specifically for
+// events  ThemeUpdate type.
+type ThemeUpdateSubscriber interface {
+	Receive(ThemeUpdate)
+}
+
+//=========================================================================================================
+
+// ThemeUpdateHandler defines a structure type which implements the
+// ThemeUpdateSubscriber interface and the EventDistributor interface.
+type ThemeUpdateHandler struct {
+	handle func(ThemeUpdate)
+}
+
+// NewThemeUpdateHandler returns a new instance of a ThemeUpdateHandler.
+func NewThemeUpdateHandler(fn func(ThemeUpdate)) *ThemeUpdateHandler {
+	return &ThemeUpdateHandler{
+		handle: fn,
+	}
+}
+
+// Receive takes the giving value and execute it against the underline handler.
+func (sn *ThemeUpdateHandler) Receive(elem ThemeUpdate) {
+	sn.handle(elem)
+}
+
+// Handle takes the giving value and asserts the expected value to match the
+// ThemeUpdate type then passes it to the Receive method.
+func (sn *ThemeUpdateHandler) Handle(receive interface{}) {
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// ThemeUpdate type, returning true/false if it was accepted.
+func (sn *ThemeUpdateHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(ThemeUpdate)
+	if !ok {
+		return false
+	}
+
+	sn.Receive(elem)
+	return true
+}
+
+//=========================================================================================================
+
+// ThemeUpdateNotification defines a structure type which must be used to
+// receive ThemeUpdate type has a event.
+type ThemeUpdateNotification struct {
+	sml        sync.Mutex
+	subs       []ThemeUpdateSubscriber
+	validation func(ThemeUpdate) bool
+	register   map[ThemeUpdateSubscriber]int
+}
+
+// NewThemeUpdateNotificationWith returns a new instance of ThemeUpdateNotification
+// which only delivers events that pass the provided validation.
+func NewThemeUpdateNotificationWith(validation func(ThemeUpdate) bool) *ThemeUpdateNotification {
+	var elem ThemeUpdateNotification
+
+	elem.validation = validation
+	elem.register = make(map[ThemeUpdateSubscriber]int, 0)
+
+	return &elem
+}
+
+// NewThemeUpdateNotification returns a new instance of ThemeUpdateNotification.
+func NewThemeUpdateNotification() *ThemeUpdateNotification {
+	var elem ThemeUpdateNotification
+	elem.register = make(map[ThemeUpdateSubscriber]int, 0)
+
+	return &elem
+}
+
+// UnNotify removes the given subscriber from the notification's list if found from future events.
+func (sn *ThemeUpdateNotification) UnNotify(sub ThemeUpdateSubscriber) {
+	sn.do(func() {
+		index, ok := sn.register[sub]
+		if !ok {
+			return
+		}
+
+		delete(sn.register, sub)
+		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
+	})
+}
+
+// Notify adds the given subscriber into the notification list and will await an update of
+// a new event of the given ThemeUpdate type.
+func (sn *ThemeUpdateNotification) Notify(sub ThemeUpdateSubscriber) {
+	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
+		sn.register[sub] = len(sn.subs)
+		sn.subs = append(sn.subs, sub)
+	})
+}
+
+// Handle takes the giving value and asserts the expected value to be of
+// the type and pass on to it's underline subscribers else ignoring the event.
+func (sn *ThemeUpdateNotification) Handle(elem interface{}) {
+	sn.Accept(elem)
+}
+
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *ThemeUpdateNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(ThemeUpdate)
+	if !ok {
+		return false
+	}
+
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
+	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
+}
+
+// do performs action with the mutex locked and unlocked appropriately, ensuring safe
+// concurrent access.
+func (sn *ThemeUpdateNotification) do(fn func()) {
+	if fn == nil {
+		return
+	}
+
+	sn.sml.Lock()
+	defer sn.sml.Unlock()
+
+	fn()
+}
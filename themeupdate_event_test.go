@@ -0,0 +1,53 @@
+package gu
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestThemeUpdateNotification validates the delivery, validation and filters of
+// ThemeUpdate events through the generated ThemeUpdateHandler and ThemeUpdateNotification.
+func TestThemeUpdateNotification(t *testing.T) {
+	var received int
+	handler := NewThemeUpdateHandler(func(ThemeUpdate) {
+		received++
+	})
+
+	var elem ThemeUpdate
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only ThemeUpdate events: %d", received)
+	}
+	tests.Passed("Should have accepted only ThemeUpdate events")
+
+	received = 0
+
+	notifier := NewThemeUpdateNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered ThemeUpdate event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered ThemeUpdate event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering ThemeUpdate events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering ThemeUpdate events after UnNotify")
+
+	invalid := NewThemeUpdateNotificationWith(func(ThemeUpdate) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered ThemeUpdate event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered ThemeUpdate event failing validation")
+}
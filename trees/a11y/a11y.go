@@ -0,0 +1,230 @@
+// Package a11y provides checks of rendered trees for accessibility issues, like
+// images without alternative text, form controls without labels or text
+// without enough contrast against its background within inline styles.
+//
+// Check runs the rules over a tree, returning the issues found:
+//
+//	for _, issue := range a11y.Check(view.Render()) {
+//		fmt.Println(issue)
+//	}
+//
+// The rules only see the tree, so styles from stylesheets are not taken into
+// account and ids referenced by aria attributes must be within it.
+package a11y
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// Issue defines an accessibility issue found on an element of a tree.
+type Issue struct {
+	Rule    string
+	Element *trees.Markup
+	Message string
+}
+
+// String returns the issue as the rule, element and message, eg
+// 'img-alt: img.avatar: Image has no alt attribute'.
+func (i Issue) String() string {
+	return fmt.Sprintf("%s: %s: %s", i.Rule, describe(i.Element), i.Message)
+}
+
+// Rule defines a function which returns the issues it finds within a tree.
+type Rule func(root *trees.Markup) []Issue
+
+// DefaultRules contains the rules run by Check when none are provided.
+var DefaultRules = []Rule{
+	ImageAlt,
+	FormLabels,
+	ButtonNames,
+	ARIA,
+	DuplicateIDs,
+	HeadingOrder,
+	Contrast,
+}
+
+// Check returns the issues found within the tree by the rules, running
+// DefaultRules if none are provided.
+func Check(root *trees.Markup, rules ...Rule) []Issue {
+	if len(rules) == 0 {
+		rules = DefaultRules
+	}
+
+	var issues []Issue
+	for _, rule := range rules {
+		issues = append(issues, rule(root)...)
+	}
+
+	return issues
+}
+
+//==============================================================================
+
+// skippedTags contains the elements whose content is never presented.
+var skippedTags = map[string]bool{
+	"head":     true,
+	"script":   true,
+	"style":    true,
+	"template": true,
+}
+
+// elements returns the elements of the tree in document order, leaving out
+// those hidden from assistive technologies along with their children.
+func elements(root *trees.Markup) []*trees.Markup {
+	var found []*trees.Markup
+
+	var walk func(*trees.Markup)
+	walk = func(item *trees.Markup) {
+		if item.Removed() || item.Kind() != trees.ElementNode || hidden(item) {
+			return
+		}
+
+		found = append(found, item)
+
+		for _, child := range item.Children() {
+			walk(child)
+		}
+	}
+
+	walk(root)
+	return found
+}
+
+// allElements returns the elements of the tree in document order, including
+// those hidden.
+func allElements(root *trees.Markup) []*trees.Markup {
+	var found []*trees.Markup
+
+	var walk func(*trees.Markup)
+	walk = func(item *trees.Markup) {
+		if item.Removed() || item.Kind() != trees.ElementNode {
+			return
+		}
+
+		found = append(found, item)
+
+		for _, child := range item.Children() {
+			walk(child)
+		}
+	}
+
+	walk(root)
+	return found
+}
+
+// hidden returns true if the element and its content are not presented.
+func hidden(e *trees.Markup) bool {
+	if e.Namespace() == "" && skippedTags[e.Name()] {
+		return true
+	}
+
+	if _, ok := attr(e, "hidden"); ok {
+		return true
+	}
+
+	if value, _ := attr(e, "aria-hidden"); value == "true" {
+		return true
+	}
+
+	return style(e, "display") == "none" || style(e, "visibility") == "hidden"
+}
+
+// isHTML returns true if the element is a html element of one of the tags.
+func isHTML(e *trees.Markup, tags ...string) bool {
+	if e.Namespace() != "" {
+		return false
+	}
+
+	for _, tag := range tags {
+		if e.Name() == tag {
+			return true
+		}
+	}
+
+	return false
+}
+
+// attr returns the value of the attribute of the element and if it has it.
+func attr(e *trees.Markup, name string) (string, bool) {
+	item, err := trees.GetAttr(e, name)
+	if err != nil {
+		return "", false
+	}
+
+	_, value := item.Render()
+	return value, true
+}
+
+// style returns the value of the inline style of the element, lowercased. The
+// style attribute is read when the element has no styles, as kept by ParseTree.
+func style(e *trees.Markup, name string) string {
+	styles := e.Styles()
+	if len(styles) == 0 {
+		if value, ok := attr(e, "style"); ok {
+			styles = trees.ParseStyle(value)
+		}
+	}
+
+	for _, item := range styles {
+		if key, value := item.Render(); key == name {
+			return strings.ToLower(strings.TrimSpace(value))
+		}
+	}
+
+	return ""
+}
+
+// inputType returns the type of the input element, defaulting to 'text'.
+func inputType(e *trees.Markup) string {
+	kind, _ := attr(e, "type")
+	if kind = strings.ToLower(strings.TrimSpace(kind)); kind == "" {
+		return "text"
+	}
+
+	return kind
+}
+
+// roles returns the roles listed by the role attribute of the element.
+func roles(e *trees.Markup) []string {
+	value, _ := attr(e, "role")
+	return strings.Fields(strings.ToLower(value))
+}
+
+// hasRole returns true if the first role of the element is one of the roles.
+func hasRole(e *trees.Markup, names ...string) bool {
+	listed := roles(e)
+	if len(listed) == 0 {
+		return false
+	}
+
+	for _, name := range names {
+		if listed[0] == name {
+			return true
+		}
+	}
+
+	return false
+}
+
+// describe returns the element as a selector of its tag, id and classes, eg
+// 'button#save.primary'.
+func describe(e *trees.Markup) string {
+	if e == nil {
+		return ""
+	}
+
+	desc := e.Name()
+
+	if id, _ := attr(e, "id"); id != "" {
+		desc += "#" + id
+	}
+
+	if classes, _ := attr(e, "class"); classes != "" {
+		desc += "." + strings.Join(strings.Fields(classes), ".")
+	}
+
+	return desc
+}
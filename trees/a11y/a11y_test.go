@@ -0,0 +1,138 @@
+package a11y_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/a11y"
+	"github.com/influx6/faux/tests"
+)
+
+// rulesOf returns the rules of the issues, with the id of their element if it
+// has one, eg 'img-alt#avatar'.
+func rulesOf(issues []a11y.Issue) []string {
+	var rules []string
+	for _, issue := range issues {
+		rule := issue.Rule
+		if id, err := trees.GetAttr(issue.Element, "id"); err == nil {
+			_, value := id.Render()
+			rule += "#" + value
+		}
+
+		rules = append(rules, rule)
+	}
+
+	return rules
+}
+
+func check(t *testing.T, markup string, rule a11y.Rule, expected ...string) {
+	root := trees.ParseTree(markup, trees.Faithful())[0]
+
+	found := strings.Join(rulesOf(a11y.Check(root, rule)), ",")
+	if found != strings.Join(expected, ",") {
+		tests.Failed("Should have found issues %q, found %q in: %s", expected, found, markup)
+	}
+	tests.Passed("Should have found issues %q", expected)
+}
+
+func TestImageAlt(t *testing.T) {
+	check(t, `<div>
+		<img id="missing" src="a.png">
+		<img id="decorative" src="a.png" alt="">
+		<img id="labelled" src="a.png" aria-label="Avatar">
+		<img id="presentation" src="a.png" role="presentation">
+		<input id="image" type="image" src="go.png">
+		<img id="hidden" src="a.png" aria-hidden="true">
+	</div>`, a11y.ImageAlt, "img-alt#missing", "img-alt#image")
+}
+
+func TestFormLabels(t *testing.T) {
+	check(t, `<form>
+		<label for="name">Name</label><input id="name" type="text">
+		<label>Email <input id="email" type="email"></label>
+		<input id="search" type="search" aria-label="Search">
+		<input id="missing" type="text">
+		<select id="select"><option>One</option></select>
+		<textarea id="labelledby" aria-labelledby="name-label"></textarea>
+		<span id="name-label">Notes</span>
+		<input type="hidden" value="1">
+		<input type="submit">
+		<div id="custom" role="checkbox" aria-checked="false"></div>
+	</form>`, a11y.FormLabels, "form-label#missing", "form-label#select", "form-label#custom")
+}
+
+func TestButtonNames(t *testing.T) {
+	check(t, `<div>
+		<button id="text">Save</button>
+		<button id="empty"></button>
+		<button id="icon"><img src="x.png" alt="Close"></button>
+		<button id="labelled" aria-label="Menu"><span class="icon"></span></button>
+		<div id="custom" role="button"></div>
+		<input id="input" type="button">
+		<input type="submit">
+	</div>`, a11y.ButtonNames, "button-name#empty", "button-name#custom", "button-name#input")
+}
+
+func TestARIA(t *testing.T) {
+	check(t, `<div>
+		<div id="role" role="buton"></div>
+		<div id="required" role="slider"></div>
+		<input id="native" type="checkbox" role="switch">
+		<div id="attr" aria-lable="Name"></div>
+		<div id="value" aria-hidden="yes"></div>
+		<div id="reference" aria-describedby="hint missing"></div>
+		<p id="hint">Hint</p>
+		<div id="valid" role="checkbox" aria-checked="mixed" aria-live="polite"></div>
+	</div>`, a11y.ARIA, "aria#role", "aria#required", "aria#attr", "aria#value", "aria#reference")
+}
+
+func TestDuplicateIDs(t *testing.T) {
+	check(t, `<div>
+		<span id="item"></span>
+		<span id="other"></span>
+		<span id="item"></span>
+	</div>`, a11y.DuplicateIDs, "duplicate-id#item")
+}
+
+func TestHeadingOrder(t *testing.T) {
+	check(t, `<div>
+		<h1>Title</h1>
+		<h2>Section</h2>
+		<h4 id="skipped">Detail</h4>
+		<h2>Section</h2>
+		<div id="role" role="heading" aria-level="5">Detail</div>
+	</div>`, a11y.HeadingOrder, "heading-order#skipped", "heading-order#role")
+}
+
+func TestContrast(t *testing.T) {
+	check(t, `<div>
+		<p id="default">Text</p>
+		<p id="grey" style="color: #aaa">Text</p>
+		<p id="dark" style="color: #333">Text</p>
+		<div style="background: #1e1e1e url(a.png)"><p id="image" style="color: #fff">Text</p></div>
+		<div style="background-color: #555"><span id="inherited">Text</span></div>
+		<p id="large" style="color: #949494; font-size: 24px">Text</p>
+		<p id="translucent" style="color: rgba(0, 0, 0, 0.3)">Text</p>
+		<p id="variable" style="color: var(--color-text)">Text</p>
+	</div>`, a11y.Contrast, "color-contrast#grey", "color-contrast#inherited", "color-contrast#translucent")
+}
+
+func TestContrastStyleAttribute(t *testing.T) {
+	root := trees.ParseTree(`<div><p id="grey" style="color:#777;background-color:#888">x</p><p id="hidden" style="display:none;color:#777;background-color:#888">x</p></div>`)[0]
+
+	if found := rulesOf(a11y.Check(root, a11y.Contrast)); len(found) != 1 || found[0] != "color-contrast#grey" {
+		tests.Failed("Should have checked contrast from style attribute: %q", found)
+	}
+	tests.Passed("Should have checked contrast from style attribute")
+}
+
+func TestIssueString(t *testing.T) {
+	root := trees.ParseTree(`<img id="avatar" class="round large" src="a.png">`, trees.Faithful())[0]
+
+	issues := a11y.Check(root)
+	if len(issues) != 1 || issues[0].String() != "img-alt: img#avatar.round.large: Image has no alt attribute" {
+		tests.Failed("Should have described issue with its rule, element and message: %+v", issues)
+	}
+	tests.Passed("Should have described issue with its rule, element and message")
+}
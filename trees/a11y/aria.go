@@ -0,0 +1,146 @@
+package a11y
+
+import "strings"
+
+// validRoles contains the non-abstract roles of WAI-ARIA 1.2.
+var validRoles = setOf(
+	"alert", "alertdialog", "application", "article", "banner", "blockquote",
+	"button", "caption", "cell", "checkbox", "code", "columnheader", "combobox",
+	"comment", "complementary", "contentinfo", "definition", "deletion",
+	"dialog", "directory", "document", "emphasis", "feed", "figure", "form",
+	"generic", "grid", "gridcell", "group", "heading", "img", "insertion",
+	"link", "list", "listbox", "listitem", "log", "main", "mark", "marquee",
+	"math", "menu", "menubar", "menuitem", "menuitemcheckbox", "menuitemradio",
+	"meter", "navigation", "none", "note", "option", "paragraph",
+	"presentation", "progressbar", "radio", "radiogroup", "region", "row",
+	"rowgroup", "rowheader", "scrollbar", "search", "searchbox", "separator",
+	"slider", "spinbutton", "status", "strong", "subscript", "superscript",
+	"switch", "tab", "table", "tablist", "tabpanel", "term", "textbox", "time",
+	"timer", "toolbar", "tooltip", "tree", "treegrid", "treeitem",
+)
+
+// rolePrefixes contains the prefixes of the roles of the aria modules, like
+// the digital publishing roles, eg 'doc-chapter'.
+var rolePrefixes = []string{"doc-", "graphics-"}
+
+// validRole returns true if the role is defined by WAI-ARIA or its modules.
+func validRole(role string) bool {
+	if validRoles[role] {
+		return true
+	}
+
+	for _, prefix := range rolePrefixes {
+		if strings.HasPrefix(role, prefix) && len(role) > len(prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// requiredStates contains the attributes required by roles, whose elements
+// must set them when they are not html elements providing the state.
+var requiredStates = map[string][]string{
+	"checkbox":         {"aria-checked"},
+	"combobox":         {"aria-expanded"},
+	"heading":          {"aria-level"},
+	"menuitemcheckbox": {"aria-checked"},
+	"menuitemradio":    {"aria-checked"},
+	"meter":            {"aria-valuenow"},
+	"radio":            {"aria-checked"},
+	"scrollbar":        {"aria-controls", "aria-valuenow"},
+	"slider":           {"aria-valuenow"},
+	"switch":           {"aria-checked"},
+}
+
+// ariaValue defines the values accepted by an aria attribute.
+type ariaValue int
+
+// contains the kinds of values of aria attributes.
+const (
+	anyValue ariaValue = iota
+	tokenValue
+	tokenListValue
+	idValue
+	idListValue
+)
+
+// ariaAttribute defines an aria attribute with the kind of its value and the
+// tokens it accepts.
+type ariaAttribute struct {
+	kind   ariaValue
+	tokens map[string]bool
+}
+
+var (
+	trueFalse          = setOf("true", "false")
+	trueFalseUndefined = setOf("true", "false", "undefined")
+	tristate           = setOf("true", "false", "mixed", "undefined")
+)
+
+// ariaAttributes contains the attributes of WAI-ARIA 1.2 by name.
+var ariaAttributes = map[string]ariaAttribute{
+	"aria-activedescendant":       {kind: idValue},
+	"aria-atomic":                 {kind: tokenValue, tokens: trueFalse},
+	"aria-autocomplete":           {kind: tokenValue, tokens: setOf("inline", "list", "both", "none")},
+	"aria-braillelabel":           {},
+	"aria-brailleroledescription": {},
+	"aria-busy":                   {kind: tokenValue, tokens: trueFalse},
+	"aria-checked":                {kind: tokenValue, tokens: tristate},
+	"aria-colcount":               {},
+	"aria-colindex":               {},
+	"aria-colindextext":           {},
+	"aria-colspan":                {},
+	"aria-controls":               {kind: idListValue},
+	"aria-current":                {kind: tokenValue, tokens: setOf("page", "step", "location", "date", "time", "true", "false")},
+	"aria-describedby":            {kind: idListValue},
+	"aria-description":            {},
+	"aria-details":                {kind: idValue},
+	"aria-disabled":               {kind: tokenValue, tokens: trueFalse},
+	"aria-dropeffect":             {kind: tokenListValue, tokens: setOf("copy", "execute", "link", "move", "none", "popup")},
+	"aria-errormessage":           {kind: idValue},
+	"aria-expanded":               {kind: tokenValue, tokens: trueFalseUndefined},
+	"aria-flowto":                 {kind: idListValue},
+	"aria-grabbed":                {kind: tokenValue, tokens: trueFalseUndefined},
+	"aria-haspopup":               {kind: tokenValue, tokens: setOf("false", "true", "menu", "listbox", "tree", "grid", "dialog")},
+	"aria-hidden":                 {kind: tokenValue, tokens: trueFalseUndefined},
+	"aria-invalid":                {kind: tokenValue, tokens: setOf("grammar", "false", "spelling", "true")},
+	"aria-keyshortcuts":           {},
+	"aria-label":                  {},
+	"aria-labelledby":             {kind: idListValue},
+	"aria-level":                  {},
+	"aria-live":                   {kind: tokenValue, tokens: setOf("assertive", "off", "polite")},
+	"aria-modal":                  {kind: tokenValue, tokens: trueFalse},
+	"aria-multiline":              {kind: tokenValue, tokens: trueFalse},
+	"aria-multiselectable":        {kind: tokenValue, tokens: trueFalse},
+	"aria-orientation":            {kind: tokenValue, tokens: setOf("horizontal", "undefined", "vertical")},
+	"aria-owns":                   {kind: idListValue},
+	"aria-placeholder":            {},
+	"aria-posinset":               {},
+	"aria-pressed":                {kind: tokenValue, tokens: tristate},
+	"aria-readonly":               {kind: tokenValue, tokens: trueFalse},
+	"aria-relevant":               {kind: tokenListValue, tokens: setOf("additions", "all", "removals", "text")},
+	"aria-required":               {kind: tokenValue, tokens: trueFalse},
+	"aria-roledescription":        {},
+	"aria-rowcount":               {},
+	"aria-rowindex":               {},
+	"aria-rowindextext":           {},
+	"aria-rowspan":                {},
+	"aria-selected":               {kind: tokenValue, tokens: trueFalseUndefined},
+	"aria-setsize":                {},
+	"aria-sort":                   {kind: tokenValue, tokens: setOf("ascending", "descending", "none", "other")},
+	"aria-valuemax":               {},
+	"aria-valuemin":               {},
+	"aria-valuenow":               {},
+	"aria-valuetext":              {},
+}
+
+// setOf returns the set of the values.
+func setOf(values ...string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+
+	return set
+}
@@ -0,0 +1,238 @@
+package a11y
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gu-io/gu/common/colors"
+	"github.com/gu-io/gu/trees"
+)
+
+// Contrast reports text whose color has a contrast ratio against its
+// background below WCAG AA, being 4.5 or 3 for large text. The colors are
+// taken from the inline color, background-color and background styles of the
+// element and its parents, defaulting to black text on white, and text whose
+// colors are all defaulted or can not be parsed, eg 'var(--color)', is not
+// checked.
+func Contrast(root *trees.Markup) []Issue {
+	var issues []Issue
+
+	for _, e := range elements(root) {
+		if !ownText(e) {
+			continue
+		}
+
+		fg, fgSet, ok := foreground(e)
+		if !ok {
+			continue
+		}
+
+		bg, bgSet, ok := background(e)
+		if !ok || (!fgSet && !bgSet) {
+			continue
+		}
+
+		if fg.A < 1 {
+			fg = colors.Mix(bg, colors.Color{R: fg.R, G: fg.G, B: fg.B, A: 1}, fg.A)
+		}
+
+		minimum := colors.ContrastAA
+		if largeText(e) {
+			minimum = colors.ContrastAALarge
+		}
+
+		if ratio := colors.Contrast(fg, bg); ratio < minimum {
+			issues = append(issues, Issue{
+				Rule:    "color-contrast",
+				Element: e,
+				Message: fmt.Sprintf("Text color %s has contrast ratio of %.2f against background %s, below %.1f", fg.Hex(), ratio, bg.Hex(), minimum),
+			})
+		}
+	}
+
+	return issues
+}
+
+// ownText returns true if the element has text which is not only spaces as
+// one of its children.
+func ownText(e *trees.Markup) bool {
+	for _, child := range e.Children() {
+		if child.Kind() == trees.TextNode && !child.Removed() && strings.TrimSpace(child.TextContent()) != "" {
+			return true
+		}
+	}
+
+	return false
+}
+
+// foreground returns the text color of the element from the inline color of
+// it or its closest parent setting one, returning false if it can not be
+// parsed.
+func foreground(e *trees.Markup) (colors.Color, bool, bool) {
+	for item := e; item != nil; item = item.Parent() {
+		value := style(item, "color")
+		if value == "" || value == "inherit" {
+			continue
+		}
+
+		if value == "currentcolor" || value == "initial" || value == "unset" {
+			break
+		}
+
+		color, err := colors.Parse(value)
+		if err != nil {
+			return colors.Color{}, true, false
+		}
+
+		return color, true, true
+	}
+
+	return colors.Black, false, true
+}
+
+// background returns the background color of the element, blending the
+// inline background colors of it and its parents, which are translucent,
+// over white. It returns false if a background can not be parsed.
+func background(e *trees.Markup) (colors.Color, bool, bool) {
+	var layers []colors.Color
+
+	for item := e; item != nil; item = item.Parent() {
+		color, set, ok := backgroundColor(item)
+		if !ok {
+			return colors.Color{}, true, false
+		}
+
+		if !set || color.A == 0 {
+			continue
+		}
+
+		layers = append(layers, color)
+		if color.A >= 1 {
+			break
+		}
+	}
+
+	bg := colors.White
+	for index := len(layers) - 1; index >= 0; index-- {
+		layer := layers[index]
+		bg = colors.Mix(bg, colors.Color{R: layer.R, G: layer.G, B: layer.B, A: 1}, layer.A)
+	}
+
+	return bg, len(layers) != 0, true
+}
+
+// backgroundColor returns the inline background color of the element, from
+// its background-color style or the color within its background style. It
+// returns false for backgrounds which can not be parsed, like images.
+func backgroundColor(e *trees.Markup) (colors.Color, bool, bool) {
+	if value := style(e, "background-color"); value != "" {
+		color, err := colors.Parse(value)
+		if err != nil {
+			return colors.Color{}, true, false
+		}
+
+		return color, true, true
+	}
+
+	value := style(e, "background")
+	if value == "" || value == "none" {
+		return colors.Color{}, false, true
+	}
+
+	if strings.Contains(value, "url(") || strings.Contains(value, "gradient(") || strings.Contains(value, "var(") {
+		return colors.Color{}, true, false
+	}
+
+	if color, err := colors.Parse(value); err == nil {
+		return color, true, true
+	}
+
+	for _, part := range splitValue(value) {
+		if color, err := colors.Parse(part); err == nil {
+			return color, true, true
+		}
+	}
+
+	return colors.Color{}, false, true
+}
+
+// splitValue returns the parts of the css value separated by spaces outside of
+// parentheses, eg 'rgb(0, 0, 0)' within 'rgb(0, 0, 0) no-repeat'.
+func splitValue(value string) []string {
+	var parts []string
+
+	var depth, start int
+	for index, r := range value {
+		switch {
+		case r == '(':
+			depth++
+		case r == ')':
+			depth--
+		case r == ' ' && depth == 0:
+			if index > start {
+				parts = append(parts, value[start:index])
+			}
+			start = index + 1
+		}
+	}
+
+	if start < len(value) {
+		parts = append(parts, value[start:])
+	}
+
+	return parts
+}
+
+// largeText returns true if the text of the element is large as defined by
+// WCAG, being at least 24px, or 18.66px when bold, from the inline font-size
+// and font-weight styles of it or its closest parents setting them.
+func largeText(e *trees.Markup) bool {
+	size := pixels(inherited(e, "font-size"))
+
+	bold := false
+	switch weight := inherited(e, "font-weight"); weight {
+	case "bold", "bolder":
+		bold = true
+	default:
+		if value, err := strconv.Atoi(weight); err == nil {
+			bold = value >= 700
+		}
+	}
+
+	return size >= 24 || (bold && size >= 18.66)
+}
+
+// inherited returns the inline style of the element or its closest parent
+// setting it.
+func inherited(e *trees.Markup, name string) string {
+	for item := e; item != nil; item = item.Parent() {
+		if value := style(item, name); value != "" && value != "inherit" {
+			return value
+		}
+	}
+
+	return ""
+}
+
+// pixels returns the size in pixels of a font size in px or pt, or 0.
+func pixels(size string) float64 {
+	unit := 1.0
+
+	switch {
+	case strings.HasSuffix(size, "px"):
+		size = strings.TrimSuffix(size, "px")
+	case strings.HasSuffix(size, "pt"):
+		size = strings.TrimSuffix(size, "pt")
+		unit = 4.0 / 3.0
+	default:
+		return 0
+	}
+
+	value, err := strconv.ParseFloat(strings.TrimSpace(size), 64)
+	if err != nil {
+		return 0
+	}
+
+	return value * unit
+}
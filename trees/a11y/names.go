@@ -0,0 +1,198 @@
+package a11y
+
+import (
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// index contains the elements of a tree by their id and the labels of the
+// elements by the id they are for, used to find the names of elements.
+type index struct {
+	ids    map[string][]*trees.Markup
+	labels map[string][]*trees.Markup
+}
+
+// newIndex returns the index of the elements of the tree, including those
+// hidden, as they can still label other elements.
+func newIndex(root *trees.Markup) index {
+	ix := index{
+		ids:    make(map[string][]*trees.Markup),
+		labels: make(map[string][]*trees.Markup),
+	}
+
+	for _, item := range allElements(root) {
+		if id, _ := attr(item, "id"); id != "" {
+			ix.ids[id] = append(ix.ids[id], item)
+		}
+
+		if isHTML(item, "label") {
+			if target, _ := attr(item, "for"); target != "" {
+				ix.labels[target] = append(ix.labels[target], item)
+			}
+		}
+	}
+
+	return ix
+}
+
+// element returns the first element of the id.
+func (ix index) element(id string) *trees.Markup {
+	if found := ix.ids[id]; len(found) != 0 {
+		return found[0]
+	}
+
+	return nil
+}
+
+// nameFromContent contains the roles whose name is computed from their content.
+var nameFromContent = map[string]bool{
+	"button":           true,
+	"cell":             true,
+	"checkbox":         true,
+	"columnheader":     true,
+	"gridcell":         true,
+	"heading":          true,
+	"link":             true,
+	"menuitem":         true,
+	"menuitemcheckbox": true,
+	"menuitemradio":    true,
+	"option":           true,
+	"radio":            true,
+	"row":              true,
+	"rowheader":        true,
+	"switch":           true,
+	"tab":              true,
+	"tooltip":          true,
+	"treeitem":         true,
+}
+
+// name returns the accessible name of the element, as computed by browsers
+// from its aria attributes, labels, alternative text, content or title.
+func (ix index) name(e *trees.Markup) string {
+	if ids, _ := attr(e, "aria-labelledby"); ids != "" {
+		var labels []string
+		for _, id := range strings.Fields(ids) {
+			if label := ix.element(id); label != nil {
+				labels = append(labels, text(label))
+			}
+		}
+
+		if name := collapse(strings.Join(labels, " ")); name != "" {
+			return name
+		}
+	}
+
+	if label, _ := attr(e, "aria-label"); strings.TrimSpace(label) != "" {
+		return collapse(label)
+	}
+
+	if name := ix.nativeName(e); name != "" {
+		return name
+	}
+
+	if isHTML(e, "button", "a", "summary", "h1", "h2", "h3", "h4", "h5", "h6", "th", "td", "option", "legend", "caption") || (len(roles(e)) != 0 && nameFromContent[roles(e)[0]]) {
+		if content := text(e); content != "" {
+			return content
+		}
+	}
+
+	title, _ := attr(e, "title")
+	return collapse(title)
+}
+
+// nativeName returns the name html elements receive from their attributes or
+// labels.
+func (ix index) nativeName(e *trees.Markup) string {
+	if isHTML(e, "img", "area") {
+		alt, _ := attr(e, "alt")
+		return collapse(alt)
+	}
+
+	if isHTML(e, "input") {
+		value, _ := attr(e, "value")
+
+		switch inputType(e) {
+		case "image":
+			alt, _ := attr(e, "alt")
+			return collapse(alt)
+		case "submit":
+			if value == "" {
+				return "Submit"
+			}
+			return collapse(value)
+		case "reset":
+			if value == "" {
+				return "Reset"
+			}
+			return collapse(value)
+		case "button":
+			return collapse(value)
+		}
+	}
+
+	if !isHTML(e, "input", "select", "textarea", "meter", "progress", "output") {
+		return ""
+	}
+
+	var labels []string
+
+	if id, _ := attr(e, "id"); id != "" {
+		for _, label := range ix.labels[id] {
+			labels = append(labels, text(label))
+		}
+	}
+
+	for parent := e.Parent(); parent != nil; parent = parent.Parent() {
+		if isHTML(parent, "label") {
+			labels = append(labels, text(parent))
+			break
+		}
+	}
+
+	return collapse(strings.Join(labels, " "))
+}
+
+// text returns the text presented by the element and its children, including
+// the alternative text of images.
+func text(e *trees.Markup) string {
+	var content []string
+
+	var walk func(*trees.Markup)
+	walk = func(item *trees.Markup) {
+		if item.Removed() {
+			return
+		}
+
+		switch item.Kind() {
+		case trees.TextNode:
+			content = append(content, item.TextContent())
+			return
+		case trees.ElementNode:
+		default:
+			return
+		}
+
+		if hidden(item) {
+			return
+		}
+
+		if isHTML(item, "img") {
+			alt, _ := attr(item, "alt")
+			content = append(content, alt)
+			return
+		}
+
+		for _, child := range item.Children() {
+			walk(child)
+		}
+	}
+
+	walk(e)
+	return collapse(strings.Join(content, " "))
+}
+
+// collapse returns the text with its spaces collapsed and trimmed.
+func collapse(text string) string {
+	return strings.Join(strings.Fields(text), " ")
+}
@@ -0,0 +1,237 @@
+package a11y
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// ImageAlt reports images, image buttons and image map areas without
+// alternative text. Images with an empty alt attribute are decorative and
+// pass.
+func ImageAlt(root *trees.Markup) []Issue {
+	var issues []Issue
+
+	ix := newIndex(root)
+	for _, e := range elements(root) {
+		switch {
+		case isHTML(e, "img"):
+			if _, ok := attr(e, "alt"); ok || hasRole(e, "presentation", "none") || ix.name(e) != "" {
+				continue
+			}
+
+			issues = append(issues, Issue{Rule: "img-alt", Element: e, Message: "Image has no alt attribute"})
+		case isHTML(e, "input") && inputType(e) == "image":
+			if ix.name(e) != "" {
+				continue
+			}
+
+			issues = append(issues, Issue{Rule: "img-alt", Element: e, Message: "Image button has no alt text"})
+		case isHTML(e, "area"):
+			if _, ok := attr(e, "href"); !ok || ix.name(e) != "" {
+				continue
+			}
+
+			issues = append(issues, Issue{Rule: "img-alt", Element: e, Message: "Image map area has no alt text"})
+		}
+	}
+
+	return issues
+}
+
+// unlabeledInputs contains the types of inputs which are labelled by their
+// value or checked by other rules.
+var unlabeledInputs = setOf("hidden", "submit", "reset", "button", "image")
+
+// controlRoles contains the roles of form controls, which need a name.
+var controlRoles = setOf("checkbox", "combobox", "listbox", "radio", "searchbox", "slider", "spinbutton", "switch", "textbox")
+
+// FormLabels reports form controls without a label, either from a label
+// element, aria-label, aria-labelledby or title.
+func FormLabels(root *trees.Markup) []Issue {
+	var issues []Issue
+
+	ix := newIndex(root)
+	for _, e := range elements(root) {
+		control := isHTML(e, "select", "textarea") || (isHTML(e, "input") && !unlabeledInputs[inputType(e)])
+		if !control && !isHTML(e, "input") && len(roles(e)) != 0 {
+			control = controlRoles[roles(e)[0]]
+		}
+
+		if !control || ix.name(e) != "" {
+			continue
+		}
+
+		issues = append(issues, Issue{Rule: "form-label", Element: e, Message: "Form control has no label"})
+	}
+
+	return issues
+}
+
+// ButtonNames reports buttons without an accessible name, from their content,
+// value, aria-label, aria-labelledby or title.
+func ButtonNames(root *trees.Markup) []Issue {
+	var issues []Issue
+
+	ix := newIndex(root)
+	for _, e := range elements(root) {
+		button := isHTML(e, "button") || (isHTML(e, "input") && inputType(e) == "button")
+		if !button && !isHTML(e, "input") {
+			button = hasRole(e, "button")
+		}
+
+		if !button || ix.name(e) != "" {
+			continue
+		}
+
+		issues = append(issues, Issue{Rule: "button-name", Element: e, Message: "Button has no accessible name"})
+	}
+
+	return issues
+}
+
+// ARIA reports invalid roles, roles without their required attributes, aria
+// attributes which do not exist or have invalid values, and aria attributes
+// referencing ids missing from the tree.
+func ARIA(root *trees.Markup) []Issue {
+	var issues []Issue
+
+	ix := newIndex(root)
+	for _, e := range elements(root) {
+		var role string
+		for _, name := range roles(e) {
+			if !validRole(name) {
+				issues = append(issues, Issue{Rule: "aria", Element: e, Message: fmt.Sprintf("Role %q is not a valid aria role", name)})
+				continue
+			}
+
+			if role == "" {
+				role = name
+			}
+		}
+
+		if !nativeState(e) {
+			for _, required := range requiredStates[role] {
+				if _, ok := attr(e, required); !ok {
+					issues = append(issues, Issue{Rule: "aria", Element: e, Message: fmt.Sprintf("Role %q requires attribute %q", role, required)})
+				}
+			}
+		}
+
+		for _, item := range e.Attributes() {
+			name, value := item.Render()
+			if !strings.HasPrefix(name, "aria-") {
+				continue
+			}
+
+			def, ok := ariaAttributes[name]
+			if !ok {
+				issues = append(issues, Issue{Rule: "aria", Element: e, Message: fmt.Sprintf("Attribute %q is not a valid aria attribute", name)})
+				continue
+			}
+
+			for _, message := range def.check(ix, name, value) {
+				issues = append(issues, Issue{Rule: "aria", Element: e, Message: message})
+			}
+		}
+	}
+
+	return issues
+}
+
+// check returns the problems of the value of the aria attribute.
+func (a ariaAttribute) check(ix index, name string, value string) []string {
+	var problems []string
+
+	switch a.kind {
+	case tokenValue:
+		if token := strings.ToLower(strings.TrimSpace(value)); token != "" && !a.tokens[token] {
+			problems = append(problems, fmt.Sprintf("Attribute %q has invalid value %q", name, value))
+		}
+	case tokenListValue:
+		for _, token := range strings.Fields(strings.ToLower(value)) {
+			if !a.tokens[token] {
+				problems = append(problems, fmt.Sprintf("Attribute %q has invalid value %q", name, token))
+			}
+		}
+	case idValue, idListValue:
+		for _, id := range strings.Fields(value) {
+			if ix.element(id) == nil {
+				problems = append(problems, fmt.Sprintf("Attribute %q references missing id %q", name, id))
+			}
+		}
+	}
+
+	return problems
+}
+
+// nativeState returns true if the element is a html element providing the
+// states required by its role, like the checked state of inputs.
+func nativeState(e *trees.Markup) bool {
+	return isHTML(e, "input", "select", "textarea", "option", "meter", "progress", "h1", "h2", "h3", "h4", "h5", "h6")
+}
+
+// DuplicateIDs reports elements whose id is used by an earlier element.
+func DuplicateIDs(root *trees.Markup) []Issue {
+	var issues []Issue
+
+	seen := make(map[string]bool)
+	for _, e := range allElements(root) {
+		id, _ := attr(e, "id")
+		if id == "" {
+			continue
+		}
+
+		if seen[id] {
+			issues = append(issues, Issue{Rule: "duplicate-id", Element: e, Message: fmt.Sprintf("Id %q is used by an earlier element", id)})
+			continue
+		}
+
+		seen[id] = true
+	}
+
+	return issues
+}
+
+// HeadingOrder reports headings which are more than one level below the
+// heading before them, eg a h4 following a h2.
+func HeadingOrder(root *trees.Markup) []Issue {
+	var issues []Issue
+
+	var previous int
+	for _, e := range elements(root) {
+		level := headingLevel(e)
+		if level == 0 {
+			continue
+		}
+
+		if previous != 0 && level > previous+1 {
+			issues = append(issues, Issue{Rule: "heading-order", Element: e, Message: fmt.Sprintf("Heading level %d follows heading level %d", level, previous)})
+		}
+
+		previous = level
+	}
+
+	return issues
+}
+
+// headingLevel returns the level of the heading element, or 0 if it is not a
+// heading.
+func headingLevel(e *trees.Markup) int {
+	if hasRole(e, "heading") {
+		value, _ := attr(e, "aria-level")
+		if level, err := strconv.Atoi(strings.TrimSpace(value)); err == nil && level > 0 {
+			return level
+		}
+
+		return 2
+	}
+
+	if e.Namespace() == "" && len(e.Name()) == 2 && e.Name()[0] == 'h' && e.Name()[1] >= '1' && e.Name()[1] <= '6' {
+		return int(e.Name()[1] - '0')
+	}
+
+	return 0
+}
@@ -0,0 +1,246 @@
+package css
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// blockKind defines the kind of css rule a Block builds.
+type blockKind int
+
+const (
+	selectBlock blockKind = iota
+	atBlock
+	keyframesBlock
+)
+
+// declaration defines a property and value set on a Block, or a selector whose
+// declarations are extended into the Block.
+type declaration struct {
+	property string
+	value    string
+	extend   bool
+}
+
+var vendorPrefix = regexp.MustCompile(`^-(webkit|moz|ms|o)-`)
+
+// templateEscaper escapes the template delimiters within the selectors and
+// values of blocks, which are built into a Rule parsed as a template.
+var templateEscaper = strings.NewReplacer("{{", "{{`{{`}}")
+
+// Block defines a css rule built in Go instead of a template string, which is
+// turned into a Rule with Build. Blocks are created with Select, Media, Supports
+// and Keyframes.
+type Block struct {
+	kind         blockKind
+	name         string
+	prelude      string
+	selectors    []string
+	declarations []declaration
+	children     []*Block
+	err          error
+}
+
+// Select returns a new Block for the rule of the provided selectors, which can
+// use `&` to target the parent node like the selectors of New. With no
+// selectors, the rule targets the parent node.
+func Select(selectors ...string) *Block {
+	if len(selectors) == 0 {
+		selectors = []string{"&"}
+	}
+
+	return &Block{kind: selectBlock, selectors: selectors}
+}
+
+// Media returns a new Block for a `@media` rule of the query containing the
+// provided blocks. Properties set on it apply to the selectors of its parent
+// block, or to the parent node if it has none.
+func Media(query string, blocks ...*Block) *Block {
+	return &Block{kind: atBlock, name: "@media", prelude: query, children: blocks}
+}
+
+// Supports returns a new Block for a `@supports` rule of the condition
+// containing the provided blocks. Properties set on it apply to the selectors of
+// its parent block, or to the parent node if it has none.
+func Supports(condition string, blocks ...*Block) *Block {
+	return &Block{kind: atBlock, name: "@supports", prelude: condition, children: blocks}
+}
+
+// Keyframes returns a new Block for a `@keyframes` rule of the name containing
+// the provided frames, like Select("from") and Select("50%").
+func Keyframes(name string, frames ...*Block) *Block {
+	return &Block{kind: keyframesBlock, name: "@keyframes", prelude: name, children: frames}
+}
+
+// Set adds the property with the value into the block and returns the block.
+// Properties which are not known css properties record an error returned by
+// Build, except custom properties (`--name`) and known properties with a vendor
+// prefix (`-webkit-name`). Custom properties containing `;`, `{` or `}` record
+// an error too, as they would end the declaration or rule.
+func (b *Block) Set(property string, value string) *Block {
+	property = strings.ToLower(strings.TrimSpace(property))
+
+	switch {
+	case b.err != nil:
+	case strings.HasPrefix(property, "--"):
+		if strings.ContainsAny(property, ";{}") {
+			b.err = fmt.Errorf("Invalid css custom property %q", property)
+		}
+	case !properties[vendorPrefix.ReplaceAllString(property, "")]:
+		b.err = fmt.Errorf("Unknown css property %q", property)
+	}
+
+	b.declarations = append(b.declarations, declaration{property: property, value: value})
+	return b
+}
+
+// Extend adds the declarations of the rule of the provided selector, found in
+// the base styles or the extension of the built Rule, into the block like the
+// `extend` template function and returns the block.
+func (b *Block) Extend(selector string) *Block {
+	b.declarations = append(b.declarations, declaration{property: selector, extend: true})
+	return b
+}
+
+// Nest adds the provided blocks into the block and returns the block. The
+// selectors of nested blocks are relative to the selectors of the block, where
+// `&` is replaced with them, else descending from them.
+func (b *Block) Nest(blocks ...*Block) *Block {
+	b.children = append(b.children, blocks...)
+	return b
+}
+
+// Err returns the first error recorded by the block or its nested blocks.
+func (b *Block) Err() error {
+	if b.err != nil {
+		return b.err
+	}
+
+	for _, child := range b.children {
+		if err := child.Err(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// String returns the css text of the block.
+func (b *Block) String() string {
+	var content bytes.Buffer
+	b.write(&content, nil)
+	return content.String()
+}
+
+// write writes the css text of the block into the buffer, for a block nested
+// within blocks of the provided selectors.
+func (b *Block) write(content *bytes.Buffer, parents []string) {
+	switch b.kind {
+	case selectBlock:
+		selectors := nestSelectors(parents, b.selectors)
+		writeRule(content, selectors, b.declarations)
+
+		for _, child := range b.children {
+			child.write(content, selectors)
+		}
+
+	case atBlock:
+		fmt.Fprintf(content, "%s %s {\n", b.name, templateEscaper.Replace(b.prelude))
+
+		if len(parents) == 0 {
+			writeRule(content, []string{"&"}, b.declarations)
+		} else {
+			writeRule(content, parents, b.declarations)
+		}
+
+		for _, child := range b.children {
+			child.write(content, parents)
+		}
+
+		content.WriteString("}\n")
+
+	case keyframesBlock:
+		fmt.Fprintf(content, "%s %s {\n", b.name, templateEscaper.Replace(b.prelude))
+
+		for _, frame := range b.children {
+			writeRule(content, frame.selectors, frame.declarations)
+		}
+
+		content.WriteString("}\n")
+	}
+}
+
+// writeRule writes the rule of the selectors with the declarations into the
+// buffer if it has any declarations.
+func writeRule(content *bytes.Buffer, selectors []string, declarations []declaration) {
+	if len(declarations) == 0 {
+		return
+	}
+
+	content.WriteString(templateEscaper.Replace(strings.Join(selectors, ", ")))
+	content.WriteString(" {\n")
+
+	for _, decl := range declarations {
+		if decl.extend {
+			fmt.Fprintf(content, "  {{ extend %s }}\n", strconv.Quote(decl.property))
+			continue
+		}
+
+		fmt.Fprintf(content, "  %s: %s;\n", decl.property, templateEscaper.Replace(decl.value))
+	}
+
+	content.WriteString("}\n")
+}
+
+// nestSelectors returns the selectors nested within the parent selectors.
+func nestSelectors(parents []string, selectors []string) []string {
+	if len(parents) == 0 {
+		return selectors
+	}
+
+	var nested []string
+
+	for _, parent := range parents {
+		for _, sel := range selectors {
+			if strings.Contains(sel, "&") {
+				nested = append(nested, strings.Replace(sel, "&", parent, -1))
+				continue
+			}
+
+			nested = append(nested, parent+" "+sel)
+		}
+	}
+
+	return nested
+}
+
+// Build returns a new Rule for the provided blocks, returning the first error
+// recorded by them. The returned Rule composes with other rules using
+// UseExtension and Add like those of New.
+func Build(blocks ...*Block) (*Rule, error) {
+	var content bytes.Buffer
+
+	for _, block := range blocks {
+		if err := block.Err(); err != nil {
+			return nil, err
+		}
+
+		block.write(&content, nil)
+	}
+
+	return newRule(content.String(), nil)
+}
+
+// MustBuild returns a new Rule for the provided blocks like Build, panicking if
+// any of them recorded an error.
+func MustBuild(blocks ...*Block) *Rule {
+	rule, err := Build(blocks...)
+	if err != nil {
+		panic(err)
+	}
+
+	return rule
+}
@@ -4,12 +4,14 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"text/template"
 
 	bcss "github.com/aymerick/douceur/css"
 	"github.com/aymerick/douceur/parser"
+	"github.com/gu-io/gu/common/colors"
 )
 
 var (
@@ -25,9 +27,9 @@ var (
 				grade = 0
 			}
 
-			wantedColor, ok := materialPalettes[colorName]
+			wantedColor, ok := colors.MaterialPalettes[colorName]
 			if !ok {
-				return "rgba(0,0,0,1)"
+				return paletteColor(colorName, grade)
 			}
 
 			var colorVals string
@@ -65,6 +67,7 @@ var (
 				return strconv.Quote(string(mo))
 			}
 		},
+		"var": Var,
 		"prefixInt": func(prefix string, b int) string {
 			return fmt.Sprintf("%s%d", prefix, b)
 		},
@@ -190,9 +193,45 @@ var (
 	}
 )
 
+// paletteColor returns the color of the grade, an index into colors.Grades, of
+// the material-design palette generated for the css color value, used by the
+// `materialColors` template function for colors which are not material
+// palettes.
+func paletteColor(value string, grade int) string {
+	base, err := colors.Parse(value)
+	if err != nil {
+		return "rgba(0,0,0,1)"
+	}
+
+	palette := colors.Palette(base)
+	if grade >= len(palette) {
+		grade = len(palette) - 1
+	}
+
+	r, g, b := palette[grade].RGB255()
+	return fmt.Sprintf("rgba(%d,%d,%d,1)", r, g, b)
+}
+
+// Var returns the css reference to the custom property of the provided name,
+// eg `var(--color-primary)`, using the fallback values if provided. The name
+// is prefixed with `--` if it has not. It is also available to templates of
+// rules as the `var` function.
+func Var(name string, fallback ...string) string {
+	if !strings.HasPrefix(name, "--") {
+		name = "--" + name
+	}
+
+	if len(fallback) == 0 {
+		return "var(" + name + ")"
+	}
+
+	return "var(" + name + ", " + strings.Join(fallback, ", ") + ")"
+}
+
 // Rule defines the a single css rule which will be transformed and
 // converted into a usable stylesheet during rendering.
 type Rule struct {
+	scoped    bool
 	plain     string
 	feed      *Rule
 	depends   []*Rule
@@ -208,6 +247,17 @@ type Rule struct {
 // 		- rules: A slice of rules which should be built with this, they will also inherit this rules parents, a nice way to
 // 				extend a rule sets property.
 func New(rules string, extension *Rule, rs ...*Rule) *Rule {
+	rsc, err := newRule(rules, extension, rs...)
+	if err != nil {
+		panic(err)
+	}
+
+	return rsc
+}
+
+// newRule returns a new instance of a Rule like New, returning the error of
+// parsing the rules as a template.
+func newRule(rules string, extension *Rule, rs ...*Rule) (*Rule, error) {
 	rsc := &Rule{depends: rs, feed: extension}
 
 	tmp, err := template.New("css").Funcs(helpers).Funcs(template.FuncMap{
@@ -215,11 +265,11 @@ func New(rules string, extension *Rule, rs ...*Rule) *Rule {
 	}).Parse(rules)
 
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	rsc.template = tmp
-	return rsc
+	return rsc, nil
 }
 
 // Plain returns a new instance of a Rule which uses the raw rule string instead
@@ -246,6 +296,15 @@ func (r *Rule) UseExtension(c *Rule) *Rule {
 	return r
 }
 
+// Scoped sets the rule to prefix every selector in its stylesheet, including
+// those within at-rules and those of the rules it is built with, with the
+// parent node and to namespace the names of its keyframes to the parent node.
+// Selectors starting with `:global(...)` are left unscoped. It returns the rule.
+func (r *Rule) Scoped() *Rule {
+	r.scoped = true
+	return r
+}
+
 // Add adds the giving rule into the rules depends list.
 func (r *Rule) Add(c *Rule) *Rule {
 	r.depends = append(r.depends, c)
@@ -295,8 +354,18 @@ func (r *Rule) extend(item string) string {
 // Stylesheet returns the provided styles using the binding as the argument for the
 // provided css template.
 func (r *Rule) Stylesheet(bind interface{}, parentNode string) (*bcss.Stylesheet, error) {
+	return r.stylesheet(bind, parentNode, false)
+}
+
+// stylesheet returns the styles of the rule like Stylesheet, scoping them if the
+// rule or a rule it is built with is scoped. The rules it depends on are scoped
+// along with it, and the keyframes of the whole stylesheet are namespaced by the
+// outermost scoped rule, so animations can reference keyframes of any of them.
+func (r *Rule) stylesheet(bind interface{}, parentNode string, scoped bool) (*bcss.Stylesheet, error) {
+	scoping := scoped || r.scoped
+
 	if r.feed != nil {
-		sheet, err := r.feed.Stylesheet(bind, parentNode)
+		sheet, err := r.feed.stylesheet(bind, parentNode, scoping)
 		if err != nil {
 			return nil, err
 		}
@@ -308,7 +377,7 @@ func (r *Rule) Stylesheet(bind interface{}, parentNode string) (*bcss.Stylesheet
 
 	{
 		for _, rule := range r.depends {
-			sheet, err := rule.Stylesheet(bind, parentNode)
+			sheet, err := rule.stylesheet(bind, parentNode, scoping)
 			if err != nil {
 				return nil, err
 			}
@@ -333,45 +402,191 @@ func (r *Rule) Stylesheet(bind interface{}, parentNode string) (*bcss.Stylesheet
 	}
 
 	for _, rule := range sheet.Rules {
-		r.morphRule(rule, parentNode)
+		morphRule(rule, parentNode, scoping)
 	}
 
 	stylesheet.Rules = append(stylesheet.Rules, sheet.Rules...)
 
+	if scoping && !scoped {
+		scopeKeyframes(stylesheet.Rules, parentNode)
+	}
+
 	return &stylesheet, nil
 }
 
 // adjustName adjust the provided name according to the set rules of for specific
-// css selectors.
-func (r *Rule) adjustName(sel string, parentNode string) string {
+// css selectors, prefixing it with the parent node if scoped.
+func adjustName(sel string, parentNode string, scoped bool) string {
 	sel = strings.TrimSpace(sel)
 
 	switch {
+	case strings.HasPrefix(sel, globalPrefix):
+		return unwrapGlobals(sel)
+
 	case strings.Contains(sel, "&"):
-		return strings.Replace(sel, "&", parentNode, -1)
+		return unwrapGlobals(strings.Replace(sel, "&", parentNode, -1))
 
 	case strings.HasPrefix(sel, ":"):
-		return parentNode + "" + sel
+		return parentNode + "" + unwrapGlobals(sel)
+
+	case scoped:
+		return parentNode + " " + unwrapGlobals(sel)
 
 	default:
-		return sel
+		return unwrapGlobals(sel)
 	}
 }
 
 // morphRules adjusts the provided rules with the parent selector.
-func (r *Rule) morphRule(base *bcss.Rule, parentNode string) {
+func morphRule(base *bcss.Rule, parentNode string, scoped bool) {
+	if base.Kind == bcss.AtRule && base.Name == "@keyframes" {
+		return
+	}
+
+	base.Selectors = joinSelectors(base.Selectors)
+
 	for index, sel := range base.Selectors {
-		base.Selectors[index] = r.adjustName(sel, parentNode)
+		base.Selectors[index] = adjustName(sel, parentNode, scoped)
 	}
 
 	for _, rule := range base.Rules {
-		if rule.Kind == bcss.AtRule {
-			r.morphRule(rule, parentNode)
+		morphRule(rule, parentNode, scoped)
+	}
+}
+
+//==============================================================================
+
+// globalPrefix defines the pseudo-class which marks parts of a selector which
+// should not be scoped to the parent node.
+const globalPrefix = ":global("
+
+var unscopable = regexp.MustCompile(`[^\w-]+`)
+
+// joinSelectors rejoins the selectors split by the parser within parentheses,
+// such as those of `:not(.a, .b)` and `:global(.a, .b)`.
+func joinSelectors(sels []string) []string {
+	var joined []string
+	var pending []string
+
+	for _, sel := range sels {
+		pending = append(pending, sel)
+
+		current := strings.Join(pending, ", ")
+		if strings.Count(current, "(") > strings.Count(current, ")") {
+			continue
+		}
+
+		joined = append(joined, current)
+		pending = nil
+	}
+
+	if len(pending) != 0 {
+		joined = append(joined, strings.Join(pending, ", "))
+	}
+
+	return joined
+}
+
+// unwrapGlobals replaces all `:global(...)` pseudo-classes within the selector
+// with their contents.
+func unwrapGlobals(sel string) string {
+	for {
+		start := strings.Index(sel, globalPrefix)
+		if start == -1 {
+			return sel
+		}
+
+		content := start + len(globalPrefix)
+		depth := 1
+		end := -1
+
+		for index := content; index < len(sel) && end == -1; index++ {
+			switch sel[index] {
+			case '(':
+				depth++
+			case ')':
+				depth--
+				if depth == 0 {
+					end = index
+				}
+			}
+		}
+
+		if end == -1 {
+			return sel
+		}
+
+		sel = sel[:start] + strings.TrimSpace(sel[content:end]) + sel[end+1:]
+	}
+}
+
+// scopeKeyframes namespaces the names of the keyframes within the rules to the
+// parent node and renames the animations referencing them.
+func scopeKeyframes(rules []*bcss.Rule, parentNode string) {
+	suffix := strings.Trim(unscopable.ReplaceAllString(parentNode, "-"), "-")
+	names := make(map[string]string)
+
+	collectKeyframes(rules, suffix, names)
+
+	if len(names) == 0 {
+		return
+	}
+
+	renameAnimations(rules, names)
+}
+
+// collectKeyframes renames the keyframes within the rules, storing their new
+// names.
+func collectKeyframes(rules []*bcss.Rule, suffix string, names map[string]string) {
+	for _, rule := range rules {
+		if rule.Kind != bcss.AtRule {
+			continue
+		}
+
+		if rule.Name != "@keyframes" {
+			collectKeyframes(rule.Rules, suffix, names)
 			continue
 		}
 
-		for index, sel := range rule.Selectors {
-			rule.Selectors[index] = r.adjustName(sel, parentNode)
+		name := strings.TrimSpace(rule.Prelude)
+		if strings.HasPrefix(name, globalPrefix) {
+			rule.Prelude = unwrapGlobals(name)
+			continue
+		}
+
+		names[name] = name + "-" + suffix
+		rule.Prelude = names[name]
+	}
+}
+
+// renameAnimations replaces the keyframe names within the animation properties
+// of the rules with their new names.
+func renameAnimations(rules []*bcss.Rule, names map[string]string) {
+	for _, rule := range rules {
+		renameAnimations(rule.Rules, names)
+
+		for _, decl := range rule.Declarations {
+			switch strings.TrimPrefix(decl.Property, "-webkit-") {
+			case "animation", "animation-name":
+			default:
+				continue
+			}
+
+			animations := strings.Split(decl.Value, ",")
+
+			for index, animation := range animations {
+				fields := strings.Fields(animation)
+
+				for findex, field := range fields {
+					if name, ok := names[field]; ok {
+						fields[findex] = name
+					}
+				}
+
+				animations[index] = strings.Join(fields, " ")
+			}
+
+			decl.Value = strings.Join(animations, ", ")
 		}
 	}
 }
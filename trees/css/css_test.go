@@ -155,3 +155,249 @@ func TestLinkedCSS(t *testing.T) {
 	}
 	tests.Passed("Should have rendered expected stylesheet")
 }
+
+func TestScopedCSS(t *testing.T) {
+	expected := "#galatica .title, #galatica h1 {\n  color: red;\n}\n#galatica:hover {\n  color: blue;\n}\n#galatica > a:not(.active, .hidden) {\n  color: black;\n}\nbody .title {\n  margin: 0;\n}\n#galatica .box .title {\n  animation: spin-galatica 1s linear, fade 2s;\n}\n@keyframes spin-galatica {\n  from {\n    opacity: 0;\n  }\n  to {\n    opacity: 1;\n  }\n}\n@keyframes fade {\n  to {\n    opacity: 0;\n  }\n}\n@media (max-width: 400px) {\n  #galatica .title {\n    font-family: Helvetica;\n  }\n  @supports (display: grid) {\n    #galatica .grid {\n      display: grid;\n    }\n  }\n}"
+
+	csr := css.New(`
+    .title, h1 {
+      color: red;
+    }
+
+    :hover {
+      color: blue;
+    }
+
+    & > a:not(.active, .hidden) {
+      color: black;
+    }
+
+    :global(body .title) {
+      margin: 0;
+    }
+
+    .box :global(.title) {
+      animation: spin 1s linear, fade 2s;
+    }
+
+    @keyframes spin {
+      from {
+        opacity: 0;
+      }
+
+      to {
+        opacity: 1;
+      }
+    }
+
+    @keyframes :global(fade) {
+      to {
+        opacity: 0;
+      }
+    }
+
+    @media (max-width: 400px){
+
+      .title {
+        font-family: {{ .Font }};
+      }
+
+      @supports (display: grid) {
+        .grid {
+          display: grid;
+        }
+      }
+
+    }
+`, nil).Scoped()
+
+	sheet, err := csr.Stylesheet(struct {
+		Font string
+	}{Font: "Helvetica"}, "#galatica")
+
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected scoped stylesheet")
+	}
+	tests.Passed("Should have rendered expected scoped stylesheet")
+}
+
+func TestScopedDependsCSS(t *testing.T) {
+	expected := "#galatica .dep {\n  animation: pulse-galatica 1s;\n}\n@keyframes pulse-galatica {\n  to {\n    opacity: 1;\n  }\n}\n#galatica .added {\n  color: blue;\n}\n#galatica .title {\n  animation: pulse-galatica 2s;\n}"
+
+	dep := css.New(`
+    .dep {
+      animation: pulse 1s;
+    }
+
+    @keyframes pulse {
+      to {
+        opacity: 1;
+      }
+    }
+  `, nil)
+
+	added := css.New(`
+    .added {
+      color: blue;
+    }
+  `, nil)
+
+	csr := css.New(`
+    .title {
+      animation: pulse 2s;
+    }
+  `, nil, dep).Add(added).Scoped()
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule")
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have scoped the stylesheets of the rules it depends on")
+	}
+	tests.Passed("Should have scoped the stylesheets of the rules it depends on")
+
+	unscoped, err := dep.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule")
+	}
+
+	if val := unscoped.String(); val != ".dep {\n  animation: pulse 1s;\n}\n@keyframes pulse {\n  to {\n    opacity: 1;\n  }\n}" {
+		t.Logf("\t\tRecieved: %q\n", val)
+		tests.Failed("Should have left the rules depended on unscoped on their own")
+	}
+	tests.Passed("Should have left the rules depended on unscoped on their own")
+}
+
+func TestBuilderCSS(t *testing.T) {
+	expected := "#galatica:hover {\n  color: red;\n  -webkit-transition: color 1s;\n}\n@media (max-width: 400px) {\n  #galatica:hover {\n    display: none;\n  }\n}\n#galatica div a, #galatica div span {\n  color: black;\n  --gap: 10px;\n}\n#galatica div a::before, #galatica div span::before {\n  content: \"{{ quote }}\";\n}\n@media (max-width: 400px) {\n  #galatica p {\n    font-family: Helvetica;\n    color: Pink;\n  }\n}\n@keyframes spin {\n  from {\n    opacity: 0;\n  }\n  to {\n    opacity: 1;\n  }\n}"
+
+	csr := css.New(`
+    block {
+      font-family: {{ .Font }};
+      color: {{ .Color }};
+    }
+  `, nil)
+
+	rule, err := css.Build(
+		css.Select("&:hover").
+			Set("color", "red").
+			Set("-webkit-transition", "color 1s").
+			Nest(css.Media("(max-width: 400px)").Set("display", "none")),
+		css.Select("& div").Nest(
+			css.Select("a", "span").
+				Set("color", "black").
+				Set("--gap", "10px").
+				Nest(css.Select("&::before").Set("content", `"{{ quote }}"`)),
+		),
+		css.Media("(max-width: 400px)",
+			css.Select("& p").Extend("block"),
+		),
+		css.Keyframes("spin",
+			css.Select("from").Set("opacity", "0"),
+			css.Select("to").Set("opacity", "1"),
+		),
+	)
+
+	if err != nil {
+		tests.Failed("Should have successfully built rule: %s", err)
+	}
+	tests.Passed("Should have successfully built rule")
+
+	sheet, err := rule.UseExtension(csr).Stylesheet(struct {
+		Font  string
+		Color string
+	}{
+		Font:  "Helvetica",
+		Color: "Pink",
+	}, "#galatica")
+
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule: %s", err)
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected stylesheet")
+	}
+	tests.Passed("Should have rendered expected stylesheet")
+
+	if _, err := css.Build(css.Select("&").Set("colour", "red")); err == nil {
+		tests.Failed("Should have failed to build rule with unknown property")
+	}
+	tests.Passed("Should have failed to build rule with unknown property")
+
+	for _, property := range []string{"--gap;color", "--gap{", "--gap}"} {
+		if _, err := css.Build(css.Select("&").Set(property, "red")); err == nil {
+			tests.Failed("Should have failed to build rule with custom property %q", property)
+		}
+	}
+	tests.Passed("Should have failed to build rules with custom properties containing ; { or }")
+}
+
+func TestVarCSS(t *testing.T) {
+	expected := "#galatica {\n  color: var(--color-primary);\n  background: var(--color-white, #fff);\n}"
+
+	csr := css.New(`
+    & {
+      color: {{ var "--color-primary" }};
+      background: {{ var "color-white" "#fff" }};
+    }
+`, nil)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule: %s", err)
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered expected stylesheet")
+	}
+	tests.Passed("Should have rendered expected stylesheet")
+
+	if val := css.Var("--color-primary", "red"); val != "var(--color-primary, red)" {
+		tests.Failed("Should have returned reference to custom property: %q", val)
+	}
+	tests.Passed("Should have returned reference to custom property")
+}
+
+func TestMaterialColorsCSS(t *testing.T) {
+	expected := "#galatica {\n  color: rgba(33,150,243,1);\n  background: rgba(33,150,243,1);\n  border-color: rgba(0,0,0,1);\n}"
+
+	csr := css.New(`
+    & {
+      color: {{ materialColors "blue" 5 }};
+      background: {{ materialColors "#2196f3" 5 }};
+      border-color: {{ materialColors "blue-ish" 5 }};
+    }
+`, nil)
+
+	sheet, err := csr.Stylesheet(nil, "#galatica")
+	if err != nil {
+		tests.Failed("Should have successfully processed stylesheet for rule: %s", err)
+	}
+	tests.Passed("Should have successfully processed stylesheet for rule")
+
+	if val := sheet.String(); val != expected {
+		t.Logf("\t\tRecieved: %q\n", val)
+		t.Logf("\t\tExpected: %q\n", expected)
+		tests.Failed("Should have rendered material palettes and palettes of colors")
+	}
+	tests.Passed("Should have rendered material palettes and palettes of colors")
+}
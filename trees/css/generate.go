@@ -0,0 +1,61 @@
+// +build ignore
+
+// The generation of the css properties known by the Block builder runs from the
+// css properties listed in the spec.json of the property package, which also
+// generates their style functions.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+)
+
+type spec struct {
+	Properties []struct {
+		Name string `json:"name"`
+	} `json:"properties"`
+}
+
+func main() {
+	data, err := ioutil.ReadFile("../property/spec.json")
+	if err != nil {
+		log.Fatalf("Unable to locate `spec.json` file: %s", err)
+	}
+
+	var sc spec
+	if err := json.Unmarshal(data, &sc); err != nil {
+		log.Fatalf("Unable to decode `spec.json` file: %s", err)
+	}
+
+	var file bytes.Buffer
+
+	fmt.Fprint(&file, `package css
+
+//go:generate go run generate.go
+
+// properties contains the names of the css properties known by the Block builder,
+// against which the properties set on blocks are validated. It is generated from
+// the spec.json of the property package.
+var properties = map[string]bool{
+`)
+
+	for _, prop := range sc.Properties {
+		fmt.Fprintf(&file, "%q: true,\n", prop.Name)
+	}
+
+	fmt.Fprint(&file, "}\n")
+
+	source, err := format.Source(file.Bytes())
+	if err != nil {
+		log.Fatalf("Unable to format css properties: %s", err)
+	}
+
+	if err := ioutil.WriteFile("properties.gen.go", source, 0644); err != nil {
+		log.Fatalf("Unable to write css properties: %s", err)
+	}
+}
@@ -158,6 +158,13 @@ func CSS(styles interface{}, bind interface{}, ext *css.Rule) *trees.Markup {
 	return trees.CSSStylesheet(styles, bind, ext, false)
 }
 
+// ScopedCSS provides a function that takes style rules which returns a stylesheet embeded into
+// the provided element parent, where every selector is scoped to the parent and its keyframes
+// are namespaced to it. Selectors starting with :global(...) are left unscoped.
+func ScopedCSS(styles interface{}, bind interface{}, ext *css.Rule) *trees.Markup {
+	return trees.ScopedCSSStylesheet(styles, bind, ext, false)
+}
+
 // SvgAnchor provides the following for SVG XML elements ->
 // The <a> SVG element defines a hyperlink.
 // https://developer.mozilla.org/en-US/docs/Web/SVG/Element/a
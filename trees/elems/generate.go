@@ -292,6 +292,13 @@ func PlainCSS(styles interface{}, bind interface{}, ext *css.Rule) *trees.Markup
 func CSS(styles interface{}, bind interface{}, ext *css.Rule) *trees.Markup {
 	return trees.CSSStylesheet(styles, bind, ext, false)
 }
+
+// ScopedCSS provides a function that takes style rules which returns a stylesheet embeded into
+// the provided element parent, where every selector is scoped to the parent and its keyframes
+// are namespaced to it. Selectors starting with :global(...) are left unscoped.
+func ScopedCSS(styles interface{}, bind interface{}, ext *css.Rule) *trees.Markup {
+	return trees.ScopedCSSStylesheet(styles, bind, ext, false)
+}
 `)
 
 	code := regexp.MustCompile("</?code>")
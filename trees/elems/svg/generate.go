@@ -0,0 +1,149 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// element defines a svg element with the description of its documentation.
+type element struct {
+	name string
+	desc string
+}
+
+// elemNameMap contains the function names of elements whose names do not
+// capitalize well.
+var elemNameMap = map[string]string{
+	"a":     "Anchor",
+	"g":     "Group",
+	"svg":   "SVG",
+	"mpath": "MPath",
+	"tspan": "TSpan",
+}
+
+// elements contains the elements of SVG 2 and SVG Filter Effects.
+var elements = []element{
+	{"a", "The <a> element creates a hyperlink to other web pages, files, locations within the same page or any other URL."},
+	{"animate", "The <animate> element provides a way to animate an attribute of an element over time."},
+	{"animateMotion", "The <animateMotion> element causes a referenced element to move along a motion path."},
+	{"animateTransform", "The <animateTransform> element animates a transformation attribute on its target element."},
+	{"circle", "The <circle> element draws a circle based on a center point and a radius."},
+	{"clipPath", "The <clipPath> element defines a clipping path, to be used by the clip-path property."},
+	{"defs", "The <defs> element stores graphical objects that will be used at a later time."},
+	{"desc", "The <desc> element provides an accessible, long-text description of any container or graphics element."},
+	{"ellipse", "The <ellipse> element draws an ellipse based on a center coordinate and both their x and y radius."},
+	{"feBlend", "The <feBlend> filter primitive composes two objects together using commonly used blending modes."},
+	{"feColorMatrix", "The <feColorMatrix> filter primitive changes colors based on a transformation matrix."},
+	{"feComponentTransfer", "The <feComponentTransfer> filter primitive performs color-component-wise remapping of data for each pixel."},
+	{"feComposite", "The <feComposite> filter primitive combines two input images using a Porter-Duff compositing operation."},
+	{"feConvolveMatrix", "The <feConvolveMatrix> filter primitive applies a matrix convolution filter effect."},
+	{"feDiffuseLighting", "The <feDiffuseLighting> filter primitive lights an image using the alpha channel as a bump map."},
+	{"feDisplacementMap", "The <feDisplacementMap> filter primitive uses the pixel values of one image to displace the pixels of another."},
+	{"feDistantLight", "The <feDistantLight> filter primitive defines a distant light source for lighting filter primitives."},
+	{"feDropShadow", "The <feDropShadow> filter primitive creates a drop shadow of the input image."},
+	{"feFlood", "The <feFlood> filter primitive fills the filter subregion with a color and opacity."},
+	{"feFuncA", "The <feFuncA> element defines the transfer function for the alpha component of its parent <feComponentTransfer>."},
+	{"feFuncB", "The <feFuncB> element defines the transfer function for the blue component of its parent <feComponentTransfer>."},
+	{"feFuncG", "The <feFuncG> element defines the transfer function for the green component of its parent <feComponentTransfer>."},
+	{"feFuncR", "The <feFuncR> element defines the transfer function for the red component of its parent <feComponentTransfer>."},
+	{"feGaussianBlur", "The <feGaussianBlur> filter primitive blurs the input image by the amount specified in stdDeviation."},
+	{"feImage", "The <feImage> filter primitive fetches image data from an external source and provides it as output."},
+	{"feMerge", "The <feMerge> filter primitive composites input image layers on top of each other."},
+	{"feMergeNode", "The <feMergeNode> element takes the result of another filter to be processed by its parent <feMerge>."},
+	{"feMorphology", "The <feMorphology> filter primitive erodes or dilates the input image."},
+	{"feOffset", "The <feOffset> filter primitive offsets the input image relative to its current position."},
+	{"fePointLight", "The <fePointLight> filter primitive defines a light source which allows to create a point light effect."},
+	{"feSpecularLighting", "The <feSpecularLighting> filter primitive lights a source graphic using the alpha channel as a bump map."},
+	{"feSpotLight", "The <feSpotLight> filter primitive defines a light source which allows to create a spotlight effect."},
+	{"feTile", "The <feTile> filter primitive fills a target rectangle with a repeated, tiled pattern of an input image."},
+	{"feTurbulence", "The <feTurbulence> filter primitive creates an image using the Perlin turbulence function."},
+	{"filter", "The <filter> element defines a custom filter effect by grouping atomic filter primitives."},
+	{"foreignObject", "The <foreignObject> element includes elements from a different XML namespace, like html."},
+	{"g", "The <g> element is a container used to group other SVG elements."},
+	{"image", "The <image> element includes images inside SVG documents."},
+	{"line", "The <line> element creates a line connecting two points."},
+	{"linearGradient", "The <linearGradient> element lets authors define linear gradients to apply to other SVG elements."},
+	{"marker", "The <marker> element defines a graphic used for drawing arrowheads or polymarkers on a given path, line, polyline or polygon element."},
+	{"mask", "The <mask> element defines an alpha mask for compositing the current object into the background."},
+	{"metadata", "The <metadata> element adds metadata to SVG content."},
+	{"mpath", "The <mpath> sub-element for the <animateMotion> element provides the ability to reference an external <path> element as the definition of a motion path."},
+	{"path", "The <path> element is the generic element to define a shape."},
+	{"pattern", "The <pattern> element defines a graphics object which can be redrawn at repeated x- and y-coordinate intervals to cover an area."},
+	{"polygon", "The <polygon> element defines a closed shape consisting of a set of connected straight line segments."},
+	{"polyline", "The <polyline> element creates straight lines connecting several points."},
+	{"radialGradient", "The <radialGradient> element lets authors define radial gradients to fill or stroke graphical elements."},
+	{"rect", "The <rect> element draws rectangles, defined by their position, width and height."},
+	{"script", "The <script> element allows to add scripts to an SVG document."},
+	{"set", "The <set> element provides a simple means of just setting the value of an attribute for a specified duration."},
+	{"stop", "The <stop> element defines a color and its position to use on a gradient."},
+	{"style", "The <style> element allows style sheets to be embedded directly within SVG content."},
+	{"svg", "The <svg> element is a container that defines a new coordinate system and viewport."},
+	{"switch", "The <switch> element evaluates any requiredFeatures, requiredExtensions and systemLanguage attributes on its direct child elements in order, and then renders the first child where these attributes evaluate to true."},
+	{"symbol", "The <symbol> element is used to define graphical template objects which can be instantiated by a <use> element."},
+	{"text", "The <text> element draws a graphics element consisting of text."},
+	{"textPath", "The <textPath> element renders text along the shape of a <path> element."},
+	{"title", "The <title> element provides an accessible, short-text description of any SVG container element or graphics element."},
+	{"tspan", "The <tspan> element defines a subtext within a <text> element or another <tspan> element."},
+	{"use", "The <use> element takes nodes from within the SVG document, and duplicates them somewhere else."},
+	{"view", "The <view> element defines a particular view of an SVG document."},
+}
+
+func main() {
+	var file bytes.Buffer
+
+	fmt.Fprint(&file, `// Package svg contains definition for the different svg element types, which
+// are created within the svg namespace.
+
+//go:generate go run generate.go
+
+// Documentation source: "SVG element reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/SVG/Element, licensed under CC-BY-SA 2.5.
+
+package svg
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+`)
+
+	for _, elem := range elements {
+		writeElem(&file, elem)
+	}
+
+	source, err := format.Source(file.Bytes())
+	if err != nil {
+		log.Fatalf("Unable to format svg elements: %s", err)
+	}
+
+	if err := ioutil.WriteFile("svg.gen.go", source, 0644); err != nil {
+		log.Fatalf("Unable to write svg elements: %s", err)
+	}
+}
+
+func writeElem(w *bytes.Buffer, elem element) {
+	funName, ok := elemNameMap[elem.name]
+	if !ok {
+		funName = strings.ToUpper(elem.name[:1]) + elem.name[1:]
+	}
+
+	fmt.Fprintf(w, `
+// %s provides the following for SVG XML elements ->
+// %s
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/%s
+func %s(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, %q, false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+`, funName, elem.desc, elem.name, funName, elem.name)
+}
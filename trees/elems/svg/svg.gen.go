@@ -0,0 +1,894 @@
+// Package svg contains definition for the different svg element types, which
+// are created within the svg namespace.
+
+//go:generate go run generate.go
+
+// Documentation source: "SVG element reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/SVG/Element, licensed under CC-BY-SA 2.5.
+
+package svg
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+
+// Anchor provides the following for SVG XML elements ->
+// The <a> element creates a hyperlink to other web pages, files, locations within the same page or any other URL.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/a
+func Anchor(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "a", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Animate provides the following for SVG XML elements ->
+// The <animate> element provides a way to animate an attribute of an element over time.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/animate
+func Animate(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "animate", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// AnimateMotion provides the following for SVG XML elements ->
+// The <animateMotion> element causes a referenced element to move along a motion path.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/animateMotion
+func AnimateMotion(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "animateMotion", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// AnimateTransform provides the following for SVG XML elements ->
+// The <animateTransform> element animates a transformation attribute on its target element.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/animateTransform
+func AnimateTransform(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "animateTransform", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Circle provides the following for SVG XML elements ->
+// The <circle> element draws a circle based on a center point and a radius.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/circle
+func Circle(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "circle", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// ClipPath provides the following for SVG XML elements ->
+// The <clipPath> element defines a clipping path, to be used by the clip-path property.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/clipPath
+func ClipPath(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "clipPath", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Defs provides the following for SVG XML elements ->
+// The <defs> element stores graphical objects that will be used at a later time.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/defs
+func Defs(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "defs", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Desc provides the following for SVG XML elements ->
+// The <desc> element provides an accessible, long-text description of any container or graphics element.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/desc
+func Desc(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "desc", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Ellipse provides the following for SVG XML elements ->
+// The <ellipse> element draws an ellipse based on a center coordinate and both their x and y radius.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/ellipse
+func Ellipse(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "ellipse", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeBlend provides the following for SVG XML elements ->
+// The <feBlend> filter primitive composes two objects together using commonly used blending modes.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feBlend
+func FeBlend(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feBlend", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeColorMatrix provides the following for SVG XML elements ->
+// The <feColorMatrix> filter primitive changes colors based on a transformation matrix.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feColorMatrix
+func FeColorMatrix(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feColorMatrix", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeComponentTransfer provides the following for SVG XML elements ->
+// The <feComponentTransfer> filter primitive performs color-component-wise remapping of data for each pixel.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feComponentTransfer
+func FeComponentTransfer(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feComponentTransfer", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeComposite provides the following for SVG XML elements ->
+// The <feComposite> filter primitive combines two input images using a Porter-Duff compositing operation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feComposite
+func FeComposite(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feComposite", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeConvolveMatrix provides the following for SVG XML elements ->
+// The <feConvolveMatrix> filter primitive applies a matrix convolution filter effect.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feConvolveMatrix
+func FeConvolveMatrix(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feConvolveMatrix", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeDiffuseLighting provides the following for SVG XML elements ->
+// The <feDiffuseLighting> filter primitive lights an image using the alpha channel as a bump map.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDiffuseLighting
+func FeDiffuseLighting(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feDiffuseLighting", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeDisplacementMap provides the following for SVG XML elements ->
+// The <feDisplacementMap> filter primitive uses the pixel values of one image to displace the pixels of another.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDisplacementMap
+func FeDisplacementMap(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feDisplacementMap", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeDistantLight provides the following for SVG XML elements ->
+// The <feDistantLight> filter primitive defines a distant light source for lighting filter primitives.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDistantLight
+func FeDistantLight(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feDistantLight", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeDropShadow provides the following for SVG XML elements ->
+// The <feDropShadow> filter primitive creates a drop shadow of the input image.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feDropShadow
+func FeDropShadow(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feDropShadow", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeFlood provides the following for SVG XML elements ->
+// The <feFlood> filter primitive fills the filter subregion with a color and opacity.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFlood
+func FeFlood(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feFlood", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeFuncA provides the following for SVG XML elements ->
+// The <feFuncA> element defines the transfer function for the alpha component of its parent <feComponentTransfer>.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncA
+func FeFuncA(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feFuncA", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeFuncB provides the following for SVG XML elements ->
+// The <feFuncB> element defines the transfer function for the blue component of its parent <feComponentTransfer>.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncB
+func FeFuncB(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feFuncB", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeFuncG provides the following for SVG XML elements ->
+// The <feFuncG> element defines the transfer function for the green component of its parent <feComponentTransfer>.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncG
+func FeFuncG(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feFuncG", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeFuncR provides the following for SVG XML elements ->
+// The <feFuncR> element defines the transfer function for the red component of its parent <feComponentTransfer>.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feFuncR
+func FeFuncR(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feFuncR", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeGaussianBlur provides the following for SVG XML elements ->
+// The <feGaussianBlur> filter primitive blurs the input image by the amount specified in stdDeviation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feGaussianBlur
+func FeGaussianBlur(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feGaussianBlur", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeImage provides the following for SVG XML elements ->
+// The <feImage> filter primitive fetches image data from an external source and provides it as output.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feImage
+func FeImage(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feImage", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeMerge provides the following for SVG XML elements ->
+// The <feMerge> filter primitive composites input image layers on top of each other.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feMerge
+func FeMerge(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feMerge", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeMergeNode provides the following for SVG XML elements ->
+// The <feMergeNode> element takes the result of another filter to be processed by its parent <feMerge>.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feMergeNode
+func FeMergeNode(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feMergeNode", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeMorphology provides the following for SVG XML elements ->
+// The <feMorphology> filter primitive erodes or dilates the input image.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feMorphology
+func FeMorphology(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feMorphology", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeOffset provides the following for SVG XML elements ->
+// The <feOffset> filter primitive offsets the input image relative to its current position.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feOffset
+func FeOffset(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feOffset", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FePointLight provides the following for SVG XML elements ->
+// The <fePointLight> filter primitive defines a light source which allows to create a point light effect.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/fePointLight
+func FePointLight(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "fePointLight", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeSpecularLighting provides the following for SVG XML elements ->
+// The <feSpecularLighting> filter primitive lights a source graphic using the alpha channel as a bump map.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feSpecularLighting
+func FeSpecularLighting(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feSpecularLighting", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeSpotLight provides the following for SVG XML elements ->
+// The <feSpotLight> filter primitive defines a light source which allows to create a spotlight effect.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feSpotLight
+func FeSpotLight(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feSpotLight", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeTile provides the following for SVG XML elements ->
+// The <feTile> filter primitive fills a target rectangle with a repeated, tiled pattern of an input image.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feTile
+func FeTile(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feTile", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// FeTurbulence provides the following for SVG XML elements ->
+// The <feTurbulence> filter primitive creates an image using the Perlin turbulence function.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/feTurbulence
+func FeTurbulence(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "feTurbulence", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Filter provides the following for SVG XML elements ->
+// The <filter> element defines a custom filter effect by grouping atomic filter primitives.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/filter
+func Filter(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "filter", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// ForeignObject provides the following for SVG XML elements ->
+// The <foreignObject> element includes elements from a different XML namespace, like html.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/foreignObject
+func ForeignObject(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "foreignObject", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Group provides the following for SVG XML elements ->
+// The <g> element is a container used to group other SVG elements.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/g
+func Group(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "g", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Image provides the following for SVG XML elements ->
+// The <image> element includes images inside SVG documents.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/image
+func Image(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "image", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Line provides the following for SVG XML elements ->
+// The <line> element creates a line connecting two points.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/line
+func Line(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "line", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// LinearGradient provides the following for SVG XML elements ->
+// The <linearGradient> element lets authors define linear gradients to apply to other SVG elements.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/linearGradient
+func LinearGradient(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "linearGradient", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Marker provides the following for SVG XML elements ->
+// The <marker> element defines a graphic used for drawing arrowheads or polymarkers on a given path, line, polyline or polygon element.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/marker
+func Marker(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "marker", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Mask provides the following for SVG XML elements ->
+// The <mask> element defines an alpha mask for compositing the current object into the background.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/mask
+func Mask(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "mask", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Metadata provides the following for SVG XML elements ->
+// The <metadata> element adds metadata to SVG content.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/metadata
+func Metadata(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "metadata", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// MPath provides the following for SVG XML elements ->
+// The <mpath> sub-element for the <animateMotion> element provides the ability to reference an external <path> element as the definition of a motion path.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/mpath
+func MPath(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "mpath", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Path provides the following for SVG XML elements ->
+// The <path> element is the generic element to define a shape.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/path
+func Path(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "path", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Pattern provides the following for SVG XML elements ->
+// The <pattern> element defines a graphics object which can be redrawn at repeated x- and y-coordinate intervals to cover an area.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/pattern
+func Pattern(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "pattern", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Polygon provides the following for SVG XML elements ->
+// The <polygon> element defines a closed shape consisting of a set of connected straight line segments.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polygon
+func Polygon(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "polygon", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Polyline provides the following for SVG XML elements ->
+// The <polyline> element creates straight lines connecting several points.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/polyline
+func Polyline(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "polyline", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// RadialGradient provides the following for SVG XML elements ->
+// The <radialGradient> element lets authors define radial gradients to fill or stroke graphical elements.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/radialGradient
+func RadialGradient(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "radialGradient", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Rect provides the following for SVG XML elements ->
+// The <rect> element draws rectangles, defined by their position, width and height.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/rect
+func Rect(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "rect", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Script provides the following for SVG XML elements ->
+// The <script> element allows to add scripts to an SVG document.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/script
+func Script(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "script", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Set provides the following for SVG XML elements ->
+// The <set> element provides a simple means of just setting the value of an attribute for a specified duration.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/set
+func Set(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "set", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Stop provides the following for SVG XML elements ->
+// The <stop> element defines a color and its position to use on a gradient.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/stop
+func Stop(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "stop", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Style provides the following for SVG XML elements ->
+// The <style> element allows style sheets to be embedded directly within SVG content.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/style
+func Style(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "style", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// SVG provides the following for SVG XML elements ->
+// The <svg> element is a container that defines a new coordinate system and viewport.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/svg
+func SVG(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "svg", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Switch provides the following for SVG XML elements ->
+// The <switch> element evaluates any requiredFeatures, requiredExtensions and systemLanguage attributes on its direct child elements in order, and then renders the first child where these attributes evaluate to true.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/switch
+func Switch(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "switch", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Symbol provides the following for SVG XML elements ->
+// The <symbol> element is used to define graphical template objects which can be instantiated by a <use> element.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/symbol
+func Symbol(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "symbol", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Text provides the following for SVG XML elements ->
+// The <text> element draws a graphics element consisting of text.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/text
+func Text(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "text", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// TextPath provides the following for SVG XML elements ->
+// The <textPath> element renders text along the shape of a <path> element.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/textPath
+func TextPath(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "textPath", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Title provides the following for SVG XML elements ->
+// The <title> element provides an accessible, short-text description of any SVG container element or graphics element.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/title
+func Title(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "title", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// TSpan provides the following for SVG XML elements ->
+// The <tspan> element defines a subtext within a <text> element or another <tspan> element.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/tspan
+func TSpan(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "tspan", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// Use provides the following for SVG XML elements ->
+// The <use> element takes nodes from within the SVG document, and duplicates them somewhere else.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/use
+func Use(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "use", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
+
+// View provides the following for SVG XML elements ->
+// The <view> element defines a particular view of an SVG document.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Element/view
+func View(markup ...trees.Appliable) *trees.Markup {
+	e := trees.NewMarkupNS(trees.SVGNamespace, "view", false)
+	for _, m := range markup {
+		if m == nil {
+			continue
+		}
+		m.Apply(e)
+	}
+	return e
+}
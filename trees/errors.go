@@ -21,3 +21,6 @@ var ErrNotFound = errors.New("Item not found")
 
 // ErrNotStyle relating to the style types
 var ErrNotStyle = errors.New("Value type is not a Style type")
+
+// ErrInvalidSelector is returned when a css selector can not be parsed.
+var ErrInvalidSelector = errors.New("Invalid css selector")
@@ -3,8 +3,11 @@ package trees
 import (
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
 	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
 )
 
 // EventOptions defines a function type used to apply specific operations to a
@@ -53,6 +56,45 @@ func PreventDefault(state bool) EventOptions {
 	}
 }
 
+// Debounce sets the event to only be delivered once no other occurrence of it
+// happened within the provided duration.
+func Debounce(d time.Duration) EventOptions {
+	return func(ev *Event) {
+		ev.Debounce = d
+	}
+}
+
+// Throttle sets the event to be delivered atmost once within the provided
+// duration, ignoring occurrences in between.
+func Throttle(d time.Duration) EventOptions {
+	return func(ev *Event) {
+		ev.Throttle = d
+	}
+}
+
+// Once sets the event to only be delivered the first time it occurs.
+func Once() EventOptions {
+	return func(ev *Event) {
+		ev.Once = true
+	}
+}
+
+// Passive sets the event listener as passive, which lets the browser scroll
+// without waiting on the listener, PreventDefault has no effect on such events.
+func Passive() EventOptions {
+	return func(ev *Event) {
+		ev.Passive = true
+	}
+}
+
+// KeyFilter sets the event to only be delivered for keyboard events whose key
+// matches one of the provided keys (e.g "Enter", "Escape").
+func KeyFilter(keys ...string) EventOptions {
+	return func(ev *Event) {
+		ev.KeyFilter = append(ev.KeyFilter, keys...)
+	}
+}
+
 // Event provide a meta registry for helps in registering events for dom markups
 // which is translated to the nodes themselves
 type Event struct {
@@ -61,14 +103,20 @@ type Event struct {
 	StopPropagation          bool
 	UseCapture               bool
 	StopImmediatePropagation bool
+	Passive                  bool
+	Once                     bool
+	Debounce                 time.Duration
+	Throttle                 time.Duration
+	KeyFilter                []string
 	Tree                     *Markup
 	Remove                   common.Remover
 	secTarget                string
+	gate                     *eventGate
 }
 
 // NewEvent returns a event object that allows registering events to eventlisteners.
 func NewEvent(options ...EventOptions) *Event {
-	evm := &Event{}
+	evm := &Event{gate: new(eventGate)}
 
 	for _, option := range options {
 		if option == nil {
@@ -92,14 +140,19 @@ func (e *Event) Target() string {
 // EventJSON defines a struct which contains the giving events and
 // and tree of the giving tree.
 type EventJSON struct {
-	ParentSelector           string `json:"ParentSelector"`
-	EventSelector            string `json:"EventSelector"`
-	EventName                string `json:"EventName"`
-	Event                    string `json:"Event"`
-	PreventDefault           bool   `json:"PreventDefault"`
-	StopPropagation          bool   `json:"StopPropagation"`
-	UseCapture               bool   `json:"UseCapture"`
-	StopImmediatePropagation bool   `json:"StopImmediatePropagation"`
+	ParentSelector           string   `json:"ParentSelector"`
+	EventSelector            string   `json:"EventSelector"`
+	EventName                string   `json:"EventName"`
+	Event                    string   `json:"Event"`
+	PreventDefault           bool     `json:"PreventDefault"`
+	StopPropagation          bool     `json:"StopPropagation"`
+	UseCapture               bool     `json:"UseCapture"`
+	StopImmediatePropagation bool     `json:"StopImmediatePropagation"`
+	Passive                  bool     `json:"Passive"`
+	Once                     bool     `json:"Once"`
+	Debounce                 int64    `json:"Debounce"`
+	Throttle                 int64    `json:"Throttle"`
+	KeyFilter                []string `json:"KeyFilter"`
 }
 
 // EventJSON returns the event json structure which represent the giving event.
@@ -113,6 +166,11 @@ func (e *Event) EventJSON() EventJSON {
 		PreventDefault:           e.PreventDefault,
 		StopPropagation:          e.StopPropagation,
 		StopImmediatePropagation: e.StopImmediatePropagation,
+		Passive:                  e.Passive,
+		Once:                     e.Once,
+		Debounce:                 int64(e.Debounce / time.Millisecond),
+		Throttle:                 int64(e.Throttle / time.Millisecond),
+		KeyFilter:                e.KeyFilter,
 	}
 }
 
@@ -158,7 +216,68 @@ func (e *Event) Clone() *Event {
 		UseCapture:               e.UseCapture,
 		StopPropagation:          e.StopPropagation,
 		StopImmediatePropagation: e.StopImmediatePropagation,
+		Passive:                  e.Passive,
+		Once:                     e.Once,
+		Debounce:                 e.Debounce,
+		Throttle:                 e.Throttle,
+		KeyFilter:                append([]string(nil), e.KeyFilter...),
+		gate:                     new(eventGate),
+	}
+}
+
+// Deliver calls the handler with the event object of the broadcast, applying
+// the Once, Debounce, Throttle and KeyFilter options of the event unless the
+// driver has already applied them.
+func (e *Event) Deliver(evm common.EventBroadcast, handler func(common.EventObject, *Markup)) {
+	if evm.OptionsApplied {
+		handler(evm.Event, e.Tree)
+		return
+	}
+
+	if len(e.KeyFilter) != 0 && !e.matchKey(evm.Event) {
+		return
+	}
+
+	e.eventGate().deliver(e, func() {
+		handler(evm.Event, e.Tree)
+	})
+}
+
+// gates guards the creation of the gates of events not made through NewEvent.
+var gates sync.Mutex
+
+// eventGate returns the gate of the event, creating it for events not made
+// through NewEvent or Clone.
+func (e *Event) eventGate() *eventGate {
+	gates.Lock()
+	defer gates.Unlock()
+
+	if e.gate == nil {
+		e.gate = new(eventGate)
+	}
+
+	return e.gate
+}
+
+// matchKey returns true/false if the provided event is a keyboard event whose
+// key is within the event's KeyFilter.
+func (e *Event) matchKey(ev common.EventObject) bool {
+	if ev == nil {
+		return false
+	}
+
+	keyEvent, ok := ev.Underlying().(*eventx.KeyboardEvent)
+	if !ok {
+		return false
+	}
+
+	for _, key := range e.KeyFilter {
+		if key == keyEvent.Key {
+			return true
+		}
 	}
+
+	return false
 }
 
 // Apply adds the event into the elements events lists
@@ -178,3 +297,59 @@ func (e *Event) String() string {
 }
 
 //==============================================================================
+
+// eventGate defines a struct which holds the state needed to apply the Once,
+// Debounce and Throttle options of a Event when delivered from Go.
+type eventGate struct {
+	ml    sync.Mutex
+	fired bool
+	last  time.Time
+	timer *time.Timer
+}
+
+// deliver calls the provided function if allowed by the options of the event.
+func (g *eventGate) deliver(e *Event, fn func()) {
+	g.ml.Lock()
+
+	if e.Once && g.fired {
+		g.ml.Unlock()
+		return
+	}
+
+	if e.Throttle > 0 {
+		now := time.Now()
+		if !g.last.IsZero() && now.Sub(g.last) < e.Throttle {
+			g.ml.Unlock()
+			return
+		}
+
+		g.last = now
+	}
+
+	if e.Debounce > 0 {
+		if g.timer != nil {
+			g.timer.Stop()
+		}
+
+		g.timer = time.AfterFunc(e.Debounce, func() {
+			g.ml.Lock()
+			if e.Once && g.fired {
+				g.ml.Unlock()
+				return
+			}
+
+			g.fired = true
+			g.ml.Unlock()
+
+			fn()
+		})
+
+		g.ml.Unlock()
+		return
+	}
+
+	g.fired = true
+	g.ml.Unlock()
+
+	fn()
+}
@@ -9,6 +9,7 @@ package events
 
 import (
 	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
 	"github.com/gu-io/gu/notifications"
 	"github.com/gu-io/gu/trees"
 )
@@ -61,7 +62,7 @@ func AbortEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -98,7 +99,7 @@ func AfterPrintEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -135,7 +136,7 @@ func AfterScriptExecuteEvent(callback interface{}, options ...trees.EventOptions
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -172,7 +173,7 @@ func AlertActiveEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -209,7 +210,7 @@ func AlertCloseEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -246,7 +247,7 @@ func AlertingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -283,7 +284,7 @@ func AnimationEndEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -291,6 +292,16 @@ func AnimationEndEvent(callback interface{}, options ...trees.EventOptions) *tre
 	return ev
 }
 
+// AnimationEndEventT provides the AnimationEndEvent with a callback which receives the event as
+// a *eventx.AnimationEvent, the event is ignored if it was not decoded into that type.
+func AnimationEndEventT(callback func(*eventx.AnimationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AnimationEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.AnimationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // AnimationIterationEvent Documentation is as below: "A CSS animation is repeated."
 // https://developer.mozilla.org/docs/Web/Events/animationiteration
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -320,7 +331,7 @@ func AnimationIterationEvent(callback interface{}, options ...trees.EventOptions
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -328,6 +339,16 @@ func AnimationIterationEvent(callback interface{}, options ...trees.EventOptions
 	return ev
 }
 
+// AnimationIterationEventT provides the AnimationIterationEvent with a callback which receives the event as
+// a *eventx.AnimationEvent, the event is ignored if it was not decoded into that type.
+func AnimationIterationEventT(callback func(*eventx.AnimationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AnimationIterationEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.AnimationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // AnimationStartEvent Documentation is as below: "A CSS animation has started."
 // https://developer.mozilla.org/docs/Web/Events/animationstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -357,7 +378,7 @@ func AnimationStartEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -365,6 +386,16 @@ func AnimationStartEvent(callback interface{}, options ...trees.EventOptions) *t
 	return ev
 }
 
+// AnimationStartEventT provides the AnimationStartEvent with a callback which receives the event as
+// a *eventx.AnimationEvent, the event is ignored if it was not decoded into that type.
+func AnimationStartEventT(callback func(*eventx.AnimationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AnimationStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.AnimationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // AppinstalledEvent Documentation is as below: "A web application\u00a0is successfully installed as a progressive web app."
 // https://developer.mozilla.org/docs/Web/Events/appinstalled
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -394,7 +425,7 @@ func AppinstalledEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -431,7 +462,7 @@ func AudioProcessEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -439,6 +470,16 @@ func AudioProcessEvent(callback interface{}, options ...trees.EventOptions) *tre
 	return ev
 }
 
+// AudioProcessEventT provides the AudioProcessEvent with a callback which receives the event as
+// a *eventx.AudioProcessingEvent, the event is ignored if it was not decoded into that type.
+func AudioProcessEventT(callback func(*eventx.AudioProcessingEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AudioProcessEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.AudioProcessingEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // AudioendEvent Documentation is as below: "The user agent has finished capturing audio for speech recognition."
 // https://developer.mozilla.org/docs/Web/Events/audioend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -468,7 +509,7 @@ func AudioendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -505,7 +546,7 @@ func AudiostartEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -542,7 +583,7 @@ func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -550,6 +591,16 @@ func AuxclickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// AuxclickEventT provides the AuxclickEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func AuxclickEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return AuxclickEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // BeforeInstallPromptEvent Documentation is as below: "A user is prompted to save a web site to a home screen on mobile."
 // https://developer.mozilla.org/docs/Web/Events/beforeinstallprompt
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -579,7 +630,7 @@ func BeforeInstallPromptEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -616,7 +667,7 @@ func BeforePrintEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -653,7 +704,7 @@ func BeforeScriptExecuteEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -690,7 +741,7 @@ func BeforeUnloadEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -698,6 +749,16 @@ func BeforeUnloadEvent(callback interface{}, options ...trees.EventOptions) *tre
 	return ev
 }
 
+// BeforeUnloadEventT provides the BeforeUnloadEvent with a callback which receives the event as
+// a *eventx.BeforeUnloadEvent, the event is ignored if it was not decoded into that type.
+func BeforeUnloadEventT(callback func(*eventx.BeforeUnloadEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return BeforeUnloadEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.BeforeUnloadEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // BeginEventEvent Documentation is as below: "A SMIL animation element begins."
 // https://developer.mozilla.org/docs/Web/Events/beginEvent
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -727,7 +788,7 @@ func BeginEventEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -735,6 +796,16 @@ func BeginEventEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// BeginEventEventT provides the BeginEventEvent with a callback which receives the event as
+// a *eventx.TimeEvent, the event is ignored if it was not decoded into that type.
+func BeginEventEventT(callback func(*eventx.TimeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return BeginEventEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TimeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // BlockedEvent Documentation is as below: "An open connection to a database is blocking a versionchange transaction on the same database."
 // https://developer.mozilla.org/docs/Web/Reference/Events/blocked_indexedDB
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -764,7 +835,7 @@ func BlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -772,6 +843,16 @@ func BlockedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// BlockedEventT provides the BlockedEvent with a callback which receives the event as
+// a *eventx.IDBVersionChangeEvent, the event is ignored if it was not decoded into that type.
+func BlockedEventT(callback func(*eventx.IDBVersionChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return BlockedEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.IDBVersionChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // BlurEvent Documentation is as below: "An element has lost focus (does not bubble)."
 // https://developer.mozilla.org/docs/Web/Events/blur
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -801,7 +882,7 @@ func BlurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -809,6 +890,16 @@ func BlurEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	return ev
 }
 
+// BlurEventT provides the BlurEvent with a callback which receives the event as
+// a *eventx.FocusEvent, the event is ignored if it was not decoded into that type.
+func BlurEventT(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return BlurEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // BoundaryEvent Documentation is as below: "The spoken utterance reaches a word or sentence boundary"
 // https://developer.mozilla.org/docs/Web/Events/boundary
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -838,7 +929,7 @@ func BoundaryEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -875,7 +966,7 @@ func BroadcastEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -912,7 +1003,7 @@ func BusyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -949,7 +1040,7 @@ func CSSRuleViewCSSLinkClickedEvent(callback interface{}, options ...trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -986,7 +1077,7 @@ func CSSRuleViewChangeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1023,7 +1114,7 @@ func CSSRuleViewRefreshedEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1060,7 +1151,7 @@ func CachedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1097,7 +1188,7 @@ func CallschangedEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1134,7 +1225,7 @@ func CanPlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1171,7 +1262,7 @@ func CanPlayThroughEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1208,7 +1299,7 @@ func CardstatechangeEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1245,7 +1336,7 @@ func CfstatechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1282,7 +1373,7 @@ func ChangeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1319,7 +1410,7 @@ func ChargingChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1356,7 +1447,7 @@ func ChargingTimeChangeEvent(callback interface{}, options ...trees.EventOptions
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1393,7 +1484,7 @@ func CheckboxStateChangeEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1430,7 +1521,7 @@ func CheckingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1467,7 +1558,7 @@ func ClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1475,6 +1566,16 @@ func ClickEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	return ev
 }
 
+// ClickEventT provides the ClickEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func ClickEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ClickEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CloseEvent Documentation is as below: "The close button of the window has been clicked."
 // https://developer.mozilla.org/docs/Web/Reference/Events/close_event
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1504,7 +1605,7 @@ func CloseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1541,7 +1642,7 @@ func CommandEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1578,7 +1679,7 @@ func CommandupdateEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1615,7 +1716,7 @@ func CompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1623,6 +1724,16 @@ func CompleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// CompleteEventT provides the CompleteEvent with a callback which receives the event as
+// a *eventx.OfflineAudioCompletionEvent, the event is ignored if it was not decoded into that type.
+func CompleteEventT(callback func(*eventx.OfflineAudioCompletionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CompleteEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.OfflineAudioCompletionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CompositionEndEvent Documentation is as below: "The composition of a passage of text has been completed or canceled."
 // https://developer.mozilla.org/docs/Web/Events/compositionend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1652,7 +1763,7 @@ func CompositionEndEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1660,6 +1771,16 @@ func CompositionEndEvent(callback interface{}, options ...trees.EventOptions) *t
 	return ev
 }
 
+// CompositionEndEventT provides the CompositionEndEvent with a callback which receives the event as
+// a *eventx.CompositionEvent, the event is ignored if it was not decoded into that type.
+func CompositionEndEventT(callback func(*eventx.CompositionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CompositionEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.CompositionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CompositionStartEvent Documentation is as below: "The composition of a passage of text is prepared (similar to keydown for a keyboard input, but works with other inputs such as speech recognition)."
 // https://developer.mozilla.org/docs/Web/Events/compositionstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1689,7 +1810,7 @@ func CompositionStartEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1697,6 +1818,16 @@ func CompositionStartEvent(callback interface{}, options ...trees.EventOptions)
 	return ev
 }
 
+// CompositionStartEventT provides the CompositionStartEvent with a callback which receives the event as
+// a *eventx.CompositionEvent, the event is ignored if it was not decoded into that type.
+func CompositionStartEventT(callback func(*eventx.CompositionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CompositionStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.CompositionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CompositionUpdateEvent Documentation is as below: "A character is added to a passage of text being composed."
 // https://developer.mozilla.org/docs/Web/Events/compositionupdate
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1726,7 +1857,7 @@ func CompositionUpdateEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1734,6 +1865,16 @@ func CompositionUpdateEvent(callback interface{}, options ...trees.EventOptions)
 	return ev
 }
 
+// CompositionUpdateEventT provides the CompositionUpdateEvent with a callback which receives the event as
+// a *eventx.CompositionEvent, the event is ignored if it was not decoded into that type.
+func CompositionUpdateEventT(callback func(*eventx.CompositionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CompositionUpdateEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.CompositionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // ConnectingEvent Documentation is as below: "A call is about to connect."
 // https://developer.mozilla.org/docs/Web/Events/connecting
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1763,7 +1904,7 @@ func ConnectingEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1800,7 +1941,7 @@ func ConnectionInfoUpdateEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1837,7 +1978,7 @@ func ContextMenuEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1845,6 +1986,16 @@ func ContextMenuEvent(callback interface{}, options ...trees.EventOptions) *tree
 	return ev
 }
 
+// ContextMenuEventT provides the ContextMenuEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func ContextMenuEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ContextMenuEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CopyEvent Documentation is as below: "The text selection has been added to the clipboard."
 // https://developer.mozilla.org/docs/Web/Events/copy
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1874,7 +2025,7 @@ func CopyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1882,6 +2033,16 @@ func CopyEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	return ev
 }
 
+// CopyEventT provides the CopyEvent with a callback which receives the event as
+// a *eventx.ClipboardEvent, the event is ignored if it was not decoded into that type.
+func CopyEventT(callback func(*eventx.ClipboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CopyEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ClipboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // CutEvent Documentation is as below: "The text selection has been removed from the document and added to the clipboard."
 // https://developer.mozilla.org/docs/Web/Events/cut
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1911,7 +2072,7 @@ func CutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1919,6 +2080,16 @@ func CutEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	return ev
 }
 
+// CutEventT provides the CutEvent with a callback which receives the event as
+// a *eventx.ClipboardEvent, the event is ignored if it was not decoded into that type.
+func CutEventT(callback func(*eventx.ClipboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return CutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ClipboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DOMAutoCompleteEvent Documentation is as below: "The content of an element has been auto-completed."
 // https://developer.mozilla.org/docs/Web/Reference/Events/DOMAutoComplete
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -1948,7 +2119,7 @@ func DOMAutoCompleteEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -1985,7 +2156,7 @@ func DOMContentLoadedEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2022,7 +2193,7 @@ func DOMFrameContentLoadedEvent(callback interface{}, options ...trees.EventOpti
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2059,7 +2230,7 @@ func DOMLinkAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2096,7 +2267,7 @@ func DOMLinkRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2133,7 +2304,7 @@ func DOMMenuItemActiveEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2170,7 +2341,7 @@ func DOMMenuItemInactiveEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2207,7 +2378,7 @@ func DOMMetaAddedEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2244,7 +2415,7 @@ func DOMMetaRemovedEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2281,7 +2452,7 @@ func DOMModalDialogClosedEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2318,7 +2489,7 @@ func DOMPopupBlockedEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2355,7 +2526,7 @@ func DOMTitleChangedEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2392,7 +2563,7 @@ func DOMWillOpenModalDialogEvent(callback interface{}, options ...trees.EventOpt
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2429,7 +2600,7 @@ func DOMWindowCloseEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2466,7 +2637,7 @@ func DOMWindowCreatedEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2503,7 +2674,7 @@ func DatachangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2540,7 +2711,7 @@ func DataerrorEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2577,7 +2748,7 @@ func DblClickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2585,6 +2756,16 @@ func DblClickEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// DblClickEventT provides the DblClickEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func DblClickEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DblClickEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeliveredEvent Documentation is as below: "An SMS has been successfully delivered."
 // https://developer.mozilla.org/docs/Web/Events/delivered
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2614,7 +2795,7 @@ func DeliveredEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2651,7 +2832,7 @@ func DeviceLightEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2659,6 +2840,16 @@ func DeviceLightEvent(callback interface{}, options ...trees.EventOptions) *tree
 	return ev
 }
 
+// DeviceLightEventT provides the DeviceLightEvent with a callback which receives the event as
+// a *eventx.DeviceLightEvent, the event is ignored if it was not decoded into that type.
+func DeviceLightEventT(callback func(*eventx.DeviceLightEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceLightEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceLightEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeviceMotionEvent Documentation is as below: "Fresh data is available from a motion sensor."
 // https://developer.mozilla.org/docs/Web/Events/devicemotion
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2688,7 +2879,7 @@ func DeviceMotionEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2696,6 +2887,16 @@ func DeviceMotionEvent(callback interface{}, options ...trees.EventOptions) *tre
 	return ev
 }
 
+// DeviceMotionEventT provides the DeviceMotionEvent with a callback which receives the event as
+// a *eventx.DeviceMotionEvent, the event is ignored if it was not decoded into that type.
+func DeviceMotionEventT(callback func(*eventx.DeviceMotionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceMotionEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceMotionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeviceOrientationEvent Documentation is as below: "Fresh data is available from an orientation sensor."
 // https://developer.mozilla.org/docs/Web/Events/deviceorientation
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2725,7 +2926,7 @@ func DeviceOrientationEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2733,6 +2934,16 @@ func DeviceOrientationEvent(callback interface{}, options ...trees.EventOptions)
 	return ev
 }
 
+// DeviceOrientationEventT provides the DeviceOrientationEvent with a callback which receives the event as
+// a *eventx.DeviceOrientationEvent, the event is ignored if it was not decoded into that type.
+func DeviceOrientationEventT(callback func(*eventx.DeviceOrientationEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceOrientationEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceOrientationEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DeviceProximityEvent Documentation is as below: "Fresh data is available from a proximity sensor (indicates an approximated distance between the device and a nearby object)."
 // https://developer.mozilla.org/docs/Web/Events/deviceproximity
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2762,7 +2973,7 @@ func DeviceProximityEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2770,6 +2981,16 @@ func DeviceProximityEvent(callback interface{}, options ...trees.EventOptions) *
 	return ev
 }
 
+// DeviceProximityEventT provides the DeviceProximityEvent with a callback which receives the event as
+// a *eventx.DeviceProximityEvent, the event is ignored if it was not decoded into that type.
+func DeviceProximityEventT(callback func(*eventx.DeviceProximityEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DeviceProximityEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DeviceProximityEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DevicechangeEvent Documentation is as below: "A media device such as a camera, microphone, or speaker is connected or removed from the system."
 // https://developer.mozilla.org/docs/Web/Events/devicechange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -2799,7 +3020,7 @@ func DevicechangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2836,7 +3057,7 @@ func DialingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2873,7 +3094,7 @@ func DisabledEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2910,7 +3131,7 @@ func DischargingTimeChangeEvent(callback interface{}, options ...trees.EventOpti
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2947,7 +3168,7 @@ func DisconnectedEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -2984,7 +3205,7 @@ func DisconnectingEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3021,7 +3242,7 @@ func DownloadingEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3058,7 +3279,7 @@ func DragEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3066,6 +3287,16 @@ func DragEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	return ev
 }
 
+// DragEventT provides the DragEvent with a callback which receives the event as
+// a *eventx.DragEvent, the event is ignored if it was not decoded into that type.
+func DragEventT(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragEndEvent Documentation is as below: "A drag operation is being ended (by releasing a mouse button or hitting the escape key)."
 // https://developer.mozilla.org/docs/Web/Events/dragend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3095,7 +3326,7 @@ func DragEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3103,6 +3334,16 @@ func DragEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// DragEndEventT provides the DragEndEvent with a callback which receives the event as
+// a *eventx.DragEvent, the event is ignored if it was not decoded into that type.
+func DragEndEventT(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragEnterEvent Documentation is as below: "A dragged element or text selection enters a valid drop target."
 // https://developer.mozilla.org/docs/Web/Events/dragenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3132,7 +3373,7 @@ func DragEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3140,6 +3381,16 @@ func DragEnterEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// DragEnterEventT provides the DragEnterEvent with a callback which receives the event as
+// a *eventx.DragEvent, the event is ignored if it was not decoded into that type.
+func DragEnterEventT(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragEnterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragLeaveEvent Documentation is as below: "A dragged element or text selection leaves a valid drop target."
 // https://developer.mozilla.org/docs/Web/Events/dragleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3169,7 +3420,7 @@ func DragLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3177,6 +3428,16 @@ func DragLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// DragLeaveEventT provides the DragLeaveEvent with a callback which receives the event as
+// a *eventx.DragEvent, the event is ignored if it was not decoded into that type.
+func DragLeaveEventT(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragLeaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragOverEvent Documentation is as below: "An element or text selection is being dragged over a valid drop target (every 350ms)."
 // https://developer.mozilla.org/docs/Web/Events/dragover
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3206,7 +3467,7 @@ func DragOverEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3214,6 +3475,16 @@ func DragOverEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// DragOverEventT provides the DragOverEvent with a callback which receives the event as
+// a *eventx.DragEvent, the event is ignored if it was not decoded into that type.
+func DragOverEventT(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragOverEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DragStartEvent Documentation is as below: "The user starts dragging an element or text selection."
 // https://developer.mozilla.org/docs/Web/Events/dragstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3243,7 +3514,7 @@ func DragStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3251,6 +3522,16 @@ func DragStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// DragStartEventT provides the DragStartEvent with a callback which receives the event as
+// a *eventx.DragEvent, the event is ignored if it was not decoded into that type.
+func DragStartEventT(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DragStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DropEvent Documentation is as below: "An element is dropped on a valid drop target."
 // https://developer.mozilla.org/docs/Web/Events/drop
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3280,7 +3561,7 @@ func DropEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3288,6 +3569,16 @@ func DropEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	return ev
 }
 
+// DropEventT provides the DropEvent with a callback which receives the event as
+// a *eventx.DragEvent, the event is ignored if it was not decoded into that type.
+func DropEventT(callback func(*eventx.DragEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return DropEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.DragEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // DurationChangeEvent Documentation is as below: "The duration attribute has been updated."
 // https://developer.mozilla.org/docs/Web/Events/durationchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3317,7 +3608,7 @@ func DurationChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3354,7 +3645,7 @@ func EmptiedEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3391,7 +3682,7 @@ func EnabledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3428,7 +3719,7 @@ func EndEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3465,7 +3756,7 @@ func EndEventEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3473,6 +3764,16 @@ func EndEventEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// EndEventEventT provides the EndEventEvent with a callback which receives the event as
+// a *eventx.TimeEvent, the event is ignored if it was not decoded into that type.
+func EndEventEventT(callback func(*eventx.TimeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return EndEventEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TimeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // EndedEvent Documentation is as below: "Playback has stopped because the end of the media was reached."
 // https://developer.mozilla.org/docs/Web/Events/ended_(Web_Audio)
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3502,7 +3803,7 @@ func EndedEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3539,7 +3840,7 @@ func FocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3547,6 +3848,16 @@ func FocusEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	return ev
 }
 
+// FocusEventT provides the FocusEvent with a callback which receives the event as
+// a *eventx.FocusEvent, the event is ignored if it was not decoded into that type.
+func FocusEventT(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return FocusEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // FocusInEvent Documentation is as below: "An element is about to receive focus (bubbles)."
 // https://developer.mozilla.org/docs/Web/Events/focusin
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3576,7 +3887,7 @@ func FocusInEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3584,6 +3895,16 @@ func FocusInEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// FocusInEventT provides the FocusInEvent with a callback which receives the event as
+// a *eventx.FocusEvent, the event is ignored if it was not decoded into that type.
+func FocusInEventT(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return FocusInEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // FocusOutEvent Documentation is as below: "An element is about to lose focus (bubbles)."
 // https://developer.mozilla.org/docs/Web/Events/focusout
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3613,7 +3934,7 @@ func FocusOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3621,6 +3942,16 @@ func FocusOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// FocusOutEventT provides the FocusOutEvent with a callback which receives the event as
+// a *eventx.FocusEvent, the event is ignored if it was not decoded into that type.
+func FocusOutEventT(callback func(*eventx.FocusEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return FocusOutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.FocusEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // FullScreenChangeEvent Documentation is as below: "An element was turned to fullscreen mode or back to normal mode."
 // https://developer.mozilla.org/docs/Web/Events/fullscreenchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3650,7 +3981,7 @@ func FullScreenChangeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3687,7 +4018,7 @@ func FullScreenErrorEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3724,7 +4055,7 @@ func FullscreenEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3761,7 +4092,7 @@ func GamepadConnectedEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3769,6 +4100,16 @@ func GamepadConnectedEvent(callback interface{}, options ...trees.EventOptions)
 	return ev
 }
 
+// GamepadConnectedEventT provides the GamepadConnectedEvent with a callback which receives the event as
+// a *eventx.GamepadEvent, the event is ignored if it was not decoded into that type.
+func GamepadConnectedEventT(callback func(*eventx.GamepadEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return GamepadConnectedEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.GamepadEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // GamepadDisconnectedEvent Documentation is as below: "A gamepad has been disconnected."
 // https://developer.mozilla.org/docs/Web/Events/gamepaddisconnected
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3798,7 +4139,7 @@ func GamepadDisconnectedEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3806,6 +4147,16 @@ func GamepadDisconnectedEvent(callback interface{}, options ...trees.EventOption
 	return ev
 }
 
+// GamepadDisconnectedEventT provides the GamepadDisconnectedEvent with a callback which receives the event as
+// a *eventx.GamepadEvent, the event is ignored if it was not decoded into that type.
+func GamepadDisconnectedEventT(callback func(*eventx.GamepadEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return GamepadDisconnectedEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.GamepadEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // GotpointercaptureEvent Documentation is as below: "Element receives pointer capture."
 // https://developer.mozilla.org/docs/Web/Events/gotpointercapture
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3835,7 +4186,7 @@ func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3843,6 +4194,16 @@ func GotpointercaptureEvent(callback interface{}, options ...trees.EventOptions)
 	return ev
 }
 
+// GotpointercaptureEventT provides the GotpointercaptureEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func GotpointercaptureEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return GotpointercaptureEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // HashChangeEvent Documentation is as below: "The fragment identifier of the URL has changed (the part of the URL after the #)."
 // https://developer.mozilla.org/docs/Web/Events/hashchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3872,7 +4233,7 @@ func HashChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3880,6 +4241,16 @@ func HashChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// HashChangeEventT provides the HashChangeEvent with a callback which receives the event as
+// a *eventx.HashChangeEvent, the event is ignored if it was not decoded into that type.
+func HashChangeEventT(callback func(*eventx.HashChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return HashChangeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.HashChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // HeldEvent Documentation is as below: "A call has been held."
 // https://developer.mozilla.org/docs/Web/Events/held
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -3909,7 +4280,7 @@ func HeldEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3946,7 +4317,7 @@ func HoldingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -3983,7 +4354,7 @@ func IcccardlockerrorEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4020,7 +4391,7 @@ func IccinfochangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4057,7 +4428,7 @@ func IncomingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4094,7 +4465,7 @@ func InputEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4131,7 +4502,7 @@ func InvalidEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4168,7 +4539,7 @@ func KeyDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4176,6 +4547,16 @@ func KeyDownEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// KeyDownEventT provides the KeyDownEvent with a callback which receives the event as
+// a *eventx.KeyboardEvent, the event is ignored if it was not decoded into that type.
+func KeyDownEventT(callback func(*eventx.KeyboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return KeyDownEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.KeyboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // KeyPressEvent Documentation is as below: "A key is pressed down and that key normally produces a character value (use input instead)."
 // https://developer.mozilla.org/docs/Web/Events/keypress
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4205,7 +4586,7 @@ func KeyPressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4213,6 +4594,16 @@ func KeyPressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// KeyPressEventT provides the KeyPressEvent with a callback which receives the event as
+// a *eventx.KeyboardEvent, the event is ignored if it was not decoded into that type.
+func KeyPressEventT(callback func(*eventx.KeyboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return KeyPressEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.KeyboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // KeyUpEvent Documentation is as below: "A key is released."
 // https://developer.mozilla.org/docs/Web/Events/keyup
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4242,7 +4633,7 @@ func KeyUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4250,6 +4641,16 @@ func KeyUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	return ev
 }
 
+// KeyUpEventT provides the KeyUpEvent with a callback which receives the event as
+// a *eventx.KeyboardEvent, the event is ignored if it was not decoded into that type.
+func KeyUpEventT(callback func(*eventx.KeyboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return KeyUpEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.KeyboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // LanguageChangeEvent Documentation is as below: "The user's preferred languages have changed."
 // https://developer.mozilla.org/docs/Web/Events/languagechange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4279,7 +4680,7 @@ func LanguageChangeEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4316,7 +4717,7 @@ func LevelChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4353,7 +4754,7 @@ func LoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4361,6 +4762,16 @@ func LoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	return ev
 }
 
+// LoadEventT provides the LoadEvent with a callback which receives the event as
+// a *eventx.ProgressEvent, the event is ignored if it was not decoded into that type.
+func LoadEventT(callback func(*eventx.ProgressEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return LoadEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ProgressEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // LoadEndEvent Documentation is as below: "Progress has stopped (after \"error\", \"abort\" or \"load\" have been dispatched)."
 // https://developer.mozilla.org/docs/Web/Events/loadend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4390,7 +4801,7 @@ func LoadEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4398,6 +4809,16 @@ func LoadEndEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// LoadEndEventT provides the LoadEndEvent with a callback which receives the event as
+// a *eventx.ProgressEvent, the event is ignored if it was not decoded into that type.
+func LoadEndEventT(callback func(*eventx.ProgressEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return LoadEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ProgressEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // LoadStartEvent Documentation is as below: "Progress has begun."
 // https://developer.mozilla.org/docs/Web/Events/loadstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4427,7 +4848,7 @@ func LoadStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4435,6 +4856,16 @@ func LoadStartEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// LoadStartEventT provides the LoadStartEvent with a callback which receives the event as
+// a *eventx.ProgressEvent, the event is ignored if it was not decoded into that type.
+func LoadStartEventT(callback func(*eventx.ProgressEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return LoadStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ProgressEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // LoadedDataEvent Documentation is as below: "The first frame of the media has finished loading."
 // https://developer.mozilla.org/docs/Web/Events/loadeddata
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4464,7 +4895,7 @@ func LoadedDataEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4501,7 +4932,7 @@ func LoadedMetadataEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4538,7 +4969,7 @@ func LocalizedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4575,7 +5006,7 @@ func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4583,6 +5014,16 @@ func LostpointercaptureEvent(callback interface{}, options ...trees.EventOptions
 	return ev
 }
 
+// LostpointercaptureEventT provides the LostpointercaptureEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func LostpointercaptureEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return LostpointercaptureEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MarkEvent Documentation is as below: "The spoken utterance reaches a named SSML \"mark\" tag."
 // https://developer.mozilla.org/docs/Web/Events/mark
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4612,7 +5053,7 @@ func MarkEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4649,7 +5090,7 @@ func MessageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4657,6 +5098,16 @@ func MessageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// MessageEventT provides the MessageEvent with a callback which receives the event as
+// a *eventx.MessageEvent, the event is ignored if it was not decoded into that type.
+func MessageEventT(callback func(*eventx.MessageEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MessageEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MessageEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseDownEvent Documentation is as below: "A pointing device button (usually a mouse) is pressed on an element."
 // https://developer.mozilla.org/docs/Web/Events/mousedown
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4686,7 +5137,7 @@ func MouseDownEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4694,6 +5145,16 @@ func MouseDownEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// MouseDownEventT provides the MouseDownEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func MouseDownEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseDownEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseEnterEvent Documentation is as below: "A pointing device is moved onto the element that has the listener attached."
 // https://developer.mozilla.org/docs/Web/Events/mouseenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4723,7 +5184,7 @@ func MouseEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4731,6 +5192,16 @@ func MouseEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// MouseEnterEventT provides the MouseEnterEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func MouseEnterEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseEnterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseLeaveEvent Documentation is as below: "A pointing device is moved off the element that has the listener attached."
 // https://developer.mozilla.org/docs/Web/Events/mouseleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4760,7 +5231,7 @@ func MouseLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4768,6 +5239,16 @@ func MouseLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// MouseLeaveEventT provides the MouseLeaveEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func MouseLeaveEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseLeaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseMoveEvent Documentation is as below: "A pointing device is moved over an element."
 // https://developer.mozilla.org/docs/Web/Events/mousemove
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4797,7 +5278,7 @@ func MouseMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4805,6 +5286,16 @@ func MouseMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// MouseMoveEventT provides the MouseMoveEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func MouseMoveEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseMoveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseOutEvent Documentation is as below: "A pointing device is moved off the element that has the listener attached or off one of its children."
 // https://developer.mozilla.org/docs/Web/Events/mouseout
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4834,7 +5325,7 @@ func MouseOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4842,6 +5333,16 @@ func MouseOutEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// MouseOutEventT provides the MouseOutEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func MouseOutEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseOutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseOverEvent Documentation is as below: "A pointing device is moved onto the element that has the listener attached or onto one of its children."
 // https://developer.mozilla.org/docs/Web/Events/mouseover
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4871,7 +5372,7 @@ func MouseOverEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4879,6 +5380,16 @@ func MouseOverEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// MouseOverEventT provides the MouseOverEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func MouseOverEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseOverEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MouseUpEvent Documentation is as below: "A pointing device button is released over an element."
 // https://developer.mozilla.org/docs/Web/Events/mouseup
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4908,7 +5419,7 @@ func MouseUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4916,6 +5427,16 @@ func MouseUpEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// MouseUpEventT provides the MouseUpEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func MouseUpEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return MouseUpEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // MozAfterPaintEvent Documentation is as below: "Content has been repainted."
 // https://developer.mozilla.org/docs/Web/Reference/Events/MozAfterPaint
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -4945,7 +5466,7 @@ func MozAfterPaintEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -4982,7 +5503,7 @@ func MozAudioAvailableEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5019,7 +5540,7 @@ func MozBeforeResizeEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5056,7 +5577,7 @@ func MozEdgeUIGestureEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5093,7 +5614,7 @@ func MozEnteredDomFullscreenEvent(callback interface{}, options ...trees.EventOp
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5130,7 +5651,7 @@ func MozGamepadButtonDownEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5167,7 +5688,7 @@ func MozGamepadButtonUpEvent(callback interface{}, options ...trees.EventOptions
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5204,7 +5725,7 @@ func MozMagnifyGestureEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5241,7 +5762,7 @@ func MozMagnifyGestureStartEvent(callback interface{}, options ...trees.EventOpt
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5278,7 +5799,7 @@ func MozMagnifyGestureUpdateEvent(callback interface{}, options ...trees.EventOp
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5315,7 +5836,7 @@ func MozPressTapGestureEvent(callback interface{}, options ...trees.EventOptions
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5352,7 +5873,7 @@ func MozRotateGestureEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5389,7 +5910,7 @@ func MozRotateGestureStartEvent(callback interface{}, options ...trees.EventOpti
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5426,7 +5947,7 @@ func MozRotateGestureUpdateEvent(callback interface{}, options ...trees.EventOpt
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5463,7 +5984,7 @@ func MozScrolledAreaChangedEvent(callback interface{}, options ...trees.EventOpt
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5500,7 +6021,7 @@ func MozSwipeGestureEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5537,7 +6058,7 @@ func MozTapGestureEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5574,7 +6095,7 @@ func MozbrowseractivitydoneEvent(callback interface{}, options ...trees.EventOpt
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5611,7 +6132,7 @@ func MozbrowserasyncscrollEvent(callback interface{}, options ...trees.EventOpti
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5648,7 +6169,7 @@ func MozbrowseraudioplaybackchangeEvent(callback interface{}, options ...trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5685,7 +6206,7 @@ func MozbrowsercaretstatechangedEvent(callback interface{}, options ...trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5722,7 +6243,7 @@ func MozbrowsercloseEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5759,7 +6280,7 @@ func MozbrowsercontextmenuEvent(callback interface{}, options ...trees.EventOpti
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5796,7 +6317,7 @@ func MozbrowserdocumentfirstpaintEvent(callback interface{}, options ...trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5833,7 +6354,7 @@ func MozbrowsererrorEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5870,7 +6391,7 @@ func MozbrowserfindchangeEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5907,7 +6428,7 @@ func MozbrowserfirstpaintEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5944,7 +6465,7 @@ func MozbrowsericonchangeEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -5981,7 +6502,7 @@ func MozbrowserloadendEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6018,7 +6539,7 @@ func MozbrowserloadstartEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6055,7 +6576,7 @@ func MozbrowserlocationchangeEvent(callback interface{}, options ...trees.EventO
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6092,7 +6613,7 @@ func MozbrowsermanifestchangeEvent(callback interface{}, options ...trees.EventO
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6129,7 +6650,7 @@ func MozbrowsermetachangeEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6166,7 +6687,7 @@ func MozbrowseropensearchEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6203,7 +6724,7 @@ func MozbrowseropentabEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6240,7 +6761,7 @@ func MozbrowseropenwindowEvent(callback interface{}, options ...trees.EventOptio
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6277,7 +6798,7 @@ func MozbrowserresizeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6314,7 +6835,7 @@ func MozbrowserscrollEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6351,7 +6872,7 @@ func MozbrowserscrollareachangedEvent(callback interface{}, options ...trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6388,7 +6909,7 @@ func MozbrowserscrollviewchangeEvent(callback interface{}, options ...trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6425,7 +6946,7 @@ func MozbrowsersecuritychangeEvent(callback interface{}, options ...trees.EventO
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6462,7 +6983,7 @@ func MozbrowserselectionstatechangedEvent(callback interface{}, options ...trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6499,7 +7020,7 @@ func MozbrowsershowmodalpromptEvent(callback interface{}, options ...trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6536,7 +7057,7 @@ func MozbrowsertitlechangeEvent(callback interface{}, options ...trees.EventOpti
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6573,7 +7094,7 @@ func MozbrowserusernameandpasswordrequiredEvent(callback interface{}, options ..
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6610,7 +7131,7 @@ func MozbrowservisibilitychangeEvent(callback interface{}, options ...trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6647,7 +7168,7 @@ func MoztimechangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6684,7 +7205,7 @@ func NoUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6721,7 +7242,7 @@ func NomatchEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6758,7 +7279,7 @@ func NotificationclickEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6795,7 +7316,7 @@ func ObsoleteEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6832,7 +7353,7 @@ func OfflineEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6869,7 +7390,7 @@ func OnconnectedEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6906,7 +7427,7 @@ func OnlineEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6943,7 +7464,7 @@ func OpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -6980,7 +7501,7 @@ func OrientationChangeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7017,7 +7538,7 @@ func OverflowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7054,7 +7575,7 @@ func PageHideEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7062,6 +7583,16 @@ func PageHideEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// PageHideEventT provides the PageHideEvent with a callback which receives the event as
+// a *eventx.PageTransitionEvent, the event is ignored if it was not decoded into that type.
+func PageHideEventT(callback func(*eventx.PageTransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PageHideEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PageTransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PageShowEvent Documentation is as below: "A session history entry is being traversed to."
 // https://developer.mozilla.org/docs/Web/Events/pageshow
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7091,7 +7622,7 @@ func PageShowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7099,6 +7630,16 @@ func PageShowEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// PageShowEventT provides the PageShowEvent with a callback which receives the event as
+// a *eventx.PageTransitionEvent, the event is ignored if it was not decoded into that type.
+func PageShowEventT(callback func(*eventx.PageTransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PageShowEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PageTransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PasteEvent Documentation is as below: "Data has been transferred from the system clipboard to the document."
 // https://developer.mozilla.org/docs/Web/Events/paste
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7128,7 +7669,7 @@ func PasteEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7136,6 +7677,16 @@ func PasteEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 	return ev
 }
 
+// PasteEventT provides the PasteEvent with a callback which receives the event as
+// a *eventx.ClipboardEvent, the event is ignored if it was not decoded into that type.
+func PasteEventT(callback func(*eventx.ClipboardEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PasteEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ClipboardEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PauseEvent Documentation is as below: "The utterance is paused part way through."
 // https://developer.mozilla.org/docs/Web/Events/pause_(SpeechSynthesis)
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7165,7 +7716,7 @@ func PauseEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7202,7 +7753,7 @@ func PlayEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7239,7 +7790,7 @@ func PlayingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7276,7 +7827,7 @@ func PointerLockChangeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7313,7 +7864,7 @@ func PointerLockErrorEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7350,7 +7901,7 @@ func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7358,6 +7909,16 @@ func PointercancelEvent(callback interface{}, options ...trees.EventOptions) *tr
 	return ev
 }
 
+// PointercancelEventT provides the PointercancelEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointercancelEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointercancelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PointerdownEvent Documentation is as below: "The pointer enters the active buttons state."
 // https://developer.mozilla.org/docs/Web/Events/pointerdown
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7387,7 +7948,7 @@ func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7395,6 +7956,16 @@ func PointerdownEvent(callback interface{}, options ...trees.EventOptions) *tree
 	return ev
 }
 
+// PointerdownEventT provides the PointerdownEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointerdownEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerdownEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PointerenterEvent Documentation is as below: "Pointing device is moved inside the hit-testing boundary."
 // https://developer.mozilla.org/docs/Web/Events/pointerenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7424,7 +7995,7 @@ func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7432,6 +8003,16 @@ func PointerenterEvent(callback interface{}, options ...trees.EventOptions) *tre
 	return ev
 }
 
+// PointerenterEventT provides the PointerenterEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointerenterEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerenterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PointerleaveEvent Documentation is as below: "Pointing device is moved out of the hit-testing boundary."
 // https://developer.mozilla.org/docs/Web/Events/pointerleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7461,7 +8042,7 @@ func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7469,6 +8050,16 @@ func PointerleaveEvent(callback interface{}, options ...trees.EventOptions) *tre
 	return ev
 }
 
+// PointerleaveEventT provides the PointerleaveEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointerleaveEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerleaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PointermoveEvent Documentation is as below: "The pointer changed coordinates."
 // https://developer.mozilla.org/docs/Web/Events/pointermove
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7498,7 +8089,7 @@ func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7506,6 +8097,16 @@ func PointermoveEvent(callback interface{}, options ...trees.EventOptions) *tree
 	return ev
 }
 
+// PointermoveEventT provides the PointermoveEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointermoveEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointermoveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PointeroutEvent Documentation is as below: "The pointing device moved out of hit-testing boundary or leaves detectable hover range."
 // https://developer.mozilla.org/docs/Web/Events/pointerout
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7535,7 +8136,7 @@ func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7543,6 +8144,16 @@ func PointeroutEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// PointeroutEventT provides the PointeroutEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointeroutEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointeroutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PointeroverEvent Documentation is as below: "The pointing device is moved into the hit-testing boundary."
 // https://developer.mozilla.org/docs/Web/Events/pointerover
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7572,7 +8183,7 @@ func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7580,6 +8191,16 @@ func PointeroverEvent(callback interface{}, options ...trees.EventOptions) *tree
 	return ev
 }
 
+// PointeroverEventT provides the PointeroverEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointeroverEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointeroverEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PointerupEvent Documentation is as below: "The pointer leaves the active buttons state."
 // https://developer.mozilla.org/docs/Web/Events/pointerup
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7609,7 +8230,7 @@ func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7617,6 +8238,16 @@ func PointerupEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// PointerupEventT provides the PointerupEvent with a callback which receives the event as
+// a *eventx.PointerEvent, the event is ignored if it was not decoded into that type.
+func PointerupEventT(callback func(*eventx.PointerEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PointerupEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PointerEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PopStateEvent Documentation is as below: "A session history entry is being navigated to (in certain cases)."
 // https://developer.mozilla.org/docs/Web/Events/popstate
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7646,7 +8277,7 @@ func PopStateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7654,6 +8285,16 @@ func PopStateEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// PopStateEventT provides the PopStateEvent with a callback which receives the event as
+// a *eventx.PopStateEvent, the event is ignored if it was not decoded into that type.
+func PopStateEventT(callback func(*eventx.PopStateEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return PopStateEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.PopStateEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PopuphiddenEvent Documentation is as below: "A menupopup, panel or tooltip has been hidden."
 // https://developer.mozilla.org/docs/Web/Events/popuphidden
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7683,7 +8324,7 @@ func PopuphiddenEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7720,7 +8361,7 @@ func PopuphidingEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7757,7 +8398,7 @@ func PopupshowingEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7794,7 +8435,7 @@ func PopupshownEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7831,7 +8472,7 @@ func ProgressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7839,6 +8480,16 @@ func ProgressEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// ProgressEventT provides the ProgressEvent with a callback which receives the event as
+// a *eventx.ProgressEvent, the event is ignored if it was not decoded into that type.
+func ProgressEventT(callback func(*eventx.ProgressEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ProgressEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ProgressEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // PushEvent Documentation is as below: "A Service Worker has received a push message."
 // https://developer.mozilla.org/docs/Web/Events/push
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -7868,7 +8519,7 @@ func PushEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7905,7 +8556,7 @@ func PushsubscriptionchangeEvent(callback interface{}, options ...trees.EventOpt
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7942,7 +8593,7 @@ func RadioStateChangeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -7979,7 +8630,7 @@ func RateChangeEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8016,7 +8667,7 @@ func ReadystateChangeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8053,7 +8704,7 @@ func ReceivedEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8090,7 +8741,7 @@ func RepeatEventEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8098,6 +8749,16 @@ func RepeatEventEvent(callback interface{}, options ...trees.EventOptions) *tree
 	return ev
 }
 
+// RepeatEventEventT provides the RepeatEventEvent with a callback which receives the event as
+// a *eventx.TimeEvent, the event is ignored if it was not decoded into that type.
+func RepeatEventEventT(callback func(*eventx.TimeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return RepeatEventEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TimeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // RequestprogressEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/requestprogress
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8127,7 +8788,7 @@ func RequestprogressEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8164,7 +8825,7 @@ func ResetEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8201,7 +8862,7 @@ func ResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8209,6 +8870,16 @@ func ResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	return ev
 }
 
+// ResizeEventT provides the ResizeEvent with a callback which receives the event as
+// a *eventx.UIEvent, the event is ignored if it was not decoded into that type.
+func ResizeEventT(callback func(*eventx.UIEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ResizeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UIEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // ResourcetimingbufferfullEvent Documentation is as below: "The browser's resource timing buffer is full."
 // https://developer.mozilla.org/docs/Web/Events/resourcetimingbufferfull
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8238,7 +8909,7 @@ func ResourcetimingbufferfullEvent(callback interface{}, options ...trees.EventO
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8275,7 +8946,7 @@ func ResponseprogressEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8312,7 +8983,7 @@ func ResultEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8349,7 +9020,7 @@ func ResumeEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8386,7 +9057,7 @@ func ResumingEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8423,7 +9094,7 @@ func SSTabClosingEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8460,7 +9131,7 @@ func SSTabRestoredEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8497,7 +9168,7 @@ func SSTabRestoringEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8534,7 +9205,7 @@ func SSWindowClosingEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8571,7 +9242,7 @@ func SSWindowStateBusyEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8608,7 +9279,7 @@ func SSWindowStateReadyEvent(callback interface{}, options ...trees.EventOptions
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8645,7 +9316,7 @@ func SVGAbortEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8653,6 +9324,16 @@ func SVGAbortEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// SVGAbortEventT provides the SVGAbortEvent with a callback which receives the event as
+// a *eventx.SVGEvent, the event is ignored if it was not decoded into that type.
+func SVGAbortEventT(callback func(*eventx.SVGEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGAbortEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SVGErrorEvent Documentation is as below: "An error has occurred before the SVG was loaded."
 // https://developer.mozilla.org/docs/Web/Events/SVGError
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8682,7 +9363,7 @@ func SVGErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8690,6 +9371,16 @@ func SVGErrorEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// SVGErrorEventT provides the SVGErrorEvent with a callback which receives the event as
+// a *eventx.SVGEvent, the event is ignored if it was not decoded into that type.
+func SVGErrorEventT(callback func(*eventx.SVGEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGErrorEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SVGLoadEvent Documentation is as below: "An SVG document has been loaded and parsed."
 // https://developer.mozilla.org/docs/Web/Events/SVGLoad
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8719,7 +9410,7 @@ func SVGLoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8727,6 +9418,16 @@ func SVGLoadEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// SVGLoadEventT provides the SVGLoadEvent with a callback which receives the event as
+// a *eventx.SVGEvent, the event is ignored if it was not decoded into that type.
+func SVGLoadEventT(callback func(*eventx.SVGEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGLoadEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SVGResizeEvent Documentation is as below: "An SVG document is being resized."
 // https://developer.mozilla.org/docs/Web/Events/SVGResize
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8756,7 +9457,7 @@ func SVGResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8764,6 +9465,16 @@ func SVGResizeEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// SVGResizeEventT provides the SVGResizeEvent with a callback which receives the event as
+// a *eventx.SVGEvent, the event is ignored if it was not decoded into that type.
+func SVGResizeEventT(callback func(*eventx.SVGEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGResizeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SVGScrollEvent Documentation is as below: "An SVG document is being scrolled."
 // https://developer.mozilla.org/docs/Web/Events/SVGScroll
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8793,7 +9504,7 @@ func SVGScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8801,6 +9512,16 @@ func SVGScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// SVGScrollEventT provides the SVGScrollEvent with a callback which receives the event as
+// a *eventx.SVGEvent, the event is ignored if it was not decoded into that type.
+func SVGScrollEventT(callback func(*eventx.SVGEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGScrollEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SVGUnloadEvent Documentation is as below: "An SVG document has been removed from a window or frame."
 // https://developer.mozilla.org/docs/Web/Events/SVGUnload
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8830,7 +9551,7 @@ func SVGUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8838,6 +9559,16 @@ func SVGUnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// SVGUnloadEventT provides the SVGUnloadEvent with a callback which receives the event as
+// a *eventx.SVGEvent, the event is ignored if it was not decoded into that type.
+func SVGUnloadEventT(callback func(*eventx.SVGEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGUnloadEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SVGZoomEvent Documentation is as below: "An SVG document is being zoomed."
 // https://developer.mozilla.org/docs/Web/Events/SVGZoom
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8867,7 +9598,7 @@ func SVGZoomEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8875,6 +9606,16 @@ func SVGZoomEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// SVGZoomEventT provides the SVGZoomEvent with a callback which receives the event as
+// a *eventx.SVGZoomEvent, the event is ignored if it was not decoded into that type.
+func SVGZoomEventT(callback func(*eventx.SVGZoomEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SVGZoomEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.SVGZoomEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // ScrollEvent Documentation is as below: "The document view or an element has been scrolled."
 // https://developer.mozilla.org/docs/Web/Events/scroll
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8904,7 +9645,7 @@ func ScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8912,6 +9653,16 @@ func ScrollEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	return ev
 }
 
+// ScrollEventT provides the ScrollEvent with a callback which receives the event as
+// a *eventx.UIEvent, the event is ignored if it was not decoded into that type.
+func ScrollEventT(callback func(*eventx.UIEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ScrollEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UIEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SeekedEvent Documentation is as below: "A seek operation completed."
 // https://developer.mozilla.org/docs/Web/Events/seeked
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -8941,7 +9692,7 @@ func SeekedEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -8978,7 +9729,7 @@ func SeekingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9015,7 +9766,7 @@ func SelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9023,6 +9774,16 @@ func SelectEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	return ev
 }
 
+// SelectEventT provides the SelectEvent with a callback which receives the event as
+// a *eventx.UIEvent, the event is ignored if it was not decoded into that type.
+func SelectEventT(callback func(*eventx.UIEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return SelectEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UIEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SelectionchangeEvent Documentation is as below: "The selection in the document has been changed."
 // https://developer.mozilla.org/docs/Web/Events/selectionchange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -9052,7 +9813,7 @@ func SelectionchangeEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9089,7 +9850,7 @@ func SelectstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9126,7 +9887,7 @@ func SentEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9163,7 +9924,7 @@ func ShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9171,6 +9932,16 @@ func ShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Event
 	return ev
 }
 
+// ShowEventT provides the ShowEvent with a callback which receives the event as
+// a *eventx.MouseEvent, the event is ignored if it was not decoded into that type.
+func ShowEventT(callback func(*eventx.MouseEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return ShowEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.MouseEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SizemodechangeEvent Documentation is as below: "Window has entered/left fullscreen mode, or has been minimized/unminimized."
 // https://developer.mozilla.org/docs/Web/Reference/Events/sizemodechange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -9200,7 +9971,7 @@ func SizemodechangeEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9237,7 +10008,7 @@ func SmartCardInsertEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9274,7 +10045,7 @@ func SmartCardRemoveEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9311,7 +10082,7 @@ func SoundendEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9348,7 +10119,7 @@ func SoundstartEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9385,7 +10156,7 @@ func SpeechendEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9422,7 +10193,7 @@ func SpeechstartEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9459,7 +10230,7 @@ func StalledEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9496,7 +10267,7 @@ func StartEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9533,7 +10304,7 @@ func StatechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9570,7 +10341,7 @@ func StatuschangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9607,7 +10378,7 @@ func StkcommandEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9644,7 +10415,7 @@ func StksessionendEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9681,7 +10452,7 @@ func StorageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9689,6 +10460,16 @@ func StorageEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// StorageEventT provides the StorageEvent with a callback which receives the event as
+// a *eventx.StorageEvent, the event is ignored if it was not decoded into that type.
+func StorageEventT(callback func(*eventx.StorageEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return StorageEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.StorageEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // SubmitEvent Documentation is as below: "A form is submitted."
 // https://developer.mozilla.org/docs/Web/Events/submit
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -9718,7 +10499,7 @@ func SubmitEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9755,7 +10536,7 @@ func SuccessEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9792,7 +10573,7 @@ func SuspendEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9829,7 +10610,7 @@ func TabCloseEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9866,7 +10647,7 @@ func TabHideEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9903,7 +10684,7 @@ func TabOpenEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9940,7 +10721,7 @@ func TabPinnedEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -9977,7 +10758,7 @@ func TabSelectEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10014,7 +10795,7 @@ func TabShowEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10051,7 +10832,7 @@ func TabUnpinnedEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10088,7 +10869,7 @@ func TimeUpdateEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10125,7 +10906,7 @@ func TimeoutEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10133,6 +10914,16 @@ func TimeoutEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 	return ev
 }
 
+// TimeoutEventT provides the TimeoutEvent with a callback which receives the event as
+// a *eventx.ProgressEvent, the event is ignored if it was not decoded into that type.
+func TimeoutEventT(callback func(*eventx.ProgressEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TimeoutEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.ProgressEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchCancelEvent Documentation is as below: "A touch point has been disrupted in an implementation-specific manners (too many touch points for example)."
 // https://developer.mozilla.org/docs/Web/Events/touchcancel
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10162,7 +10953,7 @@ func TouchCancelEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10170,6 +10961,16 @@ func TouchCancelEvent(callback interface{}, options ...trees.EventOptions) *tree
 	return ev
 }
 
+// TouchCancelEventT provides the TouchCancelEvent with a callback which receives the event as
+// a *eventx.TouchEvent, the event is ignored if it was not decoded into that type.
+func TouchCancelEventT(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchCancelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchEndEvent Documentation is as below: "A touch point is removed from the touch surface."
 // https://developer.mozilla.org/docs/Web/Events/touchend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10199,7 +11000,7 @@ func TouchEndEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10207,6 +11008,16 @@ func TouchEndEvent(callback interface{}, options ...trees.EventOptions) *trees.E
 	return ev
 }
 
+// TouchEndEventT provides the TouchEndEvent with a callback which receives the event as
+// a *eventx.TouchEvent, the event is ignored if it was not decoded into that type.
+func TouchEndEventT(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchEnterEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/touchenter
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10236,7 +11047,7 @@ func TouchEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10244,6 +11055,16 @@ func TouchEnterEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// TouchEnterEventT provides the TouchEnterEvent with a callback which receives the event as
+// a *eventx.TouchEvent, the event is ignored if it was not decoded into that type.
+func TouchEnterEventT(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchEnterEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchLeaveEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/touchleave
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10273,7 +11094,7 @@ func TouchLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10281,6 +11102,16 @@ func TouchLeaveEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// TouchLeaveEventT provides the TouchLeaveEvent with a callback which receives the event as
+// a *eventx.TouchEvent, the event is ignored if it was not decoded into that type.
+func TouchLeaveEventT(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchLeaveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchMoveEvent Documentation is as below: "A touch point is moved along the touch surface."
 // https://developer.mozilla.org/docs/Web/Events/touchmove
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10310,7 +11141,7 @@ func TouchMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10318,6 +11149,16 @@ func TouchMoveEvent(callback interface{}, options ...trees.EventOptions) *trees.
 	return ev
 }
 
+// TouchMoveEventT provides the TouchMoveEvent with a callback which receives the event as
+// a *eventx.TouchEvent, the event is ignored if it was not decoded into that type.
+func TouchMoveEventT(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchMoveEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TouchStartEvent Documentation is as below: "A touch point is placed on the touch surface."
 // https://developer.mozilla.org/docs/Web/Events/touchstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10347,7 +11188,7 @@ func TouchStartEvent(callback interface{}, options ...trees.EventOptions) *trees
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10355,6 +11196,16 @@ func TouchStartEvent(callback interface{}, options ...trees.EventOptions) *trees
 	return ev
 }
 
+// TouchStartEventT provides the TouchStartEvent with a callback which receives the event as
+// a *eventx.TouchEvent, the event is ignored if it was not decoded into that type.
+func TouchStartEventT(callback func(*eventx.TouchEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TouchStartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TouchEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TransitionEndEvent Documentation is as below: "A CSS transition has completed."
 // https://developer.mozilla.org/docs/Web/Events/transitionend
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10384,7 +11235,7 @@ func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10392,6 +11243,16 @@ func TransitionEndEvent(callback interface{}, options ...trees.EventOptions) *tr
 	return ev
 }
 
+// TransitionEndEventT provides the TransitionEndEvent with a callback which receives the event as
+// a *eventx.TransitionEvent, the event is ignored if it was not decoded into that type.
+func TransitionEndEventT(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitionEndEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TransitioncancelEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/transitioncancel
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10421,7 +11282,7 @@ func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10429,6 +11290,16 @@ func TransitioncancelEvent(callback interface{}, options ...trees.EventOptions)
 	return ev
 }
 
+// TransitioncancelEventT provides the TransitioncancelEvent with a callback which receives the event as
+// a *eventx.TransitionEvent, the event is ignored if it was not decoded into that type.
+func TransitioncancelEventT(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitioncancelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TransitionrunEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/transitionrun
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10458,7 +11329,7 @@ func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10466,6 +11337,16 @@ func TransitionrunEvent(callback interface{}, options ...trees.EventOptions) *tr
 	return ev
 }
 
+// TransitionrunEventT provides the TransitionrunEvent with a callback which receives the event as
+// a *eventx.TransitionEvent, the event is ignored if it was not decoded into that type.
+func TransitionrunEventT(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitionrunEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // TransitionstartEvent Documentation is as below: "(no documentation)"
 // https://developer.mozilla.org/docs/Web/Events/transitionstart
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10495,7 +11376,7 @@ func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10503,6 +11384,16 @@ func TransitionstartEvent(callback interface{}, options ...trees.EventOptions) *
 	return ev
 }
 
+// TransitionstartEventT provides the TransitionstartEvent with a callback which receives the event as
+// a *eventx.TransitionEvent, the event is ignored if it was not decoded into that type.
+func TransitionstartEventT(callback func(*eventx.TransitionEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return TransitionstartEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.TransitionEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // UnderflowEvent Documentation is as below: "An element is no longer overflowed by its content (only works for elements styled with overflow != visible)."
 // https://developer.mozilla.org/docs/Web/Events/underflow
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10532,7 +11423,7 @@ func UnderflowEvent(callback interface{}, options ...trees.EventOptions) *trees.
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10569,7 +11460,7 @@ func UnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10577,6 +11468,16 @@ func UnloadEvent(callback interface{}, options ...trees.EventOptions) *trees.Eve
 	return ev
 }
 
+// UnloadEventT provides the UnloadEvent with a callback which receives the event as
+// a *eventx.UIEvent, the event is ignored if it was not decoded into that type.
+func UnloadEventT(callback func(*eventx.UIEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return UnloadEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UIEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // UpdateReadyEvent Documentation is as below: "The resources listed in the manifest have been newly redownloaded, and the script can use swapCache() to switch to the new cache."
 // https://developer.mozilla.org/docs/Web/Events/updateready
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10606,7 +11507,7 @@ func UpdateReadyEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10643,7 +11544,7 @@ func UpgradeNeededEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10651,6 +11552,16 @@ func UpgradeNeededEvent(callback interface{}, options ...trees.EventOptions) *tr
 	return ev
 }
 
+// UpgradeNeededEventT provides the UpgradeNeededEvent with a callback which receives the event as
+// a *eventx.IDBVersionChangeEvent, the event is ignored if it was not decoded into that type.
+func UpgradeNeededEventT(callback func(*eventx.IDBVersionChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return UpgradeNeededEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.IDBVersionChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // UserProximityEvent Documentation is as below: "Fresh data is available from a proximity sensor (indicates whether the nearby object is near the device or not)."
 // https://developer.mozilla.org/docs/Web/Events/userproximity
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10680,7 +11591,7 @@ func UserProximityEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10688,6 +11599,16 @@ func UserProximityEvent(callback interface{}, options ...trees.EventOptions) *tr
 	return ev
 }
 
+// UserProximityEventT provides the UserProximityEvent with a callback which receives the event as
+// a *eventx.UserProximityEvent, the event is ignored if it was not decoded into that type.
+func UserProximityEventT(callback func(*eventx.UserProximityEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return UserProximityEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.UserProximityEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // UssdreceivedEvent Documentation is as below: "A new USSD message is received"
 // https://developer.mozilla.org/docs/Web/Events/ussdreceived
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10717,7 +11638,7 @@ func UssdreceivedEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10754,7 +11675,7 @@ func ValueChangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10791,7 +11712,7 @@ func VersionChangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10799,6 +11720,16 @@ func VersionChangeEvent(callback interface{}, options ...trees.EventOptions) *tr
 	return ev
 }
 
+// VersionChangeEventT provides the VersionChangeEvent with a callback which receives the event as
+// a *eventx.IDBVersionChangeEvent, the event is ignored if it was not decoded into that type.
+func VersionChangeEventT(callback func(*eventx.IDBVersionChangeEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return VersionChangeEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.IDBVersionChangeEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+
 // VisibilityChangeEvent Documentation is as below: "The content of a tab has become visible or has been hidden."
 // https://developer.mozilla.org/docs/Web/Events/visibilitychange
 // This event provides options() to be called when the events is triggered and an optional selector which will override the internal selector
@@ -10828,7 +11759,7 @@ func VisibilityChangeEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10865,7 +11796,7 @@ func VoicechangeEvent(callback interface{}, options ...trees.EventOptions) *tree
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10902,7 +11833,7 @@ func VoiceschangedEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10939,7 +11870,7 @@ func VolumeChangeEvent(callback interface{}, options ...trees.EventOptions) *tre
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -10976,7 +11907,7 @@ func VrdisplayactivateEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11013,7 +11944,7 @@ func VrdisplayblurEvent(callback interface{}, options ...trees.EventOptions) *tr
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11050,7 +11981,7 @@ func VrdisplayconnectEvent(callback interface{}, options ...trees.EventOptions)
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11087,7 +12018,7 @@ func VrdisplaydeactivateEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11124,7 +12055,7 @@ func VrdisplaydisconnectEvent(callback interface{}, options ...trees.EventOption
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11161,7 +12092,7 @@ func VrdisplayfocusEvent(callback interface{}, options ...trees.EventOptions) *t
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11198,7 +12129,7 @@ func VrdisplaypresentchangeEvent(callback interface{}, options ...trees.EventOpt
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11235,7 +12166,7 @@ func WaitingEvent(callback interface{}, options ...trees.EventOptions) *trees.Ev
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -11272,10 +12203,20 @@ func WheelEvent(callback interface{}, options ...trees.EventOptions) *trees.Even
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
 
 	return ev
 }
+
+// WheelEventT provides the WheelEvent with a callback which receives the event as
+// a *eventx.WheelEvent, the event is ignored if it was not decoded into that type.
+func WheelEventT(callback func(*eventx.WheelEvent, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return WheelEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.WheelEvent); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
@@ -0,0 +1,214 @@
+package events_test
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/influx6/faux/tests"
+)
+
+func TestTypedEvent(t *testing.T) {
+	var received *eventx.MouseEvent
+	var root *trees.Markup
+
+	click := events.ClickEventT(func(ev *eventx.MouseEvent, tree *trees.Markup) {
+		received = ev
+		root = tree
+	})
+	defer click.Remove.Remove()
+
+	div := elems.Div(click)
+
+	notifications.Dispatch(common.EventBroadcast{
+		EventName: "MouseEvent",
+		EventID:   click.ID(),
+		Event:     eventx.NewBaseEvent(&eventx.KeyboardEvent{}, nil),
+	})
+
+	if received != nil {
+		tests.Failed("Should have ignored event not decoded as a *eventx.MouseEvent")
+	}
+	tests.Passed("Should have ignored event not decoded as a *eventx.MouseEvent")
+
+	mouse := &eventx.MouseEvent{ClientX: 20}
+
+	notifications.Dispatch(common.EventBroadcast{
+		EventName: "MouseEvent",
+		EventID:   click.ID(),
+		Event:     eventx.NewBaseEvent(mouse, nil),
+	})
+
+	if received != mouse {
+		tests.Failed("Should have received the *eventx.MouseEvent")
+	}
+	tests.Passed("Should have received the *eventx.MouseEvent")
+
+	if root != div {
+		tests.Failed("Should have received the markup of the event")
+	}
+	tests.Passed("Should have received the markup of the event")
+}
+
+func TestEventOptions(t *testing.T) {
+	var received int32
+	delivered := make(chan struct{}, 4)
+
+	keyup := events.KeyUpEvent(func() {
+		atomic.AddInt32(&received, 1)
+		delivered <- struct{}{}
+	}, trees.Once(), trees.KeyFilter("Enter"), trees.Debounce(10*time.Millisecond))
+	defer keyup.Remove.Remove()
+
+	elems.Input(keyup)
+
+	ev := keyup.EventJSON()
+	if !ev.Once || ev.Debounce != 10 || len(ev.KeyFilter) != 1 {
+		tests.Failed("Should have encoded event options into EventJSON: %#v", ev)
+	}
+	tests.Passed("Should have encoded event options into EventJSON")
+
+	dispatch := func(key string) {
+		notifications.Dispatch(common.EventBroadcast{
+			EventName: "KeyboardEvent",
+			EventID:   keyup.ID(),
+			Event:     eventx.NewBaseEvent(&eventx.KeyboardEvent{Key: key}, nil),
+		})
+	}
+
+	dispatch("Escape")
+	dispatch("Enter")
+	dispatch("Enter")
+
+	select {
+	case <-delivered:
+	case <-time.After(5 * time.Second):
+		tests.Failed("Should have delivered debounced Enter key event")
+	}
+
+	if atomic.LoadInt32(&received) != 1 {
+		tests.Failed("Should have delivered only one debounced Enter key event: %d", received)
+	}
+	tests.Passed("Should have delivered only one debounced Enter key event")
+
+	dispatch("Enter")
+
+	if atomic.LoadInt32(&received) != 1 {
+		tests.Failed("Should have not delivered event after the first: %d", received)
+	}
+	tests.Passed("Should have not delivered event after the first")
+
+	notifications.Dispatch(common.EventBroadcast{
+		EventName:      "KeyboardEvent",
+		EventID:        keyup.ID(),
+		Event:          eventx.NewBaseEvent(&eventx.KeyboardEvent{Key: "Escape"}, nil),
+		OptionsApplied: true,
+	})
+
+	if atomic.LoadInt32(&received) != 2 {
+		tests.Failed("Should have delivered event with options applied by driver: %d", received)
+	}
+	tests.Passed("Should have delivered event with options applied by driver")
+}
+
+func TestEventDeliverApplied(t *testing.T) {
+	var received int
+
+	keyup := events.KeyUpEvent(func() {
+		received++
+	}, trees.Debounce(time.Hour))
+	defer keyup.Remove.Remove()
+
+	elems.Input(keyup)
+
+	driver := common.NewEventBroadcastHandler(func(evm common.EventBroadcast) {
+		notifications.Dispatch(evm)
+	})
+
+	for i := 0; i < 2; i++ {
+		driver.DeliverApplied("KeyboardEvent", keyup.ID(), eventx.NewBaseEvent(&eventx.KeyboardEvent{Key: "Enter"}, nil))
+	}
+
+	if received != 2 {
+		tests.Failed("Should have delivered events with options applied by driver without debouncing them again: %d", received)
+	}
+	tests.Passed("Should have delivered events with options applied by driver without debouncing them again")
+
+	driver.Deliver("KeyboardEvent", keyup.ID(), eventx.NewBaseEvent(&eventx.KeyboardEvent{Key: "Enter"}, nil))
+
+	if received != 2 {
+		tests.Failed("Should have debounced event delivered without options applied: %d", received)
+	}
+	tests.Passed("Should have debounced event delivered without options applied")
+}
+
+func TestThrottleEvent(t *testing.T) {
+	var received int
+
+	scroll := events.ScrollEvent(func() {
+		received++
+	}, trees.Throttle(time.Hour))
+	defer scroll.Remove.Remove()
+
+	elems.Div(scroll)
+
+	for i := 0; i < 3; i++ {
+		notifications.Dispatch(common.EventBroadcast{
+			EventName: "UIEvent",
+			EventID:   scroll.ID(),
+			Event:     eventx.NewBaseEvent(&eventx.UIEvent{}, nil),
+		})
+	}
+
+	if received != 1 {
+		tests.Failed("Should have delivered only the first throttled event: %d", received)
+	}
+	tests.Passed("Should have delivered only the first throttled event")
+}
+
+func TestEventClone(t *testing.T) {
+	original := trees.NewEvent(trees.EventType("keyup"), trees.KeyFilter("Enter"), trees.KeyFilter("Escape"), trees.KeyFilter("Tab"))
+
+	clone := original.Clone()
+	trees.KeyFilter("Space")(clone)
+	trees.KeyFilter("ArrowUp")(original)
+
+	if len(clone.KeyFilter) != 4 || clone.KeyFilter[3] != "Space" || original.KeyFilter[3] != "ArrowUp" {
+		tests.Failed("Should have kept key filters of clone apart from the original: %q %q", clone.KeyFilter, original.KeyFilter)
+	}
+	tests.Passed("Should have kept key filters of clone apart from the original")
+}
+
+func TestEventConcurrentDelivery(t *testing.T) {
+	var received int32
+
+	event := &trees.Event{Type: "click", Throttle: time.Hour}
+	broadcast := common.EventBroadcast{Event: eventx.NewBaseEvent(&eventx.MouseEvent{}, nil)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			event.Deliver(broadcast, func(common.EventObject, *trees.Markup) {
+				atomic.AddInt32(&received, 1)
+			})
+		}()
+	}
+
+	wg.Wait()
+
+	if atomic.LoadInt32(&received) != 1 {
+		tests.Failed("Should have delivered throttled event once when delivered concurrently: %d", received)
+	}
+	tests.Passed("Should have delivered throttled event once when delivered concurrently")
+}
@@ -5,7 +5,9 @@
 package main
 
 import (
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"os"
 	"sort"
 	"strings"
@@ -17,6 +19,7 @@ type event struct {
 	Name string
 	Link string
 	Desc string
+	Type string
 }
 
 func main() {
@@ -109,6 +112,13 @@ func main() {
 		"error": true,
 	}
 
+	// decoders contains the event types which drivers/core.GetEvent decodes into
+	// eventx structs, which get typed variants of the event functions.
+	decoders, err := getEventTypes("../../eventx/schema.json")
+	if err != nil {
+		panic(err)
+	}
+
 	doc, err := goquery.NewDocument("https://developer.mozilla.org/en-US/docs/Web/Events")
 	if err != nil {
 		panic(err)
@@ -136,6 +146,7 @@ func main() {
 			}
 
 			e.Link, _ = link.Attr("href")
+			e.Type = strings.TrimSpace(cols.Eq(1).Text())
 			e.Desc = strings.TrimSpace(cols.Eq(3).Text())
 			if e.Desc == "" {
 				e.Desc = "(no documentation)"
@@ -186,6 +197,7 @@ package events
 import (
 	"github.com/gu-io/gu/trees"
 	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
 	"github.com/gu-io/gu/notifications"
 )
 
@@ -242,7 +254,7 @@ func %sEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 			return
 		}
 
-		handler(evm.Event, ev.Tree)
+		ev.Deliver(evm, handler)
 	})
 
 	ev.Remove = notifications.SubscribeWithRemover(eventHandler)
@@ -250,9 +262,55 @@ func %sEvent(callback interface{}, options ...trees.EventOptions) *trees.Event {
 	return ev
 }
 `, name, e.Desc, e.Link[6:], name, e.Name)
+
+		if !decoders[e.Type] {
+			continue
+		}
+
+		fmt.Fprintf(file, `
+// %sEventT provides the %sEvent with a callback which receives the event as
+// a *eventx.%s, the event is ignored if it was not decoded into that type.
+func %sEventT(callback func(*eventx.%s, *trees.Markup), options ...trees.EventOptions) *trees.Event {
+	return %sEvent(func(ev common.EventObject, root *trees.Markup) {
+		if event, ok := ev.Underlying().(*eventx.%s); ok {
+			callback(event, root)
+		}
+	}, options...)
+}
+`, name, name, e.Type, name, e.Type, name, e.Type)
 	}
 }
 
+// getEventTypes returns the event types declared by the eventx schema, which
+// drivers/core.GetEvent decodes into eventx structs.
+func getEventTypes(path string) (map[string]bool, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var schema struct {
+		Types []struct {
+			Name  string `json:"name"`
+			Event bool   `json:"event"`
+		} `json:"types"`
+	}
+
+	if err := json.Unmarshal(data, &schema); err != nil {
+		return nil, err
+	}
+
+	types := make(map[string]bool)
+
+	for _, ty := range schema.Types {
+		if ty.Event {
+			types[ty.Name] = true
+		}
+	}
+
+	return types, nil
+}
+
 func capitalize(s string) string {
 	if len(s) == 0 {
 		return s
@@ -0,0 +1,199 @@
+package forms
+
+import (
+	"reflect"
+	"strconv"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/events"
+	"github.com/gu-io/gu/trees/property"
+)
+
+// Choice defines a option rendered within a Select element.
+type Choice struct {
+	Value string
+	Label string
+}
+
+// Input returns a input element bound to the field with the giving name, whose
+// type is set from the field's tag or type. Bool fields are rendered as a
+// checkbox.
+func (f *Form) Input(name string, markup ...trees.Appliable) *trees.Markup {
+	fl, value := f.field(name)
+
+	attrs := []trees.Appliable{
+		property.TypeAttr(fl.inputType),
+		property.NameAttr(name),
+	}
+
+	if fl.kind == reflect.Bool {
+		if value.Bool() {
+			attrs = append(attrs, property.CheckedBoolAttr(true))
+		}
+
+		attrs = append(attrs, events.ChangeEvent(f.checked(name)))
+	} else {
+		attrs = append(attrs, property.ValueAttr(format(value, fl)), events.InputEvent(f.input(name)))
+	}
+
+	return elems.Input(append(attrs, markup...)...)
+}
+
+// TextArea returns a textarea element bound to the field with the giving name.
+func (f *Form) TextArea(name string, markup ...trees.Appliable) *trees.Markup {
+	fl, value := f.field(name)
+
+	attrs := []trees.Appliable{
+		property.NameAttr(name),
+		events.InputEvent(f.input(name)),
+		trees.NewText("%s", format(value, fl)),
+	}
+
+	return elems.TextArea(append(attrs, markup...)...)
+}
+
+// Radio returns a radio input element for the giving value, which sets the
+// field with the giving name to the value when checked.
+func (f *Form) Radio(name string, value string, markup ...trees.Appliable) *trees.Markup {
+	fl, current := f.field(name)
+
+	attrs := []trees.Appliable{
+		property.TypeAttr("radio"),
+		property.NameAttr(name),
+		property.ValueAttr(value),
+		events.ChangeEvent(func(ev common.EventObject, _ *trees.Markup) {
+			if _, checked, _, ok := eventState(ev); ok && checked {
+				f.changed(name, f.Update(name, value))
+			}
+		}),
+	}
+
+	if format(current, fl) == value {
+		attrs = append(attrs, property.CheckedBoolAttr(true))
+	}
+
+	return elems.Input(append(attrs, markup...)...)
+}
+
+// Checkbox returns a checkbox input element for the giving value, which adds
+// or removes the value from the []string field with the giving name, allowing a
+// group of checkboxes to be bound to a single field.
+func (f *Form) Checkbox(name string, value string, markup ...trees.Appliable) *trees.Markup {
+	_, current := f.field(name)
+
+	attrs := []trees.Appliable{
+		property.TypeAttr("checkbox"),
+		property.NameAttr(name),
+		property.ValueAttr(value),
+		events.ChangeEvent(func(ev common.EventObject, _ *trees.Markup) {
+			if _, checked, _, ok := eventState(ev); ok {
+				f.changed(name, f.toggle(name, value, checked))
+			}
+		}),
+	}
+
+	if items, ok := current.Interface().([]string); ok && contains(items, value) {
+		attrs = append(attrs, property.CheckedBoolAttr(true))
+	}
+
+	return elems.Input(append(attrs, markup...)...)
+}
+
+// Select returns a select element with the provided choices bound to the field
+// with the giving name, []string fields are rendered as a multiple select.
+func (f *Form) Select(name string, choices []Choice, markup ...trees.Appliable) *trees.Markup {
+	fl, current := f.field(name)
+
+	var selected []string
+	var multiple bool
+
+	if items, ok := current.Interface().([]string); ok {
+		selected = items
+		multiple = true
+	} else {
+		selected = []string{format(current, fl)}
+	}
+
+	attrs := []trees.Appliable{
+		property.NameAttr(name),
+		events.ChangeEvent(func(ev common.EventObject, _ *trees.Markup) {
+			value, _, values, ok := eventState(ev)
+			if !ok {
+				return
+			}
+
+			if multiple {
+				f.changed(name, f.Update(name, values...))
+				return
+			}
+
+			f.changed(name, f.Update(name, value))
+		}),
+	}
+
+	if multiple {
+		attrs = append(attrs, property.CustomAttr("multiple", "multiple"))
+	}
+
+	for _, choice := range choices {
+		option := elems.Option(property.ValueAttr(choice.Value), trees.NewText("%s", choice.Label))
+		if contains(selected, choice.Value) {
+			property.CustomAttr("selected", "selected").Apply(option)
+		}
+
+		attrs = append(attrs, option)
+	}
+
+	return elems.Select(append(attrs, markup...)...)
+}
+
+// input returns the event handler which updates the field with the giving name
+// from the value of its element.
+func (f *Form) input(name string) func(common.EventObject, *trees.Markup) {
+	return func(ev common.EventObject, _ *trees.Markup) {
+		if value, _, _, ok := eventState(ev); ok {
+			f.changed(name, f.Update(name, value))
+		}
+	}
+}
+
+// checked returns the event handler which updates the bool field with the
+// giving name from the checked state of its element.
+func (f *Form) checked(name string) func(common.EventObject, *trees.Markup) {
+	return func(ev common.EventObject, _ *trees.Markup) {
+		if _, checked, _, ok := eventState(ev); ok {
+			f.changed(name, f.Update(name, strconv.FormatBool(checked)))
+		}
+	}
+}
+
+// eventState returns the value, checked state and selected values of the
+// element which triggered the provided input or change event.
+func eventState(ev common.EventObject) (string, bool, []string, bool) {
+	if ev == nil {
+		return "", false, nil, false
+	}
+
+	switch event := ev.Underlying().(type) {
+	case *eventx.InputEvent:
+		return event.Value, event.Checked, event.Values, true
+	case *eventx.ChangeEvent:
+		return event.Value, event.Checked, event.Values, true
+	}
+
+	return "", false, nil, false
+}
+
+// contains returns true/false if the value exists within the items.
+func contains(items []string, value string) bool {
+	for _, item := range items {
+		if item == value {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,517 @@
+// Package forms provides a two-way binding between the fields of a Go struct
+// and the input, select and textarea elements which render them.
+//
+// Fields are bound by their `form` tag, which provides the name of the field
+// and optionally the input type used to render it:
+//
+//	type Signup struct {
+//		Email    string    `form:"email,email"`
+//		Age      int       `form:"age"`
+//		Born     time.Time `form:"born,date"`
+//		Terms    bool      `form:"terms"`
+//		Gender   string    `form:"gender"`
+//		Topics   []string  `form:"topics"`
+//		Internal string    `form:"-"`
+//	}
+//
+// Fields without a tag are bound by their field name. Fields can equally be
+// validated through their `validate` tag or the rules added by Form.Rules:
+//
+//	type Signup struct {
+//		Email string `form:"email,email" validate:"required,pattern=^.+@.+$"`
+//		Name  string `form:"name" validate:"required,min=3,max=20"`
+//	}
+//
+// Rules are evaluated as the elements of a field receive input and for all
+// fields by Form.Validate on submit, which servers equally call through
+// Form.Decode when receiving the posted form.
+package forms
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gu-io/gu"
+)
+
+// ErrNotStructPointer is returned when the value bound to a Form is not a
+// pointer to a struct.
+var ErrNotStructPointer = errors.New("Value is not a pointer to a struct")
+
+// layouts defines the time layouts used for the supported date input types.
+var layouts = map[string]string{
+	"date":           "2006-01-02",
+	"datetime-local": "2006-01-02T15:04",
+	"month":          "2006-01",
+	"time":           "15:04",
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// field defines the details of a bound struct field.
+type field struct {
+	index     []int
+	inputType string
+	kind      reflect.Kind
+	typ       reflect.Type
+	rules     []Rule
+}
+
+// Form binds the fields of a struct to form elements, updating the struct as
+// the elements receive input and publishing to its subscribers when fields are
+// changed through Set.
+type Form struct {
+	gu.Reactive
+	ml      sync.Mutex
+	target  reflect.Value
+	fields  map[string]field
+	errs    map[string]error
+	invalid Errors
+}
+
+// New returns a new Form bound to the provided struct pointer.
+func New(target interface{}) (*Form, error) {
+	value := reflect.ValueOf(target)
+	if value.Kind() != reflect.Ptr || value.Elem().Kind() != reflect.Struct {
+		return nil, ErrNotStructPointer
+	}
+
+	form := &Form{
+		Reactive: gu.NewReactive(),
+		target:   value.Elem(),
+		fields:   make(map[string]field),
+		errs:     make(map[string]error),
+		invalid:  make(Errors),
+	}
+
+	tp := form.target.Type()
+	for i := 0; i < tp.NumField(); i++ {
+		item := tp.Field(i)
+		if item.PkgPath != "" {
+			continue
+		}
+
+		name, inputType := item.Name, ""
+
+		if tag, ok := item.Tag.Lookup("form"); ok {
+			if tag == "-" {
+				continue
+			}
+
+			parts := strings.SplitN(tag, ",", 2)
+			if parts[0] != "" {
+				name = parts[0]
+			}
+
+			if len(parts) > 1 {
+				inputType = parts[1]
+			}
+		}
+
+		rules, err := parseRules(item.Tag.Get("validate"))
+		if err != nil {
+			return nil, fmt.Errorf("Field %q has invalid validate tag: %+q", item.Name, err)
+		}
+
+		fl := field{
+			index:     item.Index,
+			inputType: inputType,
+			kind:      item.Type.Kind(),
+			typ:       item.Type,
+			rules:     rules,
+		}
+
+		if fl.inputType == "" {
+			fl.inputType = defaultInputType(fl)
+		}
+
+		form.fields[name] = fl
+	}
+
+	return form, nil
+}
+
+// Rules adds the provided rules to the field with the giving name, evaluated
+// after those of its `validate` tag.
+func (f *Form) Rules(name string, rules ...Rule) error {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	fl, ok := f.fields[name]
+	if !ok {
+		return fmt.Errorf("Form has no field %q", name)
+	}
+
+	fl.rules = append(fl.rules, rules...)
+	f.fields[name] = fl
+	return nil
+}
+
+// Validate evaluates the rules of all fields, returning the errors of those
+// which failed, including fields whose last received value could not be parsed.
+// The errors are kept for Errors and published to the form's subscribers.
+func (f *Form) Validate() Errors {
+	f.ml.Lock()
+
+	invalid := make(Errors)
+	for name := range f.fields {
+		if err := f.check(name); err != nil {
+			invalid[name] = err.Error()
+		}
+	}
+
+	f.invalid = invalid
+	f.ml.Unlock()
+
+	f.Publish()
+	return invalid.copy()
+}
+
+// Errors returns the errors of the fields which failed their last validation,
+// for use when rendering the form.
+func (f *Form) Errors() Errors {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	return f.invalid.copy()
+}
+
+// Decode sets the fields of the form from the provided values, as received by
+// a server from the posted form, returning the Errors from Validate if any
+// field failed. Bool fields are set by the presence of their value.
+func (f *Form) Decode(values url.Values) error {
+	f.ml.Lock()
+	names := make([]string, 0, len(f.fields))
+	for name := range f.fields {
+		names = append(names, name)
+	}
+	f.ml.Unlock()
+
+	for _, name := range names {
+		f.ml.Lock()
+		fl := f.fields[name]
+		f.ml.Unlock()
+
+		items, ok := values[name]
+
+		if fl.kind == reflect.Bool {
+			state := ok && (len(items) == 0 || items[0] != "false")
+			f.setErr(name, f.Update(name, strconv.FormatBool(state)))
+			continue
+		}
+
+		if ok {
+			f.setErr(name, f.Update(name, items...))
+		}
+	}
+
+	if invalid := f.Validate(); len(invalid) != 0 {
+		return invalid
+	}
+
+	return nil
+}
+
+// Validate binds the provided struct pointer to a new Form, returning the
+// errors from validating its fields.
+func Validate(target interface{}) (Errors, error) {
+	form, err := New(target)
+	if err != nil {
+		return nil, err
+	}
+
+	return form.Validate(), nil
+}
+
+// Get returns the value of the field with the giving name.
+func (f *Form) Get(name string) (interface{}, error) {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	fl, ok := f.fields[name]
+	if !ok {
+		return nil, fmt.Errorf("Form has no field %q", name)
+	}
+
+	return f.target.FieldByIndex(fl.index).Interface(), nil
+}
+
+// Set sets the value of the field with the giving name and publishes the
+// change to the form's subscribers, re-rendering those bound to it.
+func (f *Form) Set(name string, value interface{}) error {
+	f.ml.Lock()
+
+	fl, ok := f.fields[name]
+	if !ok {
+		f.ml.Unlock()
+		return fmt.Errorf("Form has no field %q", name)
+	}
+
+	val := reflect.ValueOf(value)
+	if !val.IsValid() || !val.Type().AssignableTo(fl.typ) {
+		f.ml.Unlock()
+		return fmt.Errorf("Value of type %T can not be set on field %q of type %s", value, name, fl.typ)
+	}
+
+	f.target.FieldByIndex(fl.index).Set(val)
+	f.ml.Unlock()
+
+	f.Publish()
+	return nil
+}
+
+// Update parses and sets the provided string values on the field with the
+// giving name, as received from its elements, without publishing the change.
+// Multiple values are only accepted for []string fields.
+func (f *Form) Update(name string, values ...string) error {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	fl, ok := f.fields[name]
+	if !ok {
+		return fmt.Errorf("Form has no field %q", name)
+	}
+
+	target := f.target.FieldByIndex(fl.index)
+
+	if fl.typ == reflect.TypeOf([]string(nil)) {
+		target.Set(reflect.ValueOf(append([]string(nil), values...)))
+		return nil
+	}
+
+	var value string
+	if len(values) != 0 {
+		value = values[0]
+	}
+
+	return parseInto(target, fl, value)
+}
+
+// Err returns the error from parsing the last value received from the elements
+// of the field with the giving name, if any.
+func (f *Form) Err(name string) error {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	return f.errs[name]
+}
+
+// setErr sets the parsing error of the field with the giving name.
+func (f *Form) setErr(name string, err error) {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	if err == nil {
+		delete(f.errs, name)
+		return
+	}
+
+	f.errs[name] = err
+}
+
+// changed records the parsing error received when updating the field with the
+// giving name from its elements, re-validating the field and publishing to the
+// form's subscribers when its error changes.
+func (f *Form) changed(name string, err error) {
+	f.setErr(name, err)
+
+	f.ml.Lock()
+
+	previous, had := f.invalid[name]
+	if err := f.check(name); err != nil {
+		f.invalid[name] = err.Error()
+	} else {
+		delete(f.invalid, name)
+	}
+
+	current, has := f.invalid[name]
+	f.ml.Unlock()
+
+	if had != has || previous != current {
+		f.Publish()
+	}
+}
+
+// check returns the parsing error of the field with the giving name or the
+// error of its first failed rule, the lock must be held by the caller.
+func (f *Form) check(name string) error {
+	if err, ok := f.errs[name]; ok {
+		return err
+	}
+
+	fl := f.fields[name]
+	value := f.target.FieldByIndex(fl.index).Interface()
+
+	for _, rule := range fl.rules {
+		if err := rule(value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// toggle adds or removes the value from the []string field with the giving
+// name, as received from a checkbox of a group.
+func (f *Form) toggle(name string, value string, checked bool) error {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	fl, ok := f.fields[name]
+	if !ok {
+		return fmt.Errorf("Form has no field %q", name)
+	}
+
+	target := f.target.FieldByIndex(fl.index)
+
+	var items []string
+	for _, item := range target.Interface().([]string) {
+		if item != value {
+			items = append(items, item)
+		}
+	}
+
+	if checked {
+		items = append(items, value)
+	}
+
+	target.Set(reflect.ValueOf(items))
+	return nil
+}
+
+// field returns the field with the giving name and its current value, it
+// panics if no such field exists as elements can not be rendered for it.
+func (f *Form) field(name string) (field, reflect.Value) {
+	f.ml.Lock()
+	defer f.ml.Unlock()
+
+	fl, ok := f.fields[name]
+	if !ok {
+		panic(fmt.Sprintf("Form has no field %q", name))
+	}
+
+	return fl, f.target.FieldByIndex(fl.index)
+}
+
+// defaultInputType returns the input type used for the giving field when not
+// set by its tag.
+func defaultInputType(fl field) string {
+	if fl.typ == timeType {
+		return "date"
+	}
+
+	switch fl.kind {
+	case reflect.Bool:
+		return "checkbox"
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+		reflect.Float32, reflect.Float64:
+		return "number"
+	}
+
+	return "text"
+}
+
+// parseInto parses the string value into the target based on the field type,
+// empty values set the zero value of the field.
+func parseInto(target reflect.Value, fl field, value string) error {
+	if fl.kind == reflect.String {
+		target.SetString(value)
+		return nil
+	}
+
+	value = strings.TrimSpace(value)
+
+	if value == "" {
+		target.Set(reflect.Zero(fl.typ))
+		return nil
+	}
+
+	if fl.typ == timeType {
+		layout, ok := layouts[fl.inputType]
+		if !ok {
+			layout = time.RFC3339
+		}
+
+		date, err := time.Parse(layout, value)
+		if err != nil {
+			return err
+		}
+
+		target.Set(reflect.ValueOf(date))
+		return nil
+	}
+
+	switch fl.kind {
+	case reflect.Bool:
+		state, err := strconv.ParseBool(value)
+		if err != nil {
+			return err
+		}
+
+		target.SetBool(state)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		number, err := strconv.ParseInt(value, 10, fl.typ.Bits())
+		if err != nil {
+			return err
+		}
+
+		target.SetInt(number)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		number, err := strconv.ParseUint(value, 10, fl.typ.Bits())
+		if err != nil {
+			return err
+		}
+
+		target.SetUint(number)
+	case reflect.Float32, reflect.Float64:
+		number, err := strconv.ParseFloat(value, fl.typ.Bits())
+		if err != nil {
+			return err
+		}
+
+		target.SetFloat(number)
+	default:
+		return fmt.Errorf("Field type %s is not supported", fl.typ)
+	}
+
+	return nil
+}
+
+// format returns the string representation of the value for its element.
+func format(value reflect.Value, fl field) string {
+	if fl.typ == timeType {
+		date := value.Interface().(time.Time)
+		if date.IsZero() {
+			return ""
+		}
+
+		layout, ok := layouts[fl.inputType]
+		if !ok {
+			layout = time.RFC3339
+		}
+
+		return date.Format(layout)
+	}
+
+	switch fl.kind {
+	case reflect.String:
+		return value.String()
+	case reflect.Bool:
+		return strconv.FormatBool(value.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(value.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(value.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(value.Float(), 'f', -1, fl.typ.Bits())
+	}
+
+	return fmt.Sprint(value.Interface())
+}
@@ -0,0 +1,171 @@
+package forms_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gu-io/gu/common"
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/notifications"
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/forms"
+	"github.com/influx6/faux/tests"
+)
+
+type signup struct {
+	Email  string    `form:"email,email"`
+	Age    int       `form:"age"`
+	Born   time.Time `form:"born"`
+	Terms  bool      `form:"terms"`
+	Gender string    `form:"gender"`
+	Topics []string  `form:"topics"`
+	Secret string    `form:"-"`
+}
+
+// dispatch delivers the provided event object to the events of the markup.
+func dispatch(markup *trees.Markup, event interface{}) {
+	markup.EachEvent(func(ev *trees.Event, _ *trees.Markup) {
+		notifications.Dispatch(common.EventBroadcast{
+			EventID: ev.ID(),
+			Event:   eventx.NewBaseEvent(event, nil),
+		})
+	})
+}
+
+// remove removes the event subscriptions of the markup.
+func remove(markup *trees.Markup) {
+	markup.EachEvent(func(ev *trees.Event, _ *trees.Markup) {
+		ev.Remove.Remove()
+	})
+}
+
+func TestFormBinding(t *testing.T) {
+	var user signup
+	user.Email = "alex@gu.io"
+
+	if _, err := forms.New(user); err != forms.ErrNotStructPointer {
+		tests.Failed("Should have failed to bind a non pointer value")
+	}
+	tests.Passed("Should have failed to bind a non pointer value")
+
+	form, err := forms.New(&user)
+	if err != nil {
+		tests.Failed("Should have bound struct to form: %+q", err)
+	}
+	tests.Passed("Should have bound struct to form")
+
+	if _, err := form.Get("Secret"); err == nil {
+		tests.Failed("Should have skipped field with '-' tag")
+	}
+	tests.Passed("Should have skipped field with '-' tag")
+
+	email := form.Input("email")
+	defer remove(email)
+
+	if html := email.HTML(); !strings.Contains(html, `type="email"`) || !strings.Contains(html, `value="alex@gu.io"`) {
+		tests.Failed("Should have rendered email input with field value: %q", html)
+	}
+	tests.Passed("Should have rendered email input with field value")
+
+	dispatch(email, &eventx.InputEvent{Value: "bob@gu.io"})
+	if user.Email != "bob@gu.io" {
+		tests.Failed("Should have updated field from input event: %q", user.Email)
+	}
+	tests.Passed("Should have updated field from input event")
+
+	age := form.Input("age")
+	defer remove(age)
+
+	dispatch(age, &eventx.InputEvent{Value: "32"})
+	if user.Age != 32 {
+		tests.Failed("Should have parsed number from input event: %d", user.Age)
+	}
+	tests.Passed("Should have parsed number from input event")
+
+	dispatch(age, &eventx.InputEvent{Value: "32a"})
+	if user.Age != 32 || form.Err("age") == nil {
+		tests.Failed("Should have kept value and recorded error for invalid number")
+	}
+	tests.Passed("Should have kept value and recorded error for invalid number")
+
+	born := form.Input("born")
+	defer remove(born)
+
+	dispatch(born, &eventx.InputEvent{Value: "1990-04-12"})
+	if user.Born.Year() != 1990 || user.Born.Month() != time.April || user.Born.Day() != 12 {
+		tests.Failed("Should have parsed date from input event: %s", user.Born)
+	}
+	tests.Passed("Should have parsed date from input event")
+
+	terms := form.Input("terms")
+	defer remove(terms)
+
+	dispatch(terms, &eventx.ChangeEvent{Checked: true})
+	if !user.Terms {
+		tests.Failed("Should have set bool field from checkbox")
+	}
+	tests.Passed("Should have set bool field from checkbox")
+
+	female := form.Radio("gender", "female")
+	defer remove(female)
+
+	dispatch(female, &eventx.ChangeEvent{Checked: true, Value: "female"})
+	if user.Gender != "female" {
+		tests.Failed("Should have set field from checked radio: %q", user.Gender)
+	}
+	tests.Passed("Should have set field from checked radio")
+
+	golang := form.Checkbox("topics", "go")
+	defer remove(golang)
+
+	dispatch(golang, &eventx.ChangeEvent{Checked: true, Value: "go"})
+	if len(user.Topics) != 1 || user.Topics[0] != "go" {
+		tests.Failed("Should have added value from checkbox group: %#v", user.Topics)
+	}
+	tests.Passed("Should have added value from checkbox group")
+
+	topics := form.Select("topics", []forms.Choice{{Value: "go", Label: "Go"}, {Value: "js", Label: "JS"}})
+	defer remove(topics)
+
+	if html := topics.HTML(); !strings.Contains(html, `multiple="multiple"`) || !strings.Contains(html, `selected="selected"`) {
+		tests.Failed("Should have rendered multiple select with selected option: %q", html)
+	}
+	tests.Passed("Should have rendered multiple select with selected option")
+
+	dispatch(topics, &eventx.ChangeEvent{Values: []string{"go", "js"}})
+	if len(user.Topics) != 2 {
+		tests.Failed("Should have set values from multiple select: %#v", user.Topics)
+	}
+	tests.Passed("Should have set values from multiple select")
+}
+
+func TestFormSet(t *testing.T) {
+	var user signup
+
+	form, err := forms.New(&user)
+	if err != nil {
+		tests.Failed("Should have bound struct to form: %+q", err)
+	}
+	tests.Passed("Should have bound struct to form")
+
+	var published int
+	form.React(func() {
+		published++
+	})
+
+	if err := form.Set("age", "20"); err == nil {
+		tests.Failed("Should have failed to set value of wrong type")
+	}
+	tests.Passed("Should have failed to set value of wrong type")
+
+	if err := form.Set("age", 20); err != nil {
+		tests.Failed("Should have set value of field: %+q", err)
+	}
+	tests.Passed("Should have set value of field")
+
+	if user.Age != 20 || published != 1 {
+		tests.Failed("Should have updated struct and published change")
+	}
+	tests.Passed("Should have updated struct and published change")
+}
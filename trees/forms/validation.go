@@ -0,0 +1,247 @@
+package forms
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// Rule defines a function which validates the value of a field, returning a
+// error whose message describes the failure.
+type Rule func(value interface{}) error
+
+// Required returns a Rule which fails when a string, slice or map is empty, a
+// pointer or interface is nil, a time is zero or a bool is false, so required
+// checkboxes must be checked. Numbers always pass, as zero is a valid value.
+func Required() Rule {
+	return func(value interface{}) error {
+		if isEmpty(reflect.ValueOf(value)) {
+			return errors.New("is required")
+		}
+
+		return nil
+	}
+}
+
+// Min returns a Rule which fails when a number is less than the provided
+// minimum, or when the length of a string or slice is less than it.
+func Min(min float64) Rule {
+	return func(value interface{}) error {
+		size, unit, ok := measure(reflect.ValueOf(value))
+		if !ok || size >= min {
+			return nil
+		}
+
+		if unit == "" {
+			return fmt.Errorf("must be atleast %s", formatFloat(min))
+		}
+
+		return fmt.Errorf("must have atleast %s %s", formatFloat(min), unit)
+	}
+}
+
+// Max returns a Rule which fails when a number is greater than the provided
+// maximum, or when the length of a string or slice is greater than it.
+func Max(max float64) Rule {
+	return func(value interface{}) error {
+		size, unit, ok := measure(reflect.ValueOf(value))
+		if !ok || size <= max {
+			return nil
+		}
+
+		if unit == "" {
+			return fmt.Errorf("must be atmost %s", formatFloat(max))
+		}
+
+		return fmt.Errorf("must have atmost %s %s", formatFloat(max), unit)
+	}
+}
+
+// Pattern returns a Rule which fails when a non-empty string does not match
+// the provided regular expression, it panics if the expression is invalid.
+func Pattern(expr string) Rule {
+	matcher := regexp.MustCompile(expr)
+
+	return func(value interface{}) error {
+		text, ok := value.(string)
+		if !ok || text == "" || matcher.MatchString(text) {
+			return nil
+		}
+
+		return errors.New("has an invalid format")
+	}
+}
+
+// WithMessage returns a Rule which replaces the message of the provided rule's
+// failures with the giving message.
+func WithMessage(rule Rule, message string) Rule {
+	return func(value interface{}) error {
+		if err := rule(value); err != nil {
+			return errors.New(message)
+		}
+
+		return nil
+	}
+}
+
+// parseRules returns the rules listed in a `validate` tag, such as
+// `validate:"required,min=3,max=20,pattern=^[a-z]+$"`. Patterns containing
+// commas must be added through Form.Rules instead.
+func parseRules(tag string) ([]Rule, error) {
+	var rules []Rule
+
+	for _, item := range strings.Split(tag, ",") {
+		item = strings.TrimSpace(item)
+		if item == "" {
+			continue
+		}
+
+		parts := strings.SplitN(item, "=", 2)
+
+		switch parts[0] {
+		case "required":
+			rules = append(rules, Required())
+		case "min", "max":
+			if len(parts) != 2 {
+				return nil, fmt.Errorf("Validation rule %q expects a value", parts[0])
+			}
+
+			limit, err := strconv.ParseFloat(parts[1], 64)
+			if err != nil {
+				return nil, fmt.Errorf("Validation rule %q has invalid value: %+q", parts[0], err)
+			}
+
+			if parts[0] == "min" {
+				rules = append(rules, Min(limit))
+			} else {
+				rules = append(rules, Max(limit))
+			}
+		case "pattern":
+			if len(parts) != 2 {
+				return nil, errors.New("Validation rule \"pattern\" expects a value")
+			}
+
+			if _, err := regexp.Compile(parts[1]); err != nil {
+				return nil, fmt.Errorf("Validation rule \"pattern\" has invalid value: %+q", err)
+			}
+
+			rules = append(rules, Pattern(parts[1]))
+		default:
+			return nil, fmt.Errorf("Unknown validation rule %q", parts[0])
+		}
+	}
+
+	return rules, nil
+}
+
+//==============================================================================
+
+// Errors defines a map of field names to the message of their first failed
+// rule or parsing error.
+type Errors map[string]string
+
+// Has returns true/false if the field with the giving name has an error.
+func (e Errors) Has(name string) bool {
+	_, ok := e[name]
+	return ok
+}
+
+// Error implements the error interface, listing the errors ordered by the
+// field names.
+func (e Errors) Error() string {
+	names := make([]string, 0, len(e))
+	for name := range e {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	messages := make([]string, 0, len(names))
+	for _, name := range names {
+		messages = append(messages, fmt.Sprintf("%s %s", name, e[name]))
+	}
+
+	return strings.Join(messages, ", ")
+}
+
+// copy returns a copy of the errors.
+func (e Errors) copy() Errors {
+	errs := make(Errors, len(e))
+	for name, message := range e {
+		errs[name] = message
+	}
+
+	return errs
+}
+
+// State returns a property which sets the aria-invalid attribute of the element
+// rendering the field with the giving name, adding the provided classes when the
+// field has an error.
+func (e Errors) State(name string, classes ...string) trees.Property {
+	if !e.Has(name) {
+		return trees.NewAttr("aria-invalid", "false")
+	}
+
+	return trees.NewAttrWith("aria-invalid", "true", func(m *trees.Markup) {
+		if len(classes) != 0 {
+			trees.NewClassList(classes...).Apply(m)
+		}
+	})
+}
+
+//==============================================================================
+
+// isEmpty returns true/false if the value is nil, empty, a zero time or false,
+// whilst numbers and other types are never empty.
+func isEmpty(value reflect.Value) bool {
+	if !value.IsValid() {
+		return true
+	}
+
+	switch value.Kind() {
+	case reflect.String, reflect.Slice, reflect.Map, reflect.Array:
+		return value.Len() == 0
+	case reflect.Ptr, reflect.Interface:
+		return value.IsNil()
+	case reflect.Bool:
+		return !value.Bool()
+	}
+
+	if date, ok := value.Interface().(time.Time); ok {
+		return date.IsZero()
+	}
+
+	return false
+}
+
+// measure returns the size of the value checked by the Min and Max rules, with
+// the unit of the size for strings and slices.
+func measure(value reflect.Value) (float64, string, bool) {
+	switch value.Kind() {
+	case reflect.String:
+		return float64(utf8.RuneCountInString(value.String())), "characters", true
+	case reflect.Slice, reflect.Map, reflect.Array:
+		return float64(value.Len()), "items", true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(value.Int()), "", true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(value.Uint()), "", true
+	case reflect.Float32, reflect.Float64:
+		return value.Float(), "", true
+	}
+
+	return 0, "", false
+}
+
+// formatFloat returns the shortest representation of the float.
+func formatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
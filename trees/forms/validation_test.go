@@ -0,0 +1,182 @@
+package forms_test
+
+import (
+	"errors"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/eventx"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/forms"
+	"github.com/influx6/faux/tests"
+)
+
+type account struct {
+	Name   string   `form:"name" validate:"required,min=3,max=10"`
+	Email  string   `form:"email,email" validate:"required,pattern=^.+@.+$"`
+	Age    int      `form:"age" validate:"min=18"`
+	Terms  bool     `form:"terms" validate:"required"`
+	Topics []string `form:"topics" validate:"max=2"`
+}
+
+func TestValidationRules(t *testing.T) {
+	if err := forms.Required()(""); err == nil {
+		tests.Failed("Should have failed required rule for empty string")
+	}
+	tests.Passed("Should have failed required rule for empty string")
+
+	if err := forms.Required()(0); err != nil {
+		tests.Failed("Should have passed required rule for zero number: %+q", err)
+	}
+	tests.Passed("Should have passed required rule for zero number")
+
+	if err := forms.Required()(false); err == nil {
+		tests.Failed("Should have failed required rule for unchecked bool")
+	}
+	tests.Passed("Should have failed required rule for unchecked bool")
+
+	if err := forms.Min(3)("ab"); err == nil || err.Error() != "must have atleast 3 characters" {
+		tests.Failed("Should have failed min rule for short string: %+q", err)
+	}
+	tests.Passed("Should have failed min rule for short string")
+
+	if err := forms.Max(10)(12); err == nil || err.Error() != "must be atmost 10" {
+		tests.Failed("Should have failed max rule for large number: %+q", err)
+	}
+	tests.Passed("Should have failed max rule for large number")
+
+	if err := forms.Pattern("^[a-z]+$")("abc"); err != nil {
+		tests.Failed("Should have passed pattern rule for matching string: %+q", err)
+	}
+	tests.Passed("Should have passed pattern rule for matching string")
+
+	if err := forms.WithMessage(forms.Required(), "enter a name")(""); err == nil || err.Error() != "enter a name" {
+		tests.Failed("Should have replaced message of failed rule: %+q", err)
+	}
+	tests.Passed("Should have replaced message of failed rule")
+
+	type broken struct {
+		Name string `validate:"minimum=3"`
+	}
+
+	if _, err := forms.New(&broken{}); err == nil {
+		tests.Failed("Should have failed to bind struct with unknown rule")
+	}
+	tests.Passed("Should have failed to bind struct with unknown rule")
+}
+
+func TestFormValidate(t *testing.T) {
+	user := account{Name: "al", Age: 20}
+
+	form, err := forms.New(&user)
+	if err != nil {
+		tests.Failed("Should have bound struct to form: %+q", err)
+	}
+	tests.Passed("Should have bound struct to form")
+
+	custom := errors.New("is reserved")
+	form.Rules("name", func(value interface{}) error {
+		if value == "admin" {
+			return custom
+		}
+		return nil
+	})
+
+	errs := form.Validate()
+	if !errs.Has("name") || !errs.Has("email") || !errs.Has("terms") || errs.Has("age") {
+		tests.Failed("Should have failed validation of invalid fields: %s", errs)
+	}
+	tests.Passed("Should have failed validation of invalid fields")
+
+	name := form.Input("name", form.Errors().State("name", "error"))
+	defer remove(name)
+
+	if html := name.HTML(); !strings.Contains(html, `aria-invalid="true"`) || !strings.Contains(html, `class="error"`) {
+		tests.Failed("Should have rendered invalid state of field: %q", html)
+	}
+	tests.Passed("Should have rendered invalid state of field")
+
+	var published int
+	form.React(func() {
+		published++
+	})
+
+	dispatch(name, &eventx.InputEvent{Value: "admin"})
+	if form.Errors()["name"] != "is reserved" || published != 1 {
+		tests.Failed("Should have evaluated custom rule on change: %s", form.Errors())
+	}
+	tests.Passed("Should have evaluated custom rule on change")
+
+	dispatch(name, &eventx.InputEvent{Value: "alex"})
+	if form.Errors().Has("name") || published != 2 {
+		tests.Failed("Should have cleared error of field on valid change: %s", form.Errors())
+	}
+	tests.Passed("Should have cleared error of field on valid change")
+
+	terms := form.Input("terms")
+	defer remove(terms)
+
+	dispatch(terms, &eventx.ChangeEvent{Checked: true})
+	if !user.Terms || form.Errors().Has("terms") || published != 3 {
+		tests.Failed("Should have validated and published checkbox change: %s", form.Errors())
+	}
+	tests.Passed("Should have validated and published checkbox change")
+
+	dispatch(terms, &eventx.ChangeEvent{Checked: false})
+	if user.Terms || !form.Errors().Has("terms") || published != 4 {
+		tests.Failed("Should have recorded error of unchecked required checkbox: %s", form.Errors())
+	}
+	tests.Passed("Should have recorded error of unchecked required checkbox")
+
+	if html := elems.Div(form.Errors().State("name", "error")).HTML(); !strings.Contains(html, `aria-invalid="false"`) || strings.Contains(html, "error") {
+		tests.Failed("Should have rendered valid state of field: %q", html)
+	}
+	tests.Passed("Should have rendered valid state of field")
+}
+
+func TestFormDecode(t *testing.T) {
+	var user account
+
+	form, err := forms.New(&user)
+	if err != nil {
+		tests.Failed("Should have bound struct to form: %+q", err)
+	}
+	tests.Passed("Should have bound struct to form")
+
+	err = form.Decode(url.Values{
+		"name":   {"alex"},
+		"email":  {"alex"},
+		"age":    {"12a"},
+		"topics": {"go", "js", "css"},
+	})
+
+	errs, ok := err.(forms.Errors)
+	if !ok {
+		tests.Failed("Should have returned validation errors from posted form: %+q", err)
+	}
+	tests.Passed("Should have returned validation errors from posted form")
+
+	if len(errs) != 4 || errs.Has("name") || errs["topics"] != "must have atmost 2 items" {
+		tests.Failed("Should have validated posted fields: %s", errs)
+	}
+	tests.Passed("Should have validated posted fields")
+
+	err = form.Decode(url.Values{
+		"name":   {"alex"},
+		"email":  {"alex@gu.io"},
+		"age":    {"21"},
+		"terms":  {"on"},
+		"topics": {"go"},
+	})
+
+	if err != nil || user.Age != 21 || !user.Terms {
+		tests.Failed("Should have decoded valid posted form: %+q", err)
+	}
+	tests.Passed("Should have decoded valid posted form")
+
+	if errs, err := forms.Validate(&user); err != nil || len(errs) != 0 {
+		tests.Failed("Should have validated struct without errors: %s", errs)
+	}
+	tests.Passed("Should have validated struct without errors")
+}
@@ -0,0 +1,251 @@
+package trees
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"math/rand"
+	"sort"
+	"strconv"
+	"sync"
+)
+
+// IDGenerator defines a interface for the generation of the uids and hashes of
+// markup.
+type IDGenerator interface {
+	// UID returns a new uid.
+	UID() string
+
+	// Hash returns the hash of the giving markup.
+	Hash(*Markup) string
+}
+
+// RandomIDs defines the default IDGenerator, which generates random uids and
+// hashes.
+var RandomIDs IDGenerator = randomIDs{}
+
+// randomIDs implements the IDGenerator interface using RandString.
+type randomIDs struct{}
+
+// UID returns a random uid.
+func (randomIDs) UID() string {
+	return RandString(8)
+}
+
+// Hash returns a random hash.
+func (randomIDs) Hash(*Markup) string {
+	return RandString(10)
+}
+
+// ids defines the IDGenerator used by NewMarkup and UpdateHash.
+var ids = struct {
+	ml  sync.Mutex
+	gen IDGenerator
+}{
+	gen: RandomIDs,
+}
+
+// GetIDGenerator returns the IDGenerator used for new markup.
+func GetIDGenerator() IDGenerator {
+	ids.ml.Lock()
+	defer ids.ml.Unlock()
+	return ids.gen
+}
+
+// SetIDGenerator sets the IDGenerator used for new markup, where a nil value
+// restores RandomIDs.
+func SetIDGenerator(gen IDGenerator) {
+	ids.ml.Lock()
+	defer ids.ml.Unlock()
+
+	if gen == nil {
+		gen = RandomIDs
+	}
+
+	ids.gen = gen
+}
+
+//==============================================================================
+
+// SeededIDs implements the IDGenerator interface, generating the same sequence
+// of uids and hashes for the same seed, which allows the server and client to
+// agree on the ids of a app.
+type SeededIDs struct {
+	ml   sync.Mutex
+	rand *rand.Rand
+}
+
+// NewSeededIDs returns a new SeededIDs for the giving seed.
+func NewSeededIDs(seed int64) *SeededIDs {
+	return &SeededIDs{
+		rand: rand.New(rand.NewSource(seed)),
+	}
+}
+
+// UID returns the next uid of the sequence.
+func (s *SeededIDs) UID() string {
+	return s.next(8)
+}
+
+// Hash returns the next hash of the sequence.
+func (s *SeededIDs) Hash(*Markup) string {
+	return s.next(10)
+}
+
+// next returns the next string of the giving length from the sequence.
+func (s *SeededIDs) next(n int) string {
+	const alphanum = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	bytes := make([]byte, n)
+	for i := range bytes {
+		bytes[i] = alphanum[s.rand.Intn(len(alphanum))]
+	}
+
+	return string(bytes)
+}
+
+//==============================================================================
+
+// ContentHashes returns a IDGenerator which generates uids using the provided
+// IDGenerator and hashes from the content of markup using Markup.ContentHash, so
+// identical subtrees produce identical hashes.
+func ContentHashes(gen IDGenerator) IDGenerator {
+	return contentHashes{IDGenerator: gen}
+}
+
+// contentHashes implements the IDGenerator interface using Markup.ContentHash.
+type contentHashes struct {
+	IDGenerator
+}
+
+// Hash returns the content hash of the markup.
+func (contentHashes) Hash(e *Markup) string {
+	return e.ContentHash()
+}
+
+// ContentHash returns a hash of the tag, text, attributes, styles and events of
+// the markup and the content hashes of its children which are not removed, so
+// markup with identical content has identical hashes. The hash is cached until
+// the markup or any of its children are changed, except for markup using a text
+// content function, whose text can change on every render, and is cleared by
+// Reconcile for the markup being reconciled.
+func (e *Markup) ContentHash() string {
+	hash, _ := e.contentHash()
+	return hash
+}
+
+// contentHash returns the content hash of the markup, and true/false if it can
+// be cached.
+func (e *Markup) contentHash() (string, bool) {
+	if e.content != "" {
+		return e.content, true
+	}
+
+	cacheable := e.textContentFn == nil
+
+	hash := sha1.New()
+
+	write := func(values ...string) {
+		for _, value := range values {
+			hash.Write([]byte(strconv.Itoa(len(value))))
+			hash.Write([]byte{':'})
+			hash.Write([]byte(value))
+		}
+	}
+
+	write(strconv.Itoa(int(e.kind)), e.tagname, e.TextContent())
+
+	for _, props := range [][]Property{e.attrs, e.styles} {
+		rendered := make([]string, 0, len(props))
+		for _, prop := range props {
+			name, value := prop.Render()
+			rendered = append(rendered, name+"="+value)
+		}
+
+		sort.Strings(rendered)
+
+		write(strconv.Itoa(len(rendered)))
+		write(rendered...)
+	}
+
+	write(strconv.Itoa(len(e.events)))
+	for _, ev := range e.events {
+		write(fmt.Sprintf("%s %s %t %t %t %t %t %t %d %d %q", ev.Type, ev.EventName(),
+			ev.PreventDefault, ev.StopPropagation, ev.StopImmediatePropagation, ev.UseCapture,
+			ev.Passive, ev.Once, ev.Debounce, ev.Throttle, ev.KeyFilter))
+	}
+
+	for _, child := range e.children {
+		if child.Removed() {
+			continue
+		}
+
+		childHash, childCacheable := child.contentHash()
+		if !childCacheable {
+			cacheable = false
+		}
+
+		write(childHash)
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))[:16]
+
+	if cacheable {
+		e.content = sum
+	}
+
+	return sum, cacheable
+}
+
+// InvalidateHash clears the cached content hash of the markup and its parents,
+// and marks them as changed since their last reconciliation. It is called by
+// all methods changing markup, but must be called when a property of the markup
+// is changed directly.
+func (e *Markup) InvalidateHash() {
+	for node := e; node != nil; node = node.parent {
+		node.content = ""
+		node.unchanged = false
+	}
+}
+
+// resetHashes clears the cached content hashes and unchanged state of the markup
+// and its children.
+func (e *Markup) resetHashes() {
+	e.content = ""
+	e.unchanged = false
+
+	for _, child := range e.children {
+		child.resetHashes()
+	}
+}
+
+//==============================================================================
+
+// AssignIDs derives the uids of the children of the root from the root's uid and
+// their position within it, and sets the hashes of the root and its children
+// from the generator, children first. This gives the same uids to markup at the
+// same position between renders, independent of how the markup was created.
+// Removed children and unchanged children which keep their position are left as
+// is.
+func AssignIDs(root *Markup, gen IDGenerator) {
+	for index, child := range root.children {
+		// removed children keep their uids for the driver to remove them.
+		if child.Removed() {
+			continue
+		}
+
+		uid := root.uid + "-" + strconv.Itoa(index)
+		if child.unchanged && child.uid == uid {
+			continue
+		}
+
+		child.uid = uid
+		child.unchanged = false
+		AssignIDs(child, gen)
+	}
+
+	root.hash = gen.Hash(root)
+}
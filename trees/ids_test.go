@@ -0,0 +1,85 @@
+package trees_test
+
+import (
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// TestSeededIDs validates the generation of the same uids for the same seed.
+func TestSeededIDs(t *testing.T) {
+	first := trees.NewSeededIDs(40)
+	second := trees.NewSeededIDs(40)
+
+	for i := 0; i < 5; i++ {
+		if first.UID() != second.UID() {
+			t.Fatalf("\t%s\t  Should have generated the same uids for the same seed", failed)
+		}
+	}
+	t.Logf("\t%s\t  Should have generated the same uids for the same seed", success)
+
+	if trees.NewSeededIDs(41).UID() == trees.NewSeededIDs(40).UID() {
+		t.Fatalf("\t%s\t  Should have generated different uids for different seeds", failed)
+	}
+	t.Logf("\t%s\t  Should have generated different uids for different seeds", success)
+
+	trees.SetIDGenerator(trees.NewSeededIDs(40))
+	firstMarkup := generateMarkup()
+
+	trees.SetIDGenerator(trees.NewSeededIDs(40))
+	secondMarkup := generateMarkup()
+
+	trees.SetIDGenerator(nil)
+
+	if firstMarkup.HTML() != secondMarkup.HTML() {
+		t.Fatalf("\t%s\t  Should have rendered the same html for the same seed", failed)
+	}
+	t.Logf("\t%s\t  Should have rendered the same html for the same seed", success)
+
+	if trees.GetIDGenerator() != trees.RandomIDs {
+		t.Fatalf("\t%s\t  Should have restored the random ids with a nil generator", failed)
+	}
+	t.Logf("\t%s\t  Should have restored the random ids with a nil generator", success)
+}
+
+// TestContentHashes validates the hashing of markup by its content and the
+// assignment of uids by position.
+func TestContentHashes(t *testing.T) {
+	gen := trees.ContentHashes(trees.RandomIDs)
+
+	first := generateMarkup()
+	second := generateMarkup()
+	first.SwapUID("root")
+	second.SwapUID("root")
+
+	trees.AssignIDs(first, gen)
+	trees.AssignIDs(second, gen)
+
+	if first.Hash() != second.Hash() {
+		t.Fatalf("\t%s\t  Should have the same hash for identical markup: %q != %q", failed, first.Hash(), second.Hash())
+	}
+	t.Logf("\t%s\t  Should have the same hash for identical markup", success)
+
+	if first.HTML() != second.HTML() {
+		t.Fatalf("\t%s\t  Should have the same html for identical markup:\n%s\n%s", failed, first.HTML(), second.HTML())
+	}
+	t.Logf("\t%s\t  Should have the same html for identical markup", success)
+
+	if child := first.Children()[0]; child.UID() != "root-0" {
+		t.Fatalf("\t%s\t  Should have derived uid of child from its position: %q", failed, child.UID())
+	}
+	t.Logf("\t%s\t  Should have derived uid of child from its position", success)
+
+	trees.NewAttr("id", "changed").Apply(second.Children()[0])
+	trees.AssignIDs(second, gen)
+
+	if first.Hash() == second.Hash() {
+		t.Fatalf("\t%s\t  Should have a different hash for changed markup", failed)
+	}
+	t.Logf("\t%s\t  Should have a different hash for changed markup", success)
+
+	if first.Children()[1].Hash() != second.Children()[1].Hash() {
+		t.Fatalf("\t%s\t  Should have the same hash for unchanged children", failed)
+	}
+	t.Logf("\t%s\t  Should have the same hash for unchanged children", success)
+}
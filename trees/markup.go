@@ -1,18 +1,40 @@
 package trees
 
 import (
+	"encoding/json"
 	"fmt"
 	"html/template"
 	"strings"
+	"sync"
 
 	"github.com/gu-io/gu/trees/css"
 	"github.com/russross/blackfriday"
 )
 
+// NodeKind defines the kind of node a markup represents.
+type NodeKind int
+
+// contains the kinds of nodes.
+const (
+	// ElementNode defines a markup for a element, eg '<div></div>'.
+	ElementNode NodeKind = iota
+
+	// TextNode defines a markup for text, whose content is written as is.
+	TextNode
+
+	// CommentNode defines a markup for a comment, eg '<!-- note -->'.
+	CommentNode
+
+	// DoctypeNode defines a markup for a doctype, eg '<!DOCTYPE html>'.
+	DoctypeNode
+)
+
 // Markup represent a concrete implementation of a element node.
 type Markup struct {
 	ID              string
+	kind            NodeKind
 	removed         bool
+	extracted       bool
 	autoclose       bool
 	allowEvents     bool
 	allowChildren   bool
@@ -21,10 +43,14 @@ type Markup struct {
 
 	uid           string
 	hash          string
+	content       string
+	unchanged     bool
 	tagname       string
+	namespace     string
 	textContent   string
 	idSelector    string
 	textContentFn func(*Markup) string
+	sheet         *Stylesheet
 
 	events   []Event
 	children []*Markup
@@ -36,11 +62,7 @@ type Markup struct {
 
 // NewText returns a new Text instance element
 func NewText(txt string, dl ...interface{}) *Markup {
-	em := NewMarkup("text", false)
-	em.allowChildren = false
-	em.allowAttributes = false
-	em.allowStyles = false
-	em.allowEvents = false
+	em := newNode("text", TextNode)
 
 	if dl != nil && len(dl) != 0 {
 		em.textContent = fmt.Sprintf(txt, dl...)
@@ -51,6 +73,32 @@ func NewText(txt string, dl ...interface{}) *Markup {
 	return em
 }
 
+// NewComment returns a new markup for a comment with the giving text.
+func NewComment(txt string) *Markup {
+	em := newNode("#comment", CommentNode)
+	em.textContent = txt
+	return em
+}
+
+// NewDoctype returns a new markup for a doctype with the giving content, eg
+// 'html'.
+func NewDoctype(doctype string) *Markup {
+	em := newNode("#doctype", DoctypeNode)
+	em.textContent = doctype
+	return em
+}
+
+// newNode returns a new markup of the giving kind which has only text content.
+func newNode(tag string, kind NodeKind) *Markup {
+	em := NewMarkup(tag, false)
+	em.kind = kind
+	em.allowChildren = false
+	em.allowAttributes = false
+	em.allowStyles = false
+	em.allowEvents = false
+	return em
+}
+
 // MarkdownTemplate returns a markup generated from a markup down string
 // which is built into a markup. If an error occured, it will be turned into
 // an error tag with the contents of the error.
@@ -71,6 +119,19 @@ func MarkdownTemplate(tml string, bind interface{}) *Markup {
 // the provided element parent and is built on the gu/css package which collects
 // necessary details from its parent to only target where it gets mounted.
 func CSSStylesheet(styles interface{}, bind interface{}, ext *css.Rule, plain bool) *Markup {
+	return cssStylesheet(cssRule(styles, ext, plain), bind)
+}
+
+// ScopedCSSStylesheet provides a function that takes style rules which returns a stylesheet
+// embeded into the provided element parent, where every selector of the stylesheet is scoped
+// to the parent and its keyframes are namespaced to it. Selectors starting with
+// `:global(...)` are left unscoped.
+func ScopedCSSStylesheet(styles interface{}, bind interface{}, ext *css.Rule, plain bool) *Markup {
+	return cssStylesheet(cssRule(styles, ext, plain).Scoped(), bind)
+}
+
+// cssRule returns the css.Rule for the provided styles.
+func cssRule(styles interface{}, ext *css.Rule, plain bool) *css.Rule {
 	var rs *css.Rule
 
 	switch so := styles.(type) {
@@ -90,39 +151,108 @@ func CSSStylesheet(styles interface{}, bind interface{}, ext *css.Rule, plain bo
 		panic("Invalid Acceptable type: Only string or *css.Rule")
 	}
 
+	return rs
+}
+
+// cssStylesheet returns the style markup rendering the stylesheet of the rule.
+func cssStylesheet(rs *css.Rule, bind interface{}) *Markup {
+	sheet := &Stylesheet{rule: rs, bind: bind}
+
 	content := NewMarkup("style", false)
 	content.allowChildren = false
 	content.allowAttributes = false
 	content.allowStyles = false
 	content.allowEvents = false
+	content.sheet = sheet
 	content.textContentFn = func(owner *Markup) string {
-		sheet, err := rs.Stylesheet(bind, owner.IDSelector(true))
+		rules, err := sheet.Rules(owner.IDSelector(true))
 		if err != nil {
 			return err.Error()
 		}
 
-		return sheet.String()
+		return strings.Join(rules, "\n")
 	}
 
 	return content
 }
 
+// Stylesheet defines the stylesheet rendered by the style markup returned by
+// CSSStylesheet, which caches the rules it renders until its owner or the
+// binding data changes. The cache is guarded, as clones of the markup share
+// the stylesheet.
+type Stylesheet struct {
+	rule  *css.Rule
+	bind  interface{}
+	ml    sync.Mutex
+	key   string
+	rules []string
+}
+
+// Rule returns the css.Rule rendered by the stylesheet.
+func (s *Stylesheet) Rule() *css.Rule {
+	return s.rule
+}
+
+// Rules returns the rules of the stylesheet rendered for the owner selector,
+// which are only recomputed if the owner or the binding data changed since the
+// last call. Binding data which can not be marshalled into json is recomputed
+// on every call.
+func (s *Stylesheet) Rules(owner string) ([]string, error) {
+	s.ml.Lock()
+	defer s.ml.Unlock()
+
+	var key string
+
+	if data, err := json.Marshal(s.bind); err == nil {
+		key = owner + "\x00" + string(data)
+
+		if s.rules != nil && s.key == key {
+			return s.rules, nil
+		}
+	}
+
+	sheet, err := s.rule.Stylesheet(s.bind, owner)
+	if err != nil {
+		return nil, err
+	}
+
+	rules := make([]string, 0, len(sheet.Rules))
+	for _, rule := range sheet.Rules {
+		rules = append(rules, rule.String())
+	}
+
+	s.key = key
+	s.rules = rules
+
+	return rules, nil
+}
+
 //==============================================================================
 
 // NewMarkup returns a new element instance giving the specified name which is
 // used as a tag name.
 func NewMarkup(tag string, autoClose bool) *Markup {
-	return &Markup{
+	gen := GetIDGenerator()
+
+	markup := &Markup{
 		allowChildren:   true,
 		allowStyles:     true,
 		allowAttributes: true,
 		allowEvents:     true,
-		uid:             RandString(8),
-		hash:            RandString(10),
+		uid:             gen.UID(),
 		autoclose:       autoClose,
 		tagname:         strings.ToLower(strings.TrimSpace(tag)),
 		attrs:           []Property{NewAttr("data-gen", "gu")},
 	}
+
+	// svg and math elements start their namespace.
+	if _, ok := namespaceURIs[markup.tagname]; ok {
+		markup.namespace = markup.tagname
+	}
+
+	markup.hash = gen.Hash(markup)
+
+	return markup
 }
 
 // Empty resets the elements children list as 0 length
@@ -131,6 +261,7 @@ func (e *Markup) Empty() {
 	e.events = nil
 	e.styles = nil
 	e.morphers = nil
+	e.InvalidateHash()
 }
 
 // MarkupJSON defines a struct which contains the giving events and
@@ -159,6 +290,21 @@ func (e *Markup) TreeJSON() MarkupJSON {
 	return mjson
 }
 
+// PatchJSON returns the giving MarkupJSON for this giving markup and the events
+// related to this markup, where unchanged markup is written out using the
+// PatchElementWriter for drivers to keep the elements they already have.
+func (e *Markup) PatchJSON() MarkupJSON {
+	var mjson MarkupJSON
+	mjson.TreeID = e.uid
+	mjson.Markup = PatchElementWriter.Print(e)
+
+	e.EachEvent(func(event *Event, _ *Markup) {
+		mjson.Events = append(mjson.Events, event.EventJSON())
+	})
+
+	return mjson
+}
+
 // IDSelector returns the unique selector for the giving markup.
 func (e *Markup) IDSelector(useParent bool) string {
 	var parentName string
@@ -197,6 +343,8 @@ func (e *Markup) UnmarshalJSON(data []byte) error {
 		e.textContent = item.textContent
 		e.textContentFn = item.textContentFn
 		e.tagname = item.tagname
+		e.kind = item.kind
+		e.namespace = item.namespace
 		e.styles = item.styles
 		e.events = item.events
 		e.allowStyles = item.allowStyles
@@ -204,6 +352,7 @@ func (e *Markup) UnmarshalJSON(data []byte) error {
 		e.allowEvents = item.allowEvents
 		e.allowChildren = item.allowChildren
 		e.ID = item.ID
+		e.InvalidateHash()
 
 		item = nil
 		parsed = nil
@@ -253,6 +402,7 @@ type Properties interface {
 // AddEvent adds an event into the event list for this element.
 func (e *Markup) AddEvent(ev Event) {
 	e.events = append(e.events, ev)
+	e.InvalidateHash()
 }
 
 // EachEvent iterates all events from this giving root down with all childrens
@@ -280,6 +430,7 @@ func (e *Markup) Styles() []Property {
 // AddStyle adds a property to the style property list.
 func (e *Markup) AddStyle(p Property) {
 	e.styles = append(e.styles, p)
+	e.InvalidateHash()
 }
 
 // Attributes return the internal attribute list of the element
@@ -290,6 +441,7 @@ func (e *Markup) Attributes() []Property {
 // AddAttribute adds a property to the attribute property list.
 func (e *Markup) AddAttribute(p Property) {
 	e.attrs = append(e.attrs, p)
+	e.InvalidateHash()
 }
 
 //==============================================================================
@@ -304,6 +456,17 @@ func (e *Markup) Name() string {
 	return e.tagname
 }
 
+// Kind returns the kind of node of the markup.
+func (e *Markup) Kind() NodeKind {
+	return e.kind
+}
+
+// Namespace returns the namespace of the element, being 'svg' or 'math' for
+// elements parsed within them and empty for html elements.
+func (e *Markup) Namespace() string {
+	return e.namespace
+}
+
 // UID returns the current uid of the Element
 func (e *Markup) UID() string {
 	return e.uid
@@ -314,6 +477,12 @@ func (e *Markup) Hash() string {
 	return e.hash
 }
 
+// Unchanged returns true/false if the markup was found unchanged from the old
+// markup it was last reconciled with, having taken over its uids and hashes.
+func (e *Markup) Unchanged() bool {
+	return e.unchanged
+}
+
 //==============================================================================
 
 // Morphers exposes a method to allow adding morphers.
@@ -383,6 +552,7 @@ func (e *Markup) Remove() {
 	if !e.Removed() {
 		e.attrs = append(e.attrs, &Attribute{Name: "NodeRemoved", Value: ""})
 		e.removed = true
+		e.InvalidateHash()
 	}
 }
 
@@ -393,6 +563,7 @@ func (e *Markup) UnRemove() {
 	}
 
 	e.removed = false
+	e.InvalidateHash()
 
 	for index, attr := range e.attrs {
 		if name, _ := attr.Render(); name != "NodeRemoved" {
@@ -404,6 +575,23 @@ func (e *Markup) UnRemove() {
 	}
 }
 
+// Stylesheet returns the Stylesheet rendered by the markup if created by
+// CSSStylesheet, else returning nil.
+func (e *Markup) Stylesheet() *Stylesheet {
+	return e.sheet
+}
+
+// Extract marks the markup as rendered elsewhere, like a stylesheet collected
+// into the head of the page, leaving it out when printed.
+func (e *Markup) Extract() {
+	e.extracted = true
+}
+
+// Extracted returns true/false if the markup is marked extracted.
+func (e *Markup) Extracted() bool {
+	return e.extracted
+}
+
 // Removed returns true/false if the Element is marked removed
 func (e *Markup) Removed() bool {
 	return !!e.removed
@@ -419,9 +607,9 @@ func (e *Markup) SwapHash(hash string) {
 	e.hash = hash
 }
 
-// UpdateHash updates the Element hash value
+// UpdateHash updates the Element hash value using the current IDGenerator.
 func (e *Markup) UpdateHash() {
-	e.hash = RandString(10)
+	e.hash = GetIDGenerator().Hash(e)
 }
 
 // Reconcile takes a old markup and reconciles its uid and its children with
@@ -436,17 +624,30 @@ func (e *Markup) UpdateHash() {
 // render and the next pass returns a Div in the position for that Anchor in the
 // new render, the old Anchor will be marked as removed and will be removed from
 // the dom and ignored by the writers.
-// When two elements position are same and their types are the same then a checkup
-// process is done using the elements attributes, this is done to determine if the
-// hash value of the new should be swapped with the old. We cant use style properties
-// here because they are the most volatile of the set and will periodically be
-// either changed and returned to normal values eg display: none to display: block
-// and vise-versa, so only attributes are used in the check process.
+// When two elements position are same and their types are the same then their
+// content hashes are compared, and if equal the new takes over the uids and
+// hashes of the old for the whole subtree, which is marked as unchanged without
+// further checks. Otherwise a checkup process is done using the elements
+// attributes and styles, this is done to determine if the hash value of the new
+// should be swapped with the old.
+// The content hashes cached within the new markup are cleared first, as its
+// attributes and classes can have been changed directly since they were cached.
 func (e *Markup) Reconcile(em *Markup) bool {
 	if e == em {
 		return false
 	}
 
+	e.resetHashes()
+	return e.reconcile(em)
+}
+
+// reconcile reconciles the markup and its children with the old markup, as
+// described by Reconcile.
+func (e *Markup) reconcile(em *Markup) bool {
+	if e == em {
+		return false
+	}
+
 	// are we reconciling the proper elements type ? if not skip (i.e different types cant reconcile eachother)]
 	// TODO: decide if we should mark the markup as removed in this case as a catchall system
 	if e.Name() != em.Name() {
@@ -455,6 +656,12 @@ func (e *Markup) Reconcile(em *Markup) bool {
 
 	em.Clean()
 
+	// if the content of both are the same, then nothing below has changed.
+	if e.ContentHash() == em.ContentHash() {
+		e.adopt(em)
+		return false
+	}
+
 	//since the tagname are the same, swap uids
 	// olduid := em.UID()
 	e.SwapUID(em.UID())
@@ -465,7 +672,7 @@ func (e *Markup) Reconcile(em *Markup) bool {
 	oldHash := em.Hash()
 
 	// if we have a special case for text element then we do things differently
-	if e.Name() == "text" {
+	if e.kind != ElementNode {
 		if e.TextContent() == em.TextContent() {
 			e.SwapHash(oldHash)
 			return false
@@ -478,24 +685,10 @@ func (e *Markup) Reconcile(em *Markup) bool {
 	oldChildren := em.Children()
 
 	maxSize := len(newChildren)
-	oldMaxSize := len(oldChildren)
 
 	equalAttr := EqualAttributes(e, em)
 	equalStyle := EqualStyles(e, em)
-
-	// if the element had no children too, swap hash.
-	if maxSize == 0 {
-		if oldMaxSize > 0 {
-			return true
-		}
-
-		if !equalAttr && !equalStyle {
-			e.SwapHash(oldHash)
-			return false
-		}
-
-		return true
-	}
+	equalText := e.TextContent() == em.TextContent()
 
 	var childChanged bool
 
@@ -511,7 +704,7 @@ func (e *Markup) Reconcile(em *Markup) bool {
 				continue
 			}
 
-			if nch.Reconcile(och) {
+			if nch.reconcile(och) {
 				childChanged = true
 			}
 
@@ -523,7 +716,7 @@ func (e *Markup) Reconcile(em *Markup) bool {
 		childChanged = true
 	}
 
-	if !childChanged && equalAttr && equalStyle {
+	if !childChanged && equalAttr && equalStyle && equalText {
 		e.SwapHash(oldHash)
 		return false
 	}
@@ -531,6 +724,32 @@ func (e *Markup) Reconcile(em *Markup) bool {
 	return true
 }
 
+// adopt swaps the uids and hashes of the markup and its children with those of
+// the old markup of the same content, marking it as unchanged.
+func (e *Markup) adopt(em *Markup) {
+	e.uid = em.uid
+	e.hash = em.hash
+
+	var oldChildren []*Markup
+	for _, child := range em.children {
+		if !child.Removed() {
+			oldChildren = append(oldChildren, child)
+		}
+	}
+
+	var index int
+	for _, child := range e.children {
+		if child.Removed() || index >= len(oldChildren) {
+			continue
+		}
+
+		child.adopt(oldChildren[index])
+		index++
+	}
+
+	e.unchanged = true
+}
+
 // FirstChild returns the first child in the markup children list.
 func (e *Markup) FirstChild() *Markup {
 	return e.NthChild(0)
@@ -565,7 +784,13 @@ func (e *Markup) AddChild(child ...*Markup) {
 
 		ch.parent = e
 		e.children = append(e.children, ch)
+
+		if !e.foreignContent() {
+			ch.adoptNamespace(e.namespace)
+		}
 	}
+
+	e.InvalidateHash()
 }
 
 // EachChild iterates all children from this giving root down with all childrens
@@ -583,6 +808,12 @@ func (e *Markup) Children() []*Markup {
 	return e.children
 }
 
+// Parent returns the parent of the element, which is nil if not a child of
+// any element.
+func (e *Markup) Parent() *Markup {
+	return e.parent
+}
+
 //==============================================================================
 
 // Appliable define the interface specification for applying changes to elements elements in tree
@@ -750,10 +981,14 @@ func (e *Markup) CopyTo(co *Markup) {
 // Clone makes a new copy of the markup structure
 func (e *Markup) Clone() *Markup {
 	co := NewMarkup(e.Name(), e.AutoClosed())
+	co.kind = e.kind
+	co.tagname = e.tagname
+	co.namespace = e.namespace
 
 	//copy over the textContent
 	co.textContent = e.textContent
 	co.textContentFn = e.textContentFn
+	co.sheet = e.sheet
 	co.ID = e.ID
 	co.hash = e.hash
 	co.uid = e.uid
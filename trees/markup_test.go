@@ -0,0 +1,208 @@
+package trees_test
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+)
+
+// TestContentHash validates the content hash of markup being cached and
+// invalidated when the markup or its children change.
+func TestContentHash(t *testing.T) {
+	first := generateMarkup()
+	second := generateMarkup()
+
+	if first.ContentHash() != second.ContentHash() {
+		t.Fatalf("\t%s\t  Should have the same content hash for identical markup", failed)
+	}
+	t.Logf("\t%s\t  Should have the same content hash for identical markup", success)
+
+	hash := first.ContentHash()
+	label := trees.Query.Query(first, "label")
+
+	trees.ReplaceORAddStyle(label, "width", "300px")
+
+	if first.ContentHash() == hash {
+		t.Fatalf("\t%s\t  Should have invalidated content hash of parents on changed style", failed)
+	}
+	t.Logf("\t%s\t  Should have invalidated content hash of parents on changed style", success)
+
+	trees.ReplaceORAddStyle(label, "width", "200px")
+
+	if first.ContentHash() != hash {
+		t.Fatalf("\t%s\t  Should have restored content hash on restored style", failed)
+	}
+	t.Logf("\t%s\t  Should have restored content hash on restored style", success)
+
+	trees.NewMarkup("span", false).Apply(label)
+
+	if first.ContentHash() == hash {
+		t.Fatalf("\t%s\t  Should have invalidated content hash of parents on added child", failed)
+	}
+	t.Logf("\t%s\t  Should have invalidated content hash of parents on added child", success)
+
+	label.Children()[0].Remove()
+
+	if first.ContentHash() != hash {
+		t.Fatalf("\t%s\t  Should have ignored removed children in content hash", failed)
+	}
+	t.Logf("\t%s\t  Should have ignored removed children in content hash", success)
+}
+
+// TestReconcileUnchanged validates the reconciliation of unchanged subtrees
+// and their patch output.
+func TestReconcileUnchanged(t *testing.T) {
+	old := generateMarkup()
+	newer := generateMarkup()
+
+	if newer.Reconcile(old) {
+		t.Fatalf("\t%s\t  Should have reconciled identical markup as unchanged", failed)
+	}
+	t.Logf("\t%s\t  Should have reconciled identical markup as unchanged", success)
+
+	if !newer.Unchanged() || newer.Hash() != old.Hash() || newer.UID() != old.UID() {
+		t.Fatalf("\t%s\t  Should have taken over uid and hash of unchanged markup", failed)
+	}
+	t.Logf("\t%s\t  Should have taken over uid and hash of unchanged markup", success)
+
+	if newer.HTML() != old.HTML() {
+		t.Fatalf("\t%s\t  Should have taken over uids and hashes of children:\n%s\n%s", failed, newer.HTML(), old.HTML())
+	}
+	t.Logf("\t%s\t  Should have taken over uids and hashes of children", success)
+
+	old = generateMarkup()
+	newer = generateMarkup()
+	trees.NewAttr("checked", "checked").Apply(trees.Query.Query(newer, "label"))
+
+	if !newer.Reconcile(old) {
+		t.Fatalf("\t%s\t  Should have reconciled changed markup as changed", failed)
+	}
+	t.Logf("\t%s\t  Should have reconciled changed markup as changed", success)
+
+	unchanged := newer.Children()[0]
+	label := trees.Query.Query(newer, "label")
+
+	if !unchanged.Unchanged() || label.Unchanged() || newer.Unchanged() {
+		t.Fatalf("\t%s\t  Should have marked only unchanged subtrees as unchanged", failed)
+	}
+	t.Logf("\t%s\t  Should have marked only unchanged subtrees as unchanged", success)
+
+	patch := newer.PatchJSON().Markup
+	if !strings.Contains(patch, `uid="`+unchanged.UID()+`" NodeUnchanged=""></div>`) || strings.Contains(patch, "<section") {
+		t.Fatalf("\t%s\t  Should have written unchanged markup without content: %s", failed, patch)
+	}
+	t.Logf("\t%s\t  Should have written unchanged markup without content", success)
+
+	if !strings.Contains(patch, `checked="checked"`) {
+		t.Fatalf("\t%s\t  Should have written changed markup with content: %s", failed, patch)
+	}
+	t.Logf("\t%s\t  Should have written changed markup with content", success)
+
+	if strings.Contains(newer.HTML(), "NodeUnchanged") {
+		t.Fatalf("\t%s\t  Should have written unchanged markup with content in html", failed)
+	}
+	t.Logf("\t%s\t  Should have written unchanged markup with content in html", success)
+}
+
+// TestReconcileDirectChanges validates that attributes and classes changed
+// directly after the content hash was cached are not reconciled as unchanged.
+func TestReconcileDirectChanges(t *testing.T) {
+	old := generateMarkup()
+	newer := generateMarkup()
+	newer.ContentHash()
+
+	attr, _ := trees.GetAttr(newer.Children()[1], "id")
+	attr.(*trees.Attribute).Value = "root-div-3"
+
+	if !newer.Reconcile(old) {
+		t.Fatalf("\t%s\t  Should have reconciled directly changed attribute as changed", failed)
+	}
+	t.Logf("\t%s\t  Should have reconciled directly changed attribute as changed", success)
+
+	if patch := newer.PatchJSON().Markup; !strings.Contains(patch, `id="root-div-3"`) {
+		t.Fatalf("\t%s\t  Should have written directly changed attribute in patch: %s", failed, patch)
+	}
+	t.Logf("\t%s\t  Should have written directly changed attribute in patch", success)
+
+	classes := trees.NewClassList("roots")
+	older := trees.NewMarkup("div", false)
+	trees.NewClassList("roots").Apply(older)
+
+	current := trees.NewMarkup("div", false)
+	classes.Apply(current)
+	current.ContentHash()
+	classes.Add("active")
+
+	current.Reconcile(older)
+	if patch := current.PatchJSON().Markup; strings.Contains(patch, "NodeUnchanged") || !strings.Contains(patch, `class="roots active"`) {
+		t.Fatalf("\t%s\t  Should have written directly added class in patch: %s", failed, patch)
+	}
+	t.Logf("\t%s\t  Should have written directly added class in patch", success)
+}
+
+// TestStylesheetRules validates the caching of the rules of stylesheets until
+// their binding data changes.
+func TestStylesheetRules(t *testing.T) {
+	bind := &struct{ Color string }{Color: "red"}
+
+	style := trees.CSSStylesheet(`& { color: {{ .Color }}; }`, bind, nil, false)
+	sheet := style.Stylesheet()
+
+	if sheet == nil {
+		t.Fatalf("\t%s\t  Should have returned the stylesheet of the style markup", failed)
+	}
+	t.Logf("\t%s\t  Should have returned the stylesheet of the style markup", success)
+
+	first, err := sheet.Rules("#owner")
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have rendered the rules of the stylesheet: %s", failed, err)
+	}
+	t.Logf("\t%s\t  Should have rendered the rules of the stylesheet", success)
+
+	second, _ := sheet.Rules("#owner")
+	if &first[0] != &second[0] {
+		t.Fatalf("\t%s\t  Should have returned the cached rules for unchanged binding", failed)
+	}
+	t.Logf("\t%s\t  Should have returned the cached rules for unchanged binding", success)
+
+	bind.Color = "blue"
+
+	third, _ := sheet.Rules("#owner")
+	if !strings.Contains(third[0], "color: blue") {
+		t.Fatalf("\t%s\t  Should have recomputed the rules for changed binding: %q", failed, third)
+	}
+	t.Logf("\t%s\t  Should have recomputed the rules for changed binding", success)
+
+	style.Extract()
+
+	if style.HTML() != "" {
+		t.Fatalf("\t%s\t  Should have left out extracted markup when printed: %q", failed, style.HTML())
+	}
+	t.Logf("\t%s\t  Should have left out extracted markup when printed", success)
+}
+
+// TestStylesheetConcurrentRules validates the rules of a stylesheet shared by
+// clones of its markup being rendered concurrently for different owners.
+func TestStylesheetConcurrentRules(t *testing.T) {
+	style := trees.CSSStylesheet(`& { color: red; }`, nil, nil, false)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func(owner string) {
+			defer wg.Done()
+
+			rules, err := style.Clone().Stylesheet().Rules(owner)
+			if err != nil || len(rules) == 0 || !strings.Contains(rules[0], owner) {
+				t.Errorf("\t%s\t  Should have rendered the rules of the shared stylesheet for %q: %q %v", failed, owner, rules, err)
+			}
+		}(fmt.Sprintf("#owner-%d", i))
+	}
+
+	wg.Wait()
+	t.Logf("\t%s\t  Should have rendered the rules of the shared stylesheet for each owner", success)
+}
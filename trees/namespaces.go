@@ -0,0 +1,134 @@
+package trees
+
+import "strings"
+
+// contains the namespaces of the foreign elements within html.
+const (
+	SVGNamespace  = "svg"
+	MathNamespace = "math"
+)
+
+// namespaceURIs contains the uris declared by the xmlns attribute of the
+// outermost element of a namespace.
+var namespaceURIs = map[string]string{
+	SVGNamespace:  "http://www.w3.org/2000/svg",
+	MathNamespace: "http://www.w3.org/1998/Math/MathML",
+}
+
+// svgTagNames contains the names of svg elements which are not lowercase, by
+// their lowercase name.
+var svgTagNames = caseNames(
+	"altGlyph", "altGlyphDef", "altGlyphItem", "animateColor", "animateMotion",
+	"animateTransform", "clipPath", "feBlend", "feColorMatrix",
+	"feComponentTransfer", "feComposite", "feConvolveMatrix", "feDiffuseLighting",
+	"feDisplacementMap", "feDistantLight", "feDropShadow", "feFlood", "feFuncA",
+	"feFuncB", "feFuncG", "feFuncR", "feGaussianBlur", "feImage", "feMerge",
+	"feMergeNode", "feMorphology", "feOffset", "fePointLight",
+	"feSpecularLighting", "feSpotLight", "feTile", "feTurbulence",
+	"foreignObject", "glyphRef", "linearGradient", "radialGradient", "textPath",
+)
+
+// svgAttrNames contains the names of svg attributes which are not lowercase, by
+// their lowercase name.
+var svgAttrNames = caseNames(
+	"attributeName", "attributeType", "baseFrequency", "baseProfile", "calcMode",
+	"clipPathUnits", "diffuseConstant", "edgeMode", "filterUnits", "glyphRef",
+	"gradientTransform", "gradientUnits", "kernelMatrix", "kernelUnitLength",
+	"keyPoints", "keySplines", "keyTimes", "lengthAdjust", "limitingConeAngle",
+	"markerHeight", "markerUnits", "markerWidth", "maskContentUnits", "maskUnits",
+	"numOctaves", "pathLength", "patternContentUnits", "patternTransform",
+	"patternUnits", "pointsAtX", "pointsAtY", "pointsAtZ", "preserveAlpha",
+	"preserveAspectRatio", "primitiveUnits", "refX", "refY", "repeatCount",
+	"repeatDur", "requiredExtensions", "requiredFeatures", "specularConstant",
+	"specularExponent", "spreadMethod", "startOffset", "stdDeviation",
+	"stitchTiles", "surfaceScale", "systemLanguage", "tableValues", "targetX",
+	"targetY", "textLength", "viewBox", "viewTarget", "xChannelSelector",
+	"yChannelSelector", "zoomAndPan",
+)
+
+// caseNames returns the names by their lowercase name.
+func caseNames(names ...string) map[string]string {
+	cased := make(map[string]string, len(names))
+	for _, name := range names {
+		cased[strings.ToLower(name)] = name
+	}
+
+	return cased
+}
+
+// NewMarkupNS returns a new element markup of the tag within the namespace,
+// whose name keeps its case, eg 'linearGradient' within the svg namespace.
+// Elements added into it take on its namespace, except within a svg
+// 'foreignObject'.
+func NewMarkupNS(namespace string, tag string, autoClose bool) *Markup {
+	markup := NewMarkup(tag, autoClose)
+	markup.tagname = strings.TrimSpace(tag)
+	markup.namespace = namespace
+	markup.hash = GetIDGenerator().Hash(markup)
+
+	return markup
+}
+
+// adoptNamespace sets the namespace on the element and its children which have
+// none, restoring the case of the names of svg elements and attributes created
+// lowercase, eg 'viewBox'.
+func (e *Markup) adoptNamespace(namespace string) {
+	if e.kind != ElementNode || e.namespace != "" {
+		return
+	}
+
+	e.namespace = namespace
+	e.content = ""
+
+	if namespace == SVGNamespace {
+		e.tagname = svgName(svgTagNames, e.tagname)
+
+		for _, prop := range e.attrs {
+			if attr, ok := prop.(*Attribute); ok {
+				attr.Name = svgName(svgAttrNames, attr.Name)
+			}
+		}
+	}
+
+	if e.foreignContent() {
+		return
+	}
+
+	for _, child := range e.children {
+		child.adoptNamespace(namespace)
+	}
+}
+
+// foreignContent returns true if the children of the element are html elements
+// instead of elements within its namespace.
+func (e *Markup) foreignContent() bool {
+	return e.namespace == "" || (e.namespace == SVGNamespace && e.tagname == "foreignObject")
+}
+
+// svgName returns the cased name of the lowercase name if it has one.
+func svgName(names map[string]string, name string) string {
+	if cased, ok := names[name]; ok {
+		return cased
+	}
+
+	return name
+}
+
+// namespaceAttr returns the xmlns attribute declaring the namespace of the
+// element if it is the outermost element of its namespace and has none.
+func namespaceAttr(e *Markup) (*Attribute, bool) {
+	uri, ok := namespaceURIs[e.namespace]
+	if !ok {
+		return nil, false
+	}
+
+	if parent := e.Parent(); parent != nil && parent.namespace == e.namespace {
+		return nil, false
+	}
+
+	if _, err := GetAttr(e, "xmlns"); err == nil {
+		return nil, false
+	}
+
+	return &Attribute{Name: "xmlns", Value: uri}, true
+}
@@ -3,6 +3,7 @@ package trees
 import (
 	"fmt"
 	"io"
+	"strconv"
 	"strings"
 	"sync"
 
@@ -10,6 +11,7 @@ import (
 	"text/template"
 
 	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
 )
 
 // ParseTemplateInto parses the provided string has a template which
@@ -40,8 +42,8 @@ func ParseTemplate(markup string, binding interface{}) []*Markup {
 
 // ParseFirstOrMakeRoot attempts to parse the giving markup and returns the
 // element if only one else creates a div and adds all children as part of div.
-func ParseFirstOrMakeRoot(markup string) *Markup {
-	trees := ParseTree(markup)
+func ParseFirstOrMakeRoot(markup string, options ...ParseOption) *Markup {
+	trees := ParseTree(markup, options...)
 	if len(trees) == 1 {
 		return trees[0]
 	}
@@ -53,8 +55,8 @@ func ParseFirstOrMakeRoot(markup string) *Markup {
 
 // ParseToRoot passes the markup generated from the markup added to the provided
 // root.
-func ParseToRoot(root *Markup, markup string) {
-	trees := ParseTree(markup)
+func ParseToRoot(root *Markup, markup string, options ...ParseOption) {
+	trees := ParseTree(markup, options...)
 	for _, child := range trees {
 		child.Apply(root)
 	}
@@ -76,11 +78,9 @@ func ParseAndFirst(markup string) *Markup {
 func ParseAsRoot(root string, markup string) *Markup {
 	tokens := html.NewTokenizer(strings.NewReader(markup))
 
-	var sel *Selector
+	sel := &Selector{Tag: root}
 	if sels := Query.ParseSelector(root); sels != nil {
 		sel = sels[0]
-	} else {
-		sel.Tag = root
 	}
 
 	rootElem := NewMarkup(sel.Tag, false)
@@ -117,7 +117,18 @@ func (c *cn) Next() int {
 // ParseTree takes a string markup and returns a *Markup which
 // contains the full structure transpiled
 // into the gutrees markup block structure.
-func ParseTree(markup string) []*Markup {
+// By default text is trimmed of surrounding whitespace and elements are closed
+// by their end tags, where the Faithful option parses the markup as browsers do.
+func ParseTree(markup string, options ...ParseOption) []*Markup {
+	var config parseConfig
+	for _, option := range options {
+		option(&config)
+	}
+
+	if config.faithful {
+		return parseFaithful(markup, config)
+	}
+
 	tokens := html.NewTokenizer(strings.NewReader(markup))
 
 	rootElem := NewMarkup("div", false)
@@ -126,6 +137,324 @@ func ParseTree(markup string) []*Markup {
 	return rootElem.Children()
 }
 
+// ParseOption defines a function which sets a option for ParseTree.
+type ParseOption func(*parseConfig)
+
+// parseConfig defines the options of ParseTree.
+type parseConfig struct {
+	faithful bool
+	context  string
+}
+
+// Faithful sets ParseTree to parse the markup using the html5 tree construction
+// algorithm of golang.org/x/net/html, as browsers do. Whitespace is preserved,
+// comments and doctypes become CommentNode and DoctypeNode markup, implied end
+// tags are closed, elements of svg and math keep their namespace and the case
+// of their names, the content of templates is parsed within the element its
+// first tag needs, eg table rows, and uid and hash attributes written by the
+// printer are restored. Markup starting with a doctype or html tag is parsed as
+// a document, else as a fragment within the element set by ParseContext.
+func Faithful() ParseOption {
+	return func(config *parseConfig) {
+		config.faithful = true
+	}
+}
+
+// ParseContext sets the tag of the element within which a fragment is parsed
+// by the Faithful option, which defaults to 'body'. Fragments such as '<tr>'
+// or '<li>' need their parent as context, eg 'tbody' or 'ul'.
+func ParseContext(tag string) ParseOption {
+	return func(config *parseConfig) {
+		config.context = strings.ToLower(tag)
+	}
+}
+
+// rawTextElements contains the elements whose text is not escaped.
+var rawTextElements = map[string]bool{
+	"iframe":    true,
+	"noembed":   true,
+	"noframes":  true,
+	"noscript":  true,
+	"plaintext": true,
+	"script":    true,
+	"style":     true,
+	"xmp":       true,
+}
+
+// voidElements contains the elements which have no end tag.
+var voidElements = map[string]bool{
+	"area":   true,
+	"base":   true,
+	"br":     true,
+	"col":    true,
+	"embed":  true,
+	"hr":     true,
+	"img":    true,
+	"input":  true,
+	"keygen": true,
+	"link":   true,
+	"meta":   true,
+	"param":  true,
+	"source": true,
+	"track":  true,
+	"wbr":    true,
+}
+
+// templatePlaceholder prefixes the comments left by extractTemplates within the
+// template elements whose content it took.
+const templatePlaceholder = "gu-template:"
+
+// templateContexts contains the elements within which the content of a template
+// is parsed for the first tag of the content, as the 'in template' insertion
+// mode of browsers does. Content starting with other tags is parsed in 'body'.
+var templateContexts = map[string]string{
+	"caption":  "table",
+	"colgroup": "table",
+	"tbody":    "table",
+	"tfoot":    "table",
+	"thead":    "table",
+	"col":      "colgroup",
+	"tr":       "tbody",
+	"td":       "tr",
+	"th":       "tr",
+}
+
+// parseFaithful returns the markup of the nodes parsed by golang.org/x/net/html.
+// As it parses templates as ordinary elements, dropping content such as table
+// rows, the content of templates is taken out and parsed on its own.
+func parseFaithful(markup string, config parseConfig) []*Markup {
+	var nodes []*html.Node
+
+	markup, contents := extractTemplates(markup)
+
+	if start := strings.ToLower(strings.TrimSpace(markup)); strings.HasPrefix(start, "<!doctype") || strings.HasPrefix(start, "<html") {
+		document, err := html.Parse(strings.NewReader(markup))
+		if err != nil {
+			return nil
+		}
+
+		for node := document.FirstChild; node != nil; node = node.NextSibling {
+			nodes = append(nodes, node)
+		}
+	} else {
+		context := config.context
+		if context == "" {
+			context = "body"
+		}
+
+		parsed, err := html.ParseFragment(strings.NewReader(markup), &html.Node{
+			Type:     html.ElementNode,
+			Data:     context,
+			DataAtom: atom.Lookup([]byte(context)),
+		})
+		if err != nil {
+			return nil
+		}
+
+		nodes = parsed
+	}
+
+	var trees []*Markup
+
+	for _, node := range nodes {
+		if item := convertNode(node, contents); item != nil {
+			trees = append(trees, item)
+		}
+	}
+
+	return trees
+}
+
+// extractTemplates returns the markup with the content of its template elements
+// replaced by placeholder comments, along with the content replaced. Templates
+// nested within a template stay in its content.
+func extractTemplates(markup string) (string, []string) {
+	var contents []string
+	var out bytes.Buffer
+	var offset, start, depth int
+
+	tokens := html.NewTokenizer(strings.NewReader(markup))
+
+	for {
+		token := tokens.Next()
+		if token == html.ErrorToken {
+			break
+		}
+
+		size := len(tokens.Raw())
+
+		switch token {
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if name, _ := tokens.TagName(); string(name) == "template" {
+				depth++
+
+				if depth == 1 {
+					out.WriteString(markup[start : offset+size])
+					start = offset + size
+				}
+			}
+
+		case html.EndTagToken:
+			if name, _ := tokens.TagName(); string(name) == "template" && depth > 0 {
+				depth--
+
+				if depth == 0 {
+					contents = append(contents, markup[start:offset])
+					fmt.Fprintf(&out, "<!--%s%d-->", templatePlaceholder, len(contents)-1)
+					start = offset
+				}
+			}
+		}
+
+		offset += size
+	}
+
+	if contents == nil && depth == 0 {
+		return markup, nil
+	}
+
+	// a template left open holds the rest of the markup.
+	if depth > 0 {
+		contents = append(contents, markup[start:])
+		fmt.Fprintf(&out, "<!--%s%d-->", templatePlaceholder, len(contents)-1)
+		start = len(markup)
+	}
+
+	out.WriteString(markup[start:])
+	return out.String(), contents
+}
+
+// templateContent returns the content taken by extractTemplates from the
+// template node, if it holds the placeholder of it.
+func templateContent(node *html.Node, contents []string) (string, bool) {
+	placeholder := node.FirstChild
+	if placeholder == nil || placeholder.Type != html.CommentNode || !strings.HasPrefix(placeholder.Data, templatePlaceholder) {
+		return "", false
+	}
+
+	index, err := strconv.Atoi(strings.TrimPrefix(placeholder.Data, templatePlaceholder))
+	if err != nil || index < 0 || index >= len(contents) {
+		return "", false
+	}
+
+	return contents[index], true
+}
+
+// templateContext returns the element within which the content of a template is
+// parsed, using the first tag of the content.
+func templateContext(content string) string {
+	tokens := html.NewTokenizer(strings.NewReader(content))
+
+	for {
+		switch tokens.Next() {
+		case html.ErrorToken:
+			return "body"
+
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := tokens.TagName()
+			if context, ok := templateContexts[string(name)]; ok {
+				return context
+			}
+
+			return "body"
+		}
+	}
+}
+
+// convertNode returns the markup for the giving node and its children, where
+// contents holds the content of the templates taken by extractTemplates.
+func convertNode(node *html.Node, contents []string) *Markup {
+	switch node.Type {
+	case html.TextNode:
+		if node.Parent != nil && node.Parent.Namespace == "" && rawTextElements[node.Parent.Data] {
+			return NewText("%s", node.Data)
+		}
+
+		return NewText("%s", html.EscapeString(node.Data))
+
+	case html.CommentNode:
+		return NewComment(node.Data)
+
+	case html.DoctypeNode:
+		doctype := node.Data
+
+		var public, system string
+		for _, attr := range node.Attr {
+			switch attr.Key {
+			case "public":
+				public = attr.Val
+			case "system":
+				system = attr.Val
+			}
+		}
+
+		switch {
+		case public != "":
+			doctype += fmt.Sprintf(" PUBLIC %q", public)
+			if system != "" {
+				doctype += fmt.Sprintf(" %q", system)
+			}
+		case system != "":
+			doctype += fmt.Sprintf(" SYSTEM %q", system)
+		}
+
+		return NewDoctype(doctype)
+
+	case html.ElementNode:
+		elem := NewMarkup(node.Data, node.Namespace == "" && voidElements[node.Data])
+
+		// keep the case of names within svg and math, eg 'foreignObject'.
+		elem.tagname = node.Data
+		elem.namespace = node.Namespace
+
+		for _, attr := range node.Attr {
+			name := attr.Key
+			if attr.Namespace != "" {
+				name = attr.Namespace + ":" + attr.Key
+			}
+
+			switch name {
+			case "data-gen":
+				continue
+			case "uid":
+				elem.uid = attr.Val
+				continue
+			case "hash":
+				elem.hash = attr.Val
+				continue
+			case "style":
+				for _, style := range ParseStyle(attr.Val) {
+					style.Apply(elem)
+				}
+
+				continue
+			}
+
+			(&Attribute{Name: name, Value: attr.Val}).Apply(elem)
+		}
+
+		if node.Data == "template" && node.Namespace == "" {
+			if content, ok := templateContent(node, contents); ok {
+				for _, item := range parseFaithful(content, parseConfig{faithful: true, context: templateContext(content)}) {
+					item.Apply(elem)
+				}
+
+				return elem
+			}
+		}
+
+		for child := node.FirstChild; child != nil; child = child.NextSibling {
+			if item := convertNode(child, contents); item != nil {
+				item.Apply(elem)
+			}
+		}
+
+		return elem
+	}
+
+	return nil
+}
+
 func pullNode(tokens *html.Tokenizer, root *Markup) {
 	for {
 		token := tokens.Next()
@@ -158,7 +487,7 @@ func pullNode(tokens *html.Tokenizer, root *Markup) {
 
 			// fmt.Printf("Token: %#v -> %+q -> %q -> %t\n", token, token, tagName, token == html.SelfClosingTagToken)
 
-			if token == html.EndTagToken && string(tagName) == root.tagname {
+			if token == html.EndTagToken && strings.EqualFold(string(tagName), root.tagname) {
 				return
 			}
 
@@ -171,7 +500,14 @@ func pullNode(tokens *html.Tokenizer, root *Markup) {
 					key, val, more := tokens.TagAttr()
 
 					if string(key) != "" {
-						NewAttr(string(key), string(val)).Apply(node)
+						attr := NewAttr(string(key), string(val))
+
+						// keep the case of svg attributes, eg 'viewBox'.
+						if node.namespace == SVGNamespace {
+							attr.Name = svgName(svgAttrNames, attr.Name)
+						}
+
+						attr.Apply(node)
 					}
 
 					if !more {
@@ -288,3 +624,51 @@ func writeNode(w io.Writer, node html.Token, parent string, elementName string)
 func writeText(w io.Writer, text string, vals ...interface{}) {
 	fmt.Fprintf(w, text+"\n", vals...)
 }
+
+// ParseStyle returns the styles declared by the value of a style attribute, eg
+// 'color: red; width: 20px'.
+func ParseStyle(value string) []Property {
+	var styles []Property
+
+	for _, rule := range splitDeclarations(value) {
+		parts := strings.SplitN(rule, ":", 2)
+		if len(parts) != 2 || strings.TrimSpace(parts[0]) == "" {
+			continue
+		}
+
+		styles = append(styles, NewCSSStyle(strings.TrimSpace(parts[0]), strings.TrimSpace(parts[1])))
+	}
+
+	return styles
+}
+
+// splitDeclarations splits the css declarations of a style attribute on the
+// ';' which are not within quotes or brackets, eg url('data:image/png;...').
+func splitDeclarations(text string) []string {
+	var parts []string
+
+	var depth, start int
+	var quote byte
+
+	for index := 0; index < len(text); index++ {
+		switch char := text[index]; {
+		case quote != 0:
+			if char == '\\' {
+				index++
+			} else if char == quote {
+				quote = 0
+			}
+		case char == '"' || char == '\'':
+			quote = char
+		case char == '(' || char == '[':
+			depth++
+		case char == ')' || char == ']':
+			depth--
+		case char == ';' && depth == 0:
+			parts = append(parts, text[start:index])
+			start = index + 1
+		}
+	}
+
+	return append(parts, text[start:])
+}
@@ -2,10 +2,13 @@ package trees_test
 
 import (
 	"bytes"
-	"strings"
 	"testing"
 
+	"github.com/gu-io/gu/guttest"
 	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/elems/svg"
+	"github.com/gu-io/gu/trees/property/svgattr"
 )
 
 var success = "\u2713"
@@ -43,12 +46,8 @@ func TestViewMarkup(t *testing.T) {
 	}
 	t.Logf("\t%s\t Should have parsed html markup properly", success)
 
-	var html []string
-	for _, res := range result {
-		html = append(html, res.HTML())
-	}
-
-	t.Logf("\t%s\t Parser should have produced markup for html: %q", success, strings.Join(html, ""))
+	guttest.Snapshot(t, "view_markup", result)
+	t.Logf("\t%s\t Parser should have produced markup for html", success)
 }
 
 func TestParserToText(t *testing.T) {
@@ -99,10 +98,247 @@ func TestParser(t *testing.T) {
 		</html>
   `)
 
-	var html []string
-	for _, res := range result {
-		html = append(html, res.HTML())
+	guttest.Snapshot(t, "parser", result)
+	t.Logf("\t%s\t Parser should have produced markup for html", success)
+}
+
+func TestFaithfulParser(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	markup := "<pre>  indented\n    code &lt;b&gt;</pre><p>Hello, <b>gu</b> world<p>second<!-- note --><ul><li>one<li>two</ul>"
+
+	items := trees.ParseTree(markup, trees.Faithful())
+	if len(items) != 4 {
+		t.Fatalf("\t%s\t  Should have parsed 4 root elements with implied end tags: %d", failed, len(items))
+	}
+	t.Logf("\t%s\t  Should have parsed 4 root elements with implied end tags", success)
+
+	if text := items[0].Children()[0].TextContent(); text != "  indented\n    code &lt;b&gt;" {
+		t.Fatalf("\t%s\t  Should have preserved whitespace and escaping of pre: %q", failed, text)
+	}
+	t.Logf("\t%s\t  Should have preserved whitespace and escaping of pre", success)
+
+	if html := items[1].HTML(); html != `<p data-gen="gu" style="">Hello, <b data-gen="gu" style="">gu</b> world</p>` {
+		t.Fatalf("\t%s\t  Should have preserved spacing of inline text: %s", failed, html)
+	}
+	t.Logf("\t%s\t  Should have preserved spacing of inline text", success)
+
+	if comment := items[2].Children()[1]; comment.Kind() != trees.CommentNode || comment.HTML() != "<!-- note -->" {
+		t.Fatalf("\t%s\t  Should have parsed comment as comment node: %s", failed, comment.HTML())
+	}
+	t.Logf("\t%s\t  Should have parsed comment as comment node", success)
+
+	if items := trees.Query.QueryAll(items[3], "li"); len(items) != 2 {
+		t.Fatalf("\t%s\t  Should have closed list items without end tags: %d", failed, len(items))
+	}
+	t.Logf("\t%s\t  Should have closed list items without end tags", success)
+
+	var printed bytes.Buffer
+	for _, item := range items {
+		printed.WriteString(item.HTML())
+	}
+
+	var reprinted bytes.Buffer
+	for _, item := range trees.ParseTree(printed.String(), trees.Faithful()) {
+		reprinted.WriteString(item.HTML())
+	}
+
+	if printed.String() != reprinted.String() {
+		t.Fatalf("\t%s\t  Should have round tripped through the printer:\n%s\n%s", failed, printed.String(), reprinted.String())
+	}
+	t.Logf("\t%s\t  Should have round tripped through the printer", success)
+}
+
+func TestFaithfulDocument(t *testing.T) {
+	items := trees.ParseTree(`<!DOCTYPE html>
+<html><head><title>Tom &amp; Jerry</title><style>p > b { color: red; }</style></head>
+<body>
+  <p title='say "hi" &amp; go'>quotes</p>
+  <template><li>item</li></template>
+  <svg viewBox="0 0 10 10"><foreignObject width="5"></foreignObject><text x="1">label</text></svg>
+  <math><mi>x</mi></math>
+</body></html>`, trees.Faithful())
+
+	if len(items) != 2 || items[0].Kind() != trees.DoctypeNode || items[0].HTML() != "<!DOCTYPE html>" {
+		t.Fatalf("\t%s\t  Should have parsed doctype as doctype node", failed)
+	}
+	t.Logf("\t%s\t  Should have parsed doctype as doctype node", success)
+
+	document := items[1]
+
+	if style := trees.Query.Query(document, "style"); style.Children()[0].TextContent() != "p > b { color: red; }" {
+		t.Fatalf("\t%s\t  Should have kept raw text of style unescaped: %q", failed, style.Children()[0].TextContent())
+	}
+	t.Logf("\t%s\t  Should have kept raw text of style unescaped", success)
+
+	if title := trees.Query.Query(document, "title"); title.Children()[0].TextContent() != "Tom &amp; Jerry" {
+		t.Fatalf("\t%s\t  Should have kept text of title escaped: %q", failed, title.Children()[0].TextContent())
+	}
+	t.Logf("\t%s\t  Should have kept text of title escaped", success)
+
+	paragraph := trees.Query.Query(document, "p[title]")
+	if title, _ := trees.GetAttr(paragraph, "title"); title == nil || !bytes.Contains([]byte(paragraph.HTML()), []byte(`title="say &quot;hi&quot; &amp; go"`)) {
+		t.Fatalf("\t%s\t  Should have escaped attribute value: %s", failed, paragraph.HTML())
+	}
+	t.Logf("\t%s\t  Should have escaped attribute value", success)
+
+	if item := trees.Query.Query(document, "template > li"); item == nil {
+		t.Fatalf("\t%s\t  Should have kept content of template", failed)
 	}
+	t.Logf("\t%s\t  Should have kept content of template", success)
 
-	t.Logf("\t%s\t Parser should have produced markup for html: %q", success, strings.Join(html, ""))
+	svg := trees.Query.Query(document, "svg")
+	if _, err := trees.GetAttr(svg, "viewBox"); err != nil || svg.Namespace() != "svg" {
+		t.Fatalf("\t%s\t  Should have kept namespace and case of svg attributes", failed)
+	}
+	t.Logf("\t%s\t  Should have kept namespace and case of svg attributes", success)
+
+	if item := svg.Children()[0]; item.Name() != "foreignObject" {
+		t.Fatalf("\t%s\t  Should have kept case of svg tag: %q", failed, item.Name())
+	}
+	t.Logf("\t%s\t  Should have kept case of svg tag", success)
+
+	if text := svg.Children()[1]; text.Kind() != trees.ElementNode || text.HTML() != `<text hash="`+text.Hash()+`"  uid="`+text.UID()+`" data-gen="gu"  x="1" style="">label</text>` {
+		t.Fatalf("\t%s\t  Should have written svg text as element: %s", failed, text.HTML())
+	}
+	t.Logf("\t%s\t  Should have written svg text as element", success)
+
+	if item := trees.Query.Query(document, "math > mi"); item == nil || item.Namespace() != "math" {
+		t.Fatalf("\t%s\t  Should have kept namespace of math elements", failed)
+	}
+	t.Logf("\t%s\t  Should have kept namespace of math elements", success)
+
+	reparsed := trees.ParseTree(document.HTML(), trees.Faithful())
+	if len(reparsed) != 1 || reparsed[0].UID() != document.UID() || reparsed[0].HTML() != document.HTML() {
+		t.Fatalf("\t%s\t  Should have round tripped document with uids and hashes", failed)
+	}
+	t.Logf("\t%s\t  Should have round tripped document with uids and hashes", success)
+
+	rows := trees.ParseTree("<tr><td>1</td></tr>", trees.Faithful(), trees.ParseContext("tbody"))
+	if len(rows) != 1 || rows[0].Name() != "tr" {
+		t.Fatalf("\t%s\t  Should have parsed fragment within provided context", failed)
+	}
+	t.Logf("\t%s\t  Should have parsed fragment within provided context", success)
+}
+
+// TestFaithfulLeadingNewline validates that leading newlines of pre and
+// textarea survive printing and parsing again.
+func TestFaithfulLeadingNewline(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	for _, markup := range []string{"<pre>\n\nx</pre>", "<textarea>\nvalue</textarea>"} {
+		items := trees.ParseTree(markup, trees.Faithful())
+		printed := items[0].HTML()
+
+		reparsed := trees.ParseTree(printed, trees.Faithful())
+		if reparsed[0].HTML() != printed {
+			t.Fatalf("\t%s\t  Should have kept leading newline of %q: %q", failed, markup, reparsed[0].HTML())
+		}
+	}
+	t.Logf("\t%s\t  Should have kept leading newline of pre and textarea", success)
+}
+
+// TestFaithfulTemplate validates that the content of templates is parsed as
+// browsers do, keeping table rows and cells, and round trips through the printer.
+func TestFaithfulTemplate(t *testing.T) {
+	items := trees.ParseTree("<template><tr><td>1</td></tr></template>", trees.Faithful())
+	if len(items) != 1 || items[0].Name() != "template" {
+		t.Fatalf("\t%s\t  Should have parsed template element: %d", failed, len(items))
+	}
+
+	if cell := trees.Query.Query(items[0], "template > tr > td"); cell == nil || cell.Children()[0].TextContent() != "1" {
+		t.Fatalf("\t%s\t  Should have kept table row within template: %s", failed, items[0].HTML())
+	}
+	t.Logf("\t%s\t  Should have kept table row within template", success)
+
+	nested := trees.ParseTree("<div><template><td>a</td><template><th>b</th></template></template><span>c</span></div>", trees.Faithful())
+	if cells := trees.Query.QueryAll(nested[0], "template td, template template th"); len(cells) != 2 {
+		t.Fatalf("\t%s\t  Should have kept cells within nested templates: %s", failed, nested[0].HTML())
+	}
+
+	if span := trees.Query.Query(nested[0], "div > span"); span == nil {
+		t.Fatalf("\t%s\t  Should have kept markup after template: %s", failed, nested[0].HTML())
+	}
+	t.Logf("\t%s\t  Should have kept cells within nested templates", success)
+
+	for _, item := range [][]*trees.Markup{items, nested} {
+		printed := item[0].HTML()
+
+		reparsed := trees.ParseTree(printed, trees.Faithful())
+		if len(reparsed) != 1 || reparsed[0].HTML() != printed {
+			t.Fatalf("\t%s\t  Should have round tripped template through the printer:\n%s", failed, printed)
+		}
+	}
+	t.Logf("\t%s\t  Should have round tripped template through the printer", success)
+}
+
+// TestFaithfulStyleAttribute validates that style values holding ';' within
+// quotes and brackets are parsed whole.
+func TestFaithfulStyleAttribute(t *testing.T) {
+	items := trees.ParseTree(`<p style="background:url('data:image/png;base64,AAAA');color:red">x</p>`, trees.Faithful())
+
+	background, err := trees.GetStyle(items[0], "background")
+	if err != nil {
+		t.Fatalf("\t%s\t  Should have parsed background style: %+q", failed, err)
+	}
+
+	if _, value := background.Render(); value != "url('data:image/png;base64,AAAA')" {
+		t.Fatalf("\t%s\t  Should have kept ';' within url of style: %q", failed, value)
+	}
+	t.Logf("\t%s\t  Should have kept ';' within url of style", success)
+
+	if _, err := trees.GetStyle(items[0], "color"); err != nil {
+		t.Fatalf("\t%s\t  Should have parsed color style: %+q", failed, err)
+	}
+	t.Logf("\t%s\t  Should have parsed color style", success)
+}
+
+// TestSVGMarkup validates the namespace and case of svg elements and attributes
+// when parsed, built and printed.
+func TestSVGMarkup(t *testing.T) {
+	trees.SetMode(trees.Pretty)
+	defer trees.SetMode(trees.Normal)
+
+	parsed := trees.ParseTree(`<svg viewBox="0 0 10 10"><clipPath id="clip"><circle r="4"/></clipPath></svg>`)[0]
+
+	if _, err := trees.GetAttr(parsed, "viewBox"); err != nil || parsed.Namespace() != trees.SVGNamespace {
+		t.Fatalf("\t%s\t  Should have kept namespace and case of parsed svg attributes: %s", failed, parsed.HTML())
+	}
+	t.Logf("\t%s\t  Should have kept namespace and case of parsed svg attributes", success)
+
+	if clip := parsed.Children()[0]; clip.Name() != "clipPath" || clip.Children()[0].Namespace() != trees.SVGNamespace {
+		t.Fatalf("\t%s\t  Should have kept case of parsed svg tags within namespace: %q", failed, clip.Name())
+	}
+	t.Logf("\t%s\t  Should have kept case of parsed svg tags within namespace", success)
+
+	icon := elems.Div(
+		svg.SVG(
+			svgattr.ViewBox("0 0 24 24"),
+			svg.LinearGradient(svg.Stop(svgattr.Offset("0"), svgattr.StopColor("red"))),
+			elems.SvgCircle(svgattr.R("10"), trees.NewAttr("pathLength", "60")),
+			svg.ForeignObject(elems.Paragraph(elems.Text("label"))),
+		),
+	)
+
+	expected := `<div data-gen="gu" style=""><svg data-gen="gu"  viewBox="0 0 24 24"  xmlns="http://www.w3.org/2000/svg" style=""><linearGradient data-gen="gu" style=""><stop data-gen="gu"  offset="0"  stop-color="red" style=""/></linearGradient><circle data-gen="gu"  r="10"  pathLength="60" style=""/><foreignObject data-gen="gu" style=""><p data-gen="gu" style="">label</p></foreignObject></svg></div>`
+
+	if html := icon.HTML(); html != expected {
+		t.Logf("\t\tRecieved: %q\n", html)
+		t.Logf("\t\tExpected: %q\n", expected)
+		t.Fatalf("\t%s\t  Should have printed svg with its namespace and closed empty elements", failed)
+	}
+	t.Logf("\t%s\t  Should have printed svg with its namespace and closed empty elements", success)
+
+	if paragraph := trees.Query.Query(icon, "p"); paragraph.Namespace() != "" {
+		t.Fatalf("\t%s\t  Should have kept html elements within foreignObject out of namespace", failed)
+	}
+	t.Logf("\t%s\t  Should have kept html elements within foreignObject out of namespace", success)
+
+	reparsed := trees.ParseTree(icon.HTML(), trees.Faithful())
+	if len(reparsed) != 1 || reparsed[0].HTML() != expected {
+		t.Fatalf("\t%s\t  Should have reparsed printed svg faithfully: %s", failed, reparsed[0].HTML())
+	}
+	t.Logf("\t%s\t  Should have reparsed printed svg faithfully", success)
 }
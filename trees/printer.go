@@ -62,6 +62,10 @@ type AttrWriter struct{}
 
 const attrformt = ` %s="%s"`
 
+// attrEscaper escapes the characters of attribute values which can not be
+// written within double quotes.
+var attrEscaper = strings.NewReplacer("&", "&amp;", `"`, "&quot;")
+
 // Print returns a stringed repesentation of the attribute object
 func (m AttrWriter) Print(a []Property) string {
 	if len(a) <= 0 {
@@ -72,7 +76,7 @@ func (m AttrWriter) Print(a []Property) string {
 
 	for _, ar := range a {
 		name, val := ar.Render()
-		attrs = append(attrs, fmt.Sprintf(attrformt, name, val))
+		attrs = append(attrs, fmt.Sprintf(attrformt, name, attrEscaper.Replace(val)))
 	}
 
 	return strings.Join(attrs, " ")
@@ -134,11 +138,18 @@ type ElementWriter struct {
 	attrWriter  AttrPrinter
 	styleWriter StylePrinter
 	text        TextPrinter
+	patch       bool
 }
 
 // SimpleElementWriter provides a default writer using the basic attribute and style writers
 var SimpleElementWriter = NewElementWriter(SimpleAttrWriter, SimpleStyleWriter, SimpleTextWriter)
 
+// PatchElementWriter provides a writer using the basic attribute and style
+// writers, which writes out unchanged markup as an empty element with only its
+// uid, hash and a 'NodeUnchanged' attribute, telling drivers to keep the
+// element they already have.
+var PatchElementWriter = NewPatchWriter(SimpleAttrWriter, SimpleStyleWriter, SimpleTextWriter)
+
 // NewElementWriter returns a new writer for Element objects
 func NewElementWriter(aw AttrPrinter, sw StylePrinter, tw TextPrinter) *ElementWriter {
 	return &ElementWriter{
@@ -148,6 +159,14 @@ func NewElementWriter(aw AttrPrinter, sw StylePrinter, tw TextPrinter) *ElementW
 	}
 }
 
+// NewPatchWriter returns a new writer for Element objects which writes out
+// unchanged markup without its content.
+func NewPatchWriter(aw AttrPrinter, sw StylePrinter, tw TextPrinter) *ElementWriter {
+	writer := NewElementWriter(aw, sw, tw)
+	writer.patch = true
+	return writer
+}
+
 // Write prints the giving *Markup as a string else returns an error.
 func (m *ElementWriter) Write(ma *Markup) (string, error) {
 	return m.Print(ma), nil
@@ -159,9 +178,18 @@ func (m *ElementWriter) Print(e *Markup) string {
 		return ""
 	}
 
-	//if we are dealing with a text type just return the content
-	if e.Name() == "text" {
+	if e.Extracted() {
+		return ""
+	}
+
+	switch e.Kind() {
+	case TextNode:
+		//if we are dealing with a text type just return the content
 		return m.text.Print(e)
+	case CommentNode:
+		return "<!--" + e.TextContent() + "-->"
+	case DoctypeNode:
+		return "<!DOCTYPE " + e.TextContent() + ">"
 	}
 
 	// Management attributes.
@@ -177,8 +205,25 @@ func (m *ElementWriter) Print(e *Markup) string {
 	//write out the hash and uid as attributes
 	hashes := m.attrWriter.Print(mido)
 
+	// unchanged markup is written out as a reference to the existing element.
+	if m.patch && e.Unchanged() && GetMode() < Pretty {
+		unchanged := m.attrWriter.Print([]Property{&Attribute{Name: "NodeUnchanged"}})
+
+		if e.AutoClosed() {
+			return fmt.Sprintf("<%s%s%s/>", e.Name(), hashes, unchanged)
+		}
+
+		return fmt.Sprintf("<%s%s%s></%s>", e.Name(), hashes, unchanged, e.Name())
+	}
+
+	// the outermost element of a namespace declares it.
+	attributes := e.Attributes()
+	if xmlns, ok := namespaceAttr(e); ok {
+		attributes = append(attributes[:len(attributes):len(attributes)], xmlns)
+	}
+
 	//write out the elements attributes using the AttrWriter
-	attrs := m.attrWriter.Print(e.Attributes())
+	attrs := m.attrWriter.Print(attributes)
 
 	//write out the elements inline-styles using the StyleWriter
 	style := m.styleWriter.Print(e.Styles())
@@ -186,7 +231,10 @@ func (m *ElementWriter) Print(e *Markup) string {
 	var closer string
 	var beginbrack string
 
-	if e.AutoClosed() {
+	// empty svg and math elements are closed like xml.
+	foreignEmpty := e.namespace != "" && len(e.Children()) == 0 && e.TextContent() == ""
+
+	if e.AutoClosed() || foreignEmpty {
 		closer = "/>"
 	} else {
 		beginbrack = ">"
@@ -202,12 +250,18 @@ func (m *ElementWriter) Print(e *Markup) string {
 		children = append(children, m.Print(ch))
 	}
 
+	// html drops a newline straight after the opening tag of these elements, so
+	// one is written to keep a leading newline of their content.
+	if beginbrack != "" && e.namespace == "" && newlineElements[e.Name()] && leadingNewline(e) {
+		beginbrack += "\n"
+	}
+
 	//lets create the elements markup now
 	return strings.Join([]string{
 		fmt.Sprintf("<%s", e.Name()),
 		hashes,
 		attrs,
-		fmt.Sprintf(` style=%q`, style),
+		fmt.Sprintf(` style="%s"`, attrEscaper.Replace(style)),
 		beginbrack,
 		e.TextContent(),
 		strings.Join(children, ""),
@@ -215,4 +269,30 @@ func (m *ElementWriter) Print(e *Markup) string {
 	}, "")
 }
 
+// newlineElements defines elements whose leading newline is ignored by html.
+var newlineElements = map[string]bool{
+	"pre":      true,
+	"textarea": true,
+	"listing":  true,
+}
+
+// leadingNewline returns true if the content of the markup starts with a newline.
+func leadingNewline(e *Markup) bool {
+	if text := e.TextContent(); text != "" {
+		return strings.HasPrefix(text, "\n")
+	}
+
+	for _, ch := range e.Children() {
+		if ch.Kind() != TextNode {
+			return false
+		}
+
+		if text := ch.TextContent(); text != "" {
+			return strings.HasPrefix(text, "\n")
+		}
+	}
+
+	return false
+}
+
 //==============================================================================
@@ -0,0 +1,1534 @@
+// Package property contains attributes and styles for html element types,
+// with typed values for enumerated attributes and css properties, eg
+// 'Display.Flex'.
+
+//go:generate go run generate.go
+
+// Documentation source: "HTML attribute reference", "ARIA" and "CSS reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web, licensed under CC-BY-SA 2.5.
+
+package property
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+
+// AcceptAttr defines the html attribute "accept", which lists the types of files accepted by a file input.
+func AcceptAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "accept", Value: val}
+}
+
+// AcceptCharsetAttr defines the html attribute "accept-charset", which lists the character encodings accepted by the server for a form.
+func AcceptCharsetAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "accept-charset", Value: val}
+}
+
+// AccessKeyAttr defines the html attribute "accesskey", which provides a hint for generating a keyboard shortcut for the element.
+func AccessKeyAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "accesskey", Value: val}
+}
+
+// ActionAttr defines the html attribute "action", which defines the url which processes the information submitted through a form.
+func ActionAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "action", Value: val}
+}
+
+// AllowAttr defines the html attribute "allow", which specifies the permissions policy of an iframe.
+func AllowAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "allow", Value: val}
+}
+
+// AllowFullscreenBoolAttr defines the boolean html attribute "allowfullscreen", which indicates that an iframe can activate fullscreen mode.
+// It is left out of the element when false.
+func AllowFullscreenBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "allowfullscreen", Value: val}
+}
+
+// AltAttr defines the html attribute "alt", which defines the alternative text describing an image.
+func AltAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "alt", Value: val}
+}
+
+// AsyncBoolAttr defines the boolean html attribute "async", which indicates that a script is executed asynchronously.
+// It is left out of the element when false.
+func AsyncBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "async", Value: val}
+}
+
+// AutocapitalizeAttr defines the html attribute "autocapitalize", which controls whether and how text input is automatically capitalized.
+func AutocapitalizeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "autocapitalize", Value: val}
+}
+
+// Autocapitalize contains the values of the html attribute "autocapitalize", eg 'Autocapitalize.Off'.
+var Autocapitalize = struct {
+	Off        AttrValue
+	None       AttrValue
+	On         AttrValue
+	Sentences  AttrValue
+	Words      AttrValue
+	Characters AttrValue
+}{
+	Off:        AttrValue{Name: "autocapitalize", Value: "off"},
+	None:       AttrValue{Name: "autocapitalize", Value: "none"},
+	On:         AttrValue{Name: "autocapitalize", Value: "on"},
+	Sentences:  AttrValue{Name: "autocapitalize", Value: "sentences"},
+	Words:      AttrValue{Name: "autocapitalize", Value: "words"},
+	Characters: AttrValue{Name: "autocapitalize", Value: "characters"},
+}
+
+// AutocompleteAttr defines the html attribute "autocomplete", which indicates whether the value of a control can be automatically completed by the browser.
+func AutocompleteAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "autocomplete", Value: val}
+}
+
+// Autocomplete contains the values of the html attribute "autocomplete", eg 'Autocomplete.On'.
+var Autocomplete = struct {
+	On              AttrValue
+	Off             AttrValue
+	Name            AttrValue
+	Email           AttrValue
+	Username        AttrValue
+	NewPassword     AttrValue
+	CurrentPassword AttrValue
+	OneTimeCode     AttrValue
+	Organization    AttrValue
+	StreetAddress   AttrValue
+	Country         AttrValue
+	PostalCode      AttrValue
+	Tel             AttrValue
+	URL             AttrValue
+	Birthday        AttrValue
+}{
+	On:              AttrValue{Name: "autocomplete", Value: "on"},
+	Off:             AttrValue{Name: "autocomplete", Value: "off"},
+	Name:            AttrValue{Name: "autocomplete", Value: "name"},
+	Email:           AttrValue{Name: "autocomplete", Value: "email"},
+	Username:        AttrValue{Name: "autocomplete", Value: "username"},
+	NewPassword:     AttrValue{Name: "autocomplete", Value: "new-password"},
+	CurrentPassword: AttrValue{Name: "autocomplete", Value: "current-password"},
+	OneTimeCode:     AttrValue{Name: "autocomplete", Value: "one-time-code"},
+	Organization:    AttrValue{Name: "autocomplete", Value: "organization"},
+	StreetAddress:   AttrValue{Name: "autocomplete", Value: "street-address"},
+	Country:         AttrValue{Name: "autocomplete", Value: "country"},
+	PostalCode:      AttrValue{Name: "autocomplete", Value: "postal-code"},
+	Tel:             AttrValue{Name: "autocomplete", Value: "tel"},
+	URL:             AttrValue{Name: "autocomplete", Value: "url"},
+	Birthday:        AttrValue{Name: "autocomplete", Value: "bday"},
+}
+
+// AutofocusAttr defines the html attribute "autofocus", which indicates that the element should be focused on page load.
+//
+// Deprecated: Use AutofocusBoolAttr, as the attribute is set for any value, including "false".
+func AutofocusAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "autofocus", Value: val}
+}
+
+// AutofocusBoolAttr defines the boolean html attribute "autofocus", which indicates that the element should be focused on page load.
+// It is left out of the element when false.
+func AutofocusBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "autofocus", Value: val}
+}
+
+// AutoPlayBoolAttr defines the boolean html attribute "autoplay", which indicates that audio or video plays as soon as it can.
+// It is left out of the element when false.
+func AutoPlayBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "autoplay", Value: val}
+}
+
+// CharsetAttr defines the html attribute "charset", which declares the character encoding of the document.
+func CharsetAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "charset", Value: val}
+}
+
+// CheckedAttr defines the html attribute "checked", which indicates whether a checkbox or radio input is selected.
+//
+// Deprecated: Use CheckedBoolAttr, as the attribute is set for any value, including "false".
+func CheckedAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "checked", Value: val}
+}
+
+// CheckedBoolAttr defines the boolean html attribute "checked", which indicates whether a checkbox or radio input is selected.
+// It is left out of the element when false.
+func CheckedBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "checked", Value: val}
+}
+
+// CiteAttr defines the html attribute "cite", which contains a url pointing to the source of a quote or change.
+func CiteAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "cite", Value: val}
+}
+
+// ColsAttr defines the html attribute "cols", which defines the number of columns in a textarea.
+func ColsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "cols", Value: val}
+}
+
+// ColSpanAttr defines the html attribute "colspan", which defines the number of columns a table cell spans.
+func ColSpanAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "colspan", Value: val}
+}
+
+// ContentAttr defines the html attribute "content", which defines the value associated with the name or http-equiv of a meta element.
+func ContentAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "content", Value: val}
+}
+
+// ContentEditableAttr defines the html attribute "contenteditable", which indicates whether the content of the element is editable by the user.
+func ContentEditableAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "contenteditable", Value: val}
+}
+
+// ContentEditable contains the values of the html attribute "contenteditable", eg 'ContentEditable.True'.
+var ContentEditable = struct {
+	True          AttrValue
+	False         AttrValue
+	PlaintextOnly AttrValue
+}{
+	True:          AttrValue{Name: "contenteditable", Value: "true"},
+	False:         AttrValue{Name: "contenteditable", Value: "false"},
+	PlaintextOnly: AttrValue{Name: "contenteditable", Value: "plaintext-only"},
+}
+
+// ControlsBoolAttr defines the boolean html attribute "controls", which indicates whether the browser shows playback controls for audio or video.
+// It is left out of the element when false.
+func ControlsBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "controls", Value: val}
+}
+
+// CoordsAttr defines the html attribute "coords", which defines the coordinates of the hot-spot region of an area.
+func CoordsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "coords", Value: val}
+}
+
+// CrossOriginAttr defines the html attribute "crossorigin", which indicates how the element handles cross-origin requests.
+func CrossOriginAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "crossorigin", Value: val}
+}
+
+// CrossOrigin contains the values of the html attribute "crossorigin", eg 'CrossOrigin.Anonymous'.
+var CrossOrigin = struct {
+	Anonymous      AttrValue
+	UseCredentials AttrValue
+}{
+	Anonymous:      AttrValue{Name: "crossorigin", Value: "anonymous"},
+	UseCredentials: AttrValue{Name: "crossorigin", Value: "use-credentials"},
+}
+
+// DateTimeAttr defines the html attribute "datetime", which indicates the date and time associated with the element.
+func DateTimeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "datetime", Value: val}
+}
+
+// DecodingAttr defines the html attribute "decoding", which indicates the preferred method to decode an image.
+func DecodingAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "decoding", Value: val}
+}
+
+// Decoding contains the values of the html attribute "decoding", eg 'Decoding.Sync'.
+var Decoding = struct {
+	Sync  AttrValue
+	Async AttrValue
+	Auto  AttrValue
+}{
+	Sync:  AttrValue{Name: "decoding", Value: "sync"},
+	Async: AttrValue{Name: "decoding", Value: "async"},
+	Auto:  AttrValue{Name: "decoding", Value: "auto"},
+}
+
+// DefaultBoolAttr defines the boolean html attribute "default", which indicates that a track should be enabled unless the user preferences indicate otherwise.
+// It is left out of the element when false.
+func DefaultBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "default", Value: val}
+}
+
+// DeferBoolAttr defines the boolean html attribute "defer", which indicates that a script is executed after the document has been parsed.
+// It is left out of the element when false.
+func DeferBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "defer", Value: val}
+}
+
+// DirAttr defines the html attribute "dir", which indicates the directionality of the text of the element.
+func DirAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "dir", Value: val}
+}
+
+// Dir contains the values of the html attribute "dir", eg 'Dir.LTR'.
+var Dir = struct {
+	LTR  AttrValue
+	RTL  AttrValue
+	Auto AttrValue
+}{
+	LTR:  AttrValue{Name: "dir", Value: "ltr"},
+	RTL:  AttrValue{Name: "dir", Value: "rtl"},
+	Auto: AttrValue{Name: "dir", Value: "auto"},
+}
+
+// DisabledBoolAttr defines the boolean html attribute "disabled", which indicates whether the user can interact with the element.
+// It is left out of the element when false.
+func DisabledBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "disabled", Value: val}
+}
+
+// DownloadAttr defines the html attribute "download", which indicates that the hyperlink is used to download a resource, with the suggested file name.
+func DownloadAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "download", Value: val}
+}
+
+// DraggableAttr defines the html attribute "draggable", which indicates whether the element can be dragged.
+func DraggableAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "draggable", Value: val}
+}
+
+// Draggable contains the values of the html attribute "draggable", eg 'Draggable.True'.
+var Draggable = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "draggable", Value: "true"},
+	False: AttrValue{Name: "draggable", Value: "false"},
+}
+
+// EncTypeAttr defines the html attribute "enctype", which defines the content type of the form data when the method is post.
+func EncTypeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "enctype", Value: val}
+}
+
+// EncType contains the values of the html attribute "enctype", eg 'EncType.URLEncoded'.
+var EncType = struct {
+	URLEncoded        AttrValue
+	MultipartFormData AttrValue
+	TextPlain         AttrValue
+}{
+	URLEncoded:        AttrValue{Name: "enctype", Value: "application/x-www-form-urlencoded"},
+	MultipartFormData: AttrValue{Name: "enctype", Value: "multipart/form-data"},
+	TextPlain:         AttrValue{Name: "enctype", Value: "text/plain"},
+}
+
+// EnterKeyHintAttr defines the html attribute "enterkeyhint", which defines the action label of the enter key on virtual keyboards.
+func EnterKeyHintAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "enterkeyhint", Value: val}
+}
+
+// EnterKeyHint contains the values of the html attribute "enterkeyhint", eg 'EnterKeyHint.Enter'.
+var EnterKeyHint = struct {
+	Enter    AttrValue
+	Done     AttrValue
+	Go       AttrValue
+	Next     AttrValue
+	Previous AttrValue
+	Search   AttrValue
+	Send     AttrValue
+}{
+	Enter:    AttrValue{Name: "enterkeyhint", Value: "enter"},
+	Done:     AttrValue{Name: "enterkeyhint", Value: "done"},
+	Go:       AttrValue{Name: "enterkeyhint", Value: "go"},
+	Next:     AttrValue{Name: "enterkeyhint", Value: "next"},
+	Previous: AttrValue{Name: "enterkeyhint", Value: "previous"},
+	Search:   AttrValue{Name: "enterkeyhint", Value: "search"},
+	Send:     AttrValue{Name: "enterkeyhint", Value: "send"},
+}
+
+// ForAttr defines the html attribute "for", which identifies the form control a label or output belongs to.
+func ForAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "for", Value: val}
+}
+
+// FormAttr defines the html attribute "form", which indicates the form which is the owner of the element.
+func FormAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "form", Value: val}
+}
+
+// FormActionAttr defines the html attribute "formaction", which defines the url which processes the form submitted by the button or input.
+func FormActionAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "formaction", Value: val}
+}
+
+// FormMethodAttr defines the html attribute "formmethod", which defines the http method used to submit the form by the button or input.
+func FormMethodAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "formmethod", Value: val}
+}
+
+// FormMethod contains the values of the html attribute "formmethod", eg 'FormMethod.Get'.
+var FormMethod = struct {
+	Get    AttrValue
+	Post   AttrValue
+	Dialog AttrValue
+}{
+	Get:    AttrValue{Name: "formmethod", Value: "get"},
+	Post:   AttrValue{Name: "formmethod", Value: "post"},
+	Dialog: AttrValue{Name: "formmethod", Value: "dialog"},
+}
+
+// FormNoValidateBoolAttr defines the boolean html attribute "formnovalidate", which indicates that the form is not validated when submitted by the button or input.
+// It is left out of the element when false.
+func FormNoValidateBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "formnovalidate", Value: val}
+}
+
+// HeadersAttr defines the html attribute "headers", which lists the ids of the header cells which apply to a table cell.
+func HeadersAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "headers", Value: val}
+}
+
+// HeightAttr defines the html attribute "height", which defines the height of images, videos, canvases and iframes.
+func HeightAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "height", Value: val}
+}
+
+// HiddenBoolAttr defines the boolean html attribute "hidden", which indicates that the element is not yet, or is no longer, relevant.
+// It is left out of the element when false.
+func HiddenBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "hidden", Value: val}
+}
+
+// HighAttr defines the html attribute "high", which indicates the lower bound of the upper range of a meter.
+func HighAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "high", Value: val}
+}
+
+// HrefAttr defines the html attribute "href", which defines the url of a linked resource.
+func HrefAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "href", Value: val}
+}
+
+// HrefLangAttr defines the html attribute "hreflang", which specifies the language of the linked resource.
+func HrefLangAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "hreflang", Value: val}
+}
+
+// HTTPEquivAttr defines the html attribute "http-equiv", which defines a pragma directive of a meta element.
+func HTTPEquivAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "http-equiv", Value: val}
+}
+
+// InertBoolAttr defines the boolean html attribute "inert", which indicates that the element and its content can not be focused or interacted with.
+// It is left out of the element when false.
+func InertBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "inert", Value: val}
+}
+
+// InputModeAttr defines the html attribute "inputmode", which provides a hint of the type of virtual keyboard to show for the element.
+func InputModeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "inputmode", Value: val}
+}
+
+// InputMode contains the values of the html attribute "inputmode", eg 'InputMode.None'.
+var InputMode = struct {
+	None    AttrValue
+	Text    AttrValue
+	Decimal AttrValue
+	Numeric AttrValue
+	Tel     AttrValue
+	Search  AttrValue
+	Email   AttrValue
+	URL     AttrValue
+}{
+	None:    AttrValue{Name: "inputmode", Value: "none"},
+	Text:    AttrValue{Name: "inputmode", Value: "text"},
+	Decimal: AttrValue{Name: "inputmode", Value: "decimal"},
+	Numeric: AttrValue{Name: "inputmode", Value: "numeric"},
+	Tel:     AttrValue{Name: "inputmode", Value: "tel"},
+	Search:  AttrValue{Name: "inputmode", Value: "search"},
+	Email:   AttrValue{Name: "inputmode", Value: "email"},
+	URL:     AttrValue{Name: "inputmode", Value: "url"},
+}
+
+// IntegrityAttr defines the html attribute "integrity", which contains the inline metadata used to verify a fetched resource.
+func IntegrityAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "integrity", Value: val}
+}
+
+// IsAttr defines the html attribute "is", which specifies the customized built-in element the element behaves as.
+func IsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "is", Value: val}
+}
+
+// IsMapBoolAttr defines the boolean html attribute "ismap", which indicates that an image is part of a server-side image map.
+// It is left out of the element when false.
+func IsMapBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "ismap", Value: val}
+}
+
+// KindAttr defines the html attribute "kind", which specifies the kind of a text track.
+func KindAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "kind", Value: val}
+}
+
+// Kind contains the values of the html attribute "kind", eg 'Kind.Subtitles'.
+var Kind = struct {
+	Subtitles    AttrValue
+	Captions     AttrValue
+	Descriptions AttrValue
+	Chapters     AttrValue
+	Metadata     AttrValue
+}{
+	Subtitles:    AttrValue{Name: "kind", Value: "subtitles"},
+	Captions:     AttrValue{Name: "kind", Value: "captions"},
+	Descriptions: AttrValue{Name: "kind", Value: "descriptions"},
+	Chapters:     AttrValue{Name: "kind", Value: "chapters"},
+	Metadata:     AttrValue{Name: "kind", Value: "metadata"},
+}
+
+// LabelAttr defines the html attribute "label", which specifies a user-readable title of an option, optgroup or track.
+func LabelAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "label", Value: val}
+}
+
+// LangAttr defines the html attribute "lang", which defines the language of the element.
+func LangAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "lang", Value: val}
+}
+
+// ListAttr defines the html attribute "list", which identifies a datalist of predefined options suggested to the user.
+func ListAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "list", Value: val}
+}
+
+// LoadingAttr defines the html attribute "loading", which indicates whether an image or iframe is loaded immediately or when needed.
+func LoadingAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "loading", Value: val}
+}
+
+// Loading contains the values of the html attribute "loading", eg 'Loading.Eager'.
+var Loading = struct {
+	Eager AttrValue
+	Lazy  AttrValue
+}{
+	Eager: AttrValue{Name: "loading", Value: "eager"},
+	Lazy:  AttrValue{Name: "loading", Value: "lazy"},
+}
+
+// LoopBoolAttr defines the boolean html attribute "loop", which indicates whether audio or video restarts when finished.
+// It is left out of the element when false.
+func LoopBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "loop", Value: val}
+}
+
+// LowAttr defines the html attribute "low", which indicates the upper bound of the lower range of a meter.
+func LowAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "low", Value: val}
+}
+
+// MaxAttr defines the html attribute "max", which indicates the maximum value allowed.
+func MaxAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "max", Value: val}
+}
+
+// MaxLengthAttr defines the html attribute "maxlength", which defines the maximum number of characters allowed in the element.
+func MaxLengthAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "maxlength", Value: val}
+}
+
+// MediaAttr defines the html attribute "media", which specifies a hint of the media the linked resource was designed for.
+func MediaAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "media", Value: val}
+}
+
+// MethodAttr defines the html attribute "method", which defines the http method used to submit the form.
+func MethodAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "method", Value: val}
+}
+
+// Method contains the values of the html attribute "method", eg 'Method.Get'.
+var Method = struct {
+	Get    AttrValue
+	Post   AttrValue
+	Dialog AttrValue
+}{
+	Get:    AttrValue{Name: "method", Value: "get"},
+	Post:   AttrValue{Name: "method", Value: "post"},
+	Dialog: AttrValue{Name: "method", Value: "dialog"},
+}
+
+// MinAttr defines the html attribute "min", which indicates the minimum value allowed.
+func MinAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "min", Value: val}
+}
+
+// MinLengthAttr defines the html attribute "minlength", which defines the minimum number of characters allowed in the element.
+func MinLengthAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "minlength", Value: val}
+}
+
+// MultipleBoolAttr defines the boolean html attribute "multiple", which indicates whether multiple values can be entered in an input or selected in a select.
+// It is left out of the element when false.
+func MultipleBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "multiple", Value: val}
+}
+
+// MutedBoolAttr defines the boolean html attribute "muted", which indicates whether the audio is initially silenced.
+// It is left out of the element when false.
+func MutedBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "muted", Value: val}
+}
+
+// NameAttr defines the html attribute "name", which defines the name of the element, used when submitting a form.
+func NameAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "name", Value: val}
+}
+
+// NonceAttr defines the html attribute "nonce", which defines a cryptographic nonce used by a content security policy.
+func NonceAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "nonce", Value: val}
+}
+
+// NoValidateBoolAttr defines the boolean html attribute "novalidate", which indicates that the form is not validated when submitted.
+// It is left out of the element when false.
+func NoValidateBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "novalidate", Value: val}
+}
+
+// OpenBoolAttr defines the boolean html attribute "open", which indicates whether the content of a details or dialog element is shown.
+// It is left out of the element when false.
+func OpenBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "open", Value: val}
+}
+
+// OptimumAttr defines the html attribute "optimum", which indicates the optimal numeric value of a meter.
+func OptimumAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "optimum", Value: val}
+}
+
+// PartAttr defines the html attribute "part", which lists the part names of the element within a shadow tree.
+func PartAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "part", Value: val}
+}
+
+// PatternAttr defines the html attribute "pattern", which defines a regular expression which the value of the element is validated against.
+func PatternAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "pattern", Value: val}
+}
+
+// PingAttr defines the html attribute "ping", which lists the urls notified when the hyperlink is followed.
+func PingAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "ping", Value: val}
+}
+
+// PlaceholderAttr defines the html attribute "placeholder", which provides a hint of what can be entered in the element.
+func PlaceholderAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "placeholder", Value: val}
+}
+
+// PlaysInlineBoolAttr defines the boolean html attribute "playsinline", which indicates that a video is played within the playback area of the element.
+// It is left out of the element when false.
+func PlaysInlineBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "playsinline", Value: val}
+}
+
+// PosterAttr defines the html attribute "poster", which defines the url of an image shown until a video plays.
+func PosterAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "poster", Value: val}
+}
+
+// PreloadAttr defines the html attribute "preload", which provides a hint of what content of audio or video is loaded before playing.
+func PreloadAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "preload", Value: val}
+}
+
+// Preload contains the values of the html attribute "preload", eg 'Preload.None'.
+var Preload = struct {
+	None     AttrValue
+	Metadata AttrValue
+	Auto     AttrValue
+}{
+	None:     AttrValue{Name: "preload", Value: "none"},
+	Metadata: AttrValue{Name: "preload", Value: "metadata"},
+	Auto:     AttrValue{Name: "preload", Value: "auto"},
+}
+
+// ReadOnlyBoolAttr defines the boolean html attribute "readonly", which indicates whether the element can be edited.
+// It is left out of the element when false.
+func ReadOnlyBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "readonly", Value: val}
+}
+
+// ReferrerPolicyAttr defines the html attribute "referrerpolicy", which specifies which referrer is sent when fetching the resource.
+func ReferrerPolicyAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "referrerpolicy", Value: val}
+}
+
+// ReferrerPolicy contains the values of the html attribute "referrerpolicy", eg 'ReferrerPolicy.NoReferrer'.
+var ReferrerPolicy = struct {
+	NoReferrer                  AttrValue
+	NoReferrerWhenDowngrade     AttrValue
+	Origin                      AttrValue
+	OriginWhenCrossOrigin       AttrValue
+	SameOrigin                  AttrValue
+	StrictOrigin                AttrValue
+	StrictOriginWhenCrossOrigin AttrValue
+	UnsafeURL                   AttrValue
+}{
+	NoReferrer:                  AttrValue{Name: "referrerpolicy", Value: "no-referrer"},
+	NoReferrerWhenDowngrade:     AttrValue{Name: "referrerpolicy", Value: "no-referrer-when-downgrade"},
+	Origin:                      AttrValue{Name: "referrerpolicy", Value: "origin"},
+	OriginWhenCrossOrigin:       AttrValue{Name: "referrerpolicy", Value: "origin-when-cross-origin"},
+	SameOrigin:                  AttrValue{Name: "referrerpolicy", Value: "same-origin"},
+	StrictOrigin:                AttrValue{Name: "referrerpolicy", Value: "strict-origin"},
+	StrictOriginWhenCrossOrigin: AttrValue{Name: "referrerpolicy", Value: "strict-origin-when-cross-origin"},
+	UnsafeURL:                   AttrValue{Name: "referrerpolicy", Value: "unsafe-url"},
+}
+
+// RelAttr defines the html attribute "rel", which specifies the relationship of the linked resource to the document.
+func RelAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "rel", Value: val}
+}
+
+// RequiredBoolAttr defines the boolean html attribute "required", which indicates whether the element is required to be filled in.
+// It is left out of the element when false.
+func RequiredBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "required", Value: val}
+}
+
+// ReversedBoolAttr defines the boolean html attribute "reversed", which indicates whether an ordered list is numbered in descending order.
+// It is left out of the element when false.
+func ReversedBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "reversed", Value: val}
+}
+
+// RoleAttr defines the html attribute "role", which defines the semantic meaning of the element for assistive technologies.
+func RoleAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "role", Value: val}
+}
+
+// Role contains the values of the html attribute "role", eg 'Role.Alert'.
+var Role = struct {
+	Alert            AttrValue
+	AlertDialog      AttrValue
+	Application      AttrValue
+	Article          AttrValue
+	Banner           AttrValue
+	Button           AttrValue
+	Cell             AttrValue
+	Checkbox         AttrValue
+	ColumnHeader     AttrValue
+	ComboBox         AttrValue
+	Complementary    AttrValue
+	ContentInfo      AttrValue
+	Definition       AttrValue
+	Dialog           AttrValue
+	Directory        AttrValue
+	Document         AttrValue
+	Feed             AttrValue
+	Figure           AttrValue
+	Form             AttrValue
+	Grid             AttrValue
+	GridCell         AttrValue
+	Group            AttrValue
+	Heading          AttrValue
+	Image            AttrValue
+	Link             AttrValue
+	List             AttrValue
+	ListBox          AttrValue
+	ListItem         AttrValue
+	Log              AttrValue
+	Main             AttrValue
+	Marquee          AttrValue
+	Math             AttrValue
+	Menu             AttrValue
+	MenuBar          AttrValue
+	MenuItem         AttrValue
+	MenuItemCheckbox AttrValue
+	MenuItemRadio    AttrValue
+	Meter            AttrValue
+	Navigation       AttrValue
+	None             AttrValue
+	Note             AttrValue
+	Option           AttrValue
+	Presentation     AttrValue
+	ProgressBar      AttrValue
+	Radio            AttrValue
+	RadioGroup       AttrValue
+	Region           AttrValue
+	Row              AttrValue
+	RowGroup         AttrValue
+	RowHeader        AttrValue
+	ScrollBar        AttrValue
+	Search           AttrValue
+	SearchBox        AttrValue
+	Separator        AttrValue
+	Slider           AttrValue
+	SpinButton       AttrValue
+	Status           AttrValue
+	Switch           AttrValue
+	Tab              AttrValue
+	Table            AttrValue
+	TabList          AttrValue
+	TabPanel         AttrValue
+	Term             AttrValue
+	TextBox          AttrValue
+	Timer            AttrValue
+	ToolBar          AttrValue
+	ToolTip          AttrValue
+	Tree             AttrValue
+	TreeGrid         AttrValue
+	TreeItem         AttrValue
+}{
+	Alert:            AttrValue{Name: "role", Value: "alert"},
+	AlertDialog:      AttrValue{Name: "role", Value: "alertdialog"},
+	Application:      AttrValue{Name: "role", Value: "application"},
+	Article:          AttrValue{Name: "role", Value: "article"},
+	Banner:           AttrValue{Name: "role", Value: "banner"},
+	Button:           AttrValue{Name: "role", Value: "button"},
+	Cell:             AttrValue{Name: "role", Value: "cell"},
+	Checkbox:         AttrValue{Name: "role", Value: "checkbox"},
+	ColumnHeader:     AttrValue{Name: "role", Value: "columnheader"},
+	ComboBox:         AttrValue{Name: "role", Value: "combobox"},
+	Complementary:    AttrValue{Name: "role", Value: "complementary"},
+	ContentInfo:      AttrValue{Name: "role", Value: "contentinfo"},
+	Definition:       AttrValue{Name: "role", Value: "definition"},
+	Dialog:           AttrValue{Name: "role", Value: "dialog"},
+	Directory:        AttrValue{Name: "role", Value: "directory"},
+	Document:         AttrValue{Name: "role", Value: "document"},
+	Feed:             AttrValue{Name: "role", Value: "feed"},
+	Figure:           AttrValue{Name: "role", Value: "figure"},
+	Form:             AttrValue{Name: "role", Value: "form"},
+	Grid:             AttrValue{Name: "role", Value: "grid"},
+	GridCell:         AttrValue{Name: "role", Value: "gridcell"},
+	Group:            AttrValue{Name: "role", Value: "group"},
+	Heading:          AttrValue{Name: "role", Value: "heading"},
+	Image:            AttrValue{Name: "role", Value: "img"},
+	Link:             AttrValue{Name: "role", Value: "link"},
+	List:             AttrValue{Name: "role", Value: "list"},
+	ListBox:          AttrValue{Name: "role", Value: "listbox"},
+	ListItem:         AttrValue{Name: "role", Value: "listitem"},
+	Log:              AttrValue{Name: "role", Value: "log"},
+	Main:             AttrValue{Name: "role", Value: "main"},
+	Marquee:          AttrValue{Name: "role", Value: "marquee"},
+	Math:             AttrValue{Name: "role", Value: "math"},
+	Menu:             AttrValue{Name: "role", Value: "menu"},
+	MenuBar:          AttrValue{Name: "role", Value: "menubar"},
+	MenuItem:         AttrValue{Name: "role", Value: "menuitem"},
+	MenuItemCheckbox: AttrValue{Name: "role", Value: "menuitemcheckbox"},
+	MenuItemRadio:    AttrValue{Name: "role", Value: "menuitemradio"},
+	Meter:            AttrValue{Name: "role", Value: "meter"},
+	Navigation:       AttrValue{Name: "role", Value: "navigation"},
+	None:             AttrValue{Name: "role", Value: "none"},
+	Note:             AttrValue{Name: "role", Value: "note"},
+	Option:           AttrValue{Name: "role", Value: "option"},
+	Presentation:     AttrValue{Name: "role", Value: "presentation"},
+	ProgressBar:      AttrValue{Name: "role", Value: "progressbar"},
+	Radio:            AttrValue{Name: "role", Value: "radio"},
+	RadioGroup:       AttrValue{Name: "role", Value: "radiogroup"},
+	Region:           AttrValue{Name: "role", Value: "region"},
+	Row:              AttrValue{Name: "role", Value: "row"},
+	RowGroup:         AttrValue{Name: "role", Value: "rowgroup"},
+	RowHeader:        AttrValue{Name: "role", Value: "rowheader"},
+	ScrollBar:        AttrValue{Name: "role", Value: "scrollbar"},
+	Search:           AttrValue{Name: "role", Value: "search"},
+	SearchBox:        AttrValue{Name: "role", Value: "searchbox"},
+	Separator:        AttrValue{Name: "role", Value: "separator"},
+	Slider:           AttrValue{Name: "role", Value: "slider"},
+	SpinButton:       AttrValue{Name: "role", Value: "spinbutton"},
+	Status:           AttrValue{Name: "role", Value: "status"},
+	Switch:           AttrValue{Name: "role", Value: "switch"},
+	Tab:              AttrValue{Name: "role", Value: "tab"},
+	Table:            AttrValue{Name: "role", Value: "table"},
+	TabList:          AttrValue{Name: "role", Value: "tablist"},
+	TabPanel:         AttrValue{Name: "role", Value: "tabpanel"},
+	Term:             AttrValue{Name: "role", Value: "term"},
+	TextBox:          AttrValue{Name: "role", Value: "textbox"},
+	Timer:            AttrValue{Name: "role", Value: "timer"},
+	ToolBar:          AttrValue{Name: "role", Value: "toolbar"},
+	ToolTip:          AttrValue{Name: "role", Value: "tooltip"},
+	Tree:             AttrValue{Name: "role", Value: "tree"},
+	TreeGrid:         AttrValue{Name: "role", Value: "treegrid"},
+	TreeItem:         AttrValue{Name: "role", Value: "treeitem"},
+}
+
+// RowsAttr defines the html attribute "rows", which defines the number of rows in a textarea.
+func RowsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "rows", Value: val}
+}
+
+// RowSpanAttr defines the html attribute "rowspan", which defines the number of rows a table cell spans.
+func RowSpanAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "rowspan", Value: val}
+}
+
+// SandboxAttr defines the html attribute "sandbox", which enables an extra set of restrictions on the content of an iframe.
+func SandboxAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "sandbox", Value: val}
+}
+
+// ScopeAttr defines the html attribute "scope", which defines the cells which a header cell relates to.
+func ScopeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "scope", Value: val}
+}
+
+// Scope contains the values of the html attribute "scope", eg 'Scope.Row'.
+var Scope = struct {
+	Row      AttrValue
+	Col      AttrValue
+	RowGroup AttrValue
+	ColGroup AttrValue
+}{
+	Row:      AttrValue{Name: "scope", Value: "row"},
+	Col:      AttrValue{Name: "scope", Value: "col"},
+	RowGroup: AttrValue{Name: "scope", Value: "rowgroup"},
+	ColGroup: AttrValue{Name: "scope", Value: "colgroup"},
+}
+
+// SelectedBoolAttr defines the boolean html attribute "selected", which indicates whether an option is initially selected.
+// It is left out of the element when false.
+func SelectedBoolAttr(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: "selected", Value: val}
+}
+
+// ShapeAttr defines the html attribute "shape", which defines the shape of the hot-spot region of an area.
+func ShapeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "shape", Value: val}
+}
+
+// Shape contains the values of the html attribute "shape", eg 'Shape.Rect'.
+var Shape = struct {
+	Rect    AttrValue
+	Circle  AttrValue
+	Poly    AttrValue
+	Default AttrValue
+}{
+	Rect:    AttrValue{Name: "shape", Value: "rect"},
+	Circle:  AttrValue{Name: "shape", Value: "circle"},
+	Poly:    AttrValue{Name: "shape", Value: "poly"},
+	Default: AttrValue{Name: "shape", Value: "default"},
+}
+
+// SizeAttr defines the html attribute "size", which defines the width of an input in characters or the number of visible options of a select.
+func SizeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "size", Value: val}
+}
+
+// SizesAttr defines the html attribute "sizes", which lists the source sizes of the images of a srcset.
+func SizesAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "sizes", Value: val}
+}
+
+// SlotAttr defines the html attribute "slot", which assigns the element to a slot within a shadow tree.
+func SlotAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "slot", Value: val}
+}
+
+// SpanAttr defines the html attribute "span", which defines the number of columns spanned by a col or colgroup.
+func SpanAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "span", Value: val}
+}
+
+// SpellCheckAttr defines the html attribute "spellcheck", which indicates whether the element is checked for spelling errors.
+func SpellCheckAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "spellcheck", Value: val}
+}
+
+// SpellCheck contains the values of the html attribute "spellcheck", eg 'SpellCheck.True'.
+var SpellCheck = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "spellcheck", Value: "true"},
+	False: AttrValue{Name: "spellcheck", Value: "false"},
+}
+
+// SrcAttr defines the html attribute "src", which defines the url of the embedded content.
+func SrcAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "src", Value: val}
+}
+
+// SrcDocAttr defines the html attribute "srcdoc", which defines the inline html embedded within an iframe.
+func SrcDocAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "srcdoc", Value: val}
+}
+
+// SrcLangAttr defines the html attribute "srclang", which defines the language of the text of a track.
+func SrcLangAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "srclang", Value: val}
+}
+
+// SrcSetAttr defines the html attribute "srcset", which lists the candidate images of an img or source.
+func SrcSetAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "srcset", Value: val}
+}
+
+// StartAttr defines the html attribute "start", which defines the first number of an ordered list.
+func StartAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "start", Value: val}
+}
+
+// StepAttr defines the html attribute "step", which defines the granularity of the values of an input.
+func StepAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "step", Value: val}
+}
+
+// TabIndexAttr defines the html attribute "tabindex", which indicates whether and in what order the element can be focused with the keyboard.
+func TabIndexAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "tabindex", Value: val}
+}
+
+// TargetAttr defines the html attribute "target", which specifies where to display the linked resource or the response of a form.
+func TargetAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "target", Value: val}
+}
+
+// Target contains the values of the html attribute "target", eg 'Target.Self'.
+var Target = struct {
+	Self   AttrValue
+	Blank  AttrValue
+	Parent AttrValue
+	Top    AttrValue
+}{
+	Self:   AttrValue{Name: "target", Value: "_self"},
+	Blank:  AttrValue{Name: "target", Value: "_blank"},
+	Parent: AttrValue{Name: "target", Value: "_parent"},
+	Top:    AttrValue{Name: "target", Value: "_top"},
+}
+
+// TitleAttr defines the html attribute "title", which contains text representing advisory information about the element.
+func TitleAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "title", Value: val}
+}
+
+// TranslateAttr defines the html attribute "translate", which indicates whether the content of the element is translated when the page is localized.
+func TranslateAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "translate", Value: val}
+}
+
+// Translate contains the values of the html attribute "translate", eg 'Translate.Yes'.
+var Translate = struct {
+	Yes AttrValue
+	No  AttrValue
+}{
+	Yes: AttrValue{Name: "translate", Value: "yes"},
+	No:  AttrValue{Name: "translate", Value: "no"},
+}
+
+// TypeAttr defines the html attribute "type", which defines the type of the element, eg the kind of control of an input.
+func TypeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "type", Value: val}
+}
+
+// Type contains the values of the html attribute "type", eg 'Type.Button'.
+var Type = struct {
+	Button        AttrValue
+	Checkbox      AttrValue
+	Color         AttrValue
+	Date          AttrValue
+	DatetimeLocal AttrValue
+	Email         AttrValue
+	File          AttrValue
+	Hidden        AttrValue
+	Image         AttrValue
+	Month         AttrValue
+	Number        AttrValue
+	Password      AttrValue
+	Radio         AttrValue
+	Range         AttrValue
+	Reset         AttrValue
+	Search        AttrValue
+	Submit        AttrValue
+	Tel           AttrValue
+	Text          AttrValue
+	Time          AttrValue
+	URL           AttrValue
+	Week          AttrValue
+}{
+	Button:        AttrValue{Name: "type", Value: "button"},
+	Checkbox:      AttrValue{Name: "type", Value: "checkbox"},
+	Color:         AttrValue{Name: "type", Value: "color"},
+	Date:          AttrValue{Name: "type", Value: "date"},
+	DatetimeLocal: AttrValue{Name: "type", Value: "datetime-local"},
+	Email:         AttrValue{Name: "type", Value: "email"},
+	File:          AttrValue{Name: "type", Value: "file"},
+	Hidden:        AttrValue{Name: "type", Value: "hidden"},
+	Image:         AttrValue{Name: "type", Value: "image"},
+	Month:         AttrValue{Name: "type", Value: "month"},
+	Number:        AttrValue{Name: "type", Value: "number"},
+	Password:      AttrValue{Name: "type", Value: "password"},
+	Radio:         AttrValue{Name: "type", Value: "radio"},
+	Range:         AttrValue{Name: "type", Value: "range"},
+	Reset:         AttrValue{Name: "type", Value: "reset"},
+	Search:        AttrValue{Name: "type", Value: "search"},
+	Submit:        AttrValue{Name: "type", Value: "submit"},
+	Tel:           AttrValue{Name: "type", Value: "tel"},
+	Text:          AttrValue{Name: "type", Value: "text"},
+	Time:          AttrValue{Name: "type", Value: "time"},
+	URL:           AttrValue{Name: "type", Value: "url"},
+	Week:          AttrValue{Name: "type", Value: "week"},
+}
+
+// UseMapAttr defines the html attribute "usemap", which defines the partial url of an image map associated with the element.
+func UseMapAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "usemap", Value: val}
+}
+
+// ValueAttr defines the html attribute "value", which defines the value of the element.
+func ValueAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "value", Value: val}
+}
+
+// WidthAttr defines the html attribute "width", which defines the width of images, videos, canvases and iframes.
+func WidthAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "width", Value: val}
+}
+
+// WrapAttr defines the html attribute "wrap", which indicates how the text of a textarea is wrapped when submitted.
+func WrapAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "wrap", Value: val}
+}
+
+// Wrap contains the values of the html attribute "wrap", eg 'Wrap.Hard'.
+var Wrap = struct {
+	Hard AttrValue
+	Soft AttrValue
+	Off  AttrValue
+}{
+	Hard: AttrValue{Name: "wrap", Value: "hard"},
+	Soft: AttrValue{Name: "wrap", Value: "soft"},
+	Off:  AttrValue{Name: "wrap", Value: "off"},
+}
+
+// AriaActivedescendantAttr defines the html attribute "aria-activedescendant", which identifies the currently active element when focus is on a composite widget.
+func AriaActivedescendantAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-activedescendant", Value: val}
+}
+
+// AriaAtomicAttr defines the html attribute "aria-atomic", which indicates whether assistive technologies present all of a changed region.
+func AriaAtomicAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-atomic", Value: val}
+}
+
+// AriaAtomic contains the values of the html attribute "aria-atomic", eg 'AriaAtomic.True'.
+var AriaAtomic = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-atomic", Value: "true"},
+	False: AttrValue{Name: "aria-atomic", Value: "false"},
+}
+
+// AriaAutocompleteAttr defines the html attribute "aria-autocomplete", which indicates whether input text can trigger the display of predictions.
+func AriaAutocompleteAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-autocomplete", Value: val}
+}
+
+// AriaAutocomplete contains the values of the html attribute "aria-autocomplete", eg 'AriaAutocomplete.None'.
+var AriaAutocomplete = struct {
+	None   AttrValue
+	Inline AttrValue
+	List   AttrValue
+	Both   AttrValue
+}{
+	None:   AttrValue{Name: "aria-autocomplete", Value: "none"},
+	Inline: AttrValue{Name: "aria-autocomplete", Value: "inline"},
+	List:   AttrValue{Name: "aria-autocomplete", Value: "list"},
+	Both:   AttrValue{Name: "aria-autocomplete", Value: "both"},
+}
+
+// AriaBusyAttr defines the html attribute "aria-busy", which indicates that the element is being modified.
+func AriaBusyAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-busy", Value: val}
+}
+
+// AriaBusy contains the values of the html attribute "aria-busy", eg 'AriaBusy.True'.
+var AriaBusy = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-busy", Value: "true"},
+	False: AttrValue{Name: "aria-busy", Value: "false"},
+}
+
+// AriaCheckedAttr defines the html attribute "aria-checked", which indicates the checked state of checkboxes, radio buttons and other widgets.
+func AriaCheckedAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-checked", Value: val}
+}
+
+// AriaChecked contains the values of the html attribute "aria-checked", eg 'AriaChecked.True'.
+var AriaChecked = struct {
+	True  AttrValue
+	False AttrValue
+	Mixed AttrValue
+}{
+	True:  AttrValue{Name: "aria-checked", Value: "true"},
+	False: AttrValue{Name: "aria-checked", Value: "false"},
+	Mixed: AttrValue{Name: "aria-checked", Value: "mixed"},
+}
+
+// AriaColCountAttr defines the html attribute "aria-colcount", which defines the total number of columns in a table, grid or treegrid.
+func AriaColCountAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-colcount", Value: val}
+}
+
+// AriaColIndexAttr defines the html attribute "aria-colindex", which defines the column index of the element within a table, grid or treegrid.
+func AriaColIndexAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-colindex", Value: val}
+}
+
+// AriaColSpanAttr defines the html attribute "aria-colspan", which defines the number of columns spanned by a cell within a table, grid or treegrid.
+func AriaColSpanAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-colspan", Value: val}
+}
+
+// AriaControlsAttr defines the html attribute "aria-controls", which identifies the elements whose contents or presence are controlled by the element.
+func AriaControlsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-controls", Value: val}
+}
+
+// AriaCurrentAttr defines the html attribute "aria-current", which indicates the element that represents the current item within a set of related elements.
+func AriaCurrentAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-current", Value: val}
+}
+
+// AriaCurrent contains the values of the html attribute "aria-current", eg 'AriaCurrent.Page'.
+var AriaCurrent = struct {
+	Page     AttrValue
+	Step     AttrValue
+	Location AttrValue
+	Date     AttrValue
+	Time     AttrValue
+	True     AttrValue
+	False    AttrValue
+}{
+	Page:     AttrValue{Name: "aria-current", Value: "page"},
+	Step:     AttrValue{Name: "aria-current", Value: "step"},
+	Location: AttrValue{Name: "aria-current", Value: "location"},
+	Date:     AttrValue{Name: "aria-current", Value: "date"},
+	Time:     AttrValue{Name: "aria-current", Value: "time"},
+	True:     AttrValue{Name: "aria-current", Value: "true"},
+	False:    AttrValue{Name: "aria-current", Value: "false"},
+}
+
+// AriaDescribedByAttr defines the html attribute "aria-describedby", which identifies the elements that describe the element.
+func AriaDescribedByAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-describedby", Value: val}
+}
+
+// AriaDetailsAttr defines the html attribute "aria-details", which identifies the element that provides a detailed description of the element.
+func AriaDetailsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-details", Value: val}
+}
+
+// AriaDisabledAttr defines the html attribute "aria-disabled", which indicates that the element is perceivable but disabled.
+func AriaDisabledAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-disabled", Value: val}
+}
+
+// AriaDisabled contains the values of the html attribute "aria-disabled", eg 'AriaDisabled.True'.
+var AriaDisabled = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-disabled", Value: "true"},
+	False: AttrValue{Name: "aria-disabled", Value: "false"},
+}
+
+// AriaErrorMessageAttr defines the html attribute "aria-errormessage", which identifies the element that provides an error message for the element.
+func AriaErrorMessageAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-errormessage", Value: val}
+}
+
+// AriaExpandedAttr defines the html attribute "aria-expanded", which indicates whether a grouping element owned or controlled by the element is expanded.
+func AriaExpandedAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-expanded", Value: val}
+}
+
+// AriaExpanded contains the values of the html attribute "aria-expanded", eg 'AriaExpanded.True'.
+var AriaExpanded = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-expanded", Value: "true"},
+	False: AttrValue{Name: "aria-expanded", Value: "false"},
+}
+
+// AriaFlowToAttr defines the html attribute "aria-flowto", which identifies the next elements in an alternate reading order of content.
+func AriaFlowToAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-flowto", Value: val}
+}
+
+// AriaHasPopupAttr defines the html attribute "aria-haspopup", which indicates the availability and type of interactive popup element triggered by the element.
+func AriaHasPopupAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-haspopup", Value: val}
+}
+
+// AriaHasPopup contains the values of the html attribute "aria-haspopup", eg 'AriaHasPopup.False'.
+var AriaHasPopup = struct {
+	False   AttrValue
+	True    AttrValue
+	Menu    AttrValue
+	ListBox AttrValue
+	Tree    AttrValue
+	Grid    AttrValue
+	Dialog  AttrValue
+}{
+	False:   AttrValue{Name: "aria-haspopup", Value: "false"},
+	True:    AttrValue{Name: "aria-haspopup", Value: "true"},
+	Menu:    AttrValue{Name: "aria-haspopup", Value: "menu"},
+	ListBox: AttrValue{Name: "aria-haspopup", Value: "listbox"},
+	Tree:    AttrValue{Name: "aria-haspopup", Value: "tree"},
+	Grid:    AttrValue{Name: "aria-haspopup", Value: "grid"},
+	Dialog:  AttrValue{Name: "aria-haspopup", Value: "dialog"},
+}
+
+// AriaHiddenAttr defines the html attribute "aria-hidden", which indicates whether the element is exposed to an accessibility api.
+func AriaHiddenAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-hidden", Value: val}
+}
+
+// AriaHidden contains the values of the html attribute "aria-hidden", eg 'AriaHidden.True'.
+var AriaHidden = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-hidden", Value: "true"},
+	False: AttrValue{Name: "aria-hidden", Value: "false"},
+}
+
+// AriaInvalidAttr defines the html attribute "aria-invalid", which indicates that the entered value does not conform to the format expected.
+func AriaInvalidAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-invalid", Value: val}
+}
+
+// AriaInvalid contains the values of the html attribute "aria-invalid", eg 'AriaInvalid.False'.
+var AriaInvalid = struct {
+	False    AttrValue
+	True     AttrValue
+	Grammar  AttrValue
+	Spelling AttrValue
+}{
+	False:    AttrValue{Name: "aria-invalid", Value: "false"},
+	True:     AttrValue{Name: "aria-invalid", Value: "true"},
+	Grammar:  AttrValue{Name: "aria-invalid", Value: "grammar"},
+	Spelling: AttrValue{Name: "aria-invalid", Value: "spelling"},
+}
+
+// AriaKeyShortcutsAttr defines the html attribute "aria-keyshortcuts", which indicates keyboard shortcuts implemented to activate or focus the element.
+func AriaKeyShortcutsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-keyshortcuts", Value: val}
+}
+
+// AriaLabelAttr defines the html attribute "aria-label", which defines a string value that labels the element.
+func AriaLabelAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-label", Value: val}
+}
+
+// AriaLabelledByAttr defines the html attribute "aria-labelledby", which identifies the elements that label the element.
+func AriaLabelledByAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-labelledby", Value: val}
+}
+
+// AriaLevelAttr defines the html attribute "aria-level", which defines the hierarchical level of the element within a structure.
+func AriaLevelAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-level", Value: val}
+}
+
+// AriaLiveAttr defines the html attribute "aria-live", which indicates that the element will be updated, and describes the updates assistive technologies can expect.
+func AriaLiveAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-live", Value: val}
+}
+
+// AriaLive contains the values of the html attribute "aria-live", eg 'AriaLive.Off'.
+var AriaLive = struct {
+	Off       AttrValue
+	Polite    AttrValue
+	Assertive AttrValue
+}{
+	Off:       AttrValue{Name: "aria-live", Value: "off"},
+	Polite:    AttrValue{Name: "aria-live", Value: "polite"},
+	Assertive: AttrValue{Name: "aria-live", Value: "assertive"},
+}
+
+// AriaModalAttr defines the html attribute "aria-modal", which indicates whether the element is modal when displayed.
+func AriaModalAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-modal", Value: val}
+}
+
+// AriaModal contains the values of the html attribute "aria-modal", eg 'AriaModal.True'.
+var AriaModal = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-modal", Value: "true"},
+	False: AttrValue{Name: "aria-modal", Value: "false"},
+}
+
+// AriaMultiLineAttr defines the html attribute "aria-multiline", which indicates whether a text box accepts multiple lines of input.
+func AriaMultiLineAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-multiline", Value: val}
+}
+
+// AriaMultiLine contains the values of the html attribute "aria-multiline", eg 'AriaMultiLine.True'.
+var AriaMultiLine = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-multiline", Value: "true"},
+	False: AttrValue{Name: "aria-multiline", Value: "false"},
+}
+
+// AriaMultiSelectableAttr defines the html attribute "aria-multiselectable", which indicates that the user can select more than one item from the descendants.
+func AriaMultiSelectableAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-multiselectable", Value: val}
+}
+
+// AriaMultiSelectable contains the values of the html attribute "aria-multiselectable", eg 'AriaMultiSelectable.True'.
+var AriaMultiSelectable = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-multiselectable", Value: "true"},
+	False: AttrValue{Name: "aria-multiselectable", Value: "false"},
+}
+
+// AriaOrientationAttr defines the html attribute "aria-orientation", which indicates whether the orientation of the element is horizontal, vertical or unknown.
+func AriaOrientationAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-orientation", Value: val}
+}
+
+// AriaOrientation contains the values of the html attribute "aria-orientation", eg 'AriaOrientation.Horizontal'.
+var AriaOrientation = struct {
+	Horizontal AttrValue
+	Vertical   AttrValue
+	Undefined  AttrValue
+}{
+	Horizontal: AttrValue{Name: "aria-orientation", Value: "horizontal"},
+	Vertical:   AttrValue{Name: "aria-orientation", Value: "vertical"},
+	Undefined:  AttrValue{Name: "aria-orientation", Value: "undefined"},
+}
+
+// AriaOwnsAttr defines the html attribute "aria-owns", which identifies elements which are children of the element where the dom hierarchy can not be used.
+func AriaOwnsAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-owns", Value: val}
+}
+
+// AriaPlaceholderAttr defines the html attribute "aria-placeholder", which defines a short hint to aid the user with data entry when the control has no value.
+func AriaPlaceholderAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-placeholder", Value: val}
+}
+
+// AriaPosInSetAttr defines the html attribute "aria-posinset", which defines the number or position of the element in the current set of listitems or treeitems.
+func AriaPosInSetAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-posinset", Value: val}
+}
+
+// AriaPressedAttr defines the html attribute "aria-pressed", which indicates the pressed state of toggle buttons.
+func AriaPressedAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-pressed", Value: val}
+}
+
+// AriaPressed contains the values of the html attribute "aria-pressed", eg 'AriaPressed.True'.
+var AriaPressed = struct {
+	True  AttrValue
+	False AttrValue
+	Mixed AttrValue
+}{
+	True:  AttrValue{Name: "aria-pressed", Value: "true"},
+	False: AttrValue{Name: "aria-pressed", Value: "false"},
+	Mixed: AttrValue{Name: "aria-pressed", Value: "mixed"},
+}
+
+// AriaReadOnlyAttr defines the html attribute "aria-readonly", which indicates that the element is not editable, but is otherwise operable.
+func AriaReadOnlyAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-readonly", Value: val}
+}
+
+// AriaReadOnly contains the values of the html attribute "aria-readonly", eg 'AriaReadOnly.True'.
+var AriaReadOnly = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-readonly", Value: "true"},
+	False: AttrValue{Name: "aria-readonly", Value: "false"},
+}
+
+// AriaRelevantAttr defines the html attribute "aria-relevant", which indicates the notifications triggered by modifications of a live region.
+func AriaRelevantAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-relevant", Value: val}
+}
+
+// AriaRequiredAttr defines the html attribute "aria-required", which indicates that user input is required on the element before a form may be submitted.
+func AriaRequiredAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-required", Value: val}
+}
+
+// AriaRequired contains the values of the html attribute "aria-required", eg 'AriaRequired.True'.
+var AriaRequired = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-required", Value: "true"},
+	False: AttrValue{Name: "aria-required", Value: "false"},
+}
+
+// AriaRoleDescriptionAttr defines the html attribute "aria-roledescription", which defines a human-readable description of the role of the element.
+func AriaRoleDescriptionAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-roledescription", Value: val}
+}
+
+// AriaRowCountAttr defines the html attribute "aria-rowcount", which defines the total number of rows in a table, grid or treegrid.
+func AriaRowCountAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-rowcount", Value: val}
+}
+
+// AriaRowIndexAttr defines the html attribute "aria-rowindex", which defines the row index of the element within a table, grid or treegrid.
+func AriaRowIndexAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-rowindex", Value: val}
+}
+
+// AriaRowSpanAttr defines the html attribute "aria-rowspan", which defines the number of rows spanned by a cell within a table, grid or treegrid.
+func AriaRowSpanAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-rowspan", Value: val}
+}
+
+// AriaSelectedAttr defines the html attribute "aria-selected", which indicates the selected state of the element.
+func AriaSelectedAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-selected", Value: val}
+}
+
+// AriaSelected contains the values of the html attribute "aria-selected", eg 'AriaSelected.True'.
+var AriaSelected = struct {
+	True  AttrValue
+	False AttrValue
+}{
+	True:  AttrValue{Name: "aria-selected", Value: "true"},
+	False: AttrValue{Name: "aria-selected", Value: "false"},
+}
+
+// AriaSetSizeAttr defines the html attribute "aria-setsize", which defines the number of items in the current set of listitems or treeitems.
+func AriaSetSizeAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-setsize", Value: val}
+}
+
+// AriaSortAttr defines the html attribute "aria-sort", which indicates whether items in a table or grid are sorted in ascending or descending order.
+func AriaSortAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-sort", Value: val}
+}
+
+// AriaSort contains the values of the html attribute "aria-sort", eg 'AriaSort.None'.
+var AriaSort = struct {
+	None       AttrValue
+	Ascending  AttrValue
+	Descending AttrValue
+	Other      AttrValue
+}{
+	None:       AttrValue{Name: "aria-sort", Value: "none"},
+	Ascending:  AttrValue{Name: "aria-sort", Value: "ascending"},
+	Descending: AttrValue{Name: "aria-sort", Value: "descending"},
+	Other:      AttrValue{Name: "aria-sort", Value: "other"},
+}
+
+// AriaValueMaxAttr defines the html attribute "aria-valuemax", which defines the maximum allowed value for a range widget.
+func AriaValueMaxAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-valuemax", Value: val}
+}
+
+// AriaValueMinAttr defines the html attribute "aria-valuemin", which defines the minimum allowed value for a range widget.
+func AriaValueMinAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-valuemin", Value: val}
+}
+
+// AriaValueNowAttr defines the html attribute "aria-valuenow", which defines the current value for a range widget.
+func AriaValueNowAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-valuenow", Value: val}
+}
+
+// AriaValueTextAttr defines the html attribute "aria-valuetext", which defines the human readable text alternative of the value for a range widget.
+func AriaValueTextAttr(val string) trees.Property {
+	return &trees.Attribute{Name: "aria-valuetext", Value: val}
+}
@@ -8,16 +8,6 @@ func CustomAttr(name, val string) trees.Property {
 	return &trees.Attribute{Name: name, Value: val}
 }
 
-// NameAttr defines attributes of type "Name" for html element types.
-func NameAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "name", Value: val}
-}
-
-// CheckedAttr defines attributes of type "Checked" for html element types.
-func CheckedAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "checked", Value: val}
-}
-
 // ClassAttr defines attributes of type "Class" for html element types.
 func ClassAttr(vals ...string) trees.Property {
 	return trees.NewClassList(vals...)
@@ -28,11 +18,6 @@ func ClassNameAttr(val string) trees.Property {
 	return &trees.Attribute{Name: "className", Value: val}
 }
 
-// AutofocusAttr defines attributes of type "Autofocus" for html element types.
-func AutofocusAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "autofocus", Value: val}
-}
-
 // IDAttr defines attributes of type "Id" for html element types..
 // This is rather special in that, it uses the after effect function field
 // to set the markups internal Id field to its value. This is intentional
@@ -42,36 +27,43 @@ func IDAttr(val string) trees.Property {
 }
 
 // HTMLForAttr defines attributes of type "HtmlFor" for html element types.
+//
+// Deprecated: Use ForAttr, as browsers only read the "for" attribute.
 func HTMLForAttr(val string) trees.Property {
 	return &trees.Attribute{Name: "htmlFor", Value: val}
 }
 
-// SrcAttr defines attributes of type "Src" for html element types.
-func SrcAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "src", Value: val}
+// DataAttr defines a custom data attribute of the giving name, written with
+// the 'data-' prefix, eg DataAttr("user-id", "20") sets 'data-user-id'.
+func DataAttr(name, val string) trees.Property {
+	return &trees.Attribute{Name: "data-" + name, Value: val}
 }
 
-// HrefAttr defines attributes of type "Href" for html element types.
-func HrefAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "href", Value: val}
+// AriaAttr defines an aria attribute of the giving name, written with the
+// 'aria-' prefix, eg AriaAttr("label", "Close") sets 'aria-label'.
+func AriaAttr(name, val string) trees.Property {
+	return &trees.Attribute{Name: "aria-" + name, Value: val}
 }
 
-// RelAttr defines attributes of type "Rel" for html element types.
-func RelAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "rel", Value: val}
+// AttrValue defines an attribute set to one of its enumerated values, eg
+// 'Dir.RTL'. It adds a new attribute to every element it applies to, allowing
+// the same value to be shared between elements.
+type AttrValue struct {
+	Name  string
+	Value string
 }
 
-// TypeAttr defines attributes of type "Type" for html element types.
-func TypeAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "type", Value: val}
+// Apply adds the attribute with the value to the element.
+func (a AttrValue) Apply(e *trees.Markup) {
+	(&trees.Attribute{Name: a.Name, Value: a.Value}).Apply(e)
 }
 
-// PlaceholderAttr defines attributes of type "Placeholder" for html element types.
-func PlaceholderAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "placeholder", Value: val}
+// Clone returns the value, which is never changed.
+func (a AttrValue) Clone() trees.Property {
+	return a
 }
 
-// ValueAttr defines attributes of type "Value" for html element types.
-func ValueAttr(val string) trees.Property {
-	return &trees.Attribute{Name: "value", Value: val}
+// Render returns the key and value for this attribute rendered.
+func (a AttrValue) Render() (string, string) {
+	return a.Name, a.Value
 }
@@ -0,0 +1,277 @@
+// +build ignore
+
+// The generation of the attribute and style helpers of the property package
+// runs from the html attributes, aria attributes and css properties listed in
+// spec.json.
+
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+	"unicode"
+)
+
+// globalKeywords contains the values every css property accepts.
+var globalKeywords = []string{"inherit", "initial", "unset"}
+
+type entry struct {
+	Name string `json:"name"`
+	Doc  string `json:"doc"`
+
+	// Go defines the name used for the function and values of the entry,
+	// defaulting to the name capitalized at every hyphen. Enum defines the name
+	// of the values where it is taken by a function, eg 'BorderStyles'.
+	Go   string `json:"go"`
+	Enum string `json:"enum"`
+
+	// Bool marks a boolean html attribute, whose function is suffixed with
+	// 'BoolAttr' and takes a bool, leaving the attribute out of the element
+	// when false.
+	Bool bool `json:"bool"`
+
+	// Descriptor marks a css descriptor of at-rules, eg 'src' of @font-face,
+	// which is known to the css package but has no style function.
+	Descriptor bool `json:"descriptor"`
+
+	// Legacy marks a boolean html attribute whose function took its value as a
+	// string before boolean attributes were generated, which keeps being
+	// generated beside the function taking a bool.
+	Legacy bool `json:"legacy"`
+
+	// Values lists the enumerated values of the entry, with Set naming a list
+	// of values shared between entries in place of it. Names defines the field
+	// names of values which do not capitalize well.
+	Values []string          `json:"values"`
+	Set    string            `json:"set"`
+	Names  map[string]string `json:"names"`
+}
+
+type spec struct {
+	Sets       map[string][]string `json:"sets"`
+	Attributes []entry             `json:"attributes"`
+	Aria       []entry             `json:"aria"`
+	Properties []entry             `json:"properties"`
+}
+
+func main() {
+	data, err := ioutil.ReadFile("./spec.json")
+	if err != nil {
+		log.Fatalf("Unable to locate `spec.json` file: %s", err)
+	}
+
+	var sc spec
+	if err := json.Unmarshal(data, &sc); err != nil {
+		log.Fatalf("Unable to decode `spec.json` file: %s", err)
+	}
+
+	// names contains the entries by the names of their functions and values,
+	// which must be unique within the package.
+	names := map[string]string{}
+	for _, attr := range append(sc.Attributes, sc.Aria...) {
+		if !attr.Bool || attr.Legacy {
+			names[goName(attr)+"Attr"] = attr.Name
+		}
+
+		if attr.Bool {
+			names[goName(attr)+"BoolAttr"] = attr.Name
+		}
+	}
+	for _, prop := range sc.Properties {
+		if !prop.Descriptor {
+			names[goName(prop)+"Style"] = prop.Name
+		}
+	}
+
+	var attrs bytes.Buffer
+	fmt.Fprint(&attrs, `// Package property contains attributes and styles for html element types,
+// with typed values for enumerated attributes and css properties, eg
+// 'Display.Flex'.
+
+//go:generate go run generate.go
+
+// Documentation source: "HTML attribute reference", "ARIA" and "CSS reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web, licensed under CC-BY-SA 2.5.
+
+package property
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+`)
+
+	for _, attr := range append(sc.Attributes, sc.Aria...) {
+		writeAttr(&attrs, attr)
+		writeValues(&attrs, names, attr, "html attribute", "AttrValue", sc.valuesOf(attr))
+	}
+
+	var styles bytes.Buffer
+	fmt.Fprint(&styles, `package property
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+`)
+
+	for _, prop := range sc.Properties {
+		if prop.Descriptor {
+			continue
+		}
+
+		writeStyle(&styles, prop)
+
+		if enumerated := sc.valuesOf(prop); len(enumerated) != 0 {
+			writeValues(&styles, names, prop, "css property", "StyleValue", append(append([]string{}, enumerated...), globalKeywords...))
+		}
+	}
+
+	writeGo("attrs.gen.go", attrs.Bytes())
+	writeGo("styles.gen.go", styles.Bytes())
+}
+
+// valuesOf returns the enumerated values of the entry.
+func (sc spec) valuesOf(e entry) []string {
+	if e.Set == "" {
+		return e.Values
+	}
+
+	set, ok := sc.Sets[e.Set]
+	if !ok {
+		log.Fatalf("Unknown set %q of %q", e.Set, e.Name)
+	}
+
+	return set
+}
+
+// writeGo formats and writes the go source into the giving file.
+func writeGo(file string, src []byte) {
+	source, err := format.Source(src)
+	if err != nil {
+		log.Fatalf("Unable to format %q: %s", file, err)
+	}
+
+	if err := ioutil.WriteFile(file, source, 0644); err != nil {
+		log.Fatalf("Unable to write %q: %s", file, err)
+	}
+}
+
+func writeAttr(w *bytes.Buffer, attr entry) {
+	funName := goName(attr) + "Attr"
+
+	if attr.Bool && attr.Legacy {
+		fmt.Fprintf(w, `
+// %s defines the html attribute %q, which %s.
+//
+// Deprecated: Use %sBoolAttr, as the attribute is set for any value, including "false".
+func %s(val string) trees.Property {
+	return &trees.Attribute{Name: %q, Value: val}
+}
+`, funName, attr.Name, attr.Doc, goName(attr), funName, attr.Name)
+	}
+
+	if attr.Bool {
+		funName = goName(attr) + "BoolAttr"
+
+		fmt.Fprintf(w, `
+// %s defines the boolean html attribute %q, which %s.
+// It is left out of the element when false.
+func %s(val bool) trees.Property {
+	return &trees.BoolAttribute{Name: %q, Value: val}
+}
+`, funName, attr.Name, attr.Doc, funName, attr.Name)
+		return
+	}
+
+	fmt.Fprintf(w, `
+// %s defines the html attribute %q, which %s.
+func %s(val string) trees.Property {
+	return &trees.Attribute{Name: %q, Value: val}
+}
+`, funName, attr.Name, attr.Doc, funName, attr.Name)
+}
+
+func writeStyle(w *bytes.Buffer, prop entry) {
+	funName := goName(prop) + "Style"
+
+	fmt.Fprintf(w, `
+// %s sets the css property %q, which %s.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/%s
+func %s(value string) trees.Property {
+	return trees.NewCSSStyle(%q, value)
+}
+`, funName, prop.Name, prop.Doc, prop.Name, funName, prop.Name)
+}
+
+// writeValues writes the enumerated values of the entry as the fields of a
+// variable named after it, failing if the name is taken by another entry.
+func writeValues(w *bytes.Buffer, names map[string]string, e entry, kind string, valueType string, values []string) {
+	if len(values) == 0 {
+		return
+	}
+
+	varName := e.Enum
+	if varName == "" {
+		varName = goName(e)
+	}
+
+	if owner, ok := names[varName]; ok {
+		log.Fatalf("Values of %q are named %q, which is taken by %q", e.Name, varName, owner)
+	}
+	names[varName] = e.Name
+
+	fields := make(map[string]bool, len(values))
+	first := valueName(e, values[0])
+
+	fmt.Fprintf(w, "\n// %s contains the values of the %s %q, eg '%s.%s'.\nvar %s = struct {\n", varName, kind, e.Name, varName, first, varName)
+	for _, value := range values {
+		field := valueName(e, value)
+		if fields[field] {
+			log.Fatalf("Value %q of %q is named %q, which is taken", value, e.Name, field)
+		}
+		fields[field] = true
+
+		fmt.Fprintf(w, "\t%s %s\n", field, valueType)
+	}
+
+	fmt.Fprint(w, "}{\n")
+	for _, value := range values {
+		fmt.Fprintf(w, "\t%s: %s{Name: %q, Value: %q},\n", valueName(e, value), valueType, e.Name, value)
+	}
+	fmt.Fprint(w, "}\n")
+}
+
+// goName returns the name used for the function and values of the entry.
+func goName(e entry) string {
+	if e.Go != "" {
+		return e.Go
+	}
+
+	return restruct(e.Name)
+}
+
+// valueName returns the field name of the value of the entry.
+func valueName(e entry, value string) string {
+	if name, ok := e.Names[value]; ok {
+		return name
+	}
+
+	return restruct(value)
+}
+
+// restruct returns the name capitalized at every character which is not a
+// letter or digit, eg 'InlineBlock'.
+func restruct(name string) string {
+	parts := strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	for index, part := range parts {
+		parts[index] = strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	return strings.Join(parts, "")
+}
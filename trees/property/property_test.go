@@ -0,0 +1,80 @@
+package property_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/gu-io/gu/trees"
+	"github.com/gu-io/gu/trees/elems"
+	"github.com/gu-io/gu/trees/property"
+	"github.com/influx6/faux/tests"
+)
+
+func TestBoolAttributes(t *testing.T) {
+	checked := elems.Input(property.TypeAttr("checkbox"), property.CheckedBoolAttr(true)).HTML()
+	if !strings.Contains(checked, `checked="checked"`) {
+		tests.Failed("Should have rendered true boolean attribute: %q", checked)
+	}
+	tests.Passed("Should have rendered true boolean attribute")
+
+	unchecked := elems.Input(property.TypeAttr("checkbox"), property.CheckedBoolAttr(false)).HTML()
+	if strings.Contains(unchecked, "checked") {
+		tests.Failed("Should have left out false boolean attribute: %q", unchecked)
+	}
+	tests.Passed("Should have left out false boolean attribute")
+
+	input := elems.Input(property.DisabledBoolAttr(true), property.DisabledBoolAttr(false))
+	if _, err := trees.GetAttr(input, "disabled"); err == nil {
+		tests.Failed("Should have removed boolean attribute set earlier when false")
+	}
+	tests.Passed("Should have removed boolean attribute set earlier when false")
+
+	legacy := elems.Input(property.CheckedAttr("checked"), property.AutofocusAttr("autofocus")).HTML()
+	if !strings.Contains(legacy, `checked="checked"`) || !strings.Contains(legacy, `autofocus="autofocus"`) {
+		tests.Failed("Should have kept string values of legacy boolean attributes: %q", legacy)
+	}
+	tests.Passed("Should have kept string values of legacy boolean attributes")
+
+	label := elems.Label(property.HTMLForAttr("email"), property.ForAttr("email"))
+	if !trees.AttrContains(label, "htmlFor", "email") || !trees.AttrContains(label, "for", "email") {
+		tests.Failed("Should have kept htmlFor of legacy attribute beside for: %q", label.HTML())
+	}
+	tests.Passed("Should have kept htmlFor of legacy attribute beside for")
+}
+
+func TestValues(t *testing.T) {
+	first := elems.Div(property.Display.Flex, property.Dir.RTL)
+	second := elems.Div(property.Display.Flex)
+
+	if !trees.StyleContains(first, "display", "flex") || !trees.StyleContains(second, "display", "flex") {
+		tests.Failed("Should have applied style value to every element")
+	}
+	tests.Passed("Should have applied style value to every element")
+
+	trees.ReplaceStyle(first, "display", "none")
+	if !trees.StyleContains(second, "display", "flex") || property.Display.Flex.Value != "flex" {
+		tests.Failed("Should have kept style value when the style of an element changed")
+	}
+	tests.Passed("Should have kept style value when the style of an element changed")
+
+	if !trees.AttrContains(first, "dir", "rtl") {
+		tests.Failed("Should have applied attribute value to element")
+	}
+	tests.Passed("Should have applied attribute value to element")
+
+	if property.BorderStyles.Dashed.Value != "dashed" || property.Overflow.Inherit.Value != "inherit" {
+		tests.Failed("Should have generated values of css properties with global keywords")
+	}
+	tests.Passed("Should have generated values of css properties with global keywords")
+}
+
+func TestPrefixedAttributes(t *testing.T) {
+	div := elems.Div(property.DataAttr("user-id", "20"), property.AriaAttr("label", "Close"), property.AriaHidden.False)
+
+	for name, value := range map[string]string{"data-user-id": "20", "aria-label": "Close", "aria-hidden": "false"} {
+		if !trees.AttrContains(div, name, value) {
+			tests.Failed("Should have applied attribute %q with value %q", name, value)
+		}
+	}
+	tests.Passed("Should have applied data and aria attributes")
+}
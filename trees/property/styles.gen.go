@@ -0,0 +1,3215 @@
+package property
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+
+// AlignContentStyle sets the css property "align-content", which sets the distribution of space between and around content items along the cross axis.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/align-content
+func AlignContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("align-content", value)
+}
+
+// AlignContent contains the values of the css property "align-content", eg 'AlignContent.Normal'.
+var AlignContent = struct {
+	Normal       StyleValue
+	Start        StyleValue
+	End          StyleValue
+	Center       StyleValue
+	FlexStart    StyleValue
+	FlexEnd      StyleValue
+	SpaceBetween StyleValue
+	SpaceAround  StyleValue
+	SpaceEvenly  StyleValue
+	Stretch      StyleValue
+	Inherit      StyleValue
+	Initial      StyleValue
+	Unset        StyleValue
+}{
+	Normal:       StyleValue{Name: "align-content", Value: "normal"},
+	Start:        StyleValue{Name: "align-content", Value: "start"},
+	End:          StyleValue{Name: "align-content", Value: "end"},
+	Center:       StyleValue{Name: "align-content", Value: "center"},
+	FlexStart:    StyleValue{Name: "align-content", Value: "flex-start"},
+	FlexEnd:      StyleValue{Name: "align-content", Value: "flex-end"},
+	SpaceBetween: StyleValue{Name: "align-content", Value: "space-between"},
+	SpaceAround:  StyleValue{Name: "align-content", Value: "space-around"},
+	SpaceEvenly:  StyleValue{Name: "align-content", Value: "space-evenly"},
+	Stretch:      StyleValue{Name: "align-content", Value: "stretch"},
+	Inherit:      StyleValue{Name: "align-content", Value: "inherit"},
+	Initial:      StyleValue{Name: "align-content", Value: "initial"},
+	Unset:        StyleValue{Name: "align-content", Value: "unset"},
+}
+
+// AlignItemsStyle sets the css property "align-items", which sets the alignment of the items of a flex or grid container along the cross axis.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/align-items
+func AlignItemsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("align-items", value)
+}
+
+// AlignItems contains the values of the css property "align-items", eg 'AlignItems.Normal'.
+var AlignItems = struct {
+	Normal    StyleValue
+	Stretch   StyleValue
+	Center    StyleValue
+	Start     StyleValue
+	End       StyleValue
+	FlexStart StyleValue
+	FlexEnd   StyleValue
+	Baseline  StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Normal:    StyleValue{Name: "align-items", Value: "normal"},
+	Stretch:   StyleValue{Name: "align-items", Value: "stretch"},
+	Center:    StyleValue{Name: "align-items", Value: "center"},
+	Start:     StyleValue{Name: "align-items", Value: "start"},
+	End:       StyleValue{Name: "align-items", Value: "end"},
+	FlexStart: StyleValue{Name: "align-items", Value: "flex-start"},
+	FlexEnd:   StyleValue{Name: "align-items", Value: "flex-end"},
+	Baseline:  StyleValue{Name: "align-items", Value: "baseline"},
+	Inherit:   StyleValue{Name: "align-items", Value: "inherit"},
+	Initial:   StyleValue{Name: "align-items", Value: "initial"},
+	Unset:     StyleValue{Name: "align-items", Value: "unset"},
+}
+
+// AlignSelfStyle sets the css property "align-self", which overrides the align-items value of the container for the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/align-self
+func AlignSelfStyle(value string) trees.Property {
+	return trees.NewCSSStyle("align-self", value)
+}
+
+// AlignSelf contains the values of the css property "align-self", eg 'AlignSelf.Auto'.
+var AlignSelf = struct {
+	Auto      StyleValue
+	Normal    StyleValue
+	Stretch   StyleValue
+	Center    StyleValue
+	Start     StyleValue
+	End       StyleValue
+	FlexStart StyleValue
+	FlexEnd   StyleValue
+	Baseline  StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Auto:      StyleValue{Name: "align-self", Value: "auto"},
+	Normal:    StyleValue{Name: "align-self", Value: "normal"},
+	Stretch:   StyleValue{Name: "align-self", Value: "stretch"},
+	Center:    StyleValue{Name: "align-self", Value: "center"},
+	Start:     StyleValue{Name: "align-self", Value: "start"},
+	End:       StyleValue{Name: "align-self", Value: "end"},
+	FlexStart: StyleValue{Name: "align-self", Value: "flex-start"},
+	FlexEnd:   StyleValue{Name: "align-self", Value: "flex-end"},
+	Baseline:  StyleValue{Name: "align-self", Value: "baseline"},
+	Inherit:   StyleValue{Name: "align-self", Value: "inherit"},
+	Initial:   StyleValue{Name: "align-self", Value: "initial"},
+	Unset:     StyleValue{Name: "align-self", Value: "unset"},
+}
+
+// AllStyle sets the css property "all", which resets all the properties of the element, except unicode-bidi and direction, to their initial, inherited or unset value.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/all
+func AllStyle(value string) trees.Property {
+	return trees.NewCSSStyle("all", value)
+}
+
+// AnimationStyle sets the css property "animation", which is a shorthand which applies an animation between styles.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation
+func AnimationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation", value)
+}
+
+// AnimationDelayStyle sets the css property "animation-delay", which sets the time to wait before starting an animation.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-delay
+func AnimationDelayStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-delay", value)
+}
+
+// AnimationDirectionStyle sets the css property "animation-direction", which sets whether an animation plays forwards, backwards, or alternates.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-direction
+func AnimationDirectionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-direction", value)
+}
+
+// AnimationDirection contains the values of the css property "animation-direction", eg 'AnimationDirection.Normal'.
+var AnimationDirection = struct {
+	Normal           StyleValue
+	Reverse          StyleValue
+	Alternate        StyleValue
+	AlternateReverse StyleValue
+	Inherit          StyleValue
+	Initial          StyleValue
+	Unset            StyleValue
+}{
+	Normal:           StyleValue{Name: "animation-direction", Value: "normal"},
+	Reverse:          StyleValue{Name: "animation-direction", Value: "reverse"},
+	Alternate:        StyleValue{Name: "animation-direction", Value: "alternate"},
+	AlternateReverse: StyleValue{Name: "animation-direction", Value: "alternate-reverse"},
+	Inherit:          StyleValue{Name: "animation-direction", Value: "inherit"},
+	Initial:          StyleValue{Name: "animation-direction", Value: "initial"},
+	Unset:            StyleValue{Name: "animation-direction", Value: "unset"},
+}
+
+// AnimationDurationStyle sets the css property "animation-duration", which sets the length of time an animation takes to complete one cycle.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-duration
+func AnimationDurationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-duration", value)
+}
+
+// AnimationFillModeStyle sets the css property "animation-fill-mode", which sets how an animation applies styles before and after its execution.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-fill-mode
+func AnimationFillModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-fill-mode", value)
+}
+
+// AnimationFillMode contains the values of the css property "animation-fill-mode", eg 'AnimationFillMode.None'.
+var AnimationFillMode = struct {
+	None      StyleValue
+	Forwards  StyleValue
+	Backwards StyleValue
+	Both      StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	None:      StyleValue{Name: "animation-fill-mode", Value: "none"},
+	Forwards:  StyleValue{Name: "animation-fill-mode", Value: "forwards"},
+	Backwards: StyleValue{Name: "animation-fill-mode", Value: "backwards"},
+	Both:      StyleValue{Name: "animation-fill-mode", Value: "both"},
+	Inherit:   StyleValue{Name: "animation-fill-mode", Value: "inherit"},
+	Initial:   StyleValue{Name: "animation-fill-mode", Value: "initial"},
+	Unset:     StyleValue{Name: "animation-fill-mode", Value: "unset"},
+}
+
+// AnimationIterationCountStyle sets the css property "animation-iteration-count", which sets the number of times an animation is played.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-iteration-count
+func AnimationIterationCountStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-iteration-count", value)
+}
+
+// AnimationNameStyle sets the css property "animation-name", which specifies the names of the keyframes applied to the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-name
+func AnimationNameStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-name", value)
+}
+
+// AnimationPlayStateStyle sets the css property "animation-play-state", which sets whether an animation is running or paused.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-play-state
+func AnimationPlayStateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-play-state", value)
+}
+
+// AnimationPlayState contains the values of the css property "animation-play-state", eg 'AnimationPlayState.Running'.
+var AnimationPlayState = struct {
+	Running StyleValue
+	Paused  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Running: StyleValue{Name: "animation-play-state", Value: "running"},
+	Paused:  StyleValue{Name: "animation-play-state", Value: "paused"},
+	Inherit: StyleValue{Name: "animation-play-state", Value: "inherit"},
+	Initial: StyleValue{Name: "animation-play-state", Value: "initial"},
+	Unset:   StyleValue{Name: "animation-play-state", Value: "unset"},
+}
+
+// AnimationTimingFunctionStyle sets the css property "animation-timing-function", which sets how an animation progresses through the duration of each cycle.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/animation-timing-function
+func AnimationTimingFunctionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("animation-timing-function", value)
+}
+
+// AnimationTimingFunction contains the values of the css property "animation-timing-function", eg 'AnimationTimingFunction.Ease'.
+var AnimationTimingFunction = struct {
+	Ease      StyleValue
+	EaseIn    StyleValue
+	EaseOut   StyleValue
+	EaseInOut StyleValue
+	Linear    StyleValue
+	StepStart StyleValue
+	StepEnd   StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Ease:      StyleValue{Name: "animation-timing-function", Value: "ease"},
+	EaseIn:    StyleValue{Name: "animation-timing-function", Value: "ease-in"},
+	EaseOut:   StyleValue{Name: "animation-timing-function", Value: "ease-out"},
+	EaseInOut: StyleValue{Name: "animation-timing-function", Value: "ease-in-out"},
+	Linear:    StyleValue{Name: "animation-timing-function", Value: "linear"},
+	StepStart: StyleValue{Name: "animation-timing-function", Value: "step-start"},
+	StepEnd:   StyleValue{Name: "animation-timing-function", Value: "step-end"},
+	Inherit:   StyleValue{Name: "animation-timing-function", Value: "inherit"},
+	Initial:   StyleValue{Name: "animation-timing-function", Value: "initial"},
+	Unset:     StyleValue{Name: "animation-timing-function", Value: "unset"},
+}
+
+// AppearanceStyle sets the css property "appearance", which controls the native appearance of ui controls.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/appearance
+func AppearanceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("appearance", value)
+}
+
+// Appearance contains the values of the css property "appearance", eg 'Appearance.None'.
+var Appearance = struct {
+	None    StyleValue
+	Auto    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	None:    StyleValue{Name: "appearance", Value: "none"},
+	Auto:    StyleValue{Name: "appearance", Value: "auto"},
+	Inherit: StyleValue{Name: "appearance", Value: "inherit"},
+	Initial: StyleValue{Name: "appearance", Value: "initial"},
+	Unset:   StyleValue{Name: "appearance", Value: "unset"},
+}
+
+// AspectRatioStyle sets the css property "aspect-ratio", which sets a preferred aspect ratio for the box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/aspect-ratio
+func AspectRatioStyle(value string) trees.Property {
+	return trees.NewCSSStyle("aspect-ratio", value)
+}
+
+// BackdropFilterStyle sets the css property "backdrop-filter", which applies graphical effects to the area behind the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/backdrop-filter
+func BackdropFilterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("backdrop-filter", value)
+}
+
+// BackfaceVisibilityStyle sets the css property "backface-visibility", which sets whether the back face of the element is visible when turned towards the user.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/backface-visibility
+func BackfaceVisibilityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("backface-visibility", value)
+}
+
+// BackfaceVisibility contains the values of the css property "backface-visibility", eg 'BackfaceVisibility.Visible'.
+var BackfaceVisibility = struct {
+	Visible StyleValue
+	Hidden  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Visible: StyleValue{Name: "backface-visibility", Value: "visible"},
+	Hidden:  StyleValue{Name: "backface-visibility", Value: "hidden"},
+	Inherit: StyleValue{Name: "backface-visibility", Value: "inherit"},
+	Initial: StyleValue{Name: "backface-visibility", Value: "initial"},
+	Unset:   StyleValue{Name: "backface-visibility", Value: "unset"},
+}
+
+// BackgroundStyle sets the css property "background", which is a shorthand which sets all background style properties.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background
+func BackgroundStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background", value)
+}
+
+// BackgroundAttachmentStyle sets the css property "background-attachment", which sets whether the position of a background image is fixed or scrolls.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-attachment
+func BackgroundAttachmentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-attachment", value)
+}
+
+// BackgroundAttachment contains the values of the css property "background-attachment", eg 'BackgroundAttachment.Scroll'.
+var BackgroundAttachment = struct {
+	Scroll  StyleValue
+	Fixed   StyleValue
+	Local   StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Scroll:  StyleValue{Name: "background-attachment", Value: "scroll"},
+	Fixed:   StyleValue{Name: "background-attachment", Value: "fixed"},
+	Local:   StyleValue{Name: "background-attachment", Value: "local"},
+	Inherit: StyleValue{Name: "background-attachment", Value: "inherit"},
+	Initial: StyleValue{Name: "background-attachment", Value: "initial"},
+	Unset:   StyleValue{Name: "background-attachment", Value: "unset"},
+}
+
+// BackgroundBlendModeStyle sets the css property "background-blend-mode", which sets how the background images of the element blend with each other and with its background color.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-blend-mode
+func BackgroundBlendModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-blend-mode", value)
+}
+
+// BackgroundClipStyle sets the css property "background-clip", which sets whether the background extends underneath the border, padding or content box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-clip
+func BackgroundClipStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-clip", value)
+}
+
+// BackgroundClip contains the values of the css property "background-clip", eg 'BackgroundClip.BorderBox'.
+var BackgroundClip = struct {
+	BorderBox  StyleValue
+	PaddingBox StyleValue
+	ContentBox StyleValue
+	Text       StyleValue
+	Inherit    StyleValue
+	Initial    StyleValue
+	Unset      StyleValue
+}{
+	BorderBox:  StyleValue{Name: "background-clip", Value: "border-box"},
+	PaddingBox: StyleValue{Name: "background-clip", Value: "padding-box"},
+	ContentBox: StyleValue{Name: "background-clip", Value: "content-box"},
+	Text:       StyleValue{Name: "background-clip", Value: "text"},
+	Inherit:    StyleValue{Name: "background-clip", Value: "inherit"},
+	Initial:    StyleValue{Name: "background-clip", Value: "initial"},
+	Unset:      StyleValue{Name: "background-clip", Value: "unset"},
+}
+
+// BackgroundColorStyle sets the css property "background-color", which sets the background color of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-color
+func BackgroundColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-color", value)
+}
+
+// BackgroundImageStyle sets the css property "background-image", which sets one or more background images on the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-image
+func BackgroundImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-image", value)
+}
+
+// BackgroundOriginStyle sets the css property "background-origin", which sets the origin of the background from the border, padding or content box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-origin
+func BackgroundOriginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-origin", value)
+}
+
+// BackgroundOrigin contains the values of the css property "background-origin", eg 'BackgroundOrigin.BorderBox'.
+var BackgroundOrigin = struct {
+	BorderBox  StyleValue
+	PaddingBox StyleValue
+	ContentBox StyleValue
+	Inherit    StyleValue
+	Initial    StyleValue
+	Unset      StyleValue
+}{
+	BorderBox:  StyleValue{Name: "background-origin", Value: "border-box"},
+	PaddingBox: StyleValue{Name: "background-origin", Value: "padding-box"},
+	ContentBox: StyleValue{Name: "background-origin", Value: "content-box"},
+	Inherit:    StyleValue{Name: "background-origin", Value: "inherit"},
+	Initial:    StyleValue{Name: "background-origin", Value: "initial"},
+	Unset:      StyleValue{Name: "background-origin", Value: "unset"},
+}
+
+// BackgroundPositionStyle sets the css property "background-position", which sets the initial position of each background image.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-position
+func BackgroundPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-position", value)
+}
+
+// BackgroundPositionXStyle sets the css property "background-position-x", which sets the initial horizontal position of each background image.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-position-x
+func BackgroundPositionXStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-position-x", value)
+}
+
+// BackgroundPositionYStyle sets the css property "background-position-y", which sets the initial vertical position of each background image.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-position-y
+func BackgroundPositionYStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-position-y", value)
+}
+
+// BackgroundRepeatStyle sets the css property "background-repeat", which sets how background images are repeated.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-repeat
+func BackgroundRepeatStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-repeat", value)
+}
+
+// BackgroundRepeat contains the values of the css property "background-repeat", eg 'BackgroundRepeat.Repeat'.
+var BackgroundRepeat = struct {
+	Repeat   StyleValue
+	RepeatX  StyleValue
+	RepeatY  StyleValue
+	NoRepeat StyleValue
+	Space    StyleValue
+	Round    StyleValue
+	Inherit  StyleValue
+	Initial  StyleValue
+	Unset    StyleValue
+}{
+	Repeat:   StyleValue{Name: "background-repeat", Value: "repeat"},
+	RepeatX:  StyleValue{Name: "background-repeat", Value: "repeat-x"},
+	RepeatY:  StyleValue{Name: "background-repeat", Value: "repeat-y"},
+	NoRepeat: StyleValue{Name: "background-repeat", Value: "no-repeat"},
+	Space:    StyleValue{Name: "background-repeat", Value: "space"},
+	Round:    StyleValue{Name: "background-repeat", Value: "round"},
+	Inherit:  StyleValue{Name: "background-repeat", Value: "inherit"},
+	Initial:  StyleValue{Name: "background-repeat", Value: "initial"},
+	Unset:    StyleValue{Name: "background-repeat", Value: "unset"},
+}
+
+// BackgroundSizeStyle sets the css property "background-size", which sets the size of the background image of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/background-size
+func BackgroundSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("background-size", value)
+}
+
+// BackgroundSize contains the values of the css property "background-size", eg 'BackgroundSize.Auto'.
+var BackgroundSize = struct {
+	Auto    StyleValue
+	Cover   StyleValue
+	Contain StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Auto:    StyleValue{Name: "background-size", Value: "auto"},
+	Cover:   StyleValue{Name: "background-size", Value: "cover"},
+	Contain: StyleValue{Name: "background-size", Value: "contain"},
+	Inherit: StyleValue{Name: "background-size", Value: "inherit"},
+	Initial: StyleValue{Name: "background-size", Value: "initial"},
+	Unset:   StyleValue{Name: "background-size", Value: "unset"},
+}
+
+// BlockSizeStyle sets the css property "block-size", which sets the size of the element in the block direction of the writing mode.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/block-size
+func BlockSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("block-size", value)
+}
+
+// BorderStyle sets the css property "border", which is a shorthand which sets the border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border
+func BorderStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border", value)
+}
+
+// BorderBlockStyle sets the css property "border-block", which is a shorthand which sets the logical block borders of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-block
+func BorderBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block", value)
+}
+
+// BorderBlockEndStyle sets the css property "border-block-end", which is a shorthand which sets the logical block-end border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-block-end
+func BorderBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-end", value)
+}
+
+// BorderBlockStartStyle sets the css property "border-block-start", which is a shorthand which sets the logical block-start border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-block-start
+func BorderBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-block-start", value)
+}
+
+// BorderBottomStyle sets the css property "border-bottom", which is a shorthand which sets the bottom border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-bottom
+func BorderBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom", value)
+}
+
+// BorderBottomColorStyle sets the css property "border-bottom-color", which sets the color of the bottom border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-bottom-color
+func BorderBottomColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-color", value)
+}
+
+// BorderBottomLeftRadiusStyle sets the css property "border-bottom-left-radius", which rounds the bottom-left corner of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-bottom-left-radius
+func BorderBottomLeftRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-left-radius", value)
+}
+
+// BorderBottomRightRadiusStyle sets the css property "border-bottom-right-radius", which rounds the bottom-right corner of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-bottom-right-radius
+func BorderBottomRightRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-right-radius", value)
+}
+
+// BorderBottomStyleStyle sets the css property "border-bottom-style", which sets the line style of the bottom border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-bottom-style
+func BorderBottomStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-style", value)
+}
+
+// BorderBottomStyles contains the values of the css property "border-bottom-style", eg 'BorderBottomStyles.None'.
+var BorderBottomStyles = struct {
+	None    StyleValue
+	Hidden  StyleValue
+	Dotted  StyleValue
+	Dashed  StyleValue
+	Solid   StyleValue
+	Double  StyleValue
+	Groove  StyleValue
+	Ridge   StyleValue
+	Inset   StyleValue
+	Outset  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	None:    StyleValue{Name: "border-bottom-style", Value: "none"},
+	Hidden:  StyleValue{Name: "border-bottom-style", Value: "hidden"},
+	Dotted:  StyleValue{Name: "border-bottom-style", Value: "dotted"},
+	Dashed:  StyleValue{Name: "border-bottom-style", Value: "dashed"},
+	Solid:   StyleValue{Name: "border-bottom-style", Value: "solid"},
+	Double:  StyleValue{Name: "border-bottom-style", Value: "double"},
+	Groove:  StyleValue{Name: "border-bottom-style", Value: "groove"},
+	Ridge:   StyleValue{Name: "border-bottom-style", Value: "ridge"},
+	Inset:   StyleValue{Name: "border-bottom-style", Value: "inset"},
+	Outset:  StyleValue{Name: "border-bottom-style", Value: "outset"},
+	Inherit: StyleValue{Name: "border-bottom-style", Value: "inherit"},
+	Initial: StyleValue{Name: "border-bottom-style", Value: "initial"},
+	Unset:   StyleValue{Name: "border-bottom-style", Value: "unset"},
+}
+
+// BorderBottomWidthStyle sets the css property "border-bottom-width", which sets the width of the bottom border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-bottom-width
+func BorderBottomWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-bottom-width", value)
+}
+
+// BorderCollapseStyle sets the css property "border-collapse", which sets whether cells inside a table have shared or separate borders.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-collapse
+func BorderCollapseStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-collapse", value)
+}
+
+// BorderCollapse contains the values of the css property "border-collapse", eg 'BorderCollapse.Collapse'.
+var BorderCollapse = struct {
+	Collapse StyleValue
+	Separate StyleValue
+	Inherit  StyleValue
+	Initial  StyleValue
+	Unset    StyleValue
+}{
+	Collapse: StyleValue{Name: "border-collapse", Value: "collapse"},
+	Separate: StyleValue{Name: "border-collapse", Value: "separate"},
+	Inherit:  StyleValue{Name: "border-collapse", Value: "inherit"},
+	Initial:  StyleValue{Name: "border-collapse", Value: "initial"},
+	Unset:    StyleValue{Name: "border-collapse", Value: "unset"},
+}
+
+// BorderColorStyle sets the css property "border-color", which sets the color of the borders of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-color
+func BorderColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-color", value)
+}
+
+// BorderImageStyle sets the css property "border-image", which draws an image around the element, replacing its border style.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-image
+func BorderImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image", value)
+}
+
+// BorderImageOutsetStyle sets the css property "border-image-outset", which sets the distance by which the border image extends beyond the border box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-image-outset
+func BorderImageOutsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-outset", value)
+}
+
+// BorderImageRepeatStyle sets the css property "border-image-repeat", which sets how the edge regions of the border image are adjusted to fit the border.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-image-repeat
+func BorderImageRepeatStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-repeat", value)
+}
+
+// BorderImageSliceStyle sets the css property "border-image-slice", which divides the border image into regions used for the border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-image-slice
+func BorderImageSliceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-slice", value)
+}
+
+// BorderImageSourceStyle sets the css property "border-image-source", which sets the image used to draw the border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-image-source
+func BorderImageSourceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-source", value)
+}
+
+// BorderImageWidthStyle sets the css property "border-image-width", which sets the width of the border image.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-image-width
+func BorderImageWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-image-width", value)
+}
+
+// BorderInlineStyle sets the css property "border-inline", which is a shorthand which sets the logical inline borders of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-inline
+func BorderInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline", value)
+}
+
+// BorderInlineEndStyle sets the css property "border-inline-end", which is a shorthand which sets the logical inline-end border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-inline-end
+func BorderInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-end", value)
+}
+
+// BorderInlineStartStyle sets the css property "border-inline-start", which is a shorthand which sets the logical inline-start border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-inline-start
+func BorderInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-inline-start", value)
+}
+
+// BorderLeftStyle sets the css property "border-left", which is a shorthand which sets the left border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-left
+func BorderLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left", value)
+}
+
+// BorderLeftColorStyle sets the css property "border-left-color", which sets the color of the left border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-left-color
+func BorderLeftColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left-color", value)
+}
+
+// BorderLeftStyleStyle sets the css property "border-left-style", which sets the line style of the left border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-left-style
+func BorderLeftStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left-style", value)
+}
+
+// BorderLeftStyles contains the values of the css property "border-left-style", eg 'BorderLeftStyles.None'.
+var BorderLeftStyles = struct {
+	None    StyleValue
+	Hidden  StyleValue
+	Dotted  StyleValue
+	Dashed  StyleValue
+	Solid   StyleValue
+	Double  StyleValue
+	Groove  StyleValue
+	Ridge   StyleValue
+	Inset   StyleValue
+	Outset  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	None:    StyleValue{Name: "border-left-style", Value: "none"},
+	Hidden:  StyleValue{Name: "border-left-style", Value: "hidden"},
+	Dotted:  StyleValue{Name: "border-left-style", Value: "dotted"},
+	Dashed:  StyleValue{Name: "border-left-style", Value: "dashed"},
+	Solid:   StyleValue{Name: "border-left-style", Value: "solid"},
+	Double:  StyleValue{Name: "border-left-style", Value: "double"},
+	Groove:  StyleValue{Name: "border-left-style", Value: "groove"},
+	Ridge:   StyleValue{Name: "border-left-style", Value: "ridge"},
+	Inset:   StyleValue{Name: "border-left-style", Value: "inset"},
+	Outset:  StyleValue{Name: "border-left-style", Value: "outset"},
+	Inherit: StyleValue{Name: "border-left-style", Value: "inherit"},
+	Initial: StyleValue{Name: "border-left-style", Value: "initial"},
+	Unset:   StyleValue{Name: "border-left-style", Value: "unset"},
+}
+
+// BorderLeftWidthStyle sets the css property "border-left-width", which sets the width of the left border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-left-width
+func BorderLeftWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-left-width", value)
+}
+
+// BorderRadiusStyle sets the css property "border-radius", which rounds the corners of the outer border edge of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-radius
+func BorderRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-radius", value)
+}
+
+// BorderRightStyle sets the css property "border-right", which is a shorthand which sets the right border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-right
+func BorderRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right", value)
+}
+
+// BorderRightColorStyle sets the css property "border-right-color", which sets the color of the right border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-right-color
+func BorderRightColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right-color", value)
+}
+
+// BorderRightStyleStyle sets the css property "border-right-style", which sets the line style of the right border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-right-style
+func BorderRightStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right-style", value)
+}
+
+// BorderRightStyles contains the values of the css property "border-right-style", eg 'BorderRightStyles.None'.
+var BorderRightStyles = struct {
+	None    StyleValue
+	Hidden  StyleValue
+	Dotted  StyleValue
+	Dashed  StyleValue
+	Solid   StyleValue
+	Double  StyleValue
+	Groove  StyleValue
+	Ridge   StyleValue
+	Inset   StyleValue
+	Outset  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	None:    StyleValue{Name: "border-right-style", Value: "none"},
+	Hidden:  StyleValue{Name: "border-right-style", Value: "hidden"},
+	Dotted:  StyleValue{Name: "border-right-style", Value: "dotted"},
+	Dashed:  StyleValue{Name: "border-right-style", Value: "dashed"},
+	Solid:   StyleValue{Name: "border-right-style", Value: "solid"},
+	Double:  StyleValue{Name: "border-right-style", Value: "double"},
+	Groove:  StyleValue{Name: "border-right-style", Value: "groove"},
+	Ridge:   StyleValue{Name: "border-right-style", Value: "ridge"},
+	Inset:   StyleValue{Name: "border-right-style", Value: "inset"},
+	Outset:  StyleValue{Name: "border-right-style", Value: "outset"},
+	Inherit: StyleValue{Name: "border-right-style", Value: "inherit"},
+	Initial: StyleValue{Name: "border-right-style", Value: "initial"},
+	Unset:   StyleValue{Name: "border-right-style", Value: "unset"},
+}
+
+// BorderRightWidthStyle sets the css property "border-right-width", which sets the width of the right border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-right-width
+func BorderRightWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-right-width", value)
+}
+
+// BorderSpacingStyle sets the css property "border-spacing", which sets the distance between the borders of adjacent cells of a table.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-spacing
+func BorderSpacingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-spacing", value)
+}
+
+// BorderStyleStyle sets the css property "border-style", which sets the line style of the borders of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-style
+func BorderStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-style", value)
+}
+
+// BorderStyles contains the values of the css property "border-style", eg 'BorderStyles.None'.
+var BorderStyles = struct {
+	None    StyleValue
+	Hidden  StyleValue
+	Dotted  StyleValue
+	Dashed  StyleValue
+	Solid   StyleValue
+	Double  StyleValue
+	Groove  StyleValue
+	Ridge   StyleValue
+	Inset   StyleValue
+	Outset  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	None:    StyleValue{Name: "border-style", Value: "none"},
+	Hidden:  StyleValue{Name: "border-style", Value: "hidden"},
+	Dotted:  StyleValue{Name: "border-style", Value: "dotted"},
+	Dashed:  StyleValue{Name: "border-style", Value: "dashed"},
+	Solid:   StyleValue{Name: "border-style", Value: "solid"},
+	Double:  StyleValue{Name: "border-style", Value: "double"},
+	Groove:  StyleValue{Name: "border-style", Value: "groove"},
+	Ridge:   StyleValue{Name: "border-style", Value: "ridge"},
+	Inset:   StyleValue{Name: "border-style", Value: "inset"},
+	Outset:  StyleValue{Name: "border-style", Value: "outset"},
+	Inherit: StyleValue{Name: "border-style", Value: "inherit"},
+	Initial: StyleValue{Name: "border-style", Value: "initial"},
+	Unset:   StyleValue{Name: "border-style", Value: "unset"},
+}
+
+// BorderTopStyle sets the css property "border-top", which is a shorthand which sets the top border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-top
+func BorderTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top", value)
+}
+
+// BorderTopColorStyle sets the css property "border-top-color", which sets the color of the top border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-top-color
+func BorderTopColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-color", value)
+}
+
+// BorderTopLeftRadiusStyle sets the css property "border-top-left-radius", which rounds the top-left corner of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-top-left-radius
+func BorderTopLeftRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-left-radius", value)
+}
+
+// BorderTopRightRadiusStyle sets the css property "border-top-right-radius", which rounds the top-right corner of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-top-right-radius
+func BorderTopRightRadiusStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-right-radius", value)
+}
+
+// BorderTopStyleStyle sets the css property "border-top-style", which sets the line style of the top border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-top-style
+func BorderTopStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-style", value)
+}
+
+// BorderTopStyles contains the values of the css property "border-top-style", eg 'BorderTopStyles.None'.
+var BorderTopStyles = struct {
+	None    StyleValue
+	Hidden  StyleValue
+	Dotted  StyleValue
+	Dashed  StyleValue
+	Solid   StyleValue
+	Double  StyleValue
+	Groove  StyleValue
+	Ridge   StyleValue
+	Inset   StyleValue
+	Outset  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	None:    StyleValue{Name: "border-top-style", Value: "none"},
+	Hidden:  StyleValue{Name: "border-top-style", Value: "hidden"},
+	Dotted:  StyleValue{Name: "border-top-style", Value: "dotted"},
+	Dashed:  StyleValue{Name: "border-top-style", Value: "dashed"},
+	Solid:   StyleValue{Name: "border-top-style", Value: "solid"},
+	Double:  StyleValue{Name: "border-top-style", Value: "double"},
+	Groove:  StyleValue{Name: "border-top-style", Value: "groove"},
+	Ridge:   StyleValue{Name: "border-top-style", Value: "ridge"},
+	Inset:   StyleValue{Name: "border-top-style", Value: "inset"},
+	Outset:  StyleValue{Name: "border-top-style", Value: "outset"},
+	Inherit: StyleValue{Name: "border-top-style", Value: "inherit"},
+	Initial: StyleValue{Name: "border-top-style", Value: "initial"},
+	Unset:   StyleValue{Name: "border-top-style", Value: "unset"},
+}
+
+// BorderTopWidthStyle sets the css property "border-top-width", which sets the width of the top border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-top-width
+func BorderTopWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-top-width", value)
+}
+
+// BorderWidthStyle sets the css property "border-width", which sets the width of the borders of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/border-width
+func BorderWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("border-width", value)
+}
+
+// BottomStyle sets the css property "bottom", which participates in setting the vertical position of a positioned element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/bottom
+func BottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("bottom", value)
+}
+
+// BoxDecorationBreakStyle sets the css property "box-decoration-break", which sets how the fragments of the element are rendered when broken across lines, columns or pages.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/box-decoration-break
+func BoxDecorationBreakStyle(value string) trees.Property {
+	return trees.NewCSSStyle("box-decoration-break", value)
+}
+
+// BoxShadowStyle sets the css property "box-shadow", which adds shadow effects around the frame of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/box-shadow
+func BoxShadowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("box-shadow", value)
+}
+
+// BoxSizingStyle sets the css property "box-sizing", which sets how the total width and height of the element is calculated.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/box-sizing
+func BoxSizingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("box-sizing", value)
+}
+
+// BoxSizing contains the values of the css property "box-sizing", eg 'BoxSizing.ContentBox'.
+var BoxSizing = struct {
+	ContentBox StyleValue
+	BorderBox  StyleValue
+	Inherit    StyleValue
+	Initial    StyleValue
+	Unset      StyleValue
+}{
+	ContentBox: StyleValue{Name: "box-sizing", Value: "content-box"},
+	BorderBox:  StyleValue{Name: "box-sizing", Value: "border-box"},
+	Inherit:    StyleValue{Name: "box-sizing", Value: "inherit"},
+	Initial:    StyleValue{Name: "box-sizing", Value: "initial"},
+	Unset:      StyleValue{Name: "box-sizing", Value: "unset"},
+}
+
+// BreakAfterStyle sets the css property "break-after", which sets how page, column or region breaks behave after the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/break-after
+func BreakAfterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("break-after", value)
+}
+
+// BreakBeforeStyle sets the css property "break-before", which sets how page, column or region breaks behave before the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/break-before
+func BreakBeforeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("break-before", value)
+}
+
+// BreakInsideStyle sets the css property "break-inside", which sets how page, column or region breaks behave inside the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/break-inside
+func BreakInsideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("break-inside", value)
+}
+
+// CaptionSideStyle sets the css property "caption-side", which puts the content of the caption of a table on the specified side.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/caption-side
+func CaptionSideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("caption-side", value)
+}
+
+// CaptionSide contains the values of the css property "caption-side", eg 'CaptionSide.Top'.
+var CaptionSide = struct {
+	Top     StyleValue
+	Bottom  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Top:     StyleValue{Name: "caption-side", Value: "top"},
+	Bottom:  StyleValue{Name: "caption-side", Value: "bottom"},
+	Inherit: StyleValue{Name: "caption-side", Value: "inherit"},
+	Initial: StyleValue{Name: "caption-side", Value: "initial"},
+	Unset:   StyleValue{Name: "caption-side", Value: "unset"},
+}
+
+// CaretColorStyle sets the css property "caret-color", which sets the color of the insertion caret.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/caret-color
+func CaretColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("caret-color", value)
+}
+
+// ClearStyle sets the css property "clear", which sets whether the element must be moved below floating elements that precede it.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/clear
+func ClearStyle(value string) trees.Property {
+	return trees.NewCSSStyle("clear", value)
+}
+
+// Clear contains the values of the css property "clear", eg 'Clear.None'.
+var Clear = struct {
+	None        StyleValue
+	Left        StyleValue
+	Right       StyleValue
+	Both        StyleValue
+	InlineStart StyleValue
+	InlineEnd   StyleValue
+	Inherit     StyleValue
+	Initial     StyleValue
+	Unset       StyleValue
+}{
+	None:        StyleValue{Name: "clear", Value: "none"},
+	Left:        StyleValue{Name: "clear", Value: "left"},
+	Right:       StyleValue{Name: "clear", Value: "right"},
+	Both:        StyleValue{Name: "clear", Value: "both"},
+	InlineStart: StyleValue{Name: "clear", Value: "inline-start"},
+	InlineEnd:   StyleValue{Name: "clear", Value: "inline-end"},
+	Inherit:     StyleValue{Name: "clear", Value: "inherit"},
+	Initial:     StyleValue{Name: "clear", Value: "initial"},
+	Unset:       StyleValue{Name: "clear", Value: "unset"},
+}
+
+// ClipStyle sets the css property "clip", which defines the visible part of an absolutely positioned element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/clip
+func ClipStyle(value string) trees.Property {
+	return trees.NewCSSStyle("clip", value)
+}
+
+// ClipPathStyle sets the css property "clip-path", which creates a clipping region that sets what part of the element is shown.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/clip-path
+func ClipPathStyle(value string) trees.Property {
+	return trees.NewCSSStyle("clip-path", value)
+}
+
+// ColorStyle sets the css property "color", which sets the foreground color of the text and decorations of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/color
+func ColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("color", value)
+}
+
+// ColorSchemeStyle sets the css property "color-scheme", which indicates the color schemes the element can be rendered in.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/color-scheme
+func ColorSchemeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("color-scheme", value)
+}
+
+// ColumnCountStyle sets the css property "column-count", which breaks the content of the element into the specified number of columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-count
+func ColumnCountStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-count", value)
+}
+
+// ColumnFillStyle sets the css property "column-fill", which sets how the contents of the element are balanced when broken into columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-fill
+func ColumnFillStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-fill", value)
+}
+
+// ColumnGapStyle sets the css property "column-gap", which sets the size of the gap between the columns of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-gap
+func ColumnGapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-gap", value)
+}
+
+// ColumnRuleStyle sets the css property "column-rule", which is a shorthand which sets the line drawn between columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-rule
+func ColumnRuleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule", value)
+}
+
+// ColumnRuleColorStyle sets the css property "column-rule-color", which sets the color of the line drawn between columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-rule-color
+func ColumnRuleColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule-color", value)
+}
+
+// ColumnRuleStyleStyle sets the css property "column-rule-style", which sets the style of the line drawn between columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-rule-style
+func ColumnRuleStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule-style", value)
+}
+
+// ColumnRuleWidthStyle sets the css property "column-rule-width", which sets the width of the line drawn between columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-rule-width
+func ColumnRuleWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-rule-width", value)
+}
+
+// ColumnSpanStyle sets the css property "column-span", which makes the element span across all columns when set to all.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-span
+func ColumnSpanStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-span", value)
+}
+
+// ColumnWidthStyle sets the css property "column-width", which sets the ideal column width in a multi-column layout.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/column-width
+func ColumnWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("column-width", value)
+}
+
+// ColumnsStyle sets the css property "columns", which is a shorthand which sets the number of columns and their width.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/columns
+func ColumnsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("columns", value)
+}
+
+// ContainStyle sets the css property "contain", which indicates that the element and its contents are independent of the rest of the document tree.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/contain
+func ContainStyle(value string) trees.Property {
+	return trees.NewCSSStyle("contain", value)
+}
+
+// ContentStyle sets the css property "content", which replaces the content of the element with a generated value.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/content
+func ContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("content", value)
+}
+
+// CounterIncrementStyle sets the css property "counter-increment", which increases or decreases the value of a css counter.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/counter-increment
+func CounterIncrementStyle(value string) trees.Property {
+	return trees.NewCSSStyle("counter-increment", value)
+}
+
+// CounterResetStyle sets the css property "counter-reset", which resets a css counter to a given value.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/counter-reset
+func CounterResetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("counter-reset", value)
+}
+
+// CounterSetStyle sets the css property "counter-set", which sets css counters to the giving values.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/counter-set
+func CounterSetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("counter-set", value)
+}
+
+// CursorStyle sets the css property "cursor", which sets the type of mouse cursor shown when the pointer is over the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/cursor
+func CursorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("cursor", value)
+}
+
+// Cursor contains the values of the css property "cursor", eg 'Cursor.Auto'.
+var Cursor = struct {
+	Auto         StyleValue
+	Default      StyleValue
+	None         StyleValue
+	ContextMenu  StyleValue
+	Help         StyleValue
+	Pointer      StyleValue
+	Progress     StyleValue
+	Wait         StyleValue
+	Cell         StyleValue
+	Crosshair    StyleValue
+	Text         StyleValue
+	VerticalText StyleValue
+	Alias        StyleValue
+	Copy         StyleValue
+	Move         StyleValue
+	NoDrop       StyleValue
+	NotAllowed   StyleValue
+	Grab         StyleValue
+	Grabbing     StyleValue
+	AllScroll    StyleValue
+	ColResize    StyleValue
+	RowResize    StyleValue
+	NResize      StyleValue
+	EResize      StyleValue
+	SResize      StyleValue
+	WResize      StyleValue
+	NEResize     StyleValue
+	NWResize     StyleValue
+	SEResize     StyleValue
+	SWResize     StyleValue
+	EWResize     StyleValue
+	NSResize     StyleValue
+	NESWResize   StyleValue
+	NWSEResize   StyleValue
+	ZoomIn       StyleValue
+	ZoomOut      StyleValue
+	Inherit      StyleValue
+	Initial      StyleValue
+	Unset        StyleValue
+}{
+	Auto:         StyleValue{Name: "cursor", Value: "auto"},
+	Default:      StyleValue{Name: "cursor", Value: "default"},
+	None:         StyleValue{Name: "cursor", Value: "none"},
+	ContextMenu:  StyleValue{Name: "cursor", Value: "context-menu"},
+	Help:         StyleValue{Name: "cursor", Value: "help"},
+	Pointer:      StyleValue{Name: "cursor", Value: "pointer"},
+	Progress:     StyleValue{Name: "cursor", Value: "progress"},
+	Wait:         StyleValue{Name: "cursor", Value: "wait"},
+	Cell:         StyleValue{Name: "cursor", Value: "cell"},
+	Crosshair:    StyleValue{Name: "cursor", Value: "crosshair"},
+	Text:         StyleValue{Name: "cursor", Value: "text"},
+	VerticalText: StyleValue{Name: "cursor", Value: "vertical-text"},
+	Alias:        StyleValue{Name: "cursor", Value: "alias"},
+	Copy:         StyleValue{Name: "cursor", Value: "copy"},
+	Move:         StyleValue{Name: "cursor", Value: "move"},
+	NoDrop:       StyleValue{Name: "cursor", Value: "no-drop"},
+	NotAllowed:   StyleValue{Name: "cursor", Value: "not-allowed"},
+	Grab:         StyleValue{Name: "cursor", Value: "grab"},
+	Grabbing:     StyleValue{Name: "cursor", Value: "grabbing"},
+	AllScroll:    StyleValue{Name: "cursor", Value: "all-scroll"},
+	ColResize:    StyleValue{Name: "cursor", Value: "col-resize"},
+	RowResize:    StyleValue{Name: "cursor", Value: "row-resize"},
+	NResize:      StyleValue{Name: "cursor", Value: "n-resize"},
+	EResize:      StyleValue{Name: "cursor", Value: "e-resize"},
+	SResize:      StyleValue{Name: "cursor", Value: "s-resize"},
+	WResize:      StyleValue{Name: "cursor", Value: "w-resize"},
+	NEResize:     StyleValue{Name: "cursor", Value: "ne-resize"},
+	NWResize:     StyleValue{Name: "cursor", Value: "nw-resize"},
+	SEResize:     StyleValue{Name: "cursor", Value: "se-resize"},
+	SWResize:     StyleValue{Name: "cursor", Value: "sw-resize"},
+	EWResize:     StyleValue{Name: "cursor", Value: "ew-resize"},
+	NSResize:     StyleValue{Name: "cursor", Value: "ns-resize"},
+	NESWResize:   StyleValue{Name: "cursor", Value: "nesw-resize"},
+	NWSEResize:   StyleValue{Name: "cursor", Value: "nwse-resize"},
+	ZoomIn:       StyleValue{Name: "cursor", Value: "zoom-in"},
+	ZoomOut:      StyleValue{Name: "cursor", Value: "zoom-out"},
+	Inherit:      StyleValue{Name: "cursor", Value: "inherit"},
+	Initial:      StyleValue{Name: "cursor", Value: "initial"},
+	Unset:        StyleValue{Name: "cursor", Value: "unset"},
+}
+
+// DirectionStyle sets the css property "direction", which sets the direction of text, table columns and horizontal overflow.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/direction
+func DirectionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("direction", value)
+}
+
+// Direction contains the values of the css property "direction", eg 'Direction.LTR'.
+var Direction = struct {
+	LTR     StyleValue
+	RTL     StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	LTR:     StyleValue{Name: "direction", Value: "ltr"},
+	RTL:     StyleValue{Name: "direction", Value: "rtl"},
+	Inherit: StyleValue{Name: "direction", Value: "inherit"},
+	Initial: StyleValue{Name: "direction", Value: "initial"},
+	Unset:   StyleValue{Name: "direction", Value: "unset"},
+}
+
+// DisplayStyle sets the css property "display", which sets whether the element is treated as a block or inline box and the layout used for its children.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/display
+func DisplayStyle(value string) trees.Property {
+	return trees.NewCSSStyle("display", value)
+}
+
+// Display contains the values of the css property "display", eg 'Display.Block'.
+var Display = struct {
+	Block            StyleValue
+	Inline           StyleValue
+	InlineBlock      StyleValue
+	Flex             StyleValue
+	InlineFlex       StyleValue
+	Grid             StyleValue
+	InlineGrid       StyleValue
+	FlowRoot         StyleValue
+	None             StyleValue
+	Contents         StyleValue
+	ListItem         StyleValue
+	Table            StyleValue
+	InlineTable      StyleValue
+	TableCaption     StyleValue
+	TableCell        StyleValue
+	TableColumn      StyleValue
+	TableColumnGroup StyleValue
+	TableFooterGroup StyleValue
+	TableHeaderGroup StyleValue
+	TableRow         StyleValue
+	TableRowGroup    StyleValue
+	Inherit          StyleValue
+	Initial          StyleValue
+	Unset            StyleValue
+}{
+	Block:            StyleValue{Name: "display", Value: "block"},
+	Inline:           StyleValue{Name: "display", Value: "inline"},
+	InlineBlock:      StyleValue{Name: "display", Value: "inline-block"},
+	Flex:             StyleValue{Name: "display", Value: "flex"},
+	InlineFlex:       StyleValue{Name: "display", Value: "inline-flex"},
+	Grid:             StyleValue{Name: "display", Value: "grid"},
+	InlineGrid:       StyleValue{Name: "display", Value: "inline-grid"},
+	FlowRoot:         StyleValue{Name: "display", Value: "flow-root"},
+	None:             StyleValue{Name: "display", Value: "none"},
+	Contents:         StyleValue{Name: "display", Value: "contents"},
+	ListItem:         StyleValue{Name: "display", Value: "list-item"},
+	Table:            StyleValue{Name: "display", Value: "table"},
+	InlineTable:      StyleValue{Name: "display", Value: "inline-table"},
+	TableCaption:     StyleValue{Name: "display", Value: "table-caption"},
+	TableCell:        StyleValue{Name: "display", Value: "table-cell"},
+	TableColumn:      StyleValue{Name: "display", Value: "table-column"},
+	TableColumnGroup: StyleValue{Name: "display", Value: "table-column-group"},
+	TableFooterGroup: StyleValue{Name: "display", Value: "table-footer-group"},
+	TableHeaderGroup: StyleValue{Name: "display", Value: "table-header-group"},
+	TableRow:         StyleValue{Name: "display", Value: "table-row"},
+	TableRowGroup:    StyleValue{Name: "display", Value: "table-row-group"},
+	Inherit:          StyleValue{Name: "display", Value: "inherit"},
+	Initial:          StyleValue{Name: "display", Value: "initial"},
+	Unset:            StyleValue{Name: "display", Value: "unset"},
+}
+
+// EmptyCellsStyle sets the css property "empty-cells", which sets whether borders and backgrounds appear around table cells without content.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/empty-cells
+func EmptyCellsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("empty-cells", value)
+}
+
+// EmptyCells contains the values of the css property "empty-cells", eg 'EmptyCells.Show'.
+var EmptyCells = struct {
+	Show    StyleValue
+	Hide    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Show:    StyleValue{Name: "empty-cells", Value: "show"},
+	Hide:    StyleValue{Name: "empty-cells", Value: "hide"},
+	Inherit: StyleValue{Name: "empty-cells", Value: "inherit"},
+	Initial: StyleValue{Name: "empty-cells", Value: "initial"},
+	Unset:   StyleValue{Name: "empty-cells", Value: "unset"},
+}
+
+// FillStyle sets the css property "fill", which defines the color used to paint svg shapes.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/fill
+func FillStyle(value string) trees.Property {
+	return trees.NewCSSStyle("fill", value)
+}
+
+// FillOpacityStyle sets the css property "fill-opacity", which sets the opacity of the paint applied to the inside of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/fill-opacity
+func FillOpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("fill-opacity", value)
+}
+
+// FillRuleStyle sets the css property "fill-rule", which sets the rule used to determine the inside part of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/fill-rule
+func FillRuleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("fill-rule", value)
+}
+
+// FilterStyle sets the css property "filter", which applies graphical effects like blur or color shift to the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/filter
+func FilterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("filter", value)
+}
+
+// FlexStyle sets the css property "flex", which is a shorthand which sets how a flex item grows or shrinks to fit the space of its container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/flex
+func FlexStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex", value)
+}
+
+// FlexBasisStyle sets the css property "flex-basis", which sets the initial main size of a flex item.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/flex-basis
+func FlexBasisStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-basis", value)
+}
+
+// FlexDirectionStyle sets the css property "flex-direction", which sets how flex items are placed in the flex container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/flex-direction
+func FlexDirectionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-direction", value)
+}
+
+// FlexDirection contains the values of the css property "flex-direction", eg 'FlexDirection.Row'.
+var FlexDirection = struct {
+	Row           StyleValue
+	RowReverse    StyleValue
+	Column        StyleValue
+	ColumnReverse StyleValue
+	Inherit       StyleValue
+	Initial       StyleValue
+	Unset         StyleValue
+}{
+	Row:           StyleValue{Name: "flex-direction", Value: "row"},
+	RowReverse:    StyleValue{Name: "flex-direction", Value: "row-reverse"},
+	Column:        StyleValue{Name: "flex-direction", Value: "column"},
+	ColumnReverse: StyleValue{Name: "flex-direction", Value: "column-reverse"},
+	Inherit:       StyleValue{Name: "flex-direction", Value: "inherit"},
+	Initial:       StyleValue{Name: "flex-direction", Value: "initial"},
+	Unset:         StyleValue{Name: "flex-direction", Value: "unset"},
+}
+
+// FlexFlowStyle sets the css property "flex-flow", which is a shorthand which sets the direction and wrapping of a flex container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/flex-flow
+func FlexFlowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-flow", value)
+}
+
+// FlexGrowStyle sets the css property "flex-grow", which sets the flex grow factor of a flex item.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/flex-grow
+func FlexGrowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-grow", value)
+}
+
+// FlexShrinkStyle sets the css property "flex-shrink", which sets the flex shrink factor of a flex item.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/flex-shrink
+func FlexShrinkStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-shrink", value)
+}
+
+// FlexWrapStyle sets the css property "flex-wrap", which sets whether flex items are forced onto one line or can wrap onto multiple lines.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/flex-wrap
+func FlexWrapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("flex-wrap", value)
+}
+
+// FlexWrap contains the values of the css property "flex-wrap", eg 'FlexWrap.NoWrap'.
+var FlexWrap = struct {
+	NoWrap      StyleValue
+	Wrap        StyleValue
+	WrapReverse StyleValue
+	Inherit     StyleValue
+	Initial     StyleValue
+	Unset       StyleValue
+}{
+	NoWrap:      StyleValue{Name: "flex-wrap", Value: "nowrap"},
+	Wrap:        StyleValue{Name: "flex-wrap", Value: "wrap"},
+	WrapReverse: StyleValue{Name: "flex-wrap", Value: "wrap-reverse"},
+	Inherit:     StyleValue{Name: "flex-wrap", Value: "inherit"},
+	Initial:     StyleValue{Name: "flex-wrap", Value: "initial"},
+	Unset:       StyleValue{Name: "flex-wrap", Value: "unset"},
+}
+
+// FloatStyle sets the css property "float", which places the element on the left or right side of its container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/float
+func FloatStyle(value string) trees.Property {
+	return trees.NewCSSStyle("float", value)
+}
+
+// Float contains the values of the css property "float", eg 'Float.None'.
+var Float = struct {
+	None        StyleValue
+	Left        StyleValue
+	Right       StyleValue
+	InlineStart StyleValue
+	InlineEnd   StyleValue
+	Inherit     StyleValue
+	Initial     StyleValue
+	Unset       StyleValue
+}{
+	None:        StyleValue{Name: "float", Value: "none"},
+	Left:        StyleValue{Name: "float", Value: "left"},
+	Right:       StyleValue{Name: "float", Value: "right"},
+	InlineStart: StyleValue{Name: "float", Value: "inline-start"},
+	InlineEnd:   StyleValue{Name: "float", Value: "inline-end"},
+	Inherit:     StyleValue{Name: "float", Value: "inherit"},
+	Initial:     StyleValue{Name: "float", Value: "initial"},
+	Unset:       StyleValue{Name: "float", Value: "unset"},
+}
+
+// FontStyle sets the css property "font", which is a shorthand which sets all the different properties of the font of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font
+func FontStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font", value)
+}
+
+// FontFamilyStyle sets the css property "font-family", which specifies a prioritized list of font family names.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-family
+func FontFamilyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-family", value)
+}
+
+// FontFeatureSettingsStyle sets the css property "font-feature-settings", which controls advanced typographic features in opentype fonts.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-feature-settings
+func FontFeatureSettingsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-feature-settings", value)
+}
+
+// FontKerningStyle sets the css property "font-kerning", which sets the use of the kerning information stored in a font.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-kerning
+func FontKerningStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-kerning", value)
+}
+
+// FontSizeStyle sets the css property "font-size", which sets the size of the font.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-size
+func FontSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-size", value)
+}
+
+// FontSizeAdjustStyle sets the css property "font-size-adjust", which sets the size of lower-case letters relative to the current font size.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-size-adjust
+func FontSizeAdjustStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-size-adjust", value)
+}
+
+// FontStretchStyle sets the css property "font-stretch", which selects a normal, condensed, or expanded face from a font.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-stretch
+func FontStretchStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-stretch", value)
+}
+
+// FontStyleStyle sets the css property "font-style", which sets whether a font should be styled with a normal, italic, or oblique face.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-style
+func FontStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-style", value)
+}
+
+// FontStyles contains the values of the css property "font-style", eg 'FontStyles.Normal'.
+var FontStyles = struct {
+	Normal  StyleValue
+	Italic  StyleValue
+	Oblique StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Normal:  StyleValue{Name: "font-style", Value: "normal"},
+	Italic:  StyleValue{Name: "font-style", Value: "italic"},
+	Oblique: StyleValue{Name: "font-style", Value: "oblique"},
+	Inherit: StyleValue{Name: "font-style", Value: "inherit"},
+	Initial: StyleValue{Name: "font-style", Value: "initial"},
+	Unset:   StyleValue{Name: "font-style", Value: "unset"},
+}
+
+// FontVariantStyle sets the css property "font-variant", which is a shorthand which sets all the font variants of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-variant
+func FontVariantStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant", value)
+}
+
+// FontVariant contains the values of the css property "font-variant", eg 'FontVariant.Normal'.
+var FontVariant = struct {
+	Normal    StyleValue
+	SmallCaps StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Normal:    StyleValue{Name: "font-variant", Value: "normal"},
+	SmallCaps: StyleValue{Name: "font-variant", Value: "small-caps"},
+	Inherit:   StyleValue{Name: "font-variant", Value: "inherit"},
+	Initial:   StyleValue{Name: "font-variant", Value: "initial"},
+	Unset:     StyleValue{Name: "font-variant", Value: "unset"},
+}
+
+// FontVariantCapsStyle sets the css property "font-variant-caps", which sets the use of alternate glyphs for capital letters.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-variant-caps
+func FontVariantCapsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-caps", value)
+}
+
+// FontVariantLigaturesStyle sets the css property "font-variant-ligatures", which sets which ligatures and contextual forms are used in the textual content of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-variant-ligatures
+func FontVariantLigaturesStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-ligatures", value)
+}
+
+// FontVariantNumericStyle sets the css property "font-variant-numeric", which sets the use of alternate glyphs for numbers, fractions and ordinal markers.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-variant-numeric
+func FontVariantNumericStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-variant-numeric", value)
+}
+
+// FontWeightStyle sets the css property "font-weight", which sets the weight or boldness of the font.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/font-weight
+func FontWeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("font-weight", value)
+}
+
+// FontWeight contains the values of the css property "font-weight", eg 'FontWeight.Normal'.
+var FontWeight = struct {
+	Normal  StyleValue
+	Bold    StyleValue
+	Bolder  StyleValue
+	Lighter StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Normal:  StyleValue{Name: "font-weight", Value: "normal"},
+	Bold:    StyleValue{Name: "font-weight", Value: "bold"},
+	Bolder:  StyleValue{Name: "font-weight", Value: "bolder"},
+	Lighter: StyleValue{Name: "font-weight", Value: "lighter"},
+	Inherit: StyleValue{Name: "font-weight", Value: "inherit"},
+	Initial: StyleValue{Name: "font-weight", Value: "initial"},
+	Unset:   StyleValue{Name: "font-weight", Value: "unset"},
+}
+
+// GapStyle sets the css property "gap", which sets the gaps between rows and columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/gap
+func GapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("gap", value)
+}
+
+// GridStyle sets the css property "grid", which is a shorthand which sets all the explicit and implicit grid properties.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid
+func GridStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid", value)
+}
+
+// GridAreaStyle sets the css property "grid-area", which specifies the size and location of a grid item within a grid.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-area
+func GridAreaStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-area", value)
+}
+
+// GridAutoColumnsStyle sets the css property "grid-auto-columns", which specifies the size of an implicitly-created grid column track.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-auto-columns
+func GridAutoColumnsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-auto-columns", value)
+}
+
+// GridAutoFlowStyle sets the css property "grid-auto-flow", which controls how auto-placed items get flowed into the grid.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-auto-flow
+func GridAutoFlowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-auto-flow", value)
+}
+
+// GridAutoFlow contains the values of the css property "grid-auto-flow", eg 'GridAutoFlow.Row'.
+var GridAutoFlow = struct {
+	Row         StyleValue
+	Column      StyleValue
+	Dense       StyleValue
+	RowDense    StyleValue
+	ColumnDense StyleValue
+	Inherit     StyleValue
+	Initial     StyleValue
+	Unset       StyleValue
+}{
+	Row:         StyleValue{Name: "grid-auto-flow", Value: "row"},
+	Column:      StyleValue{Name: "grid-auto-flow", Value: "column"},
+	Dense:       StyleValue{Name: "grid-auto-flow", Value: "dense"},
+	RowDense:    StyleValue{Name: "grid-auto-flow", Value: "row dense"},
+	ColumnDense: StyleValue{Name: "grid-auto-flow", Value: "column dense"},
+	Inherit:     StyleValue{Name: "grid-auto-flow", Value: "inherit"},
+	Initial:     StyleValue{Name: "grid-auto-flow", Value: "initial"},
+	Unset:       StyleValue{Name: "grid-auto-flow", Value: "unset"},
+}
+
+// GridAutoRowsStyle sets the css property "grid-auto-rows", which specifies the size of an implicitly-created grid row track.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-auto-rows
+func GridAutoRowsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-auto-rows", value)
+}
+
+// GridColumnStyle sets the css property "grid-column", which specifies the size and location of a grid item within a grid column.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-column
+func GridColumnStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-column", value)
+}
+
+// GridColumnEndStyle sets the css property "grid-column-end", which specifies the end position of a grid item within a grid column.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-column-end
+func GridColumnEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-column-end", value)
+}
+
+// GridColumnGapStyle sets the css property "grid-column-gap", which sets the size of the gap between the columns of a grid, now known as column-gap.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-column-gap
+func GridColumnGapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-column-gap", value)
+}
+
+// GridColumnStartStyle sets the css property "grid-column-start", which specifies the start position of a grid item within a grid column.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-column-start
+func GridColumnStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-column-start", value)
+}
+
+// GridGapStyle sets the css property "grid-gap", which is a shorthand which sets the gaps between the rows and columns of a grid, now known as gap.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-gap
+func GridGapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-gap", value)
+}
+
+// GridRowStyle sets the css property "grid-row", which specifies the size and location of a grid item within a grid row.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-row
+func GridRowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-row", value)
+}
+
+// GridRowEndStyle sets the css property "grid-row-end", which specifies the end position of a grid item within a grid row.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-row-end
+func GridRowEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-row-end", value)
+}
+
+// GridRowGapStyle sets the css property "grid-row-gap", which sets the size of the gap between the rows of a grid, now known as row-gap.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-row-gap
+func GridRowGapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-row-gap", value)
+}
+
+// GridRowStartStyle sets the css property "grid-row-start", which specifies the start position of a grid item within a grid row.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-row-start
+func GridRowStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-row-start", value)
+}
+
+// GridTemplateStyle sets the css property "grid-template", which is a shorthand which defines the grid columns, rows, and areas.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-template
+func GridTemplateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template", value)
+}
+
+// GridTemplateAreasStyle sets the css property "grid-template-areas", which specifies named grid areas.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-template-areas
+func GridTemplateAreasStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template-areas", value)
+}
+
+// GridTemplateColumnsStyle sets the css property "grid-template-columns", which defines the line names and track sizing functions of the grid columns.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-template-columns
+func GridTemplateColumnsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template-columns", value)
+}
+
+// GridTemplateRowsStyle sets the css property "grid-template-rows", which defines the line names and track sizing functions of the grid rows.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/grid-template-rows
+func GridTemplateRowsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("grid-template-rows", value)
+}
+
+// HangingPunctuationStyle sets the css property "hanging-punctuation", which sets whether a punctuation mark hangs at the start or end of a line of text.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/hanging-punctuation
+func HangingPunctuationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("hanging-punctuation", value)
+}
+
+// HeightStyle sets the css property "height", which specifies the height of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/height
+func HeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("height", value)
+}
+
+// HyphensStyle sets the css property "hyphens", which specifies how words are hyphenated when text wraps across multiple lines.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/hyphens
+func HyphensStyle(value string) trees.Property {
+	return trees.NewCSSStyle("hyphens", value)
+}
+
+// Hyphens contains the values of the css property "hyphens", eg 'Hyphens.None'.
+var Hyphens = struct {
+	None    StyleValue
+	Manual  StyleValue
+	Auto    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	None:    StyleValue{Name: "hyphens", Value: "none"},
+	Manual:  StyleValue{Name: "hyphens", Value: "manual"},
+	Auto:    StyleValue{Name: "hyphens", Value: "auto"},
+	Inherit: StyleValue{Name: "hyphens", Value: "inherit"},
+	Initial: StyleValue{Name: "hyphens", Value: "initial"},
+	Unset:   StyleValue{Name: "hyphens", Value: "unset"},
+}
+
+// ImageRenderingStyle sets the css property "image-rendering", which sets the algorithm used to scale images.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/image-rendering
+func ImageRenderingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("image-rendering", value)
+}
+
+// InlineSizeStyle sets the css property "inline-size", which sets the size of the element in the inline direction of the writing mode.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/inline-size
+func InlineSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inline-size", value)
+}
+
+// InsetStyle sets the css property "inset", which is a shorthand which sets the top, right, bottom and left positions of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/inset
+func InsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("inset", value)
+}
+
+// IsolationStyle sets the css property "isolation", which sets whether the element must create a new stacking context.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/isolation
+func IsolationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("isolation", value)
+}
+
+// Isolation contains the values of the css property "isolation", eg 'Isolation.Auto'.
+var Isolation = struct {
+	Auto    StyleValue
+	Isolate StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Auto:    StyleValue{Name: "isolation", Value: "auto"},
+	Isolate: StyleValue{Name: "isolation", Value: "isolate"},
+	Inherit: StyleValue{Name: "isolation", Value: "inherit"},
+	Initial: StyleValue{Name: "isolation", Value: "initial"},
+	Unset:   StyleValue{Name: "isolation", Value: "unset"},
+}
+
+// JustifyContentStyle sets the css property "justify-content", which sets the distribution of space between and around content items along the main axis.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/justify-content
+func JustifyContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("justify-content", value)
+}
+
+// JustifyContent contains the values of the css property "justify-content", eg 'JustifyContent.Normal'.
+var JustifyContent = struct {
+	Normal       StyleValue
+	Start        StyleValue
+	End          StyleValue
+	Center       StyleValue
+	Left         StyleValue
+	Right        StyleValue
+	FlexStart    StyleValue
+	FlexEnd      StyleValue
+	SpaceBetween StyleValue
+	SpaceAround  StyleValue
+	SpaceEvenly  StyleValue
+	Stretch      StyleValue
+	Inherit      StyleValue
+	Initial      StyleValue
+	Unset        StyleValue
+}{
+	Normal:       StyleValue{Name: "justify-content", Value: "normal"},
+	Start:        StyleValue{Name: "justify-content", Value: "start"},
+	End:          StyleValue{Name: "justify-content", Value: "end"},
+	Center:       StyleValue{Name: "justify-content", Value: "center"},
+	Left:         StyleValue{Name: "justify-content", Value: "left"},
+	Right:        StyleValue{Name: "justify-content", Value: "right"},
+	FlexStart:    StyleValue{Name: "justify-content", Value: "flex-start"},
+	FlexEnd:      StyleValue{Name: "justify-content", Value: "flex-end"},
+	SpaceBetween: StyleValue{Name: "justify-content", Value: "space-between"},
+	SpaceAround:  StyleValue{Name: "justify-content", Value: "space-around"},
+	SpaceEvenly:  StyleValue{Name: "justify-content", Value: "space-evenly"},
+	Stretch:      StyleValue{Name: "justify-content", Value: "stretch"},
+	Inherit:      StyleValue{Name: "justify-content", Value: "inherit"},
+	Initial:      StyleValue{Name: "justify-content", Value: "initial"},
+	Unset:        StyleValue{Name: "justify-content", Value: "unset"},
+}
+
+// JustifyItemsStyle sets the css property "justify-items", which sets the default justify-self of all items of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/justify-items
+func JustifyItemsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("justify-items", value)
+}
+
+// JustifyItems contains the values of the css property "justify-items", eg 'JustifyItems.Normal'.
+var JustifyItems = struct {
+	Normal   StyleValue
+	Stretch  StyleValue
+	Start    StyleValue
+	End      StyleValue
+	Center   StyleValue
+	Left     StyleValue
+	Right    StyleValue
+	Baseline StyleValue
+	Inherit  StyleValue
+	Initial  StyleValue
+	Unset    StyleValue
+}{
+	Normal:   StyleValue{Name: "justify-items", Value: "normal"},
+	Stretch:  StyleValue{Name: "justify-items", Value: "stretch"},
+	Start:    StyleValue{Name: "justify-items", Value: "start"},
+	End:      StyleValue{Name: "justify-items", Value: "end"},
+	Center:   StyleValue{Name: "justify-items", Value: "center"},
+	Left:     StyleValue{Name: "justify-items", Value: "left"},
+	Right:    StyleValue{Name: "justify-items", Value: "right"},
+	Baseline: StyleValue{Name: "justify-items", Value: "baseline"},
+	Inherit:  StyleValue{Name: "justify-items", Value: "inherit"},
+	Initial:  StyleValue{Name: "justify-items", Value: "initial"},
+	Unset:    StyleValue{Name: "justify-items", Value: "unset"},
+}
+
+// JustifySelfStyle sets the css property "justify-self", which sets the way the element is justified inside its alignment container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/justify-self
+func JustifySelfStyle(value string) trees.Property {
+	return trees.NewCSSStyle("justify-self", value)
+}
+
+// JustifySelf contains the values of the css property "justify-self", eg 'JustifySelf.Auto'.
+var JustifySelf = struct {
+	Auto     StyleValue
+	Normal   StyleValue
+	Stretch  StyleValue
+	Start    StyleValue
+	End      StyleValue
+	Center   StyleValue
+	Left     StyleValue
+	Right    StyleValue
+	Baseline StyleValue
+	Inherit  StyleValue
+	Initial  StyleValue
+	Unset    StyleValue
+}{
+	Auto:     StyleValue{Name: "justify-self", Value: "auto"},
+	Normal:   StyleValue{Name: "justify-self", Value: "normal"},
+	Stretch:  StyleValue{Name: "justify-self", Value: "stretch"},
+	Start:    StyleValue{Name: "justify-self", Value: "start"},
+	End:      StyleValue{Name: "justify-self", Value: "end"},
+	Center:   StyleValue{Name: "justify-self", Value: "center"},
+	Left:     StyleValue{Name: "justify-self", Value: "left"},
+	Right:    StyleValue{Name: "justify-self", Value: "right"},
+	Baseline: StyleValue{Name: "justify-self", Value: "baseline"},
+	Inherit:  StyleValue{Name: "justify-self", Value: "inherit"},
+	Initial:  StyleValue{Name: "justify-self", Value: "initial"},
+	Unset:    StyleValue{Name: "justify-self", Value: "unset"},
+}
+
+// LeftStyle sets the css property "left", which participates in setting the horizontal position of a positioned element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/left
+func LeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("left", value)
+}
+
+// LetterSpacingStyle sets the css property "letter-spacing", which sets the horizontal spacing behavior between text characters.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/letter-spacing
+func LetterSpacingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("letter-spacing", value)
+}
+
+// LineBreakStyle sets the css property "line-break", which sets how to break lines of chinese, japanese or korean text working with punctuation and symbols.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/line-break
+func LineBreakStyle(value string) trees.Property {
+	return trees.NewCSSStyle("line-break", value)
+}
+
+// LineHeightStyle sets the css property "line-height", which sets the height of a line box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/line-height
+func LineHeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("line-height", value)
+}
+
+// ListStyleStyle sets the css property "list-style", which is a shorthand which sets all the list style properties.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/list-style
+func ListStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style", value)
+}
+
+// ListStyleImageStyle sets the css property "list-style-image", which sets an image to be used as the list item marker.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/list-style-image
+func ListStyleImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style-image", value)
+}
+
+// ListStylePositionStyle sets the css property "list-style-position", which sets the position of the marker relative to a list item.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/list-style-position
+func ListStylePositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style-position", value)
+}
+
+// ListStylePosition contains the values of the css property "list-style-position", eg 'ListStylePosition.Inside'.
+var ListStylePosition = struct {
+	Inside  StyleValue
+	Outside StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Inside:  StyleValue{Name: "list-style-position", Value: "inside"},
+	Outside: StyleValue{Name: "list-style-position", Value: "outside"},
+	Inherit: StyleValue{Name: "list-style-position", Value: "inherit"},
+	Initial: StyleValue{Name: "list-style-position", Value: "initial"},
+	Unset:   StyleValue{Name: "list-style-position", Value: "unset"},
+}
+
+// ListStyleTypeStyle sets the css property "list-style-type", which sets the marker of a list item element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/list-style-type
+func ListStyleTypeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("list-style-type", value)
+}
+
+// ListStyleType contains the values of the css property "list-style-type", eg 'ListStyleType.None'.
+var ListStyleType = struct {
+	None               StyleValue
+	Disc               StyleValue
+	Circle             StyleValue
+	Square             StyleValue
+	Decimal            StyleValue
+	DecimalLeadingZero StyleValue
+	LowerRoman         StyleValue
+	UpperRoman         StyleValue
+	LowerAlpha         StyleValue
+	UpperAlpha         StyleValue
+	LowerLatin         StyleValue
+	UpperLatin         StyleValue
+	LowerGreek         StyleValue
+	Inherit            StyleValue
+	Initial            StyleValue
+	Unset              StyleValue
+}{
+	None:               StyleValue{Name: "list-style-type", Value: "none"},
+	Disc:               StyleValue{Name: "list-style-type", Value: "disc"},
+	Circle:             StyleValue{Name: "list-style-type", Value: "circle"},
+	Square:             StyleValue{Name: "list-style-type", Value: "square"},
+	Decimal:            StyleValue{Name: "list-style-type", Value: "decimal"},
+	DecimalLeadingZero: StyleValue{Name: "list-style-type", Value: "decimal-leading-zero"},
+	LowerRoman:         StyleValue{Name: "list-style-type", Value: "lower-roman"},
+	UpperRoman:         StyleValue{Name: "list-style-type", Value: "upper-roman"},
+	LowerAlpha:         StyleValue{Name: "list-style-type", Value: "lower-alpha"},
+	UpperAlpha:         StyleValue{Name: "list-style-type", Value: "upper-alpha"},
+	LowerLatin:         StyleValue{Name: "list-style-type", Value: "lower-latin"},
+	UpperLatin:         StyleValue{Name: "list-style-type", Value: "upper-latin"},
+	LowerGreek:         StyleValue{Name: "list-style-type", Value: "lower-greek"},
+	Inherit:            StyleValue{Name: "list-style-type", Value: "inherit"},
+	Initial:            StyleValue{Name: "list-style-type", Value: "initial"},
+	Unset:              StyleValue{Name: "list-style-type", Value: "unset"},
+}
+
+// MarginStyle sets the css property "margin", which sets the margin area on all four sides of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin
+func MarginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin", value)
+}
+
+// MarginBlockStyle sets the css property "margin-block", which is a shorthand which sets the logical block start and end margins of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-block
+func MarginBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-block", value)
+}
+
+// MarginBlockEndStyle sets the css property "margin-block-end", which sets the logical block-end margin of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-block-end
+func MarginBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-block-end", value)
+}
+
+// MarginBlockStartStyle sets the css property "margin-block-start", which sets the logical block-start margin of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-block-start
+func MarginBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-block-start", value)
+}
+
+// MarginBottomStyle sets the css property "margin-bottom", which sets the margin area on the bottom of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-bottom
+func MarginBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-bottom", value)
+}
+
+// MarginInlineStyle sets the css property "margin-inline", which is a shorthand which sets the logical inline start and end margins of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-inline
+func MarginInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-inline", value)
+}
+
+// MarginInlineEndStyle sets the css property "margin-inline-end", which sets the logical inline-end margin of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-inline-end
+func MarginInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-inline-end", value)
+}
+
+// MarginInlineStartStyle sets the css property "margin-inline-start", which sets the logical inline-start margin of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-inline-start
+func MarginInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-inline-start", value)
+}
+
+// MarginLeftStyle sets the css property "margin-left", which sets the margin area on the left side of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-left
+func MarginLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-left", value)
+}
+
+// MarginRightStyle sets the css property "margin-right", which sets the margin area on the right side of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-right
+func MarginRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-right", value)
+}
+
+// MarginTopStyle sets the css property "margin-top", which sets the margin area on the top of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/margin-top
+func MarginTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("margin-top", value)
+}
+
+// MarkerStyle sets the css property "marker", which points to a marker drawn on the first, middle and last vertices of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/marker
+func MarkerStyle(value string) trees.Property {
+	return trees.NewCSSStyle("marker", value)
+}
+
+// MaskStyle sets the css property "mask", which hides the element by masking or clipping the image at specific points.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/mask
+func MaskStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask", value)
+}
+
+// MaskImageStyle sets the css property "mask-image", which sets the image used as the mask layer of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/mask-image
+func MaskImageStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mask-image", value)
+}
+
+// MaxBlockSizeStyle sets the css property "max-block-size", which sets the maximum size of the element in the block direction of the writing mode.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/max-block-size
+func MaxBlockSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-block-size", value)
+}
+
+// MaxHeightStyle sets the css property "max-height", which sets the maximum height of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/max-height
+func MaxHeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-height", value)
+}
+
+// MaxInlineSizeStyle sets the css property "max-inline-size", which sets the maximum size of the element in the inline direction of the writing mode.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/max-inline-size
+func MaxInlineSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-inline-size", value)
+}
+
+// MaxWidthStyle sets the css property "max-width", which sets the maximum width of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/max-width
+func MaxWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("max-width", value)
+}
+
+// MinBlockSizeStyle sets the css property "min-block-size", which sets the minimum size of the element in the block direction of the writing mode.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/min-block-size
+func MinBlockSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-block-size", value)
+}
+
+// MinHeightStyle sets the css property "min-height", which sets the minimum height of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/min-height
+func MinHeightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-height", value)
+}
+
+// MinInlineSizeStyle sets the css property "min-inline-size", which sets the minimum size of the element in the inline direction of the writing mode.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/min-inline-size
+func MinInlineSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-inline-size", value)
+}
+
+// MinWidthStyle sets the css property "min-width", which sets the minimum width of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/min-width
+func MinWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("min-width", value)
+}
+
+// MixBlendModeStyle sets the css property "mix-blend-mode", which sets how the content of the element blends with the content of its parent and background.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/mix-blend-mode
+func MixBlendModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("mix-blend-mode", value)
+}
+
+// MixBlendMode contains the values of the css property "mix-blend-mode", eg 'MixBlendMode.Normal'.
+var MixBlendMode = struct {
+	Normal     StyleValue
+	Multiply   StyleValue
+	Screen     StyleValue
+	Overlay    StyleValue
+	Darken     StyleValue
+	Lighten    StyleValue
+	ColorDodge StyleValue
+	ColorBurn  StyleValue
+	HardLight  StyleValue
+	SoftLight  StyleValue
+	Difference StyleValue
+	Exclusion  StyleValue
+	Hue        StyleValue
+	Saturation StyleValue
+	Color      StyleValue
+	Luminosity StyleValue
+	Inherit    StyleValue
+	Initial    StyleValue
+	Unset      StyleValue
+}{
+	Normal:     StyleValue{Name: "mix-blend-mode", Value: "normal"},
+	Multiply:   StyleValue{Name: "mix-blend-mode", Value: "multiply"},
+	Screen:     StyleValue{Name: "mix-blend-mode", Value: "screen"},
+	Overlay:    StyleValue{Name: "mix-blend-mode", Value: "overlay"},
+	Darken:     StyleValue{Name: "mix-blend-mode", Value: "darken"},
+	Lighten:    StyleValue{Name: "mix-blend-mode", Value: "lighten"},
+	ColorDodge: StyleValue{Name: "mix-blend-mode", Value: "color-dodge"},
+	ColorBurn:  StyleValue{Name: "mix-blend-mode", Value: "color-burn"},
+	HardLight:  StyleValue{Name: "mix-blend-mode", Value: "hard-light"},
+	SoftLight:  StyleValue{Name: "mix-blend-mode", Value: "soft-light"},
+	Difference: StyleValue{Name: "mix-blend-mode", Value: "difference"},
+	Exclusion:  StyleValue{Name: "mix-blend-mode", Value: "exclusion"},
+	Hue:        StyleValue{Name: "mix-blend-mode", Value: "hue"},
+	Saturation: StyleValue{Name: "mix-blend-mode", Value: "saturation"},
+	Color:      StyleValue{Name: "mix-blend-mode", Value: "color"},
+	Luminosity: StyleValue{Name: "mix-blend-mode", Value: "luminosity"},
+	Inherit:    StyleValue{Name: "mix-blend-mode", Value: "inherit"},
+	Initial:    StyleValue{Name: "mix-blend-mode", Value: "initial"},
+	Unset:      StyleValue{Name: "mix-blend-mode", Value: "unset"},
+}
+
+// ObjectFitStyle sets the css property "object-fit", which sets how the content of a replaced element is resized to fit its container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/object-fit
+func ObjectFitStyle(value string) trees.Property {
+	return trees.NewCSSStyle("object-fit", value)
+}
+
+// ObjectFit contains the values of the css property "object-fit", eg 'ObjectFit.Fill'.
+var ObjectFit = struct {
+	Fill      StyleValue
+	Contain   StyleValue
+	Cover     StyleValue
+	None      StyleValue
+	ScaleDown StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Fill:      StyleValue{Name: "object-fit", Value: "fill"},
+	Contain:   StyleValue{Name: "object-fit", Value: "contain"},
+	Cover:     StyleValue{Name: "object-fit", Value: "cover"},
+	None:      StyleValue{Name: "object-fit", Value: "none"},
+	ScaleDown: StyleValue{Name: "object-fit", Value: "scale-down"},
+	Inherit:   StyleValue{Name: "object-fit", Value: "inherit"},
+	Initial:   StyleValue{Name: "object-fit", Value: "initial"},
+	Unset:     StyleValue{Name: "object-fit", Value: "unset"},
+}
+
+// ObjectPositionStyle sets the css property "object-position", which specifies the alignment of the content of a replaced element within its box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/object-position
+func ObjectPositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("object-position", value)
+}
+
+// OpacityStyle sets the css property "opacity", which sets the opacity of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/opacity
+func OpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("opacity", value)
+}
+
+// OrderStyle sets the css property "order", which sets the order to lay out an item in a flex or grid container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/order
+func OrderStyle(value string) trees.Property {
+	return trees.NewCSSStyle("order", value)
+}
+
+// OrphansStyle sets the css property "orphans", which sets the minimum number of lines of a block left at the bottom of a page, region or column.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/orphans
+func OrphansStyle(value string) trees.Property {
+	return trees.NewCSSStyle("orphans", value)
+}
+
+// OutlineStyle sets the css property "outline", which is a shorthand which sets the outline of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/outline
+func OutlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline", value)
+}
+
+// OutlineColorStyle sets the css property "outline-color", which sets the color of the outline of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/outline-color
+func OutlineColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-color", value)
+}
+
+// OutlineOffsetStyle sets the css property "outline-offset", which sets the amount of space between the outline and the edge or border of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/outline-offset
+func OutlineOffsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-offset", value)
+}
+
+// OutlineStyleStyle sets the css property "outline-style", which sets the style of the outline of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/outline-style
+func OutlineStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-style", value)
+}
+
+// OutlineStyles contains the values of the css property "outline-style", eg 'OutlineStyles.Auto'.
+var OutlineStyles = struct {
+	Auto    StyleValue
+	None    StyleValue
+	Dotted  StyleValue
+	Dashed  StyleValue
+	Solid   StyleValue
+	Double  StyleValue
+	Groove  StyleValue
+	Ridge   StyleValue
+	Inset   StyleValue
+	Outset  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Auto:    StyleValue{Name: "outline-style", Value: "auto"},
+	None:    StyleValue{Name: "outline-style", Value: "none"},
+	Dotted:  StyleValue{Name: "outline-style", Value: "dotted"},
+	Dashed:  StyleValue{Name: "outline-style", Value: "dashed"},
+	Solid:   StyleValue{Name: "outline-style", Value: "solid"},
+	Double:  StyleValue{Name: "outline-style", Value: "double"},
+	Groove:  StyleValue{Name: "outline-style", Value: "groove"},
+	Ridge:   StyleValue{Name: "outline-style", Value: "ridge"},
+	Inset:   StyleValue{Name: "outline-style", Value: "inset"},
+	Outset:  StyleValue{Name: "outline-style", Value: "outset"},
+	Inherit: StyleValue{Name: "outline-style", Value: "inherit"},
+	Initial: StyleValue{Name: "outline-style", Value: "initial"},
+	Unset:   StyleValue{Name: "outline-style", Value: "unset"},
+}
+
+// OutlineWidthStyle sets the css property "outline-width", which sets the thickness of the outline of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/outline-width
+func OutlineWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("outline-width", value)
+}
+
+// OverflowStyle sets the css property "overflow", which sets what to do when the content of the element is too big to fit in its block formatting context.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/overflow
+func OverflowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow", value)
+}
+
+// Overflow contains the values of the css property "overflow", eg 'Overflow.Visible'.
+var Overflow = struct {
+	Visible StyleValue
+	Hidden  StyleValue
+	Clip    StyleValue
+	Scroll  StyleValue
+	Auto    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Visible: StyleValue{Name: "overflow", Value: "visible"},
+	Hidden:  StyleValue{Name: "overflow", Value: "hidden"},
+	Clip:    StyleValue{Name: "overflow", Value: "clip"},
+	Scroll:  StyleValue{Name: "overflow", Value: "scroll"},
+	Auto:    StyleValue{Name: "overflow", Value: "auto"},
+	Inherit: StyleValue{Name: "overflow", Value: "inherit"},
+	Initial: StyleValue{Name: "overflow", Value: "initial"},
+	Unset:   StyleValue{Name: "overflow", Value: "unset"},
+}
+
+// OverflowWrapStyle sets the css property "overflow-wrap", which sets whether the browser inserts line breaks within an otherwise unbreakable string to prevent overflow.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/overflow-wrap
+func OverflowWrapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-wrap", value)
+}
+
+// OverflowWrap contains the values of the css property "overflow-wrap", eg 'OverflowWrap.Normal'.
+var OverflowWrap = struct {
+	Normal    StyleValue
+	BreakWord StyleValue
+	Anywhere  StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Normal:    StyleValue{Name: "overflow-wrap", Value: "normal"},
+	BreakWord: StyleValue{Name: "overflow-wrap", Value: "break-word"},
+	Anywhere:  StyleValue{Name: "overflow-wrap", Value: "anywhere"},
+	Inherit:   StyleValue{Name: "overflow-wrap", Value: "inherit"},
+	Initial:   StyleValue{Name: "overflow-wrap", Value: "initial"},
+	Unset:     StyleValue{Name: "overflow-wrap", Value: "unset"},
+}
+
+// OverflowXStyle sets the css property "overflow-x", which sets what shows when content overflows the left and right edges of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/overflow-x
+func OverflowXStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-x", value)
+}
+
+// OverflowX contains the values of the css property "overflow-x", eg 'OverflowX.Visible'.
+var OverflowX = struct {
+	Visible StyleValue
+	Hidden  StyleValue
+	Clip    StyleValue
+	Scroll  StyleValue
+	Auto    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Visible: StyleValue{Name: "overflow-x", Value: "visible"},
+	Hidden:  StyleValue{Name: "overflow-x", Value: "hidden"},
+	Clip:    StyleValue{Name: "overflow-x", Value: "clip"},
+	Scroll:  StyleValue{Name: "overflow-x", Value: "scroll"},
+	Auto:    StyleValue{Name: "overflow-x", Value: "auto"},
+	Inherit: StyleValue{Name: "overflow-x", Value: "inherit"},
+	Initial: StyleValue{Name: "overflow-x", Value: "initial"},
+	Unset:   StyleValue{Name: "overflow-x", Value: "unset"},
+}
+
+// OverflowYStyle sets the css property "overflow-y", which sets what shows when content overflows the top and bottom edges of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/overflow-y
+func OverflowYStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overflow-y", value)
+}
+
+// OverflowY contains the values of the css property "overflow-y", eg 'OverflowY.Visible'.
+var OverflowY = struct {
+	Visible StyleValue
+	Hidden  StyleValue
+	Clip    StyleValue
+	Scroll  StyleValue
+	Auto    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Visible: StyleValue{Name: "overflow-y", Value: "visible"},
+	Hidden:  StyleValue{Name: "overflow-y", Value: "hidden"},
+	Clip:    StyleValue{Name: "overflow-y", Value: "clip"},
+	Scroll:  StyleValue{Name: "overflow-y", Value: "scroll"},
+	Auto:    StyleValue{Name: "overflow-y", Value: "auto"},
+	Inherit: StyleValue{Name: "overflow-y", Value: "inherit"},
+	Initial: StyleValue{Name: "overflow-y", Value: "initial"},
+	Unset:   StyleValue{Name: "overflow-y", Value: "unset"},
+}
+
+// OverscrollBehaviorStyle sets the css property "overscroll-behavior", which sets what the browser does when reaching the boundary of a scrolling area.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/overscroll-behavior
+func OverscrollBehaviorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("overscroll-behavior", value)
+}
+
+// PaddingStyle sets the css property "padding", which sets the padding area on all four sides of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding
+func PaddingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding", value)
+}
+
+// PaddingBlockStyle sets the css property "padding-block", which is a shorthand which sets the logical block start and end paddings of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-block
+func PaddingBlockStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-block", value)
+}
+
+// PaddingBlockEndStyle sets the css property "padding-block-end", which sets the logical block-end padding of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-block-end
+func PaddingBlockEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-block-end", value)
+}
+
+// PaddingBlockStartStyle sets the css property "padding-block-start", which sets the logical block-start padding of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-block-start
+func PaddingBlockStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-block-start", value)
+}
+
+// PaddingBottomStyle sets the css property "padding-bottom", which sets the height of the padding area on the bottom of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-bottom
+func PaddingBottomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-bottom", value)
+}
+
+// PaddingInlineStyle sets the css property "padding-inline", which is a shorthand which sets the logical inline start and end paddings of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-inline
+func PaddingInlineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-inline", value)
+}
+
+// PaddingInlineEndStyle sets the css property "padding-inline-end", which sets the logical inline-end padding of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-inline-end
+func PaddingInlineEndStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-inline-end", value)
+}
+
+// PaddingInlineStartStyle sets the css property "padding-inline-start", which sets the logical inline-start padding of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-inline-start
+func PaddingInlineStartStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-inline-start", value)
+}
+
+// PaddingLeftStyle sets the css property "padding-left", which sets the width of the padding area to the left of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-left
+func PaddingLeftStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-left", value)
+}
+
+// PaddingRightStyle sets the css property "padding-right", which sets the width of the padding area on the right of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-right
+func PaddingRightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-right", value)
+}
+
+// PaddingTopStyle sets the css property "padding-top", which sets the height of the padding area on the top of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/padding-top
+func PaddingTopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("padding-top", value)
+}
+
+// PageBreakAfterStyle sets the css property "page-break-after", which adjusts the page breaks after the element, now known as break-after.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/page-break-after
+func PageBreakAfterStyle(value string) trees.Property {
+	return trees.NewCSSStyle("page-break-after", value)
+}
+
+// PageBreakBeforeStyle sets the css property "page-break-before", which adjusts the page breaks before the element, now known as break-before.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/page-break-before
+func PageBreakBeforeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("page-break-before", value)
+}
+
+// PageBreakInsideStyle sets the css property "page-break-inside", which adjusts the page breaks inside the element, now known as break-inside.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/page-break-inside
+func PageBreakInsideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("page-break-inside", value)
+}
+
+// PerspectiveStyle sets the css property "perspective", which determines the distance between the z=0 plane and the user.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/perspective
+func PerspectiveStyle(value string) trees.Property {
+	return trees.NewCSSStyle("perspective", value)
+}
+
+// PerspectiveOriginStyle sets the css property "perspective-origin", which sets the position at which the viewer is looking, used as the vanishing point of the perspective property.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/perspective-origin
+func PerspectiveOriginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("perspective-origin", value)
+}
+
+// PlaceContentStyle sets the css property "place-content", which is a shorthand which aligns content along both the block and inline directions.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/place-content
+func PlaceContentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("place-content", value)
+}
+
+// PlaceItemsStyle sets the css property "place-items", which is a shorthand which aligns items along both the block and inline directions.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/place-items
+func PlaceItemsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("place-items", value)
+}
+
+// PlaceSelfStyle sets the css property "place-self", which is a shorthand which aligns the element along both the block and inline directions.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/place-self
+func PlaceSelfStyle(value string) trees.Property {
+	return trees.NewCSSStyle("place-self", value)
+}
+
+// PointerEventsStyle sets the css property "pointer-events", which sets under what circumstances the element can become the target of pointer events.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/pointer-events
+func PointerEventsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("pointer-events", value)
+}
+
+// PointerEvents contains the values of the css property "pointer-events", eg 'PointerEvents.Auto'.
+var PointerEvents = struct {
+	Auto    StyleValue
+	None    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Auto:    StyleValue{Name: "pointer-events", Value: "auto"},
+	None:    StyleValue{Name: "pointer-events", Value: "none"},
+	Inherit: StyleValue{Name: "pointer-events", Value: "inherit"},
+	Initial: StyleValue{Name: "pointer-events", Value: "initial"},
+	Unset:   StyleValue{Name: "pointer-events", Value: "unset"},
+}
+
+// PositionStyle sets the css property "position", which sets how the element is positioned in the document.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/position
+func PositionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("position", value)
+}
+
+// Position contains the values of the css property "position", eg 'Position.Static'.
+var Position = struct {
+	Static   StyleValue
+	Relative StyleValue
+	Absolute StyleValue
+	Fixed    StyleValue
+	Sticky   StyleValue
+	Inherit  StyleValue
+	Initial  StyleValue
+	Unset    StyleValue
+}{
+	Static:   StyleValue{Name: "position", Value: "static"},
+	Relative: StyleValue{Name: "position", Value: "relative"},
+	Absolute: StyleValue{Name: "position", Value: "absolute"},
+	Fixed:    StyleValue{Name: "position", Value: "fixed"},
+	Sticky:   StyleValue{Name: "position", Value: "sticky"},
+	Inherit:  StyleValue{Name: "position", Value: "inherit"},
+	Initial:  StyleValue{Name: "position", Value: "initial"},
+	Unset:    StyleValue{Name: "position", Value: "unset"},
+}
+
+// QuotesStyle sets the css property "quotes", which sets how quotation marks appear.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/quotes
+func QuotesStyle(value string) trees.Property {
+	return trees.NewCSSStyle("quotes", value)
+}
+
+// ResizeStyle sets the css property "resize", which sets whether the element is resizable, and if so, in which directions.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/resize
+func ResizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("resize", value)
+}
+
+// Resize contains the values of the css property "resize", eg 'Resize.None'.
+var Resize = struct {
+	None       StyleValue
+	Both       StyleValue
+	Horizontal StyleValue
+	Vertical   StyleValue
+	Block      StyleValue
+	Inline     StyleValue
+	Inherit    StyleValue
+	Initial    StyleValue
+	Unset      StyleValue
+}{
+	None:       StyleValue{Name: "resize", Value: "none"},
+	Both:       StyleValue{Name: "resize", Value: "both"},
+	Horizontal: StyleValue{Name: "resize", Value: "horizontal"},
+	Vertical:   StyleValue{Name: "resize", Value: "vertical"},
+	Block:      StyleValue{Name: "resize", Value: "block"},
+	Inline:     StyleValue{Name: "resize", Value: "inline"},
+	Inherit:    StyleValue{Name: "resize", Value: "inherit"},
+	Initial:    StyleValue{Name: "resize", Value: "initial"},
+	Unset:      StyleValue{Name: "resize", Value: "unset"},
+}
+
+// RightStyle sets the css property "right", which participates in setting the horizontal position of a positioned element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/right
+func RightStyle(value string) trees.Property {
+	return trees.NewCSSStyle("right", value)
+}
+
+// RotateStyle sets the css property "rotate", which sets a rotation transform independently of the transform property.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/rotate
+func RotateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("rotate", value)
+}
+
+// RowGapStyle sets the css property "row-gap", which sets the size of the gap between the rows of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/row-gap
+func RowGapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("row-gap", value)
+}
+
+// ScaleStyle sets the css property "scale", which sets a scale transform independently of the transform property.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/scale
+func ScaleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scale", value)
+}
+
+// ScrollBehaviorStyle sets the css property "scroll-behavior", which sets the behavior for a scrolling box when scrolling is triggered.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/scroll-behavior
+func ScrollBehaviorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-behavior", value)
+}
+
+// ScrollBehavior contains the values of the css property "scroll-behavior", eg 'ScrollBehavior.Auto'.
+var ScrollBehavior = struct {
+	Auto    StyleValue
+	Smooth  StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Auto:    StyleValue{Name: "scroll-behavior", Value: "auto"},
+	Smooth:  StyleValue{Name: "scroll-behavior", Value: "smooth"},
+	Inherit: StyleValue{Name: "scroll-behavior", Value: "inherit"},
+	Initial: StyleValue{Name: "scroll-behavior", Value: "initial"},
+	Unset:   StyleValue{Name: "scroll-behavior", Value: "unset"},
+}
+
+// ScrollMarginStyle sets the css property "scroll-margin", which is a shorthand which sets the margins of the element used to snap it to the snapport.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/scroll-margin
+func ScrollMarginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-margin", value)
+}
+
+// ScrollPaddingStyle sets the css property "scroll-padding", which is a shorthand which sets the offsets of the optimal viewing region of a scroll container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/scroll-padding
+func ScrollPaddingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-padding", value)
+}
+
+// ScrollSnapAlignStyle sets the css property "scroll-snap-align", which sets the snap position of the element within its snap container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/scroll-snap-align
+func ScrollSnapAlignStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-snap-align", value)
+}
+
+// ScrollSnapTypeStyle sets the css property "scroll-snap-type", which sets how strictly snap points are enforced on a scroll container.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/scroll-snap-type
+func ScrollSnapTypeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("scroll-snap-type", value)
+}
+
+// ShapeOutsideStyle sets the css property "shape-outside", which defines a shape around which adjacent inline content wraps.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/shape-outside
+func ShapeOutsideStyle(value string) trees.Property {
+	return trees.NewCSSStyle("shape-outside", value)
+}
+
+// StrokeStyle sets the css property "stroke", which defines the color used to paint the outline of svg shapes.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/stroke
+func StrokeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke", value)
+}
+
+// StrokeDasharrayStyle sets the css property "stroke-dasharray", which sets the pattern of dashes and gaps used to paint the outline of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/stroke-dasharray
+func StrokeDasharrayStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-dasharray", value)
+}
+
+// StrokeDashoffsetStyle sets the css property "stroke-dashoffset", which sets the offset of the dash array used to paint the outline of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/stroke-dashoffset
+func StrokeDashoffsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-dashoffset", value)
+}
+
+// StrokeLinecapStyle sets the css property "stroke-linecap", which sets the shape of the ends of the open subpaths of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/stroke-linecap
+func StrokeLinecapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-linecap", value)
+}
+
+// StrokeLinejoinStyle sets the css property "stroke-linejoin", which sets the shape of the corners of the paths of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/stroke-linejoin
+func StrokeLinejoinStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-linejoin", value)
+}
+
+// StrokeOpacityStyle sets the css property "stroke-opacity", which sets the opacity of the paint applied to the outline of a svg shape.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/stroke-opacity
+func StrokeOpacityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-opacity", value)
+}
+
+// StrokeWidthStyle sets the css property "stroke-width", which defines the width of the stroke applied to svg shapes.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/stroke-width
+func StrokeWidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("stroke-width", value)
+}
+
+// TabSizeStyle sets the css property "tab-size", which specifies the width of tab characters.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/tab-size
+func TabSizeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("tab-size", value)
+}
+
+// TableLayoutStyle sets the css property "table-layout", which sets the algorithm used to lay out the cells, rows, and columns of a table.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/table-layout
+func TableLayoutStyle(value string) trees.Property {
+	return trees.NewCSSStyle("table-layout", value)
+}
+
+// TableLayout contains the values of the css property "table-layout", eg 'TableLayout.Auto'.
+var TableLayout = struct {
+	Auto    StyleValue
+	Fixed   StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Auto:    StyleValue{Name: "table-layout", Value: "auto"},
+	Fixed:   StyleValue{Name: "table-layout", Value: "fixed"},
+	Inherit: StyleValue{Name: "table-layout", Value: "inherit"},
+	Initial: StyleValue{Name: "table-layout", Value: "initial"},
+	Unset:   StyleValue{Name: "table-layout", Value: "unset"},
+}
+
+// TextAlignStyle sets the css property "text-align", which sets the horizontal alignment of the content inside a block element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-align
+func TextAlignStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-align", value)
+}
+
+// TextAlign contains the values of the css property "text-align", eg 'TextAlign.Start'.
+var TextAlign = struct {
+	Start       StyleValue
+	End         StyleValue
+	Left        StyleValue
+	Right       StyleValue
+	Center      StyleValue
+	Justify     StyleValue
+	MatchParent StyleValue
+	Inherit     StyleValue
+	Initial     StyleValue
+	Unset       StyleValue
+}{
+	Start:       StyleValue{Name: "text-align", Value: "start"},
+	End:         StyleValue{Name: "text-align", Value: "end"},
+	Left:        StyleValue{Name: "text-align", Value: "left"},
+	Right:       StyleValue{Name: "text-align", Value: "right"},
+	Center:      StyleValue{Name: "text-align", Value: "center"},
+	Justify:     StyleValue{Name: "text-align", Value: "justify"},
+	MatchParent: StyleValue{Name: "text-align", Value: "match-parent"},
+	Inherit:     StyleValue{Name: "text-align", Value: "inherit"},
+	Initial:     StyleValue{Name: "text-align", Value: "initial"},
+	Unset:       StyleValue{Name: "text-align", Value: "unset"},
+}
+
+// TextAlignLastStyle sets the css property "text-align-last", which sets how the last line of a block or a line before a forced line break is aligned.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-align-last
+func TextAlignLastStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-align-last", value)
+}
+
+// TextDecorationStyle sets the css property "text-decoration", which is a shorthand which sets the appearance of decorative lines on text.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-decoration
+func TextDecorationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration", value)
+}
+
+// TextDecorationColorStyle sets the css property "text-decoration-color", which sets the color of decorations added to text.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-decoration-color
+func TextDecorationColorStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-color", value)
+}
+
+// TextDecorationLineStyle sets the css property "text-decoration-line", which sets the kind of decoration that is used on the text of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-decoration-line
+func TextDecorationLineStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-line", value)
+}
+
+// TextDecorationLine contains the values of the css property "text-decoration-line", eg 'TextDecorationLine.None'.
+var TextDecorationLine = struct {
+	None        StyleValue
+	Underline   StyleValue
+	Overline    StyleValue
+	LineThrough StyleValue
+	Inherit     StyleValue
+	Initial     StyleValue
+	Unset       StyleValue
+}{
+	None:        StyleValue{Name: "text-decoration-line", Value: "none"},
+	Underline:   StyleValue{Name: "text-decoration-line", Value: "underline"},
+	Overline:    StyleValue{Name: "text-decoration-line", Value: "overline"},
+	LineThrough: StyleValue{Name: "text-decoration-line", Value: "line-through"},
+	Inherit:     StyleValue{Name: "text-decoration-line", Value: "inherit"},
+	Initial:     StyleValue{Name: "text-decoration-line", Value: "initial"},
+	Unset:       StyleValue{Name: "text-decoration-line", Value: "unset"},
+}
+
+// TextDecorationStyleStyle sets the css property "text-decoration-style", which sets the style of the lines specified by text-decoration-line.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-decoration-style
+func TextDecorationStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-decoration-style", value)
+}
+
+// TextDecorationStyles contains the values of the css property "text-decoration-style", eg 'TextDecorationStyles.Solid'.
+var TextDecorationStyles = struct {
+	Solid   StyleValue
+	Double  StyleValue
+	Dotted  StyleValue
+	Dashed  StyleValue
+	Wavy    StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Solid:   StyleValue{Name: "text-decoration-style", Value: "solid"},
+	Double:  StyleValue{Name: "text-decoration-style", Value: "double"},
+	Dotted:  StyleValue{Name: "text-decoration-style", Value: "dotted"},
+	Dashed:  StyleValue{Name: "text-decoration-style", Value: "dashed"},
+	Wavy:    StyleValue{Name: "text-decoration-style", Value: "wavy"},
+	Inherit: StyleValue{Name: "text-decoration-style", Value: "inherit"},
+	Initial: StyleValue{Name: "text-decoration-style", Value: "initial"},
+	Unset:   StyleValue{Name: "text-decoration-style", Value: "unset"},
+}
+
+// TextIndentStyle sets the css property "text-indent", which sets the length of empty space that is put before lines of text.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-indent
+func TextIndentStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-indent", value)
+}
+
+// TextJustifyStyle sets the css property "text-justify", which sets the justification applied to text when text-align is justify.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-justify
+func TextJustifyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-justify", value)
+}
+
+// TextOverflowStyle sets the css property "text-overflow", which sets how hidden overflow content is signaled to users.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-overflow
+func TextOverflowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-overflow", value)
+}
+
+// TextOverflow contains the values of the css property "text-overflow", eg 'TextOverflow.Clip'.
+var TextOverflow = struct {
+	Clip     StyleValue
+	Ellipsis StyleValue
+	Inherit  StyleValue
+	Initial  StyleValue
+	Unset    StyleValue
+}{
+	Clip:     StyleValue{Name: "text-overflow", Value: "clip"},
+	Ellipsis: StyleValue{Name: "text-overflow", Value: "ellipsis"},
+	Inherit:  StyleValue{Name: "text-overflow", Value: "inherit"},
+	Initial:  StyleValue{Name: "text-overflow", Value: "initial"},
+	Unset:    StyleValue{Name: "text-overflow", Value: "unset"},
+}
+
+// TextRenderingStyle sets the css property "text-rendering", which provides hints to the rendering engine about what to optimize for when rendering text.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-rendering
+func TextRenderingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-rendering", value)
+}
+
+// TextShadowStyle sets the css property "text-shadow", which adds shadows to text.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-shadow
+func TextShadowStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-shadow", value)
+}
+
+// TextTransformStyle sets the css property "text-transform", which specifies how to capitalize the text of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-transform
+func TextTransformStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-transform", value)
+}
+
+// TextTransform contains the values of the css property "text-transform", eg 'TextTransform.None'.
+var TextTransform = struct {
+	None       StyleValue
+	Capitalize StyleValue
+	Uppercase  StyleValue
+	Lowercase  StyleValue
+	FullWidth  StyleValue
+	Inherit    StyleValue
+	Initial    StyleValue
+	Unset      StyleValue
+}{
+	None:       StyleValue{Name: "text-transform", Value: "none"},
+	Capitalize: StyleValue{Name: "text-transform", Value: "capitalize"},
+	Uppercase:  StyleValue{Name: "text-transform", Value: "uppercase"},
+	Lowercase:  StyleValue{Name: "text-transform", Value: "lowercase"},
+	FullWidth:  StyleValue{Name: "text-transform", Value: "full-width"},
+	Inherit:    StyleValue{Name: "text-transform", Value: "inherit"},
+	Initial:    StyleValue{Name: "text-transform", Value: "initial"},
+	Unset:      StyleValue{Name: "text-transform", Value: "unset"},
+}
+
+// TextUnderlineOffsetStyle sets the css property "text-underline-offset", which sets the offset distance of the underline of the text from its original position.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/text-underline-offset
+func TextUnderlineOffsetStyle(value string) trees.Property {
+	return trees.NewCSSStyle("text-underline-offset", value)
+}
+
+// TopStyle sets the css property "top", which participates in setting the vertical position of a positioned element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/top
+func TopStyle(value string) trees.Property {
+	return trees.NewCSSStyle("top", value)
+}
+
+// TouchActionStyle sets the css property "touch-action", which sets how the region of the element can be manipulated by a touchscreen user.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/touch-action
+func TouchActionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("touch-action", value)
+}
+
+// TouchAction contains the values of the css property "touch-action", eg 'TouchAction.Auto'.
+var TouchAction = struct {
+	Auto         StyleValue
+	None         StyleValue
+	PanX         StyleValue
+	PanY         StyleValue
+	Manipulation StyleValue
+	PinchZoom    StyleValue
+	Inherit      StyleValue
+	Initial      StyleValue
+	Unset        StyleValue
+}{
+	Auto:         StyleValue{Name: "touch-action", Value: "auto"},
+	None:         StyleValue{Name: "touch-action", Value: "none"},
+	PanX:         StyleValue{Name: "touch-action", Value: "pan-x"},
+	PanY:         StyleValue{Name: "touch-action", Value: "pan-y"},
+	Manipulation: StyleValue{Name: "touch-action", Value: "manipulation"},
+	PinchZoom:    StyleValue{Name: "touch-action", Value: "pinch-zoom"},
+	Inherit:      StyleValue{Name: "touch-action", Value: "inherit"},
+	Initial:      StyleValue{Name: "touch-action", Value: "initial"},
+	Unset:        StyleValue{Name: "touch-action", Value: "unset"},
+}
+
+// TransformStyle sets the css property "transform", which lets you rotate, scale, skew, or translate the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transform
+func TransformStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transform", value)
+}
+
+// TransformOriginStyle sets the css property "transform-origin", which sets the origin for the transformations of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transform-origin
+func TransformOriginStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transform-origin", value)
+}
+
+// TransformStyleStyle sets the css property "transform-style", which sets whether the children of the element are positioned in the 3d space or flattened in its plane.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transform-style
+func TransformStyleStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transform-style", value)
+}
+
+// TransitionStyle sets the css property "transition", which is a shorthand which sets the transitions of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transition
+func TransitionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition", value)
+}
+
+// TransitionDelayStyle sets the css property "transition-delay", which specifies the duration to wait before starting a transition.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transition-delay
+func TransitionDelayStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-delay", value)
+}
+
+// TransitionDurationStyle sets the css property "transition-duration", which sets the length of time a transition takes to complete.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transition-duration
+func TransitionDurationStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-duration", value)
+}
+
+// TransitionPropertyStyle sets the css property "transition-property", which sets the css properties to which a transition effect is applied.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transition-property
+func TransitionPropertyStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-property", value)
+}
+
+// TransitionTimingFunctionStyle sets the css property "transition-timing-function", which sets how intermediate values are calculated for css properties being affected by a transition.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/transition-timing-function
+func TransitionTimingFunctionStyle(value string) trees.Property {
+	return trees.NewCSSStyle("transition-timing-function", value)
+}
+
+// TransitionTimingFunction contains the values of the css property "transition-timing-function", eg 'TransitionTimingFunction.Ease'.
+var TransitionTimingFunction = struct {
+	Ease      StyleValue
+	EaseIn    StyleValue
+	EaseOut   StyleValue
+	EaseInOut StyleValue
+	Linear    StyleValue
+	StepStart StyleValue
+	StepEnd   StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Ease:      StyleValue{Name: "transition-timing-function", Value: "ease"},
+	EaseIn:    StyleValue{Name: "transition-timing-function", Value: "ease-in"},
+	EaseOut:   StyleValue{Name: "transition-timing-function", Value: "ease-out"},
+	EaseInOut: StyleValue{Name: "transition-timing-function", Value: "ease-in-out"},
+	Linear:    StyleValue{Name: "transition-timing-function", Value: "linear"},
+	StepStart: StyleValue{Name: "transition-timing-function", Value: "step-start"},
+	StepEnd:   StyleValue{Name: "transition-timing-function", Value: "step-end"},
+	Inherit:   StyleValue{Name: "transition-timing-function", Value: "inherit"},
+	Initial:   StyleValue{Name: "transition-timing-function", Value: "initial"},
+	Unset:     StyleValue{Name: "transition-timing-function", Value: "unset"},
+}
+
+// TranslateStyle sets the css property "translate", which sets a translation transform independently of the transform property.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/translate
+func TranslateStyle(value string) trees.Property {
+	return trees.NewCSSStyle("translate", value)
+}
+
+// UnicodeBidiStyle sets the css property "unicode-bidi", which sets how bidirectional text in the element is handled, together with direction.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/unicode-bidi
+func UnicodeBidiStyle(value string) trees.Property {
+	return trees.NewCSSStyle("unicode-bidi", value)
+}
+
+// UserSelectStyle sets the css property "user-select", which controls whether the user can select text.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/user-select
+func UserSelectStyle(value string) trees.Property {
+	return trees.NewCSSStyle("user-select", value)
+}
+
+// UserSelect contains the values of the css property "user-select", eg 'UserSelect.Auto'.
+var UserSelect = struct {
+	Auto    StyleValue
+	Text    StyleValue
+	None    StyleValue
+	Contain StyleValue
+	All     StyleValue
+	Inherit StyleValue
+	Initial StyleValue
+	Unset   StyleValue
+}{
+	Auto:    StyleValue{Name: "user-select", Value: "auto"},
+	Text:    StyleValue{Name: "user-select", Value: "text"},
+	None:    StyleValue{Name: "user-select", Value: "none"},
+	Contain: StyleValue{Name: "user-select", Value: "contain"},
+	All:     StyleValue{Name: "user-select", Value: "all"},
+	Inherit: StyleValue{Name: "user-select", Value: "inherit"},
+	Initial: StyleValue{Name: "user-select", Value: "initial"},
+	Unset:   StyleValue{Name: "user-select", Value: "unset"},
+}
+
+// VerticalAlignStyle sets the css property "vertical-align", which sets the vertical alignment of an inline, inline-block or table-cell box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/vertical-align
+func VerticalAlignStyle(value string) trees.Property {
+	return trees.NewCSSStyle("vertical-align", value)
+}
+
+// VerticalAlign contains the values of the css property "vertical-align", eg 'VerticalAlign.Baseline'.
+var VerticalAlign = struct {
+	Baseline   StyleValue
+	Sub        StyleValue
+	Super      StyleValue
+	TextTop    StyleValue
+	TextBottom StyleValue
+	Middle     StyleValue
+	Top        StyleValue
+	Bottom     StyleValue
+	Inherit    StyleValue
+	Initial    StyleValue
+	Unset      StyleValue
+}{
+	Baseline:   StyleValue{Name: "vertical-align", Value: "baseline"},
+	Sub:        StyleValue{Name: "vertical-align", Value: "sub"},
+	Super:      StyleValue{Name: "vertical-align", Value: "super"},
+	TextTop:    StyleValue{Name: "vertical-align", Value: "text-top"},
+	TextBottom: StyleValue{Name: "vertical-align", Value: "text-bottom"},
+	Middle:     StyleValue{Name: "vertical-align", Value: "middle"},
+	Top:        StyleValue{Name: "vertical-align", Value: "top"},
+	Bottom:     StyleValue{Name: "vertical-align", Value: "bottom"},
+	Inherit:    StyleValue{Name: "vertical-align", Value: "inherit"},
+	Initial:    StyleValue{Name: "vertical-align", Value: "initial"},
+	Unset:      StyleValue{Name: "vertical-align", Value: "unset"},
+}
+
+// VisibilityStyle sets the css property "visibility", which shows or hides the element without changing the layout of a document.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/visibility
+func VisibilityStyle(value string) trees.Property {
+	return trees.NewCSSStyle("visibility", value)
+}
+
+// Visibility contains the values of the css property "visibility", eg 'Visibility.Visible'.
+var Visibility = struct {
+	Visible  StyleValue
+	Hidden   StyleValue
+	Collapse StyleValue
+	Inherit  StyleValue
+	Initial  StyleValue
+	Unset    StyleValue
+}{
+	Visible:  StyleValue{Name: "visibility", Value: "visible"},
+	Hidden:   StyleValue{Name: "visibility", Value: "hidden"},
+	Collapse: StyleValue{Name: "visibility", Value: "collapse"},
+	Inherit:  StyleValue{Name: "visibility", Value: "inherit"},
+	Initial:  StyleValue{Name: "visibility", Value: "initial"},
+	Unset:    StyleValue{Name: "visibility", Value: "unset"},
+}
+
+// WhiteSpaceStyle sets the css property "white-space", which sets how white space inside the element is handled.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/white-space
+func WhiteSpaceStyle(value string) trees.Property {
+	return trees.NewCSSStyle("white-space", value)
+}
+
+// WhiteSpace contains the values of the css property "white-space", eg 'WhiteSpace.Normal'.
+var WhiteSpace = struct {
+	Normal      StyleValue
+	NoWrap      StyleValue
+	Pre         StyleValue
+	PreWrap     StyleValue
+	PreLine     StyleValue
+	BreakSpaces StyleValue
+	Inherit     StyleValue
+	Initial     StyleValue
+	Unset       StyleValue
+}{
+	Normal:      StyleValue{Name: "white-space", Value: "normal"},
+	NoWrap:      StyleValue{Name: "white-space", Value: "nowrap"},
+	Pre:         StyleValue{Name: "white-space", Value: "pre"},
+	PreWrap:     StyleValue{Name: "white-space", Value: "pre-wrap"},
+	PreLine:     StyleValue{Name: "white-space", Value: "pre-line"},
+	BreakSpaces: StyleValue{Name: "white-space", Value: "break-spaces"},
+	Inherit:     StyleValue{Name: "white-space", Value: "inherit"},
+	Initial:     StyleValue{Name: "white-space", Value: "initial"},
+	Unset:       StyleValue{Name: "white-space", Value: "unset"},
+}
+
+// WidowsStyle sets the css property "widows", which sets the minimum number of lines of a block left at the top of a page, region or column.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/widows
+func WidowsStyle(value string) trees.Property {
+	return trees.NewCSSStyle("widows", value)
+}
+
+// WidthStyle sets the css property "width", which sets the width of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/width
+func WidthStyle(value string) trees.Property {
+	return trees.NewCSSStyle("width", value)
+}
+
+// WillChangeStyle sets the css property "will-change", which hints to browsers how the element is expected to change.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/will-change
+func WillChangeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("will-change", value)
+}
+
+// WordBreakStyle sets the css property "word-break", which sets whether line breaks appear wherever the text would otherwise overflow its content box.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/word-break
+func WordBreakStyle(value string) trees.Property {
+	return trees.NewCSSStyle("word-break", value)
+}
+
+// WordBreak contains the values of the css property "word-break", eg 'WordBreak.Normal'.
+var WordBreak = struct {
+	Normal    StyleValue
+	BreakAll  StyleValue
+	KeepAll   StyleValue
+	BreakWord StyleValue
+	Inherit   StyleValue
+	Initial   StyleValue
+	Unset     StyleValue
+}{
+	Normal:    StyleValue{Name: "word-break", Value: "normal"},
+	BreakAll:  StyleValue{Name: "word-break", Value: "break-all"},
+	KeepAll:   StyleValue{Name: "word-break", Value: "keep-all"},
+	BreakWord: StyleValue{Name: "word-break", Value: "break-word"},
+	Inherit:   StyleValue{Name: "word-break", Value: "inherit"},
+	Initial:   StyleValue{Name: "word-break", Value: "initial"},
+	Unset:     StyleValue{Name: "word-break", Value: "unset"},
+}
+
+// WordSpacingStyle sets the css property "word-spacing", which sets the length of space between words and between tags.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/word-spacing
+func WordSpacingStyle(value string) trees.Property {
+	return trees.NewCSSStyle("word-spacing", value)
+}
+
+// WordWrapStyle sets the css property "word-wrap", which sets whether the browser breaks lines within words to prevent overflow, now known as overflow-wrap.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/word-wrap
+func WordWrapStyle(value string) trees.Property {
+	return trees.NewCSSStyle("word-wrap", value)
+}
+
+// WritingModeStyle sets the css property "writing-mode", which sets whether lines of text are laid out horizontally or vertically.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/writing-mode
+func WritingModeStyle(value string) trees.Property {
+	return trees.NewCSSStyle("writing-mode", value)
+}
+
+// WritingMode contains the values of the css property "writing-mode", eg 'WritingMode.HorizontalTB'.
+var WritingMode = struct {
+	HorizontalTB StyleValue
+	VerticalRL   StyleValue
+	VerticalLR   StyleValue
+	Inherit      StyleValue
+	Initial      StyleValue
+	Unset        StyleValue
+}{
+	HorizontalTB: StyleValue{Name: "writing-mode", Value: "horizontal-tb"},
+	VerticalRL:   StyleValue{Name: "writing-mode", Value: "vertical-rl"},
+	VerticalLR:   StyleValue{Name: "writing-mode", Value: "vertical-lr"},
+	Inherit:      StyleValue{Name: "writing-mode", Value: "inherit"},
+	Initial:      StyleValue{Name: "writing-mode", Value: "initial"},
+	Unset:        StyleValue{Name: "writing-mode", Value: "unset"},
+}
+
+// ZIndexStyle sets the css property "z-index", which sets the z-order of a positioned element and its descendants.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/z-index
+func ZIndexStyle(value string) trees.Property {
+	return trees.NewCSSStyle("z-index", value)
+}
+
+// ZoomStyle sets the css property "zoom", which sets the magnification level of the element.
+// https://developer.mozilla.org/en-US/docs/Web/CSS/zoom
+func ZoomStyle(value string) trees.Property {
+	return trees.NewCSSStyle("zoom", value)
+}
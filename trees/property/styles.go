@@ -8,42 +8,32 @@ func CustomStyle(name, value string) trees.Property {
 	return trees.NewCSSStyle(name, value)
 }
 
-// BackgroundStyle provides the color style value
-func BackgroundStyle(value string) trees.Property {
-	return trees.NewCSSStyle("background", value)
-}
-
-// ColorStyle provides the color style value
-func ColorStyle(value string) trees.Property {
-	return trees.NewCSSStyle("color", value)
-}
-
-// DisplayStyle provides the style setter that sets the css display value.
-func DisplayStyle(ops string) trees.Property {
-	return trees.NewCSSStyle("display", ops)
-}
-
-// HeightStyle provides the height style value
-func HeightStyle(size string) trees.Property {
-	return trees.NewCSSStyle("height", size)
+// FontstringStyle provides the font-size style value.
+//
+// Deprecated: Use FontSizeStyle.
+func FontstringStyle(size string) trees.Property {
+	return FontSizeStyle(size)
 }
 
-// FontstringStyle provides the margin style value
-func FontstringStyle(size string) trees.Property {
-	return trees.NewCSSStyle("font-size", size)
+// StyleValue defines a css property set to one of its enumerated values, eg
+// 'Display.Flex'. It adds a new style to every element it applies to, allowing
+// the same value to be shared between elements.
+type StyleValue struct {
+	Name  string
+	Value string
 }
 
-// PaddingStyle provides the margin style value
-func PaddingStyle(size string) trees.Property {
-	return trees.NewCSSStyle("padding", size)
+// Apply adds the style with the value to the element.
+func (s StyleValue) Apply(e *trees.Markup) {
+	trees.NewCSSStyle(s.Name, s.Value).Apply(e)
 }
 
-// MarginStyle provides the margin style value
-func MarginStyle(size string) trees.Property {
-	return trees.NewCSSStyle("margin", size)
+// Clone returns the value, which is never changed.
+func (s StyleValue) Clone() trees.Property {
+	return s
 }
 
-// WidthStyle provides the width style value
-func WidthStyle(size string) trees.Property {
-	return trees.NewCSSStyle("width", size)
+// Render returns the key and value for this style rendered.
+func (s StyleValue) Render() (string, string) {
+	return s.Name, s.Value
 }
@@ -0,0 +1,207 @@
+// +build ignore
+
+package main
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"io/ioutil"
+	"log"
+	"strings"
+)
+
+// attribute defines a svg attribute with the description of its documentation.
+type attribute struct {
+	name string
+	desc string
+}
+
+// presentation contains the presentation attributes of SVG 2, which can also
+// be set as css properties.
+var presentation = []string{
+	"alignment-baseline", "baseline-shift", "clip", "clip-path", "clip-rule",
+	"color", "color-interpolation", "color-interpolation-filters",
+	"color-rendering", "cursor", "direction", "display", "dominant-baseline",
+	"fill", "fill-opacity", "fill-rule", "filter", "flood-color",
+	"flood-opacity", "font-family", "font-size", "font-size-adjust",
+	"font-stretch", "font-style", "font-variant", "font-weight",
+	"image-rendering", "letter-spacing", "lighting-color", "marker-end",
+	"marker-mid", "marker-start", "mask", "opacity", "overflow", "paint-order",
+	"pointer-events", "shape-rendering", "stop-color", "stop-opacity", "stroke",
+	"stroke-dasharray", "stroke-dashoffset", "stroke-linecap",
+	"stroke-linejoin", "stroke-miterlimit", "stroke-opacity", "stroke-width",
+	"text-anchor", "text-decoration", "text-overflow", "text-rendering",
+	"transform", "transform-origin", "unicode-bidi", "vector-effect",
+	"visibility", "white-space", "word-spacing", "writing-mode",
+}
+
+// attributes contains the regular attributes of SVG 2 elements, where names
+// keep their case.
+var attributes = []attribute{
+	{"viewBox", "defines the position and dimension, in user space, of an SVG viewport"},
+	{"preserveAspectRatio", "indicates how an element with a viewBox providing a given aspect ratio must fit into a viewport with a different aspect ratio"},
+	{"href", "defines a link to a resource as a reference URL"},
+	{"x", "defines an x-axis coordinate in the user coordinate system"},
+	{"y", "defines a y-axis coordinate in the user coordinate system"},
+	{"width", "defines the horizontal length of an element in the user coordinate system"},
+	{"height", "defines the vertical length of an element in the user coordinate system"},
+	{"cx", "defines the x-axis coordinate of a center point"},
+	{"cy", "defines the y-axis coordinate of a center point"},
+	{"r", "defines the radius of a circle"},
+	{"rx", "defines a radius on the x-axis"},
+	{"ry", "defines a radius on the y-axis"},
+	{"x1", "defines the x-axis coordinate of the start of a line or gradient vector"},
+	{"y1", "defines the y-axis coordinate of the start of a line or gradient vector"},
+	{"x2", "defines the x-axis coordinate of the end of a line or gradient vector"},
+	{"y2", "defines the y-axis coordinate of the end of a line or gradient vector"},
+	{"fx", "defines the x-axis coordinate of the focal point for a radial gradient"},
+	{"fy", "defines the y-axis coordinate of the focal point for a radial gradient"},
+	{"d", "defines a path to be drawn"},
+	{"points", "defines a list of points for polylines and polygons"},
+	{"pathLength", "lets authors specify a total length for the path, in user units"},
+	{"dx", "indicates a shift along the x-axis on the position of an element or its content"},
+	{"dy", "indicates a shift along the y-axis on the position of an element or its content"},
+	{"rotate", "specifies how the animated element rotates as it travels along a path, or the rotation of glyphs"},
+	{"textLength", "lets the author specify a width to which the text should be rendered"},
+	{"lengthAdjust", "controls how the text is stretched into the length defined by textLength"},
+	{"startOffset", "defines an offset from the start of the path for the initial current text position"},
+	{"offset", "defines where the gradient stop is placed along the gradient vector"},
+	{"gradientUnits", "defines the coordinate system for attributes of gradients"},
+	{"gradientTransform", "contains the definitions of an optional additional transformation from the gradient coordinate system"},
+	{"spreadMethod", "determines how a shape is filled beyond the defined edges of a gradient"},
+	{"patternUnits", "indicates which coordinate system to use for the geometry properties of a pattern"},
+	{"patternContentUnits", "indicates which coordinate system to use for the contents of a pattern"},
+	{"patternTransform", "defines a list of transform definitions that are applied to a pattern tile"},
+	{"markerWidth", "defines the width of the marker viewport"},
+	{"markerHeight", "defines the height of the marker viewport"},
+	{"markerUnits", "defines the coordinate system for the markerWidth and markerHeight attributes"},
+	{"refX", "defines the x coordinate of the reference point of a marker or symbol"},
+	{"refY", "defines the y coordinate of the reference point of a marker or symbol"},
+	{"orient", "indicates how a marker is rotated when it is placed at its position on the shape"},
+	{"maskUnits", "indicates which coordinate system to use for the geometry properties of a mask"},
+	{"maskContentUnits", "indicates which coordinate system to use for the contents of a mask"},
+	{"clipPathUnits", "indicates which coordinate system to use for the contents of a clipPath"},
+	{"filterUnits", "defines the coordinate system for the attributes x, y, width and height of a filter"},
+	{"primitiveUnits", "specifies the coordinate system for the various length values within the filter primitives"},
+	{"in", "identifies input for the given filter primitive"},
+	{"in2", "identifies the second input for the given filter primitive"},
+	{"result", "defines the assigned name for a filter primitive"},
+	{"stdDeviation", "defines the standard deviation for the blur operation"},
+	{"mode", "defines the blending mode of a feBlend filter primitive"},
+	{"operator", "defines the compositing or morphing operation to be performed"},
+	{"type", "defines the type of a color matrix, transfer function or turbulence"},
+	{"values", "defines a list of values, for animations and color matrices"},
+	{"k1", "defines one of the values to be used within the arithmetic operation of a feComposite"},
+	{"k2", "defines one of the values to be used within the arithmetic operation of a feComposite"},
+	{"k3", "defines one of the values to be used within the arithmetic operation of a feComposite"},
+	{"k4", "defines one of the values to be used within the arithmetic operation of a feComposite"},
+	{"tableValues", "defines a list of numbers defining a lookup table for a transfer function"},
+	{"slope", "defines the slope of a linear transfer function"},
+	{"intercept", "defines the intercept of a linear transfer function"},
+	{"amplitude", "controls the amplitude of a gamma transfer function"},
+	{"exponent", "defines the exponent of a gamma transfer function"},
+	{"baseFrequency", "represents the base frequency parameter for the noise function of a feTurbulence"},
+	{"numOctaves", "defines the number of octaves for the noise function of a feTurbulence"},
+	{"seed", "represents the starting number for the pseudo random number generator of a feTurbulence"},
+	{"stitchTiles", "defines how the Perlin Noise tiles behave at the border"},
+	{"scale", "defines the displacement scale factor of a feDisplacementMap"},
+	{"xChannelSelector", "indicates which color channel from in2 displaces the pixels along the x-axis"},
+	{"yChannelSelector", "indicates which color channel from in2 displaces the pixels along the y-axis"},
+	{"radius", "represents the radius for the operation of a feMorphology"},
+	{"order", "indicates the size of the matrix of a feConvolveMatrix"},
+	{"kernelMatrix", "defines the list of numbers that make up the kernel matrix of a feConvolveMatrix"},
+	{"divisor", "specifies the value by which the result of a feConvolveMatrix is divided"},
+	{"bias", "shifts the range of the filter of a feConvolveMatrix"},
+	{"targetX", "determines the positioning in the horizontal direction of the convolution matrix"},
+	{"targetY", "determines the positioning in the vertical direction of the convolution matrix"},
+	{"edgeMode", "determines how to extend the input image as necessary with color values"},
+	{"preserveAlpha", "indicates how a feConvolveMatrix handles alpha transparency"},
+	{"surfaceScale", "represents the height of the surface for a light filter primitive"},
+	{"diffuseConstant", "represents the kd value in the Phong lighting model"},
+	{"specularConstant", "controls the ratio of reflection of the specular lighting"},
+	{"specularExponent", "controls the focus for the light source"},
+	{"azimuth", "specifies the direction angle for the light source on the XY plane"},
+	{"elevation", "specifies the direction angle for the light source from the XY plane towards the z-axis"},
+	{"z", "defines the location along the z-axis for a light source"},
+	{"pointsAtX", "represents the x location in the coordinate system of the point at which the light source is pointing"},
+	{"pointsAtY", "represents the y location in the coordinate system of the point at which the light source is pointing"},
+	{"pointsAtZ", "represents the z location in the coordinate system of the point at which the light source is pointing"},
+	{"limitingConeAngle", "represents the angle in degrees between the spot light axis and the spot light cone"},
+	{"attributeName", "indicates the name of the attribute of the target element that is going to be changed during an animation"},
+	{"begin", "defines when an animation should begin"},
+	{"dur", "indicates the simple duration of an animation"},
+	{"end", "defines an end value for the animation that can constrain the active duration"},
+	{"from", "indicates the initial value of the attribute that will be modified during the animation"},
+	{"to", "indicates the final value of the attribute that will be modified during the animation"},
+	{"by", "specifies a relative offset value for an attribute that will be modified during an animation"},
+	{"repeatCount", "indicates the number of times an animation will take place"},
+	{"repeatDur", "specifies the total duration for repeating an animation"},
+	{"calcMode", "specifies the interpolation mode for the animation"},
+	{"keyTimes", "represents a list of time values used to control the pacing of the animation"},
+	{"keySplines", "defines a set of Bézier curve control points associated with the keyTimes list"},
+	{"keyPoints", "indicates the progress along the motion path for each of the keyTimes values"},
+	{"path", "defines the motion path of an animateMotion"},
+	{"additive", "controls whether or not an animation is additive"},
+	{"accumulate", "controls whether or not an animation is cumulative"},
+	{"restart", "specifies whether or not an animation can restart"},
+	{"requiredExtensions", "lists the extensions required for the element to be rendered"},
+	{"systemLanguage", "represents the languages for which the element is rendered"},
+}
+
+func main() {
+	var file bytes.Buffer
+
+	fmt.Fprint(&file, `// Package svgattr contains attributes for svg element types, created with the
+// case of their names, eg 'viewBox'.
+
+//go:generate go run generate.go
+
+// Documentation source: "SVG attribute reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute, licensed under CC-BY-SA 2.5.
+
+package svgattr
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+`)
+
+	for _, attr := range attributes {
+		writeAttr(&file, attr.name, attr.desc)
+	}
+
+	for _, name := range presentation {
+		writeAttr(&file, name, "is a presentation attribute which can also be set as the css property of the same name")
+	}
+
+	source, err := format.Source(file.Bytes())
+	if err != nil {
+		log.Fatalf("Unable to format svg attributes: %s", err)
+	}
+
+	if err := ioutil.WriteFile("svgattr.gen.go", source, 0644); err != nil {
+		log.Fatalf("Unable to write svg attributes: %s", err)
+	}
+}
+
+func writeAttr(w *bytes.Buffer, name, desc string) {
+	funName := restruct(name)
+
+	fmt.Fprintf(w, `
+// %s defines the svg attribute %q, which %s.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/%s
+func %s(val string) trees.Property {
+	return &trees.Attribute{Name: %q, Value: val}
+}
+`, funName, name, desc, name, funName, name)
+}
+
+// restruct returns the name capitalized at every hyphen, eg 'StrokeWidth'.
+func restruct(name string) string {
+	parts := strings.Split(name, "-")
+	for index, part := range parts {
+		parts[index] = strings.ToUpper(part[:1]) + part[1:]
+	}
+
+	return strings.Join(parts, "")
+}
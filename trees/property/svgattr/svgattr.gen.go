@@ -0,0 +1,1020 @@
+// Package svgattr contains attributes for svg element types, created with the
+// case of their names, eg 'viewBox'.
+
+//go:generate go run generate.go
+
+// Documentation source: "SVG attribute reference" by Mozilla Contributors, https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute, licensed under CC-BY-SA 2.5.
+
+package svgattr
+
+import (
+	"github.com/gu-io/gu/trees"
+)
+
+// ViewBox defines the svg attribute "viewBox", which defines the position and dimension, in user space, of an SVG viewport.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/viewBox
+func ViewBox(val string) trees.Property {
+	return &trees.Attribute{Name: "viewBox", Value: val}
+}
+
+// PreserveAspectRatio defines the svg attribute "preserveAspectRatio", which indicates how an element with a viewBox providing a given aspect ratio must fit into a viewport with a different aspect ratio.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/preserveAspectRatio
+func PreserveAspectRatio(val string) trees.Property {
+	return &trees.Attribute{Name: "preserveAspectRatio", Value: val}
+}
+
+// Href defines the svg attribute "href", which defines a link to a resource as a reference URL.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/href
+func Href(val string) trees.Property {
+	return &trees.Attribute{Name: "href", Value: val}
+}
+
+// X defines the svg attribute "x", which defines an x-axis coordinate in the user coordinate system.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/x
+func X(val string) trees.Property {
+	return &trees.Attribute{Name: "x", Value: val}
+}
+
+// Y defines the svg attribute "y", which defines a y-axis coordinate in the user coordinate system.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/y
+func Y(val string) trees.Property {
+	return &trees.Attribute{Name: "y", Value: val}
+}
+
+// Width defines the svg attribute "width", which defines the horizontal length of an element in the user coordinate system.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/width
+func Width(val string) trees.Property {
+	return &trees.Attribute{Name: "width", Value: val}
+}
+
+// Height defines the svg attribute "height", which defines the vertical length of an element in the user coordinate system.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/height
+func Height(val string) trees.Property {
+	return &trees.Attribute{Name: "height", Value: val}
+}
+
+// Cx defines the svg attribute "cx", which defines the x-axis coordinate of a center point.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/cx
+func Cx(val string) trees.Property {
+	return &trees.Attribute{Name: "cx", Value: val}
+}
+
+// Cy defines the svg attribute "cy", which defines the y-axis coordinate of a center point.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/cy
+func Cy(val string) trees.Property {
+	return &trees.Attribute{Name: "cy", Value: val}
+}
+
+// R defines the svg attribute "r", which defines the radius of a circle.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/r
+func R(val string) trees.Property {
+	return &trees.Attribute{Name: "r", Value: val}
+}
+
+// Rx defines the svg attribute "rx", which defines a radius on the x-axis.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/rx
+func Rx(val string) trees.Property {
+	return &trees.Attribute{Name: "rx", Value: val}
+}
+
+// Ry defines the svg attribute "ry", which defines a radius on the y-axis.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/ry
+func Ry(val string) trees.Property {
+	return &trees.Attribute{Name: "ry", Value: val}
+}
+
+// X1 defines the svg attribute "x1", which defines the x-axis coordinate of the start of a line or gradient vector.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/x1
+func X1(val string) trees.Property {
+	return &trees.Attribute{Name: "x1", Value: val}
+}
+
+// Y1 defines the svg attribute "y1", which defines the y-axis coordinate of the start of a line or gradient vector.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/y1
+func Y1(val string) trees.Property {
+	return &trees.Attribute{Name: "y1", Value: val}
+}
+
+// X2 defines the svg attribute "x2", which defines the x-axis coordinate of the end of a line or gradient vector.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/x2
+func X2(val string) trees.Property {
+	return &trees.Attribute{Name: "x2", Value: val}
+}
+
+// Y2 defines the svg attribute "y2", which defines the y-axis coordinate of the end of a line or gradient vector.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/y2
+func Y2(val string) trees.Property {
+	return &trees.Attribute{Name: "y2", Value: val}
+}
+
+// Fx defines the svg attribute "fx", which defines the x-axis coordinate of the focal point for a radial gradient.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/fx
+func Fx(val string) trees.Property {
+	return &trees.Attribute{Name: "fx", Value: val}
+}
+
+// Fy defines the svg attribute "fy", which defines the y-axis coordinate of the focal point for a radial gradient.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/fy
+func Fy(val string) trees.Property {
+	return &trees.Attribute{Name: "fy", Value: val}
+}
+
+// D defines the svg attribute "d", which defines a path to be drawn.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/d
+func D(val string) trees.Property {
+	return &trees.Attribute{Name: "d", Value: val}
+}
+
+// Points defines the svg attribute "points", which defines a list of points for polylines and polygons.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/points
+func Points(val string) trees.Property {
+	return &trees.Attribute{Name: "points", Value: val}
+}
+
+// PathLength defines the svg attribute "pathLength", which lets authors specify a total length for the path, in user units.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/pathLength
+func PathLength(val string) trees.Property {
+	return &trees.Attribute{Name: "pathLength", Value: val}
+}
+
+// Dx defines the svg attribute "dx", which indicates a shift along the x-axis on the position of an element or its content.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/dx
+func Dx(val string) trees.Property {
+	return &trees.Attribute{Name: "dx", Value: val}
+}
+
+// Dy defines the svg attribute "dy", which indicates a shift along the y-axis on the position of an element or its content.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/dy
+func Dy(val string) trees.Property {
+	return &trees.Attribute{Name: "dy", Value: val}
+}
+
+// Rotate defines the svg attribute "rotate", which specifies how the animated element rotates as it travels along a path, or the rotation of glyphs.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/rotate
+func Rotate(val string) trees.Property {
+	return &trees.Attribute{Name: "rotate", Value: val}
+}
+
+// TextLength defines the svg attribute "textLength", which lets the author specify a width to which the text should be rendered.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/textLength
+func TextLength(val string) trees.Property {
+	return &trees.Attribute{Name: "textLength", Value: val}
+}
+
+// LengthAdjust defines the svg attribute "lengthAdjust", which controls how the text is stretched into the length defined by textLength.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/lengthAdjust
+func LengthAdjust(val string) trees.Property {
+	return &trees.Attribute{Name: "lengthAdjust", Value: val}
+}
+
+// StartOffset defines the svg attribute "startOffset", which defines an offset from the start of the path for the initial current text position.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/startOffset
+func StartOffset(val string) trees.Property {
+	return &trees.Attribute{Name: "startOffset", Value: val}
+}
+
+// Offset defines the svg attribute "offset", which defines where the gradient stop is placed along the gradient vector.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/offset
+func Offset(val string) trees.Property {
+	return &trees.Attribute{Name: "offset", Value: val}
+}
+
+// GradientUnits defines the svg attribute "gradientUnits", which defines the coordinate system for attributes of gradients.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/gradientUnits
+func GradientUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "gradientUnits", Value: val}
+}
+
+// GradientTransform defines the svg attribute "gradientTransform", which contains the definitions of an optional additional transformation from the gradient coordinate system.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/gradientTransform
+func GradientTransform(val string) trees.Property {
+	return &trees.Attribute{Name: "gradientTransform", Value: val}
+}
+
+// SpreadMethod defines the svg attribute "spreadMethod", which determines how a shape is filled beyond the defined edges of a gradient.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/spreadMethod
+func SpreadMethod(val string) trees.Property {
+	return &trees.Attribute{Name: "spreadMethod", Value: val}
+}
+
+// PatternUnits defines the svg attribute "patternUnits", which indicates which coordinate system to use for the geometry properties of a pattern.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/patternUnits
+func PatternUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "patternUnits", Value: val}
+}
+
+// PatternContentUnits defines the svg attribute "patternContentUnits", which indicates which coordinate system to use for the contents of a pattern.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/patternContentUnits
+func PatternContentUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "patternContentUnits", Value: val}
+}
+
+// PatternTransform defines the svg attribute "patternTransform", which defines a list of transform definitions that are applied to a pattern tile.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/patternTransform
+func PatternTransform(val string) trees.Property {
+	return &trees.Attribute{Name: "patternTransform", Value: val}
+}
+
+// MarkerWidth defines the svg attribute "markerWidth", which defines the width of the marker viewport.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/markerWidth
+func MarkerWidth(val string) trees.Property {
+	return &trees.Attribute{Name: "markerWidth", Value: val}
+}
+
+// MarkerHeight defines the svg attribute "markerHeight", which defines the height of the marker viewport.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/markerHeight
+func MarkerHeight(val string) trees.Property {
+	return &trees.Attribute{Name: "markerHeight", Value: val}
+}
+
+// MarkerUnits defines the svg attribute "markerUnits", which defines the coordinate system for the markerWidth and markerHeight attributes.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/markerUnits
+func MarkerUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "markerUnits", Value: val}
+}
+
+// RefX defines the svg attribute "refX", which defines the x coordinate of the reference point of a marker or symbol.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/refX
+func RefX(val string) trees.Property {
+	return &trees.Attribute{Name: "refX", Value: val}
+}
+
+// RefY defines the svg attribute "refY", which defines the y coordinate of the reference point of a marker or symbol.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/refY
+func RefY(val string) trees.Property {
+	return &trees.Attribute{Name: "refY", Value: val}
+}
+
+// Orient defines the svg attribute "orient", which indicates how a marker is rotated when it is placed at its position on the shape.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/orient
+func Orient(val string) trees.Property {
+	return &trees.Attribute{Name: "orient", Value: val}
+}
+
+// MaskUnits defines the svg attribute "maskUnits", which indicates which coordinate system to use for the geometry properties of a mask.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/maskUnits
+func MaskUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "maskUnits", Value: val}
+}
+
+// MaskContentUnits defines the svg attribute "maskContentUnits", which indicates which coordinate system to use for the contents of a mask.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/maskContentUnits
+func MaskContentUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "maskContentUnits", Value: val}
+}
+
+// ClipPathUnits defines the svg attribute "clipPathUnits", which indicates which coordinate system to use for the contents of a clipPath.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/clipPathUnits
+func ClipPathUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "clipPathUnits", Value: val}
+}
+
+// FilterUnits defines the svg attribute "filterUnits", which defines the coordinate system for the attributes x, y, width and height of a filter.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/filterUnits
+func FilterUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "filterUnits", Value: val}
+}
+
+// PrimitiveUnits defines the svg attribute "primitiveUnits", which specifies the coordinate system for the various length values within the filter primitives.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/primitiveUnits
+func PrimitiveUnits(val string) trees.Property {
+	return &trees.Attribute{Name: "primitiveUnits", Value: val}
+}
+
+// In defines the svg attribute "in", which identifies input for the given filter primitive.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/in
+func In(val string) trees.Property {
+	return &trees.Attribute{Name: "in", Value: val}
+}
+
+// In2 defines the svg attribute "in2", which identifies the second input for the given filter primitive.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/in2
+func In2(val string) trees.Property {
+	return &trees.Attribute{Name: "in2", Value: val}
+}
+
+// Result defines the svg attribute "result", which defines the assigned name for a filter primitive.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/result
+func Result(val string) trees.Property {
+	return &trees.Attribute{Name: "result", Value: val}
+}
+
+// StdDeviation defines the svg attribute "stdDeviation", which defines the standard deviation for the blur operation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stdDeviation
+func StdDeviation(val string) trees.Property {
+	return &trees.Attribute{Name: "stdDeviation", Value: val}
+}
+
+// Mode defines the svg attribute "mode", which defines the blending mode of a feBlend filter primitive.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/mode
+func Mode(val string) trees.Property {
+	return &trees.Attribute{Name: "mode", Value: val}
+}
+
+// Operator defines the svg attribute "operator", which defines the compositing or morphing operation to be performed.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/operator
+func Operator(val string) trees.Property {
+	return &trees.Attribute{Name: "operator", Value: val}
+}
+
+// Type defines the svg attribute "type", which defines the type of a color matrix, transfer function or turbulence.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/type
+func Type(val string) trees.Property {
+	return &trees.Attribute{Name: "type", Value: val}
+}
+
+// Values defines the svg attribute "values", which defines a list of values, for animations and color matrices.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/values
+func Values(val string) trees.Property {
+	return &trees.Attribute{Name: "values", Value: val}
+}
+
+// K1 defines the svg attribute "k1", which defines one of the values to be used within the arithmetic operation of a feComposite.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/k1
+func K1(val string) trees.Property {
+	return &trees.Attribute{Name: "k1", Value: val}
+}
+
+// K2 defines the svg attribute "k2", which defines one of the values to be used within the arithmetic operation of a feComposite.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/k2
+func K2(val string) trees.Property {
+	return &trees.Attribute{Name: "k2", Value: val}
+}
+
+// K3 defines the svg attribute "k3", which defines one of the values to be used within the arithmetic operation of a feComposite.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/k3
+func K3(val string) trees.Property {
+	return &trees.Attribute{Name: "k3", Value: val}
+}
+
+// K4 defines the svg attribute "k4", which defines one of the values to be used within the arithmetic operation of a feComposite.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/k4
+func K4(val string) trees.Property {
+	return &trees.Attribute{Name: "k4", Value: val}
+}
+
+// TableValues defines the svg attribute "tableValues", which defines a list of numbers defining a lookup table for a transfer function.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/tableValues
+func TableValues(val string) trees.Property {
+	return &trees.Attribute{Name: "tableValues", Value: val}
+}
+
+// Slope defines the svg attribute "slope", which defines the slope of a linear transfer function.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/slope
+func Slope(val string) trees.Property {
+	return &trees.Attribute{Name: "slope", Value: val}
+}
+
+// Intercept defines the svg attribute "intercept", which defines the intercept of a linear transfer function.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/intercept
+func Intercept(val string) trees.Property {
+	return &trees.Attribute{Name: "intercept", Value: val}
+}
+
+// Amplitude defines the svg attribute "amplitude", which controls the amplitude of a gamma transfer function.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/amplitude
+func Amplitude(val string) trees.Property {
+	return &trees.Attribute{Name: "amplitude", Value: val}
+}
+
+// Exponent defines the svg attribute "exponent", which defines the exponent of a gamma transfer function.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/exponent
+func Exponent(val string) trees.Property {
+	return &trees.Attribute{Name: "exponent", Value: val}
+}
+
+// BaseFrequency defines the svg attribute "baseFrequency", which represents the base frequency parameter for the noise function of a feTurbulence.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/baseFrequency
+func BaseFrequency(val string) trees.Property {
+	return &trees.Attribute{Name: "baseFrequency", Value: val}
+}
+
+// NumOctaves defines the svg attribute "numOctaves", which defines the number of octaves for the noise function of a feTurbulence.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/numOctaves
+func NumOctaves(val string) trees.Property {
+	return &trees.Attribute{Name: "numOctaves", Value: val}
+}
+
+// Seed defines the svg attribute "seed", which represents the starting number for the pseudo random number generator of a feTurbulence.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/seed
+func Seed(val string) trees.Property {
+	return &trees.Attribute{Name: "seed", Value: val}
+}
+
+// StitchTiles defines the svg attribute "stitchTiles", which defines how the Perlin Noise tiles behave at the border.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stitchTiles
+func StitchTiles(val string) trees.Property {
+	return &trees.Attribute{Name: "stitchTiles", Value: val}
+}
+
+// Scale defines the svg attribute "scale", which defines the displacement scale factor of a feDisplacementMap.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/scale
+func Scale(val string) trees.Property {
+	return &trees.Attribute{Name: "scale", Value: val}
+}
+
+// XChannelSelector defines the svg attribute "xChannelSelector", which indicates which color channel from in2 displaces the pixels along the x-axis.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/xChannelSelector
+func XChannelSelector(val string) trees.Property {
+	return &trees.Attribute{Name: "xChannelSelector", Value: val}
+}
+
+// YChannelSelector defines the svg attribute "yChannelSelector", which indicates which color channel from in2 displaces the pixels along the y-axis.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/yChannelSelector
+func YChannelSelector(val string) trees.Property {
+	return &trees.Attribute{Name: "yChannelSelector", Value: val}
+}
+
+// Radius defines the svg attribute "radius", which represents the radius for the operation of a feMorphology.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/radius
+func Radius(val string) trees.Property {
+	return &trees.Attribute{Name: "radius", Value: val}
+}
+
+// Order defines the svg attribute "order", which indicates the size of the matrix of a feConvolveMatrix.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/order
+func Order(val string) trees.Property {
+	return &trees.Attribute{Name: "order", Value: val}
+}
+
+// KernelMatrix defines the svg attribute "kernelMatrix", which defines the list of numbers that make up the kernel matrix of a feConvolveMatrix.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/kernelMatrix
+func KernelMatrix(val string) trees.Property {
+	return &trees.Attribute{Name: "kernelMatrix", Value: val}
+}
+
+// Divisor defines the svg attribute "divisor", which specifies the value by which the result of a feConvolveMatrix is divided.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/divisor
+func Divisor(val string) trees.Property {
+	return &trees.Attribute{Name: "divisor", Value: val}
+}
+
+// Bias defines the svg attribute "bias", which shifts the range of the filter of a feConvolveMatrix.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/bias
+func Bias(val string) trees.Property {
+	return &trees.Attribute{Name: "bias", Value: val}
+}
+
+// TargetX defines the svg attribute "targetX", which determines the positioning in the horizontal direction of the convolution matrix.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/targetX
+func TargetX(val string) trees.Property {
+	return &trees.Attribute{Name: "targetX", Value: val}
+}
+
+// TargetY defines the svg attribute "targetY", which determines the positioning in the vertical direction of the convolution matrix.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/targetY
+func TargetY(val string) trees.Property {
+	return &trees.Attribute{Name: "targetY", Value: val}
+}
+
+// EdgeMode defines the svg attribute "edgeMode", which determines how to extend the input image as necessary with color values.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/edgeMode
+func EdgeMode(val string) trees.Property {
+	return &trees.Attribute{Name: "edgeMode", Value: val}
+}
+
+// PreserveAlpha defines the svg attribute "preserveAlpha", which indicates how a feConvolveMatrix handles alpha transparency.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/preserveAlpha
+func PreserveAlpha(val string) trees.Property {
+	return &trees.Attribute{Name: "preserveAlpha", Value: val}
+}
+
+// SurfaceScale defines the svg attribute "surfaceScale", which represents the height of the surface for a light filter primitive.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/surfaceScale
+func SurfaceScale(val string) trees.Property {
+	return &trees.Attribute{Name: "surfaceScale", Value: val}
+}
+
+// DiffuseConstant defines the svg attribute "diffuseConstant", which represents the kd value in the Phong lighting model.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/diffuseConstant
+func DiffuseConstant(val string) trees.Property {
+	return &trees.Attribute{Name: "diffuseConstant", Value: val}
+}
+
+// SpecularConstant defines the svg attribute "specularConstant", which controls the ratio of reflection of the specular lighting.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/specularConstant
+func SpecularConstant(val string) trees.Property {
+	return &trees.Attribute{Name: "specularConstant", Value: val}
+}
+
+// SpecularExponent defines the svg attribute "specularExponent", which controls the focus for the light source.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/specularExponent
+func SpecularExponent(val string) trees.Property {
+	return &trees.Attribute{Name: "specularExponent", Value: val}
+}
+
+// Azimuth defines the svg attribute "azimuth", which specifies the direction angle for the light source on the XY plane.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/azimuth
+func Azimuth(val string) trees.Property {
+	return &trees.Attribute{Name: "azimuth", Value: val}
+}
+
+// Elevation defines the svg attribute "elevation", which specifies the direction angle for the light source from the XY plane towards the z-axis.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/elevation
+func Elevation(val string) trees.Property {
+	return &trees.Attribute{Name: "elevation", Value: val}
+}
+
+// Z defines the svg attribute "z", which defines the location along the z-axis for a light source.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/z
+func Z(val string) trees.Property {
+	return &trees.Attribute{Name: "z", Value: val}
+}
+
+// PointsAtX defines the svg attribute "pointsAtX", which represents the x location in the coordinate system of the point at which the light source is pointing.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/pointsAtX
+func PointsAtX(val string) trees.Property {
+	return &trees.Attribute{Name: "pointsAtX", Value: val}
+}
+
+// PointsAtY defines the svg attribute "pointsAtY", which represents the y location in the coordinate system of the point at which the light source is pointing.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/pointsAtY
+func PointsAtY(val string) trees.Property {
+	return &trees.Attribute{Name: "pointsAtY", Value: val}
+}
+
+// PointsAtZ defines the svg attribute "pointsAtZ", which represents the z location in the coordinate system of the point at which the light source is pointing.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/pointsAtZ
+func PointsAtZ(val string) trees.Property {
+	return &trees.Attribute{Name: "pointsAtZ", Value: val}
+}
+
+// LimitingConeAngle defines the svg attribute "limitingConeAngle", which represents the angle in degrees between the spot light axis and the spot light cone.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/limitingConeAngle
+func LimitingConeAngle(val string) trees.Property {
+	return &trees.Attribute{Name: "limitingConeAngle", Value: val}
+}
+
+// AttributeName defines the svg attribute "attributeName", which indicates the name of the attribute of the target element that is going to be changed during an animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/attributeName
+func AttributeName(val string) trees.Property {
+	return &trees.Attribute{Name: "attributeName", Value: val}
+}
+
+// Begin defines the svg attribute "begin", which defines when an animation should begin.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/begin
+func Begin(val string) trees.Property {
+	return &trees.Attribute{Name: "begin", Value: val}
+}
+
+// Dur defines the svg attribute "dur", which indicates the simple duration of an animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/dur
+func Dur(val string) trees.Property {
+	return &trees.Attribute{Name: "dur", Value: val}
+}
+
+// End defines the svg attribute "end", which defines an end value for the animation that can constrain the active duration.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/end
+func End(val string) trees.Property {
+	return &trees.Attribute{Name: "end", Value: val}
+}
+
+// From defines the svg attribute "from", which indicates the initial value of the attribute that will be modified during the animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/from
+func From(val string) trees.Property {
+	return &trees.Attribute{Name: "from", Value: val}
+}
+
+// To defines the svg attribute "to", which indicates the final value of the attribute that will be modified during the animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/to
+func To(val string) trees.Property {
+	return &trees.Attribute{Name: "to", Value: val}
+}
+
+// By defines the svg attribute "by", which specifies a relative offset value for an attribute that will be modified during an animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/by
+func By(val string) trees.Property {
+	return &trees.Attribute{Name: "by", Value: val}
+}
+
+// RepeatCount defines the svg attribute "repeatCount", which indicates the number of times an animation will take place.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/repeatCount
+func RepeatCount(val string) trees.Property {
+	return &trees.Attribute{Name: "repeatCount", Value: val}
+}
+
+// RepeatDur defines the svg attribute "repeatDur", which specifies the total duration for repeating an animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/repeatDur
+func RepeatDur(val string) trees.Property {
+	return &trees.Attribute{Name: "repeatDur", Value: val}
+}
+
+// CalcMode defines the svg attribute "calcMode", which specifies the interpolation mode for the animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/calcMode
+func CalcMode(val string) trees.Property {
+	return &trees.Attribute{Name: "calcMode", Value: val}
+}
+
+// KeyTimes defines the svg attribute "keyTimes", which represents a list of time values used to control the pacing of the animation.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/keyTimes
+func KeyTimes(val string) trees.Property {
+	return &trees.Attribute{Name: "keyTimes", Value: val}
+}
+
+// KeySplines defines the svg attribute "keySplines", which defines a set of Bézier curve control points associated with the keyTimes list.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/keySplines
+func KeySplines(val string) trees.Property {
+	return &trees.Attribute{Name: "keySplines", Value: val}
+}
+
+// KeyPoints defines the svg attribute "keyPoints", which indicates the progress along the motion path for each of the keyTimes values.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/keyPoints
+func KeyPoints(val string) trees.Property {
+	return &trees.Attribute{Name: "keyPoints", Value: val}
+}
+
+// Path defines the svg attribute "path", which defines the motion path of an animateMotion.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/path
+func Path(val string) trees.Property {
+	return &trees.Attribute{Name: "path", Value: val}
+}
+
+// Additive defines the svg attribute "additive", which controls whether or not an animation is additive.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/additive
+func Additive(val string) trees.Property {
+	return &trees.Attribute{Name: "additive", Value: val}
+}
+
+// Accumulate defines the svg attribute "accumulate", which controls whether or not an animation is cumulative.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/accumulate
+func Accumulate(val string) trees.Property {
+	return &trees.Attribute{Name: "accumulate", Value: val}
+}
+
+// Restart defines the svg attribute "restart", which specifies whether or not an animation can restart.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/restart
+func Restart(val string) trees.Property {
+	return &trees.Attribute{Name: "restart", Value: val}
+}
+
+// RequiredExtensions defines the svg attribute "requiredExtensions", which lists the extensions required for the element to be rendered.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/requiredExtensions
+func RequiredExtensions(val string) trees.Property {
+	return &trees.Attribute{Name: "requiredExtensions", Value: val}
+}
+
+// SystemLanguage defines the svg attribute "systemLanguage", which represents the languages for which the element is rendered.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/systemLanguage
+func SystemLanguage(val string) trees.Property {
+	return &trees.Attribute{Name: "systemLanguage", Value: val}
+}
+
+// AlignmentBaseline defines the svg attribute "alignment-baseline", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/alignment-baseline
+func AlignmentBaseline(val string) trees.Property {
+	return &trees.Attribute{Name: "alignment-baseline", Value: val}
+}
+
+// BaselineShift defines the svg attribute "baseline-shift", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/baseline-shift
+func BaselineShift(val string) trees.Property {
+	return &trees.Attribute{Name: "baseline-shift", Value: val}
+}
+
+// Clip defines the svg attribute "clip", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/clip
+func Clip(val string) trees.Property {
+	return &trees.Attribute{Name: "clip", Value: val}
+}
+
+// ClipPath defines the svg attribute "clip-path", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/clip-path
+func ClipPath(val string) trees.Property {
+	return &trees.Attribute{Name: "clip-path", Value: val}
+}
+
+// ClipRule defines the svg attribute "clip-rule", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/clip-rule
+func ClipRule(val string) trees.Property {
+	return &trees.Attribute{Name: "clip-rule", Value: val}
+}
+
+// Color defines the svg attribute "color", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/color
+func Color(val string) trees.Property {
+	return &trees.Attribute{Name: "color", Value: val}
+}
+
+// ColorInterpolation defines the svg attribute "color-interpolation", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/color-interpolation
+func ColorInterpolation(val string) trees.Property {
+	return &trees.Attribute{Name: "color-interpolation", Value: val}
+}
+
+// ColorInterpolationFilters defines the svg attribute "color-interpolation-filters", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/color-interpolation-filters
+func ColorInterpolationFilters(val string) trees.Property {
+	return &trees.Attribute{Name: "color-interpolation-filters", Value: val}
+}
+
+// ColorRendering defines the svg attribute "color-rendering", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/color-rendering
+func ColorRendering(val string) trees.Property {
+	return &trees.Attribute{Name: "color-rendering", Value: val}
+}
+
+// Cursor defines the svg attribute "cursor", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/cursor
+func Cursor(val string) trees.Property {
+	return &trees.Attribute{Name: "cursor", Value: val}
+}
+
+// Direction defines the svg attribute "direction", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/direction
+func Direction(val string) trees.Property {
+	return &trees.Attribute{Name: "direction", Value: val}
+}
+
+// Display defines the svg attribute "display", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/display
+func Display(val string) trees.Property {
+	return &trees.Attribute{Name: "display", Value: val}
+}
+
+// DominantBaseline defines the svg attribute "dominant-baseline", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/dominant-baseline
+func DominantBaseline(val string) trees.Property {
+	return &trees.Attribute{Name: "dominant-baseline", Value: val}
+}
+
+// Fill defines the svg attribute "fill", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/fill
+func Fill(val string) trees.Property {
+	return &trees.Attribute{Name: "fill", Value: val}
+}
+
+// FillOpacity defines the svg attribute "fill-opacity", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/fill-opacity
+func FillOpacity(val string) trees.Property {
+	return &trees.Attribute{Name: "fill-opacity", Value: val}
+}
+
+// FillRule defines the svg attribute "fill-rule", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/fill-rule
+func FillRule(val string) trees.Property {
+	return &trees.Attribute{Name: "fill-rule", Value: val}
+}
+
+// Filter defines the svg attribute "filter", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/filter
+func Filter(val string) trees.Property {
+	return &trees.Attribute{Name: "filter", Value: val}
+}
+
+// FloodColor defines the svg attribute "flood-color", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/flood-color
+func FloodColor(val string) trees.Property {
+	return &trees.Attribute{Name: "flood-color", Value: val}
+}
+
+// FloodOpacity defines the svg attribute "flood-opacity", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/flood-opacity
+func FloodOpacity(val string) trees.Property {
+	return &trees.Attribute{Name: "flood-opacity", Value: val}
+}
+
+// FontFamily defines the svg attribute "font-family", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/font-family
+func FontFamily(val string) trees.Property {
+	return &trees.Attribute{Name: "font-family", Value: val}
+}
+
+// FontSize defines the svg attribute "font-size", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/font-size
+func FontSize(val string) trees.Property {
+	return &trees.Attribute{Name: "font-size", Value: val}
+}
+
+// FontSizeAdjust defines the svg attribute "font-size-adjust", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/font-size-adjust
+func FontSizeAdjust(val string) trees.Property {
+	return &trees.Attribute{Name: "font-size-adjust", Value: val}
+}
+
+// FontStretch defines the svg attribute "font-stretch", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/font-stretch
+func FontStretch(val string) trees.Property {
+	return &trees.Attribute{Name: "font-stretch", Value: val}
+}
+
+// FontStyle defines the svg attribute "font-style", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/font-style
+func FontStyle(val string) trees.Property {
+	return &trees.Attribute{Name: "font-style", Value: val}
+}
+
+// FontVariant defines the svg attribute "font-variant", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/font-variant
+func FontVariant(val string) trees.Property {
+	return &trees.Attribute{Name: "font-variant", Value: val}
+}
+
+// FontWeight defines the svg attribute "font-weight", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/font-weight
+func FontWeight(val string) trees.Property {
+	return &trees.Attribute{Name: "font-weight", Value: val}
+}
+
+// ImageRendering defines the svg attribute "image-rendering", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/image-rendering
+func ImageRendering(val string) trees.Property {
+	return &trees.Attribute{Name: "image-rendering", Value: val}
+}
+
+// LetterSpacing defines the svg attribute "letter-spacing", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/letter-spacing
+func LetterSpacing(val string) trees.Property {
+	return &trees.Attribute{Name: "letter-spacing", Value: val}
+}
+
+// LightingColor defines the svg attribute "lighting-color", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/lighting-color
+func LightingColor(val string) trees.Property {
+	return &trees.Attribute{Name: "lighting-color", Value: val}
+}
+
+// MarkerEnd defines the svg attribute "marker-end", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/marker-end
+func MarkerEnd(val string) trees.Property {
+	return &trees.Attribute{Name: "marker-end", Value: val}
+}
+
+// MarkerMid defines the svg attribute "marker-mid", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/marker-mid
+func MarkerMid(val string) trees.Property {
+	return &trees.Attribute{Name: "marker-mid", Value: val}
+}
+
+// MarkerStart defines the svg attribute "marker-start", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/marker-start
+func MarkerStart(val string) trees.Property {
+	return &trees.Attribute{Name: "marker-start", Value: val}
+}
+
+// Mask defines the svg attribute "mask", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/mask
+func Mask(val string) trees.Property {
+	return &trees.Attribute{Name: "mask", Value: val}
+}
+
+// Opacity defines the svg attribute "opacity", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/opacity
+func Opacity(val string) trees.Property {
+	return &trees.Attribute{Name: "opacity", Value: val}
+}
+
+// Overflow defines the svg attribute "overflow", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/overflow
+func Overflow(val string) trees.Property {
+	return &trees.Attribute{Name: "overflow", Value: val}
+}
+
+// PaintOrder defines the svg attribute "paint-order", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/paint-order
+func PaintOrder(val string) trees.Property {
+	return &trees.Attribute{Name: "paint-order", Value: val}
+}
+
+// PointerEvents defines the svg attribute "pointer-events", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/pointer-events
+func PointerEvents(val string) trees.Property {
+	return &trees.Attribute{Name: "pointer-events", Value: val}
+}
+
+// ShapeRendering defines the svg attribute "shape-rendering", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/shape-rendering
+func ShapeRendering(val string) trees.Property {
+	return &trees.Attribute{Name: "shape-rendering", Value: val}
+}
+
+// StopColor defines the svg attribute "stop-color", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stop-color
+func StopColor(val string) trees.Property {
+	return &trees.Attribute{Name: "stop-color", Value: val}
+}
+
+// StopOpacity defines the svg attribute "stop-opacity", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stop-opacity
+func StopOpacity(val string) trees.Property {
+	return &trees.Attribute{Name: "stop-opacity", Value: val}
+}
+
+// Stroke defines the svg attribute "stroke", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke
+func Stroke(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke", Value: val}
+}
+
+// StrokeDasharray defines the svg attribute "stroke-dasharray", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke-dasharray
+func StrokeDasharray(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke-dasharray", Value: val}
+}
+
+// StrokeDashoffset defines the svg attribute "stroke-dashoffset", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke-dashoffset
+func StrokeDashoffset(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke-dashoffset", Value: val}
+}
+
+// StrokeLinecap defines the svg attribute "stroke-linecap", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke-linecap
+func StrokeLinecap(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke-linecap", Value: val}
+}
+
+// StrokeLinejoin defines the svg attribute "stroke-linejoin", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke-linejoin
+func StrokeLinejoin(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke-linejoin", Value: val}
+}
+
+// StrokeMiterlimit defines the svg attribute "stroke-miterlimit", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke-miterlimit
+func StrokeMiterlimit(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke-miterlimit", Value: val}
+}
+
+// StrokeOpacity defines the svg attribute "stroke-opacity", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke-opacity
+func StrokeOpacity(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke-opacity", Value: val}
+}
+
+// StrokeWidth defines the svg attribute "stroke-width", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/stroke-width
+func StrokeWidth(val string) trees.Property {
+	return &trees.Attribute{Name: "stroke-width", Value: val}
+}
+
+// TextAnchor defines the svg attribute "text-anchor", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/text-anchor
+func TextAnchor(val string) trees.Property {
+	return &trees.Attribute{Name: "text-anchor", Value: val}
+}
+
+// TextDecoration defines the svg attribute "text-decoration", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/text-decoration
+func TextDecoration(val string) trees.Property {
+	return &trees.Attribute{Name: "text-decoration", Value: val}
+}
+
+// TextOverflow defines the svg attribute "text-overflow", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/text-overflow
+func TextOverflow(val string) trees.Property {
+	return &trees.Attribute{Name: "text-overflow", Value: val}
+}
+
+// TextRendering defines the svg attribute "text-rendering", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/text-rendering
+func TextRendering(val string) trees.Property {
+	return &trees.Attribute{Name: "text-rendering", Value: val}
+}
+
+// Transform defines the svg attribute "transform", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/transform
+func Transform(val string) trees.Property {
+	return &trees.Attribute{Name: "transform", Value: val}
+}
+
+// TransformOrigin defines the svg attribute "transform-origin", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/transform-origin
+func TransformOrigin(val string) trees.Property {
+	return &trees.Attribute{Name: "transform-origin", Value: val}
+}
+
+// UnicodeBidi defines the svg attribute "unicode-bidi", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/unicode-bidi
+func UnicodeBidi(val string) trees.Property {
+	return &trees.Attribute{Name: "unicode-bidi", Value: val}
+}
+
+// VectorEffect defines the svg attribute "vector-effect", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/vector-effect
+func VectorEffect(val string) trees.Property {
+	return &trees.Attribute{Name: "vector-effect", Value: val}
+}
+
+// Visibility defines the svg attribute "visibility", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/visibility
+func Visibility(val string) trees.Property {
+	return &trees.Attribute{Name: "visibility", Value: val}
+}
+
+// WhiteSpace defines the svg attribute "white-space", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/white-space
+func WhiteSpace(val string) trees.Property {
+	return &trees.Attribute{Name: "white-space", Value: val}
+}
+
+// WordSpacing defines the svg attribute "word-spacing", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/word-spacing
+func WordSpacing(val string) trees.Property {
+	return &trees.Attribute{Name: "word-spacing", Value: val}
+}
+
+// WritingMode defines the svg attribute "writing-mode", which is a presentation attribute which can also be set as the css property of the same name.
+// https://developer.mozilla.org/en-US/docs/Web/SVG/Attribute/writing-mode
+func WritingMode(val string) trees.Property {
+	return &trees.Attribute{Name: "writing-mode", Value: val}
+}
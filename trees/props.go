@@ -95,6 +95,49 @@ func (a *Attribute) Clone() Property {
 
 //==============================================================================
 
+// BoolAttribute defines a boolean attribute, eg 'checked', which is written
+// with its name as value when true and left out of the element when false, as
+// html treats the presence of the attribute as true whatever its value.
+type BoolAttribute struct {
+	Name  string
+	Value bool
+}
+
+// NewBoolAttr returns a new boolean attribute instance.
+func NewBoolAttr(name string, val bool) *BoolAttribute {
+	return &BoolAttribute{Name: strings.ToLower(name), Value: val}
+}
+
+// Render returns the key and value for this attribute rendered.
+func (b *BoolAttribute) Render() (string, string) {
+	if !b.Value {
+		return b.Name, ""
+	}
+
+	return b.Name, b.Name
+}
+
+// Apply adds the attribute to the element when true, removing any attribute of
+// the same name set earlier when false.
+func (b *BoolAttribute) Apply(e *Markup) {
+	if !e.allowAttributes {
+		return
+	}
+
+	RemoveAttribute(e, b.Name)
+
+	if b.Value {
+		e.AddAttribute(b)
+	}
+}
+
+//Clone replicates the attribute into a unique instance
+func (b *BoolAttribute) Clone() Property {
+	return &BoolAttribute{Name: b.Name, Value: b.Value}
+}
+
+//==============================================================================
+
 // CSSStyle define the style specification for element styles
 type CSSStyle struct {
 	Name  string
@@ -177,6 +220,8 @@ func (c *ClassList) Apply(em *Markup) {
 			em.attrs[index] = c
 		}
 
+		em.InvalidateHash()
+
 	}
 }
 
@@ -1,6 +1,10 @@
 package trees
 
-import "strings"
+import (
+	"strconv"
+	"strings"
+	"sync"
+)
 
 // Query defines a package level variable for access the query interface
 // which handles running css queries on markup structures.
@@ -8,18 +12,54 @@ var Query queryCtrl
 
 type queryCtrl struct{}
 
+// contains the combinators which relate a compound selector to the one before
+// it.
+const (
+	DescendantCombinator      = " "
+	ChildCombinator           = ">"
+	AdjacentSiblingCombinator = "+"
+	GeneralSiblingCombinator  = "~"
+)
+
 // Selector defines a structure which defines the requirements for a given
-// matching to be processed.
+// matching to be processed. A Selector is a compound selector, where the
+// compound selectors following it in a complex selector (eg 'div > a.link')
+// are kept in Children, each related to the one before it by its Combinator.
 type Selector struct {
-	Tag       string
-	ID        string
-	Psuedo    string
-	AttrOp    string
-	AttrName  string
-	AttrValue string
-	Classes   []string
-	Children  []*Selector
-	Order     map[string]string
+	Tag        string
+	ID         string
+	Psuedo     string
+	AttrOp     string
+	AttrName   string
+	AttrValue  string
+	Combinator string
+	Classes    []string
+	Attrs      []AttrSelector
+	Pseudos    []PseudoSelector
+	Children   []*Selector
+	Order      map[string]string
+}
+
+// AttrSelector defines the requirement of a attribute selector, eg
+// '[rel|=bull]', where a empty Op only requires the attribute to exist.
+type AttrSelector struct {
+	Name            string
+	Op              string
+	Value           string
+	CaseInsensitive bool
+}
+
+// PseudoSelector defines the requirement of a pseudo-class selector, eg
+// ':nth-child(2n+1)'. Pseudo-elements and unsupported pseudo-classes never
+// match.
+type PseudoSelector struct {
+	Name string
+	Args string
+	Not  []*Selector
+
+	// nth defines the an+b values of the nth pseudo-classes.
+	a, b  int
+	valid bool
 }
 
 // GetSelector returns the selector received for the given selector.
@@ -34,8 +74,8 @@ func (s *Selector) GetSelector() string {
 		sel += s.GetClass()
 	}
 
-	if s.AttrName != "" {
-		sel += "[" + s.AttrName + s.AttrOp + s.AttrValue + "]"
+	for _, attr := range s.Attrs {
+		sel += attr.String()
 	}
 
 	if s.Psuedo != "" {
@@ -45,6 +85,22 @@ func (s *Selector) GetSelector() string {
 	return sel
 }
 
+// String returns the complex selector of the selector and its children.
+func (s *Selector) String() string {
+	sel := s.GetSelector()
+
+	for _, child := range s.Children {
+		if child.Combinator == DescendantCombinator {
+			sel += " " + child.GetSelector()
+			continue
+		}
+
+		sel += " " + child.Combinator + " " + child.GetSelector()
+	}
+
+	return sel
+}
+
 // GetID returns the id string for this selector.
 func (s *Selector) GetID() string {
 	if s.ID != "" {
@@ -69,122 +125,225 @@ func (s *Selector) GetClass() string {
 	return strings.Join(sels, "")
 }
 
-// Query returns the first element matching the giving selector.
+// String returns the attribute selector.
+func (a AttrSelector) String() string {
+	if a.Op == "" {
+		return "[" + a.Name + "]"
+	}
+
+	sel := "[" + a.Name + a.Op + strconv.Quote(a.Value)
+	if a.CaseInsensitive {
+		sel += " i"
+	}
+
+	return sel + "]"
+}
+
+//==============================================================================
+
+// Query returns the first element within the root matching the giving
+// selector, or nil if none matches or the selector is invalid.
 func (q queryCtrl) Query(root *Markup, sel string) *Markup {
-	sels := q.ParseSelector(sel)
+	sels := q.compile(sel)
 	if sels == nil {
 		return nil
 	}
 
-	return q.QuerySelector(root, sels[0])
+	var found *Markup
+
+	q.walk(root, q.ancestors(root), func(target *Markup, ancestors []*Markup) bool {
+		if q.matchList(target, ancestors, sels) {
+			found = target
+			return false
+		}
+
+		return true
+	})
+
+	return found
 }
 
-// QueryAll returns the first element matching the giving selector.
+// QueryAll returns all elements within the root matching the giving selector
+// in document order.
 func (q queryCtrl) QueryAll(root *Markup, sel string) []*Markup {
-	sels := q.ParseSelector(sel)
+	sels := q.compile(sel)
 	if sels == nil {
 		return nil
 	}
 
-	return q.QueryAllSelector(root, sels[0])
+	var found []*Markup
+
+	q.walk(root, q.ancestors(root), func(target *Markup, ancestors []*Markup) bool {
+		if q.matchList(target, ancestors, sels) {
+			found = append(found, target)
+		}
+
+		return true
+	})
+
+	return found
 }
 
 // QuerySelector uses the provided selector and root returning the first
 // element that matches the selector's criteria.
 func (q queryCtrl) QuerySelector(root *Markup, sel *Selector) *Markup {
-	var filtered *Markup
+	var found *Markup
 
-childloop:
-	for _, child := range root.children {
-		if q.queryOne(child, sel) {
-			filtered = child
-			break childloop
+	q.walk(root, q.ancestors(root), func(target *Markup, ancestors []*Markup) bool {
+		if q.match(target, ancestors, sel) {
+			found = target
+			return false
 		}
 
-		for _, kid := range child.children {
-			if q.queryOne(kid, sel) {
-				filtered = kid
-				break childloop
-			}
+		return true
+	})
 
-			if item := q.QuerySelector(kid, sel); item != nil {
-				filtered = item
-				break childloop
-			}
+	return found
+}
+
+// QueryAllSelector uses the provided selector and root returning all
+// elements that matches the selector's criteria.
+func (q queryCtrl) QueryAllSelector(root *Markup, sel *Selector) []*Markup {
+	var found []*Markup
+
+	q.walk(root, q.ancestors(root), func(target *Markup, ancestors []*Markup) bool {
+		if q.match(target, ancestors, sel) {
+			found = append(found, target)
 		}
+
+		return true
+	})
+
+	return found
+}
+
+// Matches returns true/false if the markup matches the giving selector, using
+// its parents for the combinators of the selector.
+func (q queryCtrl) Matches(target *Markup, sel string) bool {
+	sels := q.compile(sel)
+	if sels == nil || target == nil {
+		return false
 	}
 
-	if sel.Children == nil {
-		return filtered
+	return q.matchList(target, q.ancestors(target), sels)
+}
+
+// walk calls fn with the elements below the root which are not removed in
+// document order, along with their ancestors, until fn returns false.
+func (q queryCtrl) walk(root *Markup, ancestors []*Markup, fn func(*Markup, []*Markup) bool) bool {
+	if root == nil {
+		return true
 	}
 
-	for _, child := range sel.Children {
-		filtered = q.QuerySelector(filtered, child)
-		if filtered == nil {
-			return nil
+	ancestors = append(ancestors, root)
+
+	for _, child := range root.children {
+		if child.Removed() || child.kind != ElementNode {
+			continue
+		}
+
+		// copy the ancestors for each child, so fn can keep them.
+		path := ancestors[:len(ancestors):len(ancestors)]
+
+		if !fn(child, path) || !q.walk(child, path, fn) {
+			return false
 		}
 	}
 
-	return filtered
+	return true
 }
 
-// QueryAllSelector uses the provided selector and root returning all
-// elements that matches the selector's criteria.
-func (q queryCtrl) QueryAllSelector(root *Markup, sel *Selector) []*Markup {
-	var found []*Markup
+// ancestors returns the parents of the markup, from the top most.
+func (queryCtrl) ancestors(target *Markup) []*Markup {
+	var parents []*Markup
 
-	for _, child := range root.children {
-		if !q.queryOne(child, sel) {
+	for parent := target.parent; parent != nil; parent = parent.parent {
+		parents = append([]*Markup{parent}, parents...)
+	}
 
-			for _, kid := range child.children {
-				if q.queryOne(kid, sel) {
-					found = append(found, kid)
-				}
+	return parents
+}
 
-				found = append(found, q.QueryAllSelector(kid, sel)...)
-			}
+//==============================================================================
 
-			continue
+// matchList returns true/false if the target matches any of the selectors.
+func (q queryCtrl) matchList(target *Markup, ancestors []*Markup, sels []*Selector) bool {
+	for _, sel := range sels {
+		if q.match(target, ancestors, sel) {
+			return true
 		}
+	}
 
-		if sel.Children == nil {
-			found = append(found, child)
+	return false
+}
 
-			for _, kid := range child.children {
-				if q.queryOne(kid, sel) {
-					found = append(found, kid)
-				}
+// match returns true/false if the target matches the complex selector, whose
+// compound selectors are matched from right to left.
+func (q queryCtrl) match(target *Markup, ancestors []*Markup, sel *Selector) bool {
+	chain := make([]*Selector, 0, len(sel.Children)+1)
+	chain = append(chain, sel)
+	chain = append(chain, sel.Children...)
 
-				found = append(found, q.QueryAllSelector(kid, sel)...)
-			}
+	return q.matchChain(target, ancestors, chain)
+}
 
-			continue
+// matchChain returns true/false if the target matches the last selector of the
+// chain, and its ancestors or siblings match the rest according to the
+// combinator of the last selector.
+func (q queryCtrl) matchChain(target *Markup, ancestors []*Markup, chain []*Selector) bool {
+	last := chain[len(chain)-1]
+	if !q.matchCompound(target, ancestors, last) {
+		return false
+	}
+
+	if len(chain) == 1 {
+		return true
+	}
+
+	rest := chain[:len(chain)-1]
+
+	switch last.Combinator {
+	case ChildCombinator:
+		if len(ancestors) == 0 {
+			return false
 		}
 
-		kid := child
-		for _, kidSel := range sel.Children {
-			kid = q.QuerySelector(kid, kidSel)
-			if kid == nil {
-				continue
+		return q.matchChain(ancestors[len(ancestors)-1], ancestors[:len(ancestors)-1], rest)
+
+	case AdjacentSiblingCombinator, GeneralSiblingCombinator:
+		siblings, index := q.siblings(target, ancestors)
+
+		for at := index - 1; at >= 0; at-- {
+			if q.matchChain(siblings[at], ancestors, rest) {
+				return true
 			}
-		}
 
-		for _, mkid := range child.children {
-			if q.queryOne(mkid, sel) {
-				found = append(found, mkid)
+			if last.Combinator == AdjacentSiblingCombinator {
+				return false
 			}
+		}
+
+		return false
 
-			found = append(found, q.QueryAllSelector(mkid, sel)...)
+	default:
+		for at := len(ancestors) - 1; at >= 0; at-- {
+			if q.matchChain(ancestors[at], ancestors[:at], rest) {
+				return true
+			}
 		}
 
-		found = append(found, kid)
+		return false
 	}
-
-	return found
 }
 
-func (q queryCtrl) queryOne(target *Markup, sel *Selector) bool {
-	if sel.Tag != "" && !q.tagFor(target, sel.Tag) {
+// matchCompound returns true/false if the target matches all requirements of
+// the compound selector.
+func (q queryCtrl) matchCompound(target *Markup, ancestors []*Markup, sel *Selector) bool {
+	if target.Removed() || target.kind != ElementNode {
+		return false
+	}
+
+	if sel.Tag != "" && sel.Tag != "*" && !q.tagFor(target, sel.Tag) {
 		return false
 	}
 
@@ -192,9 +351,88 @@ func (q queryCtrl) queryOne(target *Markup, sel *Selector) bool {
 		return false
 	}
 
-	if sel.Classes != nil {
-		for _, class := range sel.Classes {
-			if !q.classFor(target, class) {
+	for _, class := range sel.Classes {
+		if !q.classFor(target, class) {
+			return false
+		}
+	}
+
+	for _, attr := range sel.Attrs {
+		if !q.attrFor(target, attr) {
+			return false
+		}
+	}
+
+	for _, pseudo := range sel.Pseudos {
+		if !q.pseudoFor(target, ancestors, pseudo) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// siblings returns the element siblings of the target which are not removed,
+// including the target, and the index of the target within them.
+func (queryCtrl) siblings(target *Markup, ancestors []*Markup) ([]*Markup, int) {
+	if len(ancestors) == 0 {
+		return []*Markup{target}, 0
+	}
+
+	var index int
+	var siblings []*Markup
+
+	for _, child := range ancestors[len(ancestors)-1].children {
+		if child.Removed() || child.kind != ElementNode {
+			continue
+		}
+
+		if child == target {
+			index = len(siblings)
+		}
+
+		siblings = append(siblings, child)
+	}
+
+	return siblings, index
+}
+
+func (q queryCtrl) pseudoFor(target *Markup, ancestors []*Markup, pseudo PseudoSelector) bool {
+	switch pseudo.Name {
+	case "not":
+		return !q.matchList(target, ancestors, pseudo.Not)
+
+	case "first-child":
+		_, index := q.siblings(target, ancestors)
+		return index == 0
+
+	case "last-child":
+		siblings, index := q.siblings(target, ancestors)
+		return index == len(siblings)-1
+
+	case "only-child":
+		siblings, _ := q.siblings(target, ancestors)
+		return len(siblings) == 1
+
+	case "nth-child":
+		_, index := q.siblings(target, ancestors)
+		return pseudo.valid && nthMatch(pseudo.a, pseudo.b, index+1)
+
+	case "nth-last-child":
+		siblings, index := q.siblings(target, ancestors)
+		return pseudo.valid && nthMatch(pseudo.a, pseudo.b, len(siblings)-index)
+
+	case "empty":
+		if target.TextContent() != "" {
+			return false
+		}
+
+		for _, child := range target.children {
+			if child.Removed() {
+				continue
+			}
+
+			if child.kind == ElementNode || child.kind == TextNode && child.TextContent() != "" {
 				return false
 			}
 		}
@@ -202,654 +440,255 @@ func (q queryCtrl) queryOne(target *Markup, sel *Selector) bool {
 		return true
 	}
 
-	if sel.AttrName != "" && !q.attrFor(target, sel.AttrName, sel.AttrValue, sel.AttrOp) {
-		return false
+	return false
+}
+
+// nthMatch returns true/false if the position is a+b for a positive or zero n.
+func nthMatch(a int, b int, position int) bool {
+	if a == 0 {
+		return position == b
 	}
 
-	return true
+	n := position - b
+	return n%a == 0 && n/a >= 0
 }
 
-var (
-	dot         = byte('.')
-	coma        = byte(',')
-	hash        = byte('#')
-	space       = byte(' ')
-	bracket     = byte('[')
-	endbracket  = byte(']')
-	orderOpen   = byte('(')
-	orderClosed = byte(')')
-)
+func (queryCtrl) attrFor(target *Markup, sel AttrSelector) bool {
+	attr, err := GetAttr(target, sel.Name)
+	if err != nil {
+		return false
+	}
 
-// ParseSelector returns the giving selector parsed out into its individual
-// sections.
-func (q queryCtrl) ParseSelector(sel string) []*Selector {
-	var sels []*Selector
-	items := []byte(sel)
-	itemsLen := len(items)
+	_, val := attr.Render()
+	attrVal := sel.Value
 
-	var index int
-	var doChildren bool
-	var seenSpace bool
-	var seenComa bool
+	if sel.CaseInsensitive {
+		val = strings.ToLower(val)
+		attrVal = strings.ToLower(attrVal)
+	}
 
-	var child *Selector
-	sels = append(sels, &Selector{})
+	switch sel.Op {
+	case "":
+		return true
+
+	case exactMatch:
+		return val == attrVal
 
-	{
+	case exactWordInListMatch:
+		if attrVal == "" {
+			return false
+		}
 
-	parseLoop:
-		for {
-			if index >= itemsLen {
-				break
+		for _, item := range strings.Fields(val) {
+			if item == attrVal {
+				return true
 			}
+		}
 
-			item := items[index]
-			if seenSpace && item == space {
-				index++
-				continue
-			}
+	case beginOrExactlyMatch:
+		return val == attrVal || strings.HasPrefix(val, attrVal+"-")
+
+	case prefixMatch:
+		return attrVal != "" && strings.HasPrefix(val, attrVal)
+
+	case suffixMatch:
+		return attrVal != "" && strings.HasSuffix(val, attrVal)
+
+	case containsMatch:
+		return attrVal != "" && strings.Contains(val, attrVal)
+	}
+
+	return false
+}
+
+func (queryCtrl) tagFor(target *Markup, tag string) bool {
+	return target.tagname == strings.ToLower(tag)
+}
+
+func (queryCtrl) classFor(target *Markup, class string) bool {
+	for _, attr := range GetAttrs(target, "class", "") {
+		_, val := attr.Render()
 
-			if seenSpace && item != space {
-				seenSpace = false
+		for _, item := range strings.Fields(val) {
+			if item == class {
+				return true
 			}
+		}
+	}
 
-			csel := sels[len(sels)-1]
+	return false
+}
 
-			switch item {
-			case space:
-				if seenComa {
-					doChildren = false
-				}
+func (queryCtrl) idFor(target *Markup, id string) bool {
+	attr, err := GetAttr(target, "id")
+	if err != nil {
+		return false
+	}
 
-				if !seenComa {
-					doChildren = true
-				}
+	if _, val := attr.Render(); val == id {
+		return true
+	}
 
-				if !seenComa {
-					doChildren = true
-				}
+	return false
+}
 
-				if !seenSpace && !seenComa {
-					csel.Children = append(csel.Children, &Selector{})
-				}
+//==============================================================================
 
-				index++
-				seenSpace = true
-				continue parseLoop
-
-			case orderOpen:
-				var order []byte
-				order = append(order, item)
-
-				{
-				nOrderLoop:
-					for {
-						index++
-
-						if index >= itemsLen {
-							ordered := string(order)
-							ordered = strings.TrimPrefix(ordered, "(")
-							ordered = strings.TrimSuffix(ordered, ")")
-
-							if doChildren {
-								if child.Order == nil {
-									child.Order = make(map[string]string)
-								}
-							} else {
-								if csel.Order == nil {
-									csel.Order = make(map[string]string)
-								}
-							}
-
-							for _, or := range strings.Split(ordered, ",") {
-								ors := strings.Split(or, ":")
-								if len(ors) < 2 {
-									continue
-								}
-
-								if doChildren {
-									child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-								} else {
-									csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-								}
-							}
-
-							break parseLoop
-						}
-
-						item = items[index]
-						switch item {
-						case orderClosed:
-							order = append(order, item)
-
-							ordered := string(order)
-							ordered = strings.TrimPrefix(ordered, "(")
-							ordered = strings.TrimSuffix(ordered, ")")
-
-							if doChildren {
-								if child.Order == nil {
-									child.Order = make(map[string]string)
-								}
-							} else {
-								if csel.Order == nil {
-									csel.Order = make(map[string]string)
-								}
-							}
-
-							for _, or := range strings.Split(ordered, ",") {
-								ors := strings.Split(or, ":")
-								if len(ors) < 2 {
-									continue
-								}
-
-								if doChildren {
-									child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-								} else {
-									csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-								}
-							}
-
-							continue nOrderLoop
-						default:
-							order = append(order, item)
-							continue nOrderLoop
-						}
-					}
-				}
+// maxCachedSelectors defines the total compiled selectors kept by the cache
+// before it is reset.
+const maxCachedSelectors = 1024
 
-			case coma:
-				seenComa = true
+// selectorCache keeps the compiled selectors used by Query and QueryAll, as
+// they are run for the targets of components on every render.
+var selectorCache = struct {
+	ml    sync.RWMutex
+	items map[string][]*Selector
+}{
+	items: make(map[string][]*Selector),
+}
 
-				sels = append(sels, &Selector{})
+// compile returns the cached selectors for the selector, parsing them if not
+// yet cached. Invalid selectors are cached as nil.
+func (q queryCtrl) compile(sel string) []*Selector {
+	selectorCache.ml.RLock()
+	sels, ok := selectorCache.items[sel]
+	selectorCache.ml.RUnlock()
 
-				index++
-				continue parseLoop
+	if ok {
+		return sels
+	}
 
-			case dot:
-				if doChildren {
-					child = csel.Children[len(csel.Children)-1]
-				}
+	sels, _ = q.Parse(sel)
 
-				{
-					var blk []byte
-
-				dotLoop:
-					for {
-						index++
-
-						if index >= itemsLen {
-							if len(blk) != 0 {
-								if doChildren {
-									child.Classes = append(child.Classes, string(blk))
-								} else {
-									csel.Classes = append(csel.Classes, string(blk))
-								}
-
-								blk = nil
-							}
-
-							break dotLoop
-						}
-
-						item = items[index]
-
-						switch item {
-						case dot:
-							if doChildren {
-								child.Classes = append(child.Classes, string(blk))
-							} else {
-								csel.Classes = append(csel.Classes, string(blk))
-							}
-
-							blk = nil
-							continue dotLoop
-						case space, coma, hash:
-							if doChildren {
-								child.Classes = append(child.Classes, string(blk))
-							} else {
-								csel.Classes = append(csel.Classes, string(blk))
-							}
-
-							blk = nil
-							continue parseLoop
-						case orderOpen:
-							var order []byte
-							order = append(order, item)
-
-							{
-							orderLoop:
-								for {
-									index++
-
-									item = items[index]
-									// fmt.Printf("order: %+q : %+q -> %d : %d\n", order, item, index, itemsLen)
-
-									if index >= itemsLen {
-										ordered := string(order)
-										ordered = strings.TrimPrefix(ordered, "(")
-										ordered = strings.TrimSuffix(ordered, ")")
-
-										if doChildren {
-											if child.Order == nil {
-												child.Order = make(map[string]string)
-											}
-										} else {
-											if csel.Order == nil {
-												csel.Order = make(map[string]string)
-											}
-										}
-
-										for _, or := range strings.Split(ordered, ",") {
-											ors := strings.Split(or, ":")
-											if len(ors) < 2 {
-												continue
-											}
-
-											if doChildren {
-												child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											} else {
-												csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											}
-										}
-
-										continue dotLoop
-									}
-
-									switch item {
-									case orderClosed:
-										order = append(order, item)
-
-										ordered := string(order)
-										ordered = strings.TrimPrefix(ordered, "(")
-										ordered = strings.TrimSuffix(ordered, ")")
-
-										if doChildren {
-											if child.Order == nil {
-												child.Order = make(map[string]string)
-											}
-										} else {
-											if csel.Order == nil {
-												csel.Order = make(map[string]string)
-											}
-										}
-
-										for _, or := range strings.Split(ordered, ",") {
-											ors := strings.Split(or, ":")
-											if len(ors) < 2 {
-												continue
-											}
-
-											if doChildren {
-												child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											} else {
-												csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											}
-										}
-
-										continue dotLoop
-									default:
-										order = append(order, item)
-										continue orderLoop
-									}
-
-								}
-							}
-						}
-
-						blk = append(blk, item)
-					}
-				}
+	selectorCache.ml.Lock()
+	defer selectorCache.ml.Unlock()
 
-				continue parseLoop
+	if len(selectorCache.items) >= maxCachedSelectors {
+		selectorCache.items = make(map[string][]*Selector)
+	}
 
-			case hash:
-				if doChildren {
-					child = csel.Children[len(csel.Children)-1]
-				}
+	selectorCache.items[sel] = sels
 
-				{
-					var blk []byte
-
-				hashLoop:
-					for {
-						index++
-
-						if index >= itemsLen {
-							if doChildren {
-								child.ID = string(blk)
-							} else {
-								csel.ID = string(blk)
-							}
-
-							blk = nil
-							break hashLoop
-						}
-
-						item = items[index]
-
-						switch item {
-						case orderOpen:
-
-							var order []byte
-							order = append(order, item)
-
-							{
-							hashOrderLoop:
-								for {
-									index++
-
-									item = items[index]
-									if index >= itemsLen {
-										ordered := string(order)
-										ordered = strings.TrimPrefix(ordered, "(")
-										ordered = strings.TrimSuffix(ordered, ")")
-
-										if doChildren {
-											if child.Order == nil {
-												child.Order = make(map[string]string)
-											}
-										} else {
-											if csel.Order == nil {
-												csel.Order = make(map[string]string)
-											}
-										}
-
-										for _, or := range strings.Split(ordered, ",") {
-											ors := strings.Split(or, ":")
-											if len(ors) < 2 {
-												continue
-											}
-
-											if doChildren {
-												child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											} else {
-												csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											}
-										}
-
-										continue hashLoop
-									}
-
-									switch item {
-									case orderClosed:
-										order = append(order, item)
-
-										ordered := string(order)
-										ordered = strings.TrimPrefix(ordered, "(")
-										ordered = strings.TrimSuffix(ordered, ")")
-
-										if doChildren {
-											if child.Order == nil {
-												child.Order = make(map[string]string)
-											}
-										} else {
-											if csel.Order == nil {
-												csel.Order = make(map[string]string)
-											}
-										}
-
-										for _, or := range strings.Split(ordered, ",") {
-											ors := strings.Split(or, ":")
-											if len(ors) < 2 {
-												continue
-											}
-
-											if doChildren {
-												child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											} else {
-												csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											}
-										}
-
-										continue hashLoop
-									default:
-										order = append(order, item)
-										continue hashOrderLoop
-									}
-
-								}
-							}
-
-						case dot, space, coma:
-							if doChildren {
-								child.ID = string(blk)
-							} else {
-								csel.ID = string(blk)
-							}
-
-							blk = nil
-							continue parseLoop
-
-						}
-
-						blk = append(blk, item)
-					}
-				}
+	return sels
+}
 
-			case bracket:
-				if doChildren {
-					child = csel.Children[len(csel.Children)-1]
-				}
+// ParseSelector returns the giving selector parsed out into its individual
+// sections, or nil if the selector is invalid.
+func (q queryCtrl) ParseSelector(sel string) []*Selector {
+	sels, err := q.Parse(sel)
+	if err != nil {
+		return nil
+	}
+
+	return sels
+}
+
+// Parse returns the giving selector list parsed out into a selector for each
+// of its complex selectors, or ErrInvalidSelector if the selector is invalid.
+func (q queryCtrl) Parse(sel string) ([]*Selector, error) {
+	tokens, err := tokenizeSelector(sel)
+	if err != nil {
+		return nil, err
+	}
+
+	var sels []*Selector
+
+	var head, current *Selector
+	var combinator string
+
+	for _, token := range tokens {
+		switch token.kind {
+		case commaToken:
+			if current == nil || combinator != "" {
+				return nil, ErrInvalidSelector
+			}
 
-				{
-					var blk []byte
-					blk = append(blk, item)
+			sels = append(sels, head)
+			head, current = nil, nil
+			continue
 
-					for {
-						index++
+		case combinatorToken:
+			if current == nil || combinator != "" {
+				return nil, ErrInvalidSelector
+			}
 
-						if index >= itemsLen {
-							break
-						}
+			combinator = token.value
+			continue
+		}
 
-						item = items[index]
-						if item == endbracket {
-							blk = append(blk, item)
+		// tags can only start a compound selector.
+		if token.kind == tagToken && current != nil && combinator == "" {
+			return nil, ErrInvalidSelector
+		}
 
-							attr, val, op := q.splitBracketSelector(string(blk))
-							val = strings.Replace(val, "'", "", -1)
-							val = strings.Replace(val, "\"", "", -1)
+		// start a new compound selector after a combinator or comma.
+		if current == nil || combinator != "" {
+			next := &Selector{Combinator: combinator}
 
-							if doChildren {
-								child.AttrOp = op
-								child.AttrName = attr
-								child.AttrValue = val
-							} else {
-								csel.AttrOp = op
-								csel.AttrName = attr
-								csel.AttrValue = val
-							}
+			if head == nil {
+				head = next
+			} else {
+				head.Children = append(head.Children, next)
+			}
 
-							index++
-							continue parseLoop
-						}
+			current = next
+			combinator = ""
+		}
 
-						blk = append(blk, item)
-					}
+		switch token.kind {
+		case tagToken:
+			current.Tag = token.value
 
-					continue parseLoop
-				}
+		case idToken:
+			current.ID = token.value
 
-			default:
-				if doChildren {
-					child = csel.Children[len(csel.Children)-1]
-				}
+		case classToken:
+			current.Classes = append(current.Classes, token.value)
 
-				{
-					var blk []byte
-					blk = append(blk, item)
-
-				defaultLoop:
-					for {
-						index++
-
-						if index >= itemsLen {
-							if len(blk) != 0 {
-								if doChildren {
-									if child.Tag != "" {
-										break parseLoop
-									}
-
-									child.Tag = string(blk)
-									if psud := strings.Index(child.Tag, ":"); psud != -1 {
-										psuedo := child.Tag[psud:]
-										child.Tag = child.Tag[:psud]
-										child.Psuedo = psuedo
-									}
-								} else {
-									if csel.Tag != "" {
-										break parseLoop
-									}
-
-									csel.Tag = string(blk)
-									if psud := strings.Index(csel.Tag, ":"); psud != -1 {
-										psuedo := csel.Tag[psud:]
-										csel.Tag = csel.Tag[:psud]
-										csel.Psuedo = psuedo
-									}
-								}
-							}
-
-							break defaultLoop
-						}
-
-						item := items[index]
-
-						switch item {
-						case space, coma, hash, dot, bracket, endbracket:
-							if doChildren {
-								child.Tag = string(blk)
-								if psud := strings.Index(child.Tag, ":"); psud != -1 {
-									psuedo := child.Tag[psud:]
-									child.Tag = child.Tag[:psud]
-									child.Psuedo = psuedo
-								}
-							} else {
-								csel.Tag = string(blk)
-								if psud := strings.Index(csel.Tag, ":"); psud != -1 {
-									psuedo := csel.Tag[psud:]
-									csel.Tag = csel.Tag[:psud]
-									csel.Psuedo = psuedo
-								}
-							}
-
-							continue parseLoop
-						case orderOpen:
-
-							var order []byte
-							order = append(order, item)
-
-							{
-							defaultOrderLoop:
-								for {
-									index++
-
-									item = items[index]
-									if index >= itemsLen {
-										reodered := string(order)
-										ordered := string(order)
-										ordered = strings.TrimPrefix(ordered, "(")
-										ordered = strings.TrimSuffix(ordered, ")")
-
-										if doChildren {
-											if child.Order == nil {
-												child.Order = make(map[string]string)
-											}
-										} else {
-											if csel.Order == nil {
-												csel.Order = make(map[string]string)
-											}
-										}
-
-										insertos := strings.Split(ordered, ",")
-										if len(insertos) == 1 && insertos[0] == ordered {
-											if strings.Contains(string(blk), ":") {
-												blk = append(blk, []byte(reodered)...)
-											}
-
-											continue defaultLoop
-										}
-
-										for _, or := range insertos {
-											ors := strings.Split(or, ":")
-											if len(ors) < 2 {
-												continue
-											}
-
-											if doChildren {
-												child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											} else {
-												csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											}
-										}
-
-										continue defaultLoop
-									}
-
-									switch item {
-									case orderClosed:
-										order = append(order, item)
-
-										reordered := string(order)
-										ordered := string(order)
-										ordered = strings.TrimPrefix(ordered, "(")
-										ordered = strings.TrimSuffix(ordered, ")")
-
-										if doChildren {
-											if child.Order == nil {
-												child.Order = make(map[string]string)
-											}
-										} else {
-											if csel.Order == nil {
-												csel.Order = make(map[string]string)
-											}
-										}
-
-										insertos := strings.Split(ordered, ",")
-										if len(insertos) == 1 && insertos[0] == ordered {
-											if strings.Contains(string(blk), ":") {
-												blk = append(blk, []byte(reordered)...)
-											}
-
-											continue defaultLoop
-										}
-
-										for _, or := range insertos {
-											ors := strings.Split(or, ":")
-											if len(ors) < 2 {
-												continue
-											}
-
-											if doChildren {
-												child.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											} else {
-												csel.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
-											}
-										}
-
-										continue defaultLoop
-									default:
-										order = append(order, item)
-										continue defaultOrderLoop
-									}
-								}
-							}
-
-						}
-
-						blk = append(blk, item)
-					}
-
-					continue parseLoop
-				}
+		case attrToken:
+			attr, err := parseAttrSelector(token.value)
+			if err != nil {
+				return nil, err
+			}
 
+			if len(current.Attrs) == 0 {
+				current.AttrName = attr.Name
+				current.AttrOp = attr.Op
+				current.AttrValue = attr.Value
 			}
 
-			index++
+			current.Attrs = append(current.Attrs, attr)
+
+		case pseudoToken:
+			pseudo, err := q.parsePseudoSelector(token.value, token.args, token.hasArgs)
+			if err != nil {
+				return nil, err
+			}
+
+			current.Psuedo += token.raw
+			current.Pseudos = append(current.Pseudos, pseudo)
+
+		case orderToken:
+			if current.Order == nil {
+				current.Order = make(map[string]string)
+			}
+
+			for _, or := range strings.Split(token.value, ",") {
+				ors := strings.Split(or, ":")
+				if len(ors) < 2 {
+					continue
+				}
+
+				current.Order[strings.TrimSpace(ors[0])] = strings.TrimSpace(ors[1])
+			}
 		}
 	}
 
-	return sels
+	if current == nil || combinator != "" {
+		return nil, ErrInvalidSelector
+	}
+
+	return append(sels, head), nil
 }
 
 var (
@@ -861,133 +700,349 @@ var (
 	containsMatch        = "*="
 )
 
-// splitBracketSelector returns the attribute name, value and operator if available
-// for attribute selector.
-func (queryCtrl) splitBracketSelector(sel string) (string, string, string) {
-	sel = strings.TrimPrefix(sel, "[")
-	sel = strings.TrimSuffix(sel, "]")
+// parseAttrSelector returns the attribute selector for the content of a
+// attribute selector's brackets, eg 'rel|="bull" i'.
+func parseAttrSelector(sel string) (AttrSelector, error) {
+	var attr AttrSelector
 
-	switch {
-	case strings.Contains(sel, exactWordInListMatch):
-		splits := strings.Split(sel, exactWordInListMatch)
-		if len(splits) == 1 {
-			return splits[0], "", exactWordInListMatch
+	opIndex := strings.IndexAny(sel, "~|^$*=")
+	if opIndex == -1 {
+		attr.Name = strings.ToLower(strings.TrimSpace(sel))
+		if attr.Name == "" {
+			return attr, ErrInvalidSelector
 		}
 
-		return splits[0], splits[1], exactWordInListMatch
+		return attr, nil
+	}
+
+	attr.Name = strings.ToLower(strings.TrimSpace(sel[:opIndex]))
+	rest := sel[opIndex:]
+
+	switch {
+	case strings.HasPrefix(rest, exactMatch):
+		attr.Op = exactMatch
+	case len(rest) > 1 && rest[1] == '=':
+		attr.Op = rest[:2]
+	default:
+		return attr, ErrInvalidSelector
+	}
+
+	if attr.Name == "" {
+		return attr, ErrInvalidSelector
+	}
+
+	rest = strings.TrimSpace(rest[len(attr.Op):])
 
-	case strings.Contains(sel, beginOrExactlyMatch):
-		splits := strings.Split(sel, beginOrExactlyMatch)
-		if len(splits) == 1 {
-			return splits[0], "", beginOrExactlyMatch
+	if rest != "" && (rest[0] == '"' || rest[0] == '\'') {
+		end := strings.IndexByte(rest[1:], rest[0])
+		if end == -1 {
+			return attr, ErrInvalidSelector
 		}
 
-		return splits[0], splits[1], beginOrExactlyMatch
+		attr.Value = rest[1 : end+1]
+		rest = strings.TrimSpace(rest[end+2:])
+	} else {
+		fields := strings.Fields(rest)
+		if len(fields) != 0 {
+			attr.Value = fields[0]
+			rest = strings.TrimSpace(strings.TrimPrefix(rest, fields[0]))
+		}
+	}
+
+	switch rest {
+	case "":
+	case "i", "I":
+		attr.CaseInsensitive = true
+	case "s", "S":
+	default:
+		return attr, ErrInvalidSelector
+	}
 
-	case strings.Contains(sel, prefixMatch):
-		splits := strings.Split(sel, prefixMatch)
-		if len(splits) == 1 {
-			return splits[0], "", prefixMatch
+	return attr, nil
+}
+
+// parsePseudoSelector returns the pseudo selector for the giving name, which
+// starts with ':' for pseudo-elements, and arguments.
+func (q queryCtrl) parsePseudoSelector(name string, args string, hasArgs bool) (PseudoSelector, error) {
+	pseudo := PseudoSelector{Name: strings.ToLower(name), Args: args}
+
+	switch pseudo.Name {
+	case "not":
+		if !hasArgs {
+			return pseudo, ErrInvalidSelector
 		}
 
-		return splits[0], splits[1], prefixMatch
+		not, err := q.Parse(args)
+		if err != nil {
+			return pseudo, err
+		}
 
-	case strings.Contains(sel, suffixMatch):
-		splits := strings.Split(sel, suffixMatch)
-		if len(splits) == 1 {
-			return splits[0], "", suffixMatch
+		pseudo.Not = not
+
+	case "nth-child", "nth-last-child":
+		if !hasArgs {
+			return pseudo, ErrInvalidSelector
 		}
 
-		return splits[0], splits[1], suffixMatch
+		pseudo.a, pseudo.b, pseudo.valid = parseNth(args)
+	}
+
+	return pseudo, nil
+}
+
+// parseNth returns the a and b values of a an+b expression, eg '2n+1', 'odd'.
+func parseNth(expr string) (int, int, bool) {
+	expr = strings.ToLower(strings.Replace(expr, " ", "", -1))
+
+	switch expr {
+	case "odd":
+		return 2, 1, true
+	case "even":
+		return 2, 0, true
+	}
+
+	nIndex := strings.IndexByte(expr, 'n')
+	if nIndex == -1 {
+		b, err := strconv.Atoi(expr)
+		return 0, b, err == nil
+	}
 
-	case strings.Contains(sel, containsMatch):
-		splits := strings.Split(sel, containsMatch)
-		if len(splits) == 1 {
-			return splits[0], "", containsMatch
+	var a int
+	switch coefficient := expr[:nIndex]; coefficient {
+	case "", "+":
+		a = 1
+	case "-":
+		a = -1
+	default:
+		value, err := strconv.Atoi(coefficient)
+		if err != nil {
+			return 0, 0, false
 		}
 
-		return splits[0], splits[1], containsMatch
+		a = value
+	}
+
+	var b int
+	if offset := expr[nIndex+1:]; offset != "" {
+		if offset[0] != '+' && offset[0] != '-' {
+			return 0, 0, false
+		}
 
-	case strings.Contains(sel, exactMatch):
-		splits := strings.Split(sel, exactMatch)
-		if len(splits) == 1 {
-			return splits[0], "", exactMatch
+		value, err := strconv.Atoi(offset)
+		if err != nil {
+			return 0, 0, false
 		}
 
-		return splits[0], splits[1], exactMatch
+		b = value
 	}
 
-	return sel, "", ""
+	return a, b, true
 }
 
-func (queryCtrl) attrFor(target *Markup, attrName string, attrVal string, op string) bool {
-	attr, err := GetAttr(target, attrName)
-	if err != nil {
-		return false
+//==============================================================================
+
+// tokenKind defines the kind of a selectorToken.
+type tokenKind int
+
+// contains the kinds of selector tokens.
+const (
+	tagToken tokenKind = iota
+	idToken
+	classToken
+	attrToken
+	pseudoToken
+	orderToken
+	combinatorToken
+	commaToken
+)
+
+// selectorToken defines a token of a selector.
+type selectorToken struct {
+	kind    tokenKind
+	value   string
+	args    string
+	hasArgs bool
+	raw     string
+}
+
+// tokenizeSelector returns the tokens of the selector, where whitespace is
+// turned into descendant combinators only between compound selectors.
+func tokenizeSelector(sel string) ([]selectorToken, error) {
+	var tokens []selectorToken
+	var spaced bool
+
+	// push adds the token, adding a descendant combinator for the whitespace
+	// before it if between compound selectors.
+	push := func(token selectorToken) {
+		if spaced && len(tokens) != 0 && token.kind != combinatorToken && token.kind != commaToken {
+			if last := tokens[len(tokens)-1]; last.kind != combinatorToken && last.kind != commaToken {
+				tokens = append(tokens, selectorToken{kind: combinatorToken, value: DescendantCombinator})
+			}
+		}
+
+		spaced = false
+		tokens = append(tokens, token)
 	}
 
-	_, val := attr.Render()
+	for index := 0; index < len(sel); {
+		item := sel[index]
 
-	switch op {
-	case exactMatch:
-		return val == attrVal
+		switch {
+		case item == ' ' || item == '\t' || item == '\n' || item == '\r' || item == '\f':
+			spaced = true
+			index++
 
-	case exactWordInListMatch:
-		splits := strings.Split(val, " ")
-		for _, item := range splits {
-			if item != attrVal {
-				continue
+		case item == '>' || item == '+' || item == '~':
+			push(selectorToken{kind: combinatorToken, value: string(item)})
+			index++
+
+		case item == ',':
+			push(selectorToken{kind: commaToken})
+			index++
+
+		case item == '#' || item == '.':
+			name, next := readSelectorName(sel, index+1)
+			if name == "" {
+				return nil, ErrInvalidSelector
 			}
 
-			return true
-		}
+			kind := idToken
+			if item == '.' {
+				kind = classToken
+			}
 
-	case beginOrExactlyMatch:
-		if strings.HasPrefix(val, attrVal+"-") {
-			return true
-		}
+			push(selectorToken{kind: kind, value: name})
+			index = next
 
-		return val == attrVal
+		case item == '[':
+			end := closingIndex(sel, index, '[', ']')
+			if end == -1 {
+				return nil, ErrInvalidSelector
+			}
 
-	case prefixMatch:
-		return strings.HasPrefix(val, attrVal)
+			push(selectorToken{kind: attrToken, value: sel[index+1 : end]})
+			index = end + 1
 
-	case suffixMatch:
-		return strings.HasSuffix(val, attrVal)
+		case item == ':':
+			start := index
+			index++
 
-	case containsMatch:
-		return strings.Contains(val, attrVal)
+			// pseudo-elements are kept with their leading colon, so they never match.
+			var element string
+			if index < len(sel) && sel[index] == ':' {
+				element = ":"
+				index++
+			}
 
-	default:
-		return true
+			name, next := readSelectorName(sel, index)
+			if name == "" {
+				return nil, ErrInvalidSelector
+			}
+
+			token := selectorToken{kind: pseudoToken, value: element + name}
+			index = next
+
+			if index < len(sel) && sel[index] == '(' {
+				end := closingIndex(sel, index, '(', ')')
+				if end == -1 {
+					return nil, ErrInvalidSelector
+				}
+
+				token.args = strings.TrimSpace(sel[index+1 : end])
+				token.hasArgs = true
+				index = end + 1
+			}
+
+			token.raw = sel[start:index]
+			push(token)
+
+		case item == '(':
+			end := closingIndex(sel, index, '(', ')')
+			if end == -1 {
+				return nil, ErrInvalidSelector
+			}
+
+			push(selectorToken{kind: orderToken, value: sel[index+1 : end]})
+			index = end + 1
+
+		case item == '*':
+			push(selectorToken{kind: tagToken, value: "*"})
+			index++
+
+		default:
+			name, next := readSelectorName(sel, index)
+			if name == "" {
+				return nil, ErrInvalidSelector
+			}
+
+			push(selectorToken{kind: tagToken, value: name})
+			index = next
+		}
 	}
 
-	return false
+	return tokens, nil
 }
 
-func (queryCtrl) tagFor(target *Markup, tag string) bool {
-	return target.tagname == tag
-}
+// readSelectorName returns the name starting at the index of the selector,
+// with escaped characters unescaped, and the index after it.
+func readSelectorName(sel string, index int) (string, int) {
+	var name []byte
 
-func (queryCtrl) classFor(target *Markup, class string) bool {
-	attr, err := GetAttr(target, "class")
-	if err != nil {
-		return false
+	for index < len(sel) {
+		item := sel[index]
+
+		switch {
+		case item == '\\' && index+1 < len(sel):
+			name = append(name, sel[index+1])
+			index += 2
+			continue
+
+		case item == '-' || item == '_' || item >= 0x80,
+			item >= 'a' && item <= 'z',
+			item >= 'A' && item <= 'Z',
+			item >= '0' && item <= '9':
+			name = append(name, item)
+			index++
+			continue
+		}
+
+		break
 	}
 
-	_, val := attr.Render()
-	return strings.Contains(val, class)
+	return string(name), index
 }
 
-func (queryCtrl) idFor(target *Markup, id string) bool {
-	attr, err := GetAttr(target, "id")
-	if err != nil {
-		return false
-	}
+// closingIndex returns the index of the closing character matching the opening
+// character at the index of the selector, skipping quoted strings and nested
+// pairs, or -1 if not closed.
+func closingIndex(sel string, index int, open byte, close byte) int {
+	var depth int
+	var quote byte
 
-	if _, val := attr.Render(); val == id {
-		return true
+	for ; index < len(sel); index++ {
+		item := sel[index]
+
+		switch {
+		case quote != 0:
+			if item == '\\' {
+				index++
+			} else if item == quote {
+				quote = 0
+			}
+
+		case item == '"' || item == '\'':
+			quote = item
+
+		case item == open:
+			depth++
+
+		case item == close:
+			depth--
+			if depth == 0 {
+				return index
+			}
+		}
 	}
 
-	return false
+	return -1
 }
@@ -1,6 +1,7 @@
 package trees_test
 
 import (
+	"strings"
 	"testing"
 
 	"github.com/gu-io/gu/trees"
@@ -157,3 +158,102 @@ func TestQueries(t *testing.T) {
 	tests.Passed("Should have returned 3 elements for selector 'section.section'")
 
 }
+
+func TestQueryCombinators(t *testing.T) {
+	tree := trees.ParseTree(`
+    <body>
+      <div class="wrapper">
+        <section id="header" class="section main"></section>
+        <section id="menu" class="section"><a href="#home" lang="en-US">Home</a></section>
+        <p class="note"></p>
+        <section id="content" class="sections"><div><a href="/docs.pdf" rel="external nofollow">Docs</a></div></section>
+      </div>
+    </body>
+  `)[0]
+
+	cases := []struct {
+		selector string
+		ids      []string
+	}{
+		{"body > div > section", []string{"header", "menu", "content"}},
+		{"body > section", nil},
+		{"div section.section", []string{"header", "menu"}},
+		{"section#header + section", []string{"menu"}},
+		{"section#header + p", nil},
+		{"section#menu ~ section", []string{"content"}},
+		{"section:first-child", []string{"header"}},
+		{"div.wrapper > :last-child", []string{"content"}},
+		{"section:nth-child(even)", []string{"menu", "content"}},
+		{"section:nth-child(2)", []string{"menu"}},
+		{"section:nth-last-child(n+3)", []string{"header", "menu"}},
+		{"section:not(.main, #content)", []string{"menu"}},
+		{"section:empty", []string{"header"}},
+		{"section:has-focus", nil},
+		{"section::before", nil},
+		{"section[id^=he], section[id$='nt']", []string{"header", "content"}},
+		{"section[class~=section]", []string{"header", "menu"}},
+		{"section[class*=ion]", []string{"header", "menu", "content"}},
+		{"section[ID=MENU i]", []string{"menu"}},
+	}
+
+	for _, item := range cases {
+		var ids []string
+		for _, found := range trees.Query.QueryAll(tree, item.selector) {
+			id, _ := trees.GetAttr(found, "id")
+			_, value := id.Render()
+			ids = append(ids, value)
+		}
+
+		if strings.Join(ids, ",") != strings.Join(item.ids, ",") {
+			tests.Failed("Should have matched %q for %q: %q", item.ids, item.selector, ids)
+		}
+		tests.Passed("Should have matched %q for %q", item.ids, item.selector)
+	}
+
+	if item := trees.Query.Query(tree, "a[lang|=en]"); item == nil {
+		tests.Failed("Should have matched attribute with language prefix")
+	}
+	tests.Passed("Should have matched attribute with language prefix")
+
+	if item := trees.Query.Query(tree, "section > div a[rel~=external][href$='.pdf']"); item == nil {
+		tests.Failed("Should have matched element with multiple attribute selectors")
+	}
+	tests.Passed("Should have matched element with multiple attribute selectors")
+
+	if item := trees.Query.Query(tree, "section > a[href]"); !trees.Query.Matches(item, "div > section#menu > a") {
+		tests.Failed("Should have matched element against selector using its parents")
+	}
+	tests.Passed("Should have matched element against selector using its parents")
+
+	trees.Query.Query(tree, "#menu").Remove()
+
+	if items := trees.Query.QueryAll(tree, "section"); len(items) != 2 {
+		tests.Failed("Should have skipped removed elements: %d", len(items))
+	}
+	tests.Passed("Should have skipped removed elements")
+}
+
+func TestParseInvalidSelectors(t *testing.T) {
+	for _, selector := range []string{"", "div >", "> div", "div,,a", "div[rel", "a:not(", "div > > a", "div.", "section div*"} {
+		if _, err := trees.Query.Parse(selector); err != trees.ErrInvalidSelector {
+			tests.Failed("Should have failed to parse invalid selector %q", selector)
+		}
+		tests.Passed("Should have failed to parse invalid selector %q", selector)
+
+		if item := trees.Query.Query(trees.NewMarkup("div", false), selector); item != nil {
+			tests.Failed("Should have matched nothing for invalid selector %q", selector)
+		}
+		tests.Passed("Should have matched nothing for invalid selector %q", selector)
+	}
+
+	sels, err := trees.Query.Parse("div.list > a.item:not([disabled]) ~ span")
+	if err != nil {
+		tests.Failed("Should have parsed complex selector: %+q", err)
+	}
+	tests.Passed("Should have parsed complex selector")
+
+	if selector := sels[0].String(); selector != "div.list > a.item:not([disabled]) ~ span" {
+		tests.Failed("Should have returned complex selector from parsed selector: %q", selector)
+	}
+	tests.Passed("Should have returned complex selector from parsed selector")
+}
@@ -75,11 +75,8 @@ type Styles interface {
 func EqualStyles(e, em Styles) bool {
 	old := em.Styles()
 
-	if len(old) < 1 {
-		if len(e.Styles()) > 0 {
-			return false
-		}
-		return true
+	if len(old) != len(e.Styles()) {
+		return false
 	}
 
 	for _, oa := range old {
@@ -108,11 +105,8 @@ type Attributes interface {
 func EqualAttributes(e, em Attributes) bool {
 	old := em.Attributes()
 
-	if len(old) < 1 {
-		if len(e.Attributes()) > 0 {
-			return false
-		}
-		return true
+	if len(old) != len(e.Attributes()) {
+		return false
 	}
 
 	for _, oa := range old {
@@ -262,6 +256,7 @@ func ReplaceStyle(m Styles, name string, val string) {
 	}
 
 	stylm.Value = val
+	invalidateHash(m)
 }
 
 // ReplaceAttribute replaces a specific attribute with the given
@@ -278,6 +273,7 @@ func ReplaceAttribute(m Attributes, name string, val string) {
 	}
 
 	attrm.Value = val
+	invalidateHash(m)
 }
 
 // ReplaceORAddStyle replaces a specific style with the given
@@ -296,6 +292,7 @@ func ReplaceORAddStyle(m Properties, name string, val string) {
 	}
 
 	stylm.Value = val
+	invalidateHash(m)
 }
 
 // ReplaceORAddAttribute replaces a specific attribute with the given
@@ -310,12 +307,36 @@ func ReplaceORAddAttribute(m Properties, name string, val string) {
 
 	if attrm, ok := attr.(*Attribute); ok {
 		attrm.Value = val
+		invalidateHash(m)
 		return
 	}
 
 	if classlist, ok := attr.(*ClassList); ok {
 		classlist.list = nil
 		classlist.list = append(classlist.list, val)
+		invalidateHash(m)
+	}
+}
+
+// RemoveAttribute removes the attributes with the given name from the markup.
+func RemoveAttribute(m *Markup, name string) {
+	attrs := m.attrs[:0]
+
+	for _, attr := range m.attrs {
+		if attrName, _ := attr.Render(); attrName != name {
+			attrs = append(attrs, attr)
+		}
+	}
+
+	m.attrs = attrs
+	m.InvalidateHash()
+}
+
+// invalidateHash invalidates the content hash of the properties if they are a
+// *Markup.
+func invalidateHash(m interface{}) {
+	if markup, ok := m.(*Markup); ok {
+		markup.InvalidateHash()
 	}
 }
 
@@ -1,6 +1,8 @@
 package gu
 
-import "sync"
+import (
+	"sync"
+)
 
 // ViewUpdateSubscriber defines a interface that which is used to subscribe specifically for
 // events  ViewUpdate type.
@@ -31,9 +33,19 @@ func (sn *ViewUpdateHandler) Receive(elem ViewUpdate) {
 // Handle takes the giving value and asserts the expected value to match the
 // ViewUpdate type then passes it to the Receive method.
 func (sn *ViewUpdateHandler) Handle(receive interface{}) {
-	if elem, ok := receive.(ViewUpdate); ok {
-		sn.Receive(elem)
+	sn.Accept(receive)
+}
+
+// Accept passes the giving value to the Receive method if it is of the
+// ViewUpdate type, returning true/false if it was accepted.
+func (sn *ViewUpdateHandler) Accept(receive interface{}) bool {
+	elem, ok := receive.(ViewUpdate)
+	if !ok {
+		return false
 	}
+
+	sn.Receive(elem)
+	return true
 }
 
 //=========================================================================================================
@@ -47,7 +59,8 @@ type ViewUpdateNotification struct {
 	register   map[ViewUpdateSubscriber]int
 }
 
-// NewViewUpdateNotificationWith returns a new instance of ViewUpdateNotification.
+// NewViewUpdateNotificationWith returns a new instance of ViewUpdateNotification
+// which only delivers events that pass the provided validation.
 func NewViewUpdateNotificationWith(validation func(ViewUpdate) bool) *ViewUpdateNotification {
 	var elem ViewUpdateNotification
 
@@ -57,7 +70,7 @@ func NewViewUpdateNotificationWith(validation func(ViewUpdate) bool) *ViewUpdate
 	return &elem
 }
 
-// NewViewUpdateNotification returns a new instance of NewViewUpdateNotification.
+// NewViewUpdateNotification returns a new instance of ViewUpdateNotification.
 func NewViewUpdateNotification() *ViewUpdateNotification {
 	var elem ViewUpdateNotification
 	elem.register = make(map[ViewUpdateSubscriber]int, 0)
@@ -73,7 +86,12 @@ func (sn *ViewUpdateNotification) UnNotify(sub ViewUpdateSubscriber) {
 			return
 		}
 
+		delete(sn.register, sub)
 		sn.subs = append(sn.subs[:index], sn.subs[index+1:]...)
+
+		for next, item := range sn.subs[index:] {
+			sn.register[item] = index + next
+		}
 	})
 }
 
@@ -81,6 +99,10 @@ func (sn *ViewUpdateNotification) UnNotify(sub ViewUpdateSubscriber) {
 // a new event of the given ViewUpdate type.
 func (sn *ViewUpdateNotification) Notify(sub ViewUpdateSubscriber) {
 	sn.do(func() {
+		if _, ok := sn.register[sub]; ok {
+			return
+		}
+
 		sn.register[sub] = len(sn.subs)
 		sn.subs = append(sn.subs, sub)
 	})
@@ -89,23 +111,28 @@ func (sn *ViewUpdateNotification) Notify(sub ViewUpdateSubscriber) {
 // Handle takes the giving value and asserts the expected value to be of
 // the type and pass on to it's underline subscribers else ignoring the event.
 func (sn *ViewUpdateNotification) Handle(elem interface{}) {
-	if elemEvent, ok := elem.(ViewUpdate); ok {
-		if sn.validation != nil && sn.validation(elemEvent) {
-			sn.do(func() {
-				for _, sub := range sn.subs {
-					sub.Receive(elemEvent)
-				}
-			})
+	sn.Accept(elem)
+}
 
-			return
-		}
+// Accept passes the giving value to the underline subscribers if it is of the
+// type and passes the validation, returning true/false if it was accepted.
+func (sn *ViewUpdateNotification) Accept(elem interface{}) bool {
+	elemEvent, ok := elem.(ViewUpdate)
+	if !ok {
+		return false
+	}
 
-		sn.do(func() {
-			for _, sub := range sn.subs {
-				sub.Receive(elemEvent)
-			}
-		})
+	if sn.validation != nil && !sn.validation(elemEvent) {
+		return false
 	}
+
+	sn.do(func() {
+		for _, sub := range sn.subs {
+			sub.Receive(elemEvent)
+		}
+	})
+
+	return true
 }
 
 // do performs action with the mutex locked and unlocked appropriately, ensuring safe
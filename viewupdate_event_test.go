@@ -0,0 +1,53 @@
+package gu
+
+import (
+	"testing"
+
+	"github.com/influx6/faux/tests"
+)
+
+// TestViewUpdateNotification validates the delivery, validation and filters of
+// ViewUpdate events through the generated ViewUpdateHandler and ViewUpdateNotification.
+func TestViewUpdateNotification(t *testing.T) {
+	var received int
+	handler := NewViewUpdateHandler(func(ViewUpdate) {
+		received++
+	})
+
+	var elem ViewUpdate
+
+	if handler.Accept(struct{}{}) || !handler.Accept(elem) || received != 1 {
+		tests.Failed("Should have accepted only ViewUpdate events: %d", received)
+	}
+	tests.Passed("Should have accepted only ViewUpdate events")
+
+	received = 0
+
+	notifier := NewViewUpdateNotification()
+	notifier.Notify(handler)
+	notifier.Notify(handler)
+
+	notifier.Handle(elem)
+	notifier.Handle(struct{}{})
+
+	if received != 1 {
+		tests.Failed("Should have delivered ViewUpdate event once to a subscriber added twice: %d", received)
+	}
+	tests.Passed("Should have delivered ViewUpdate event once to a subscriber added twice")
+
+	notifier.UnNotify(handler)
+	notifier.Handle(elem)
+
+	if received != 1 {
+		tests.Failed("Should have stopped delivering ViewUpdate events after UnNotify: %d", received)
+	}
+	tests.Passed("Should have stopped delivering ViewUpdate events after UnNotify")
+
+	invalid := NewViewUpdateNotificationWith(func(ViewUpdate) bool { return false })
+	invalid.Notify(handler)
+
+	if invalid.Accept(elem) || received != 1 {
+		tests.Failed("Should have not delivered ViewUpdate event failing validation: %d", received)
+	}
+	tests.Passed("Should have not delivered ViewUpdate event failing validation")
+}